@@ -168,7 +168,8 @@ func (a *Anonymizer) AnonymizeDNSLogLine(logEntry string) string {
 		parts := strings.Split(match, `"`)
 		if len(parts) >= 2 {
 			domain := parts[1]
-			if strings.HasSuffix(domain, ".domain") {
+			// the names of the questions are fully qualified
+			if strings.HasSuffix(strings.TrimSuffix(domain, "."), ".domain") {
 				return match
 			}
 			randomDomain := generateRandomString(10) + ".domain"
@@ -51,6 +51,9 @@ func TestAnonymizeDNSLogLine(t *testing.T) {
 	result := anonymizer.AnonymizeDNSLogLine(testLog)
 	require.NotEqual(t, testLog, result)
 	assert.NotContains(t, result, "example.com")
+
+	anonymizedLog := `2024-04-23T20:01:11+02:00 TRAC client/internal/dns/local.go:25: received question: dns.Question{Name:"www.anon-abcde.domain.", Qtype:0x1c, Qclass:0x1}`
+	assert.Equal(t, anonymizedLog, anonymizer.AnonymizeDNSLogLine(anonymizedLog), "anonymized domains should be kept")
 }
 
 func TestAnonymizeDomain(t *testing.T) {
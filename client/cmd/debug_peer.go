@@ -0,0 +1,206 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/netbirdio/netbird/client/internal/peer"
+	"github.com/netbirdio/netbird/client/proto"
+)
+
+var debugPeerFollow bool
+
+var debugPeerCmd = &cobra.Command{
+	Use:   "peer <name>",
+	Short: "Show how the connection to a peer is established",
+	Long: `Shows the ICE candidates gathered for a peer, the candidate pairs that were checked and why they failed, ` +
+		`the relay server, the last WireGuard handshake and the transfer counters. ` +
+		`The peer is selected by its FQDN, hostname, NetBird IP or public key. ` +
+		`With --follow the state transitions of the connection are printed as they happen.`,
+	Example: "  netbird debug peer peer-a.netbird.cloud --follow",
+	Args:    cobra.ExactArgs(1),
+	RunE:    debugPeer,
+}
+
+func debugPeer(cmd *cobra.Command, args []string) error {
+	conn, err := getClient(cmd)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			log.Errorf(errCloseConnection, err)
+		}
+	}()
+
+	client := proto.NewDaemonServiceClient(conn)
+	stream, err := client.DebugPeer(cmd.Context(), &proto.DebugPeerRequest{
+		Peer:   args[0],
+		Follow: debugPeerFollow,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to debug peer: %v", status.Convert(err).Message())
+	}
+
+	var lastSummary string
+	for first := true; ; first = false {
+		resp, err := stream.Recv()
+		if errors.Is(err, io.EOF) || status.Code(err) == codes.Canceled {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to debug peer: %v", status.Convert(err).Message())
+		}
+
+		if first {
+			cmd.Print(parseDebugPeer(resp))
+			lastSummary = peerSummary(resp.GetState())
+			if debugPeerFollow {
+				cmd.Println("\nFollowing the connection, press Ctrl+C to stop")
+			}
+			continue
+		}
+
+		for _, event := range resp.GetEvents() {
+			cmd.Println(formatConnEvent(event))
+		}
+		if summary := peerSummary(resp.GetState()); summary != lastSummary {
+			cmd.Printf("%s %s\n", time.Now().Format(time.TimeOnly), summary)
+			lastSummary = summary
+		}
+	}
+}
+
+func parseDebugPeer(resp *proto.DebugPeerResponse) string {
+	var b strings.Builder
+	state := resp.GetState()
+
+	relayAddress := "-"
+	if state.GetRelayAddress() != "" {
+		relayAddress = state.GetRelayAddress()
+	}
+
+	fmt.Fprintf(&b, "\n %s:\n"+
+		"  NetBird IP: %s\n"+
+		"  Public key: %s\n"+
+		"  Status: %s\n"+
+		"  Connection type: %s\n"+
+		"  Relay server address: %s\n"+
+		"  Last connection update: %s\n"+
+		"  Last WireGuard handshake: %s\n"+
+		"  Transfer status (received/sent) %s/%s\n"+
+		"  Latency: %s\n",
+		state.GetFqdn(),
+		state.GetIP(),
+		state.GetPubKey(),
+		state.GetConnStatus(),
+		connectionType(state),
+		relayAddress,
+		timeAgo(state.GetConnStatusUpdate().AsTime().Local()),
+		timeAgo(state.GetLastWireguardHandshake().AsTime().Local()),
+		toIEC(state.GetBytesRx()),
+		toIEC(state.GetBytesTx()),
+		state.GetLatency().AsDuration().String(),
+	)
+
+	ice := resp.GetIce()
+	fmt.Fprintf(&b, "\n ICE agent: %s", ice.GetAgentState())
+	if ice.GetStartedAt() != nil {
+		fmt.Fprintf(&b, ", started %s", timeAgo(ice.GetStartedAt().AsTime().Local()))
+	}
+	b.WriteString("\n")
+	if ice.GetError() != "" {
+		fmt.Fprintf(&b, "  Error: %s\n", ice.GetError())
+	}
+
+	b.WriteString("  Local candidates:\n")
+	writeCandidates(&b, ice.GetLocalCandidates())
+	b.WriteString("  Remote candidates:\n")
+	writeCandidates(&b, ice.GetRemoteCandidates())
+
+	b.WriteString("  Candidate pairs (local <-> remote):\n")
+	if len(ice.GetPairs()) == 0 {
+		b.WriteString("   -\n")
+	}
+	for _, pair := range ice.GetPairs() {
+		fmt.Fprintf(&b, "   %s <-> %s: %s", formatCandidate(pair.GetLocal()), formatCandidate(pair.GetRemote()), pair.GetState())
+		var flags []string
+		if pair.GetSelected() {
+			flags = append(flags, "selected")
+		}
+		if pair.GetNominated() {
+			flags = append(flags, "nominated")
+		}
+		if len(flags) > 0 {
+			fmt.Fprintf(&b, " (%s)", strings.Join(flags, ", "))
+		}
+		if pair.GetFailureReason() != "" {
+			fmt.Fprintf(&b, ", %s", pair.GetFailureReason())
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n Connection events:\n")
+	if len(resp.GetEvents()) == 0 {
+		b.WriteString("  -\n")
+	}
+	for _, event := range resp.GetEvents() {
+		fmt.Fprintf(&b, "  %s\n", formatConnEvent(event))
+	}
+
+	return b.String()
+}
+
+func writeCandidates(b *strings.Builder, candidates []*proto.ICECandidate) {
+	if len(candidates) == 0 {
+		b.WriteString("   -\n")
+	}
+	for _, c := range candidates {
+		fmt.Fprintf(b, "   %s", formatCandidate(c))
+		if c.GetRelayProtocol() != "" {
+			fmt.Fprintf(b, " via %s", c.GetRelayProtocol())
+		}
+		if c.GetIgnoredReason() != "" {
+			fmt.Fprintf(b, " (ignored: %s)", c.GetIgnoredReason())
+		}
+		b.WriteString("\n")
+	}
+}
+
+func formatCandidate(c *proto.ICECandidate) string {
+	if c.GetAddress() == "" {
+		return "-"
+	}
+	return fmt.Sprintf("%s %s %s:%d", c.GetType(), c.GetNetwork(), c.GetAddress(), c.GetPort())
+}
+
+func formatConnEvent(event *proto.ConnEvent) string {
+	return fmt.Sprintf("%s %s", event.GetTimestamp().AsTime().Local().Format(time.TimeOnly), event.GetMessage())
+}
+
+// peerSummary is printed in follow mode when the status, the connection type or the relay server changes
+func peerSummary(state *proto.PeerState) string {
+	summary := fmt.Sprintf("status: %s, connection type: %s", state.GetConnStatus(), connectionType(state))
+	if state.GetRelayAddress() != "" {
+		summary += ", relay server: " + state.GetRelayAddress()
+	}
+	return summary
+}
+
+func connectionType(state *proto.PeerState) string {
+	if state.GetConnStatus() != peer.StatusConnected.String() {
+		return "-"
+	}
+	if state.GetRelayed() {
+		return "Relayed"
+	}
+	return "P2P"
+}
@@ -0,0 +1,102 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/status"
+
+	"github.com/netbirdio/netbird/client/proto"
+)
+
+var dnsCmd = &cobra.Command{
+	Use:   "dns",
+	Short: "Manage the DNS resolver of the client",
+	Long:  "Commands to manage the DNS resolver answering the names of the NetBird network and forwarding the nameserver group queries.",
+}
+
+var dnsFlushCmd = &cobra.Command{
+	Use:     "flush",
+	Short:   "Flush the DNS cache",
+	Long:    "Remove the responses of the upstream nameservers cached by the client. The cache is also flushed on every network map update.",
+	Example: "  netbird dns flush",
+	Args:    cobra.NoArgs,
+	RunE:    dnsFlush,
+}
+
+var dnsLogLimit int
+
+var dnsLogCmd = &cobra.Command{
+	Use:   "log",
+	Short: "Show the DNS query log",
+	Long: "Show the last queries answered by the DNS resolver of the client with the handler that matched them, " +
+		"the upstream nameserver, the latency and the response code, followed by the statistics per domain. " +
+		"The query log is enabled with \"netbird up --dns-query-log\".",
+	Example: "  netbird dns log --limit 20\n  netbird dns log --anonymize",
+	Args:    cobra.NoArgs,
+	RunE:    dnsLog,
+}
+
+func init() {
+	dnsLogCmd.Flags().IntVar(&dnsLogLimit, "limit", 0, "show only the given number of most recent queries, 0 shows all of them")
+}
+
+func dnsLog(cmd *cobra.Command, _ []string) error {
+	conn, err := getClient(cmd)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := proto.NewDaemonServiceClient(conn)
+	resp, err := client.GetDNSQueryLog(cmd.Context(), &proto.GetDNSQueryLogRequest{Anonymize: anonymizeFlag})
+	if err != nil {
+		return fmt.Errorf("failed to get the DNS query log: %v", status.Convert(err).Message())
+	}
+
+	if !resp.GetEnabled() {
+		cmd.Println("The DNS query log is disabled, enable it with \"netbird up --dns-query-log\"")
+	}
+
+	entries := resp.GetEntries()
+	if dnsLogLimit > 0 && len(entries) > dnsLogLimit {
+		entries = entries[len(entries)-dnsLogLimit:]
+	}
+	if len(entries) == 0 {
+		cmd.Println("No queries recorded")
+		return nil
+	}
+
+	for _, entry := range entries {
+		cmd.Println(entry)
+	}
+
+	cmd.Println()
+	cmd.Printf("%-50s %8s %8s %8s %12s\n", "DOMAIN", "QUERIES", "NXDOMAIN", "FAILURES", "AVG LATENCY")
+	for _, stats := range resp.GetStats() {
+		cmd.Printf("%-50s %8d %8d %8d %12s\n",
+			stats.GetDomain(),
+			stats.GetQueries(),
+			stats.GetNxdomain(),
+			stats.GetFailures(),
+			stats.GetAvgLatency().AsDuration(),
+		)
+	}
+	return nil
+}
+
+func dnsFlush(cmd *cobra.Command, _ []string) error {
+	conn, err := getClient(cmd)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := proto.NewDaemonServiceClient(conn)
+	if _, err := client.FlushDNSCache(cmd.Context(), &proto.FlushDNSCacheRequest{}); err != nil {
+		return fmt.Errorf("failed to flush the DNS cache: %v", status.Convert(err).Message())
+	}
+
+	cmd.Println("DNS cache flushed")
+	return nil
+}
@@ -0,0 +1,143 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/status"
+
+	"github.com/netbirdio/netbird/client/proto"
+)
+
+var exitNodePeer string
+
+var exitNodeCmd = &cobra.Command{
+	Use:   "exit-node",
+	Short: "Manage the exit node",
+	Long:  `Commands to list the available exit nodes, i.e. default routes, and to choose the one routing all traffic.`,
+}
+
+var exitNodeListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List exit nodes",
+	Example: "  netbird exit-node list",
+	Long:    "List all available exit nodes with their routing peers.",
+	RunE:    exitNodeList,
+}
+
+var exitNodeUseCmd = &cobra.Command{
+	Use:     "use exit-node",
+	Short:   "Use an exit node",
+	Long:    "Route all traffic through the given exit node and ignore the other exit nodes.\nBy default the best routing peer of the exit node is used, use --peer to pin one of them by FQDN, IP or public key.\nThe choice is kept across restarts.",
+	Example: "  netbird exit-node use exit1\n  netbird exit-node use exit1 --peer gateway-eu",
+	Args:    cobra.ExactArgs(1),
+	RunE:    exitNodeUse,
+}
+
+var exitNodeOffCmd = &cobra.Command{
+	Use:     "off",
+	Short:   "Stop using exit nodes",
+	Long:    "Stop routing traffic through any exit node. The choice is kept across restarts.",
+	Example: "  netbird exit-node off",
+	Args:    cobra.NoArgs,
+	RunE:    exitNodeOff,
+}
+
+func init() {
+	exitNodeUseCmd.PersistentFlags().StringVar(&exitNodePeer, "peer", "", "FQDN, IP or public key of the routing peer to use")
+}
+
+func exitNodeList(cmd *cobra.Command, _ []string) error {
+	conn, err := getClient(cmd)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := proto.NewDaemonServiceClient(conn)
+	resp, err := client.ListExitNodes(cmd.Context(), &proto.ListExitNodesRequest{})
+	if err != nil {
+		return fmt.Errorf("failed to list exit nodes: %v", status.Convert(err).Message())
+	}
+
+	if len(resp.GetExitNodes()) == 0 {
+		cmd.Println("No exit nodes available.")
+		return nil
+	}
+
+	if resp.GetDisabled() {
+		cmd.Println("Exit nodes are turned off.")
+	}
+
+	cmd.Println("Available Exit Nodes:")
+	for _, exitNode := range resp.GetExitNodes() {
+		cmd.Printf("\n  - ID: %s\n    Network: %s\n    Status: %s\n    Peers:\n", exitNode.GetID(), exitNode.GetNetwork(), getExitNodeStatus(exitNode.GetSelected()))
+		for _, p := range exitNode.GetPeers() {
+			name := p.GetFqdn()
+			if name == "" {
+				name = p.GetPubKey()
+			}
+			connStatus := "Disconnected"
+			if p.GetConnected() {
+				connStatus = fmt.Sprintf("Connected, latency %s", p.GetLatency().AsDuration())
+			}
+			cmd.Printf("      [%s] %s %s (%s)\n", getExitNodeMark(p.GetSelected()), name, p.GetIP(), connStatus)
+		}
+	}
+
+	return nil
+}
+
+func getExitNodeStatus(selected bool) string {
+	if selected {
+		return "Selected"
+	}
+	return "Not Selected"
+}
+
+func getExitNodeMark(selected bool) string {
+	if selected {
+		return "x"
+	}
+	return " "
+}
+
+func exitNodeUse(cmd *cobra.Command, args []string) error {
+	conn, err := getClient(cmd)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := proto.NewDaemonServiceClient(conn)
+	req := &proto.SelectExitNodeRequest{
+		ID:   args[0],
+		Peer: exitNodePeer,
+	}
+
+	if _, err := client.SelectExitNode(cmd.Context(), req); err != nil {
+		return fmt.Errorf("failed to select exit node: %v", status.Convert(err).Message())
+	}
+
+	cmd.Println("Exit node selected successfully.")
+
+	return nil
+}
+
+func exitNodeOff(cmd *cobra.Command, _ []string) error {
+	conn, err := getClient(cmd)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := proto.NewDaemonServiceClient(conn)
+	if _, err := client.DeselectExitNode(cmd.Context(), &proto.DeselectExitNodeRequest{}); err != nil {
+		return fmt.Errorf("failed to turn off exit nodes: %v", status.Convert(err).Message())
+	}
+
+	cmd.Println("Exit nodes turned off successfully.")
+
+	return nil
+}
@@ -19,6 +19,15 @@ import (
 	"github.com/netbirdio/netbird/util"
 )
 
+const reauthFlag = "reauth"
+
+var reauthenticate bool
+
+func init() {
+	loginCmd.PersistentFlags().BoolVar(&reauthenticate, reauthFlag, false,
+		"Renew the SSO login of a connected peer before it expires, the connection stays up")
+}
+
 var loginCmd = &cobra.Command{
 	Use:   "login",
 	Short: "login to the Netbird Management Service (first run)",
@@ -46,6 +55,10 @@ var loginCmd = &cobra.Command{
 
 		// workaround to run without service
 		if logFile == "console" {
+			if reauthenticate {
+				return fmt.Errorf("--%s requires the NetBird service", reauthFlag)
+			}
+
 			err = handleRebrand(cmd)
 			if err != nil {
 				return err
@@ -90,6 +103,7 @@ var loginCmd = &cobra.Command{
 			ManagementUrl:        managementURL,
 			IsLinuxDesktopClient: isLinuxRunningDesktop(),
 			Hostname:             hostName,
+			Reauthenticate:       reauthenticate,
 		}
 
 		if rootCmd.PersistentFlags().Changed(preSharedKeyFlag) {
@@ -37,6 +37,8 @@ const (
 	serverSSHAllowedFlag    = "allow-server-ssh"
 	extraIFaceBlackListFlag = "extra-iface-blacklist"
 	dnsRouteIntervalFlag    = "dns-router-interval"
+	dnsQueryLogFlag         = "dns-query-log"
+	peerTestResponderFlag   = "peer-test-responder"
 	systemInfoFlag          = "system-info"
 )
 
@@ -73,6 +75,8 @@ var (
 	anonymizeFlag           bool
 	debugSystemInfoFlag     bool
 	dnsRouteInterval        time.Duration
+	dnsQueryLog             bool
+	peerTestResponder       bool
 
 	rootCmd = &cobra.Command{
 		Use:          "netbird",
@@ -143,7 +147,10 @@ func init() {
 	rootCmd.AddCommand(versionCmd)
 	rootCmd.AddCommand(sshCmd)
 	rootCmd.AddCommand(routesCmd)
+	rootCmd.AddCommand(exitNodeCmd)
 	rootCmd.AddCommand(debugCmd)
+	rootCmd.AddCommand(testCmd)
+	rootCmd.AddCommand(dnsCmd)
 
 	serviceCmd.AddCommand(runCmd, startCmd, stopCmd, restartCmd) // service control commands are subcommands of service
 	serviceCmd.AddCommand(installCmd, uninstallCmd)              // service installer commands are subcommands of service
@@ -151,10 +158,16 @@ func init() {
 	routesCmd.AddCommand(routesListCmd)
 	routesCmd.AddCommand(routesSelectCmd, routesDeselectCmd)
 
+	exitNodeCmd.AddCommand(exitNodeListCmd, exitNodeUseCmd, exitNodeOffCmd)
+
+	dnsCmd.AddCommand(dnsFlushCmd)
+	dnsCmd.AddCommand(dnsLogCmd)
+
 	debugCmd.AddCommand(debugBundleCmd)
 	debugCmd.AddCommand(logCmd)
 	logCmd.AddCommand(logLevelCmd)
 	debugCmd.AddCommand(forCmd)
+	debugCmd.AddCommand(debugPeerCmd)
 
 	upCmd.PersistentFlags().StringSliceVar(&natExternalIPs, externalIPMapFlag, nil,
 		`Sets external IPs maps between local addresses and interfaces.`+
@@ -175,6 +188,8 @@ func init() {
 	upCmd.PersistentFlags().BoolVar(&autoConnectDisabled, disableAutoConnectFlag, false, "Disables auto-connect feature. If enabled, then the client won't connect automatically when the service starts.")
 
 	debugCmd.PersistentFlags().BoolVarP(&debugSystemInfoFlag, systemInfoFlag, "S", false, "Adds system information to the debug bundle")
+	debugPeerCmd.Flags().BoolVarP(&debugPeerFollow, "follow", "f", false, "Print the state transitions of the connection as they happen")
+	testCmd.Flags().DurationVar(&testDuration, "duration", 0, "Duration of the throughput test of each direction, 5s if not set, at most 30s")
 }
 
 // SetupCloseHandler handles SIGTERM signal and exits with success
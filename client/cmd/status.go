@@ -99,6 +99,7 @@ type statusOutputOverview struct {
 	RosenpassPermissive bool                       `json:"quantumResistancePermissive" yaml:"quantumResistancePermissive"`
 	Routes              []string                   `json:"routes" yaml:"routes"`
 	NSServerGroups      []nsServerGroupStateOutput `json:"dnsServers" yaml:"dnsServers"`
+	LoginExpiresAt      *time.Time                 `json:"loginExpiresAt,omitempty" yaml:"loginExpiresAt,omitempty"`
 }
 
 var (
@@ -286,6 +287,11 @@ func convertToStatusOutputOverview(resp *proto.StatusResponse) statusOutputOverv
 		NSServerGroups:      mapNSGroups(pbFullStatus.GetDnsServers()),
 	}
 
+	if expiresAt := pbFullStatus.GetLocalPeerState().GetLoginExpiresAt(); expiresAt != nil {
+		loginExpiresAt := expiresAt.AsTime().Local()
+		overview.LoginExpiresAt = &loginExpiresAt
+	}
+
 	if anonymizeFlag {
 		anonymizer := anonymize.NewAnonymizer(anonymize.DefaultAddresses())
 		anonymizeOverview(anonymizer, &overview)
@@ -571,9 +577,21 @@ func parseGeneralSummary(overview statusOutputOverview, showURL bool, showRelays
 		routes,
 		peersCountString,
 	)
+
+	if overview.LoginExpiresAt != nil {
+		summary += fmt.Sprintf("Login expires: %s\n", formatLoginExpiration(*overview.LoginExpiresAt))
+	}
 	return summary
 }
 
+func formatLoginExpiration(expiresAt time.Time) string {
+	remaining := time.Until(expiresAt)
+	if remaining <= 0 {
+		return fmt.Sprintf("%s (expired)", expiresAt.Format("2006-01-02 15:04:05"))
+	}
+	return fmt.Sprintf("%s (in %s)", expiresAt.Format("2006-01-02 15:04:05"), remaining.Round(time.Minute))
+}
+
 func parseToFullDetailSummary(overview statusOutputOverview) string {
 	parsedPeersString := parsePeers(overview.Peers, overview.RosenpassEnabled, overview.RosenpassPermissive)
 	summary := parseGeneralSummary(overview, true, true, true)
@@ -838,9 +856,16 @@ func anonymizeOverview(a *anonymize.Anonymizer, overview *statusOutputOverview)
 			overview.NSServerGroups[i].Domains[j] = a.AnonymizeDomain(domain)
 		}
 		for j, ns := range nsGroup.Servers {
-			host, port, err := net.SplitHostPort(ns)
+			// the encrypted nameservers are prefixed with their type, e.g. tls://1.1.1.1:853
+			scheme, hostPort, found := strings.Cut(ns, "://")
+			if !found {
+				scheme, hostPort = "", ns
+			} else {
+				scheme += "://"
+			}
+			host, port, err := net.SplitHostPort(hostPort)
 			if err == nil {
-				overview.NSServerGroups[i].Servers[j] = fmt.Sprintf("%s:%s", a.AnonymizeIPString(host), port)
+				overview.NSServerGroups[i].Servers[j] = fmt.Sprintf("%s%s:%s", scheme, a.AnonymizeIPString(host), port)
 			}
 		}
 	}
@@ -570,6 +570,26 @@ Peers count: 2/2 Connected
 	assert.Equal(t, expectedString, shortVersion)
 }
 
+func TestParsingLoginExpiration(t *testing.T) {
+	expiresAt := time.Now().Add(3*time.Hour + 10*time.Second).Truncate(time.Second)
+	statusResp := &proto.StatusResponse{
+		FullStatus: &proto.FullStatus{
+			ManagementState: &proto.ManagementState{},
+			SignalState:     &proto.SignalState{},
+			LocalPeerState:  &proto.LocalPeerState{LoginExpiresAt: timestamppb.New(expiresAt)},
+		},
+	}
+
+	loginOverview := convertToStatusOutputOverview(statusResp)
+	require.NotNil(t, loginOverview.LoginExpiresAt)
+	assert.True(t, expiresAt.Equal(*loginOverview.LoginExpiresAt))
+
+	summary := parseGeneralSummary(loginOverview, false, false, false)
+	assert.Contains(t, summary, fmt.Sprintf("Login expires: %s (in 3h0m0s)\n", expiresAt.Format("2006-01-02 15:04:05")))
+
+	assert.Nil(t, convertToStatusOutputOverview(resp).LoginExpiresAt, "the login of the peer doesn't expire")
+}
+
 func TestParsingOfIP(t *testing.T) {
 	InterfaceIP := "192.168.178.123/16"
 
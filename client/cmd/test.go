@@ -0,0 +1,115 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/netbirdio/netbird/client/proto"
+)
+
+var testDuration time.Duration
+
+var testCmd = &cobra.Command{
+	Use:   "test <peer>",
+	Short: "Test the latency and the throughput of the connection to a peer",
+	Long: `Measures the round trip time, the jitter and the packet loss to a peer and the throughput of both directions ` +
+		`through the WireGuard interface. The remote peer answers the test only if the policies allow this peer to ` +
+		`connect to it. The peer is selected by its FQDN, hostname, NetBird IP or public key.`,
+	Example: "  netbird test peer-a.netbird.cloud --duration 10s",
+	Args:    cobra.ExactArgs(1),
+	RunE:    testPeer,
+}
+
+func testPeer(cmd *cobra.Command, args []string) error {
+	conn, err := getClient(cmd)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			log.Errorf(errCloseConnection, err)
+		}
+	}()
+
+	req := &proto.TestPeerRequest{Peer: args[0]}
+	if testDuration > 0 {
+		req.Duration = durationpb.New(testDuration)
+	}
+
+	cmd.Printf("Testing the connection to %s, this takes about %s\n", args[0], estimatedTestDuration(testDuration))
+
+	client := proto.NewDaemonServiceClient(conn)
+	resp, err := client.TestPeer(cmd.Context(), req)
+	if err != nil {
+		return fmt.Errorf("failed to test peer: %v", status.Convert(err).Message())
+	}
+
+	cmd.Print(parseTestPeer(resp))
+	return nil
+}
+
+// estimatedTestDuration is the duration of both throughput tests and the latency and UDP stream tests
+func estimatedTestDuration(duration time.Duration) time.Duration {
+	if duration <= 0 {
+		duration = 5 * time.Second
+	}
+	return 2*duration + 5*time.Second
+}
+
+func parseTestPeer(resp *proto.TestPeerResponse) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "\nPeer: %s (%s)\n", resp.GetFqdn(), resp.GetIP())
+
+	latency := resp.GetLatency()
+	fmt.Fprintf(&b, "Latency: %d sent, %d received, %.1f%% loss",
+		latency.GetSent(), latency.GetReceived(), lossPercent(latency.GetSent(), latency.GetReceived()))
+	if latency.GetReceived() > 0 {
+		fmt.Fprintf(&b, ", rtt min/avg/max/jitter = %s/%s/%s/%s",
+			formatTestDuration(latency.GetMin()), formatTestDuration(latency.GetAvg()),
+			formatTestDuration(latency.GetMax()), formatTestDuration(latency.GetJitter()))
+	}
+	b.WriteString("\n")
+
+	writeThroughput(&b, "Upload", resp.GetUpload())
+	writeThroughput(&b, "Download", resp.GetDownload())
+
+	return b.String()
+}
+
+func writeThroughput(b *strings.Builder, name string, result *proto.ThroughputTestResult) {
+	fmt.Fprintf(b, "%s:", name)
+	if duration := result.GetDuration().AsDuration(); duration > 0 {
+		mbps := float64(result.GetBytes()*8) / duration.Seconds() / 1e6
+		fmt.Fprintf(b, " %.2f Mbps", mbps)
+	} else {
+		b.WriteString(" -")
+	}
+	if result.GetPacketsSent() > 0 {
+		fmt.Fprintf(b, ", jitter %s, %.1f%% loss (%d/%d packets)",
+			formatTestDuration(result.GetJitter()),
+			lossPercent(result.GetPacketsSent(), result.GetPacketsReceived()),
+			result.GetPacketsReceived(), result.GetPacketsSent())
+	}
+	b.WriteString("\n")
+	if result.GetError() != "" {
+		fmt.Fprintf(b, "  Error: %s\n", result.GetError())
+	}
+}
+
+func lossPercent(sent, received int32) float64 {
+	if sent <= 0 || received >= sent {
+		return 0
+	}
+	return float64(sent-received) / float64(sent) * 100
+}
+
+func formatTestDuration(d *durationpb.Duration) string {
+	return d.AsDuration().Round(10 * time.Microsecond).String()
+}
@@ -48,6 +48,14 @@ func init() {
 	)
 	upCmd.PersistentFlags().StringSliceVar(&extraIFaceBlackList, extraIFaceBlackListFlag, nil, "Extra list of default interfaces to ignore for listening")
 	upCmd.PersistentFlags().DurationVar(&dnsRouteInterval, dnsRouteIntervalFlag, time.Minute, "DNS route update interval")
+	upCmd.PersistentFlags().BoolVar(&dnsQueryLog, dnsQueryLogFlag, false,
+		`Record the last queries answered by the NetBird DNS server, shown by "netbird dns log". `+
+			`E.g. --dns-query-log=false to disable or --dns-query-log=true to enable.`,
+	)
+	upCmd.PersistentFlags().BoolVar(&peerTestResponder, peerTestResponderFlag, false,
+		`Answer the connection tests of the peers the policies allow to connect to this peer, it listens on the NetBird address. `+
+			`E.g. --peer-test-responder=false to disable or --peer-test-responder=true to enable.`,
+	)
 }
 
 func upFunc(cmd *cobra.Command, args []string) error {
@@ -127,6 +135,14 @@ func runInForegroundMode(ctx context.Context, cmd *cobra.Command) error {
 		ic.NetworkMonitor = &networkMonitor
 	}
 
+	if cmd.Flag(dnsQueryLogFlag).Changed {
+		ic.DNSQueryLog = &dnsQueryLog
+	}
+
+	if cmd.Flag(peerTestResponderFlag).Changed {
+		ic.PeerTestResponder = &peerTestResponder
+	}
+
 	if rootCmd.PersistentFlags().Changed(preSharedKeyFlag) {
 		ic.PreSharedKey = &preSharedKey
 	}
@@ -264,6 +280,14 @@ func runInDaemonMode(ctx context.Context, cmd *cobra.Command) error {
 		loginRequest.DnsRouteInterval = durationpb.New(dnsRouteInterval)
 	}
 
+	if cmd.Flag(dnsQueryLogFlag).Changed {
+		loginRequest.DnsQueryLog = &dnsQueryLog
+	}
+
+	if cmd.Flag(peerTestResponderFlag).Changed {
+		loginRequest.PeerTestResponder = &peerTestResponder
+	}
+
 	var loginErr error
 
 	var loginResp *proto.LoginResponse
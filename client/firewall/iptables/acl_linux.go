@@ -70,14 +70,8 @@ func (m *aclManager) AddFiltering(
 	action firewall.Action,
 	ipsetName string,
 ) ([]firewall.Rule, error) {
-	var dPortVal, sPortVal string
-	if dPort != nil && dPort.Values != nil {
-		// TODO: we support only one port per rule in current implementation of ACLs
-		dPortVal = strconv.Itoa(dPort.Values[0])
-	}
-	if sPort != nil && sPort.Values != nil {
-		sPortVal = strconv.Itoa(sPort.Values[0])
-	}
+	dPortVal := portSpec(dPort)
+	sPortVal := portSpec(sPort)
 
 	var chain string
 	if direction == firewall.RuleDirectionOUT {
@@ -435,6 +429,18 @@ func filterRuleSpecs(
 	return append(specs, "-j", actionToStr(action))
 }
 
+// portSpec returns the iptables port specification, e.g. "80" for a single port or "30000:32767" for a port range.
+// We support only one port or one range per rule in current implementation of ACLs
+func portSpec(port *firewall.Port) string {
+	if port == nil || len(port.Values) == 0 {
+		return ""
+	}
+	if port.IsRange && len(port.Values) == 2 {
+		return fmt.Sprintf("%d:%d", port.Values[0], port.Values[1])
+	}
+	return strconv.Itoa(port.Values[0])
+}
+
 func actionToStr(action firewall.Action) string {
 	if action == firewall.ActionAccept {
 		return "ACCEPT"
@@ -88,6 +88,12 @@ func (m *Manager) IsServerRouteSupported() bool {
 	return true
 }
 
+// IsIPv6Supported returns false, the ACL rules are only applied to the IPv4 traffic. The iptables manager is only used
+// with iptables versions older than 1.8, the nftables manager filters the IPv6 traffic otherwise
+func (m *Manager) IsIPv6Supported() bool {
+	return false
+}
+
 func (m *Manager) InsertRoutingRules(pair firewall.RouterPair) error {
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
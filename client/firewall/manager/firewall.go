@@ -70,6 +70,9 @@ type Manager interface {
 	// IsServerRouteSupported returns true if the firewall supports server side routing operations
 	IsServerRouteSupported() bool
 
+	// IsIPv6Supported returns true if the firewall filters the IPv6 traffic of the peers
+	IsIPv6Supported() bool
+
 	// InsertRoutingRules inserts a routing firewall rule
 	InsertRoutingRules(pair RouterPair) error
 
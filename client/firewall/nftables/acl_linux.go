@@ -31,6 +31,10 @@ const (
 	chainNameForwardFilter = "netbird-acl-forward-filter"
 
 	allowNetbirdInputRuleID = "allow Netbird incoming traffic"
+
+	// offsets of the source addresses in the network headers
+	ipv4SrcOffset = 12
+	ipv6SrcOffset = 8
 )
 
 var (
@@ -44,11 +48,17 @@ type AclManager struct {
 	wgIface             iFaceMapper
 	routeingFwChainName string
 
-	workTable        *nftables.Table
-	chainInputRules  *nftables.Chain
-	chainOutputRules *nftables.Chain
-	chainFwFilter    *nftables.Chain
-	chainPrerouting  *nftables.Chain
+	// ipv6 is set for the manager of the IPv6 table, which only filters the traffic within the overlay IPv6 network
+	ipv6      bool
+	networkV6 *net.IPNet
+
+	workTable         *nftables.Table
+	chainInputRules   *nftables.Chain
+	chainOutputRules  *nftables.Chain
+	chainInputFilter  *nftables.Chain
+	chainOutputFilter *nftables.Chain
+	chainFwFilter     *nftables.Chain
+	chainPrerouting   *nftables.Chain
 
 	ipsetStore *ipsetStore
 	rules      map[string]*Rule
@@ -77,6 +87,7 @@ func newAclManager(table *nftables.Table, wgIface iFaceMapper, routeingFwChainNa
 		wgIface:             wgIface,
 		workTable:           table,
 		routeingFwChainName: routeingFwChainName,
+		ipv6:                table.Family == nftables.TableFamilyIPv6,
 
 		ipsetStore: newIpsetStore(),
 		rules:      make(map[string]*Rule),
@@ -104,6 +115,13 @@ func (m *AclManager) AddFiltering(
 	ipsetName string,
 	comment string,
 ) ([]firewall.Rule, error) {
+	if m.ipv6 {
+		// the overlay IPv6 network is only known once the interface got its IPv6 address
+		if err := m.syncNetworkV6(); err != nil {
+			return nil, err
+		}
+	}
+
 	var ipset *nftables.Set
 	if ipsetName != "" {
 		var err error
@@ -120,7 +138,8 @@ func (m *AclManager) AddFiltering(
 	}
 
 	newRules = append(newRules, ioRule)
-	if !shouldAddToPrerouting(proto, dPort, direction) {
+	// the routed traffic is marked in prerouting for the IPv4 rules only, the IPv6 traffic is not routed
+	if m.ipv6 || !shouldAddToPrerouting(proto, dPort, direction) {
 		return newRules, nil
 	}
 
@@ -158,7 +177,7 @@ func (m *AclManager) DeleteRule(rule firewall.Rule) error {
 		return m.rConn.Flush()
 	}
 	if _, ok := ips[r.ip.String()]; ok {
-		err := m.sConn.SetDeleteElements(r.nftSet, []nftables.SetElement{{Key: r.ip.To4()}})
+		err := m.sConn.SetDeleteElements(r.nftSet, []nftables.SetElement{{Key: m.rawIP(r.ip)}})
 		if err != nil {
 			log.Errorf("delete elements for set %q: %v", r.nftSet.Name, err)
 		}
@@ -202,6 +221,10 @@ func (m *AclManager) DeleteRule(rule firewall.Rule) error {
 // createDefaultAllowRules In case if the USP firewall manager can use the native firewall manager we must to create allow rules for
 // input and output chains
 func (m *AclManager) createDefaultAllowRules() error {
+	if m.ipv6 {
+		return m.createDefaultAllowRulesV6()
+	}
+
 	expIn := []expr.Any{
 		&expr.Payload{
 			DestRegister: 1,
@@ -322,39 +345,24 @@ func (m *AclManager) addIOFiltering(ip net.IP, proto firewall.Protocol, sPort *f
 	}
 
 	if proto != firewall.ProtocolALL {
-		expressions = append(expressions, &expr.Payload{
-			DestRegister: 1,
-			Base:         expr.PayloadBaseNetworkHeader,
-			Offset:       uint32(9),
-			Len:          uint32(1),
-		})
-
-		var protoData []byte
-		switch proto {
-		case firewall.ProtocolTCP:
-			protoData = []byte{unix.IPPROTO_TCP}
-		case firewall.ProtocolUDP:
-			protoData = []byte{unix.IPPROTO_UDP}
-		case firewall.ProtocolICMP:
-			protoData = []byte{unix.IPPROTO_ICMP}
-		default:
-			return nil, fmt.Errorf("unsupported protocol: %s", proto)
+		protoExpressions, err := m.protoExpressions(proto)
+		if err != nil {
+			return nil, err
 		}
-		expressions = append(expressions, &expr.Cmp{
-			Register: 1,
-			Op:       expr.CmpOpEq,
-			Data:     protoData,
-		})
+		expressions = append(expressions, protoExpressions...)
 	}
 
-	rawIP := ip.To4()
-	// check if rawIP contains zeroed IPv4 0.0.0.0 value
+	rawIP := m.rawIP(ip)
+	// check if rawIP contains zeroed IPv4 0.0.0.0 or IPv6 :: value
 	// in that case not add IP match expression into the rule definition
 	if !bytes.HasPrefix(anyIP, rawIP) {
 		// source address position
-		addrOffset := uint32(12)
+		addrOffset := uint32(ipv4SrcOffset)
+		if m.ipv6 {
+			addrOffset = ipv6SrcOffset
+		}
 		if direction == firewall.RuleDirectionOUT {
-			addrOffset += 4 // is ipv4 address length
+			addrOffset += uint32(len(rawIP)) // the destination address follows the source address
 		}
 
 		expressions = append(expressions,
@@ -362,7 +370,7 @@ func (m *AclManager) addIOFiltering(ip net.IP, proto firewall.Protocol, sPort *f
 				DestRegister: 1,
 				Base:         expr.PayloadBaseNetworkHeader,
 				Offset:       addrOffset,
-				Len:          4,
+				Len:          uint32(len(rawIP)),
 			},
 		)
 		// add individual IP for match if no ipset defined
@@ -386,35 +394,11 @@ func (m *AclManager) addIOFiltering(ip net.IP, proto firewall.Protocol, sPort *f
 	}
 
 	if sPort != nil && len(sPort.Values) != 0 {
-		expressions = append(expressions,
-			&expr.Payload{
-				DestRegister: 1,
-				Base:         expr.PayloadBaseTransportHeader,
-				Offset:       0,
-				Len:          2,
-			},
-			&expr.Cmp{
-				Op:       expr.CmpOpEq,
-				Register: 1,
-				Data:     encodePort(*sPort),
-			},
-		)
+		expressions = append(expressions, portExpressions(*sPort, 0)...)
 	}
 
 	if dPort != nil && len(dPort.Values) != 0 {
-		expressions = append(expressions,
-			&expr.Payload{
-				DestRegister: 1,
-				Base:         expr.PayloadBaseTransportHeader,
-				Offset:       2,
-				Len:          2,
-			},
-			&expr.Cmp{
-				Op:       expr.CmpOpEq,
-				Register: 1,
-				Data:     encodePort(*dPort),
-			},
-		)
+		expressions = append(expressions, portExpressions(*dPort, 2)...)
 	}
 
 	switch action {
@@ -526,19 +510,7 @@ func (m *AclManager) addPreroutingFiltering(ipset *nftables.Set, proto firewall.
 	}
 
 	if port != nil {
-		expressions = append(expressions,
-			&expr.Payload{
-				DestRegister: 1,
-				Base:         expr.PayloadBaseTransportHeader,
-				Offset:       2,
-				Len:          2,
-			},
-			&expr.Cmp{
-				Op:       expr.CmpOpEq,
-				Register: 1,
-				Data:     encodePort(*port),
-			},
-		)
+		expressions = append(expressions, portExpressions(*port, 2)...)
 	}
 
 	expressions = append(expressions,
@@ -580,6 +552,10 @@ func (m *AclManager) addPreroutingFiltering(ipset *nftables.Set, proto firewall.
 }
 
 func (m *AclManager) createDefaultChains() (err error) {
+	if m.ipv6 {
+		return m.createDefaultChainsV6()
+	}
+
 	// chainNameInputRules
 	chain := m.createChain(chainNameInputRules)
 	err = m.rConn.Flush()
@@ -1040,7 +1016,7 @@ func (m *AclManager) addJumpRule(chain *nftables.Chain, to string, ifaceKey expr
 
 func (m *AclManager) addIpToSet(ipsetName string, ip net.IP) (*nftables.Set, error) {
 	ipset, err := m.rConn.GetSetByName(m.workTable, ipsetName)
-	rawIP := ip.To4()
+	rawIP := m.rawIP(ip)
 	if err != nil {
 		if ipset, err = m.createSet(m.workTable, ipsetName); err != nil {
 			return nil, fmt.Errorf("get set name: %v", err)
@@ -1074,6 +1050,9 @@ func (m *AclManager) createSet(table *nftables.Table, name string) (*nftables.Se
 		Dynamic: true,
 		KeyType: nftables.TypeIPAddr,
 	}
+	if m.ipv6 {
+		ipset.KeyType = nftables.TypeIP6Addr
+	}
 
 	if err := m.rConn.AddSet(ipset, nil); err != nil {
 		return nil, fmt.Errorf("create set: %v", err)
@@ -1132,6 +1111,159 @@ func (m *AclManager) refreshRuleHandles(chain *nftables.Chain) error {
 	return nil
 }
 
+// createDefaultChainsV6 creates the chains of the IPv6 table. The filter chains jump to the rules chains for the
+// traffic within the overlay IPv6 network and drop the rest of it, other IPv6 traffic of the interface is not filtered
+func (m *AclManager) createDefaultChainsV6() error {
+	m.chainInputRules = m.createChain(chainNameInputRules)
+	m.chainOutputRules = m.createChain(chainNameOutputRules)
+	m.chainInputFilter = m.createFilterChainWithHook(chainNameInputFilter, nftables.ChainHookInput)
+	m.chainOutputFilter = m.createFilterChainWithHook(chainNameOutputFilter, nftables.ChainHookOutput)
+	if err := m.rConn.Flush(); err != nil {
+		log.Debugf("failed to create IPv6 chains: %s", err)
+		return err
+	}
+
+	return m.syncNetworkV6()
+}
+
+// syncNetworkV6 updates the filter chains of the IPv6 table when the overlay IPv6 network of the interface changed
+func (m *AclManager) syncNetworkV6() error {
+	network := m.wgIface.Address().NetworkV6
+	if network.String() == m.networkV6.String() {
+		return nil
+	}
+
+	m.rConn.FlushChain(m.chainInputFilter)
+	m.rConn.FlushChain(m.chainOutputFilter)
+	if network != nil {
+		m.addNetworkV6Rules(m.chainInputFilter, m.chainInputRules.Name, expr.MetaKeyIIFNAME, network)
+		m.addNetworkV6Rules(m.chainOutputFilter, m.chainOutputRules.Name, expr.MetaKeyOIFNAME, network)
+	}
+
+	if err := m.rConn.Flush(); err != nil {
+		return fmt.Errorf("flush IPv6 filter chains: %w", err)
+	}
+	m.networkV6 = network
+	return nil
+}
+
+// addNetworkV6Rules adds the rules jumping to the rules chain for the traffic within the network and dropping it
+// when no rule accepted it
+func (m *AclManager) addNetworkV6Rules(chain *nftables.Chain, to string, ifaceKey expr.MetaKey, network *net.IPNet) {
+	verdicts := []*expr.Verdict{
+		{Kind: expr.VerdictJump, Chain: to},
+		{Kind: expr.VerdictDrop},
+	}
+	for _, verdict := range verdicts {
+		expressions := []expr.Any{
+			&expr.Meta{Key: ifaceKey, Register: 1},
+			&expr.Cmp{
+				Op:       expr.CmpOpEq,
+				Register: 1,
+				Data:     ifname(m.wgIface.Name()),
+			},
+		}
+		// both the source and the destination address are in the network
+		for _, offset := range []uint32{ipv6SrcOffset, ipv6SrcOffset + net.IPv6len} {
+			expressions = append(expressions,
+				&expr.Payload{
+					DestRegister: 2,
+					Base:         expr.PayloadBaseNetworkHeader,
+					Offset:       offset,
+					Len:          net.IPv6len,
+				},
+				&expr.Bitwise{
+					SourceRegister: 2,
+					DestRegister:   2,
+					Len:            net.IPv6len,
+					Xor:            make([]byte, net.IPv6len),
+					Mask:           network.Mask,
+				},
+				&expr.Cmp{
+					Op:       expr.CmpOpEq,
+					Register: 2,
+					Data:     network.IP.To16(),
+				},
+			)
+		}
+		expressions = append(expressions, verdict)
+
+		_ = m.rConn.AddRule(&nftables.Rule{
+			Table: chain.Table,
+			Chain: chain,
+			Exprs: expressions,
+		})
+	}
+}
+
+// createDefaultAllowRulesV6 accepts all the IPv6 traffic of the rules chains, like createDefaultAllowRules
+func (m *AclManager) createDefaultAllowRulesV6() error {
+	for _, chain := range []*nftables.Chain{m.chainInputRules, m.chainOutputRules} {
+		_ = m.rConn.InsertRule(&nftables.Rule{
+			Table:    m.workTable,
+			Chain:    chain,
+			Position: 0,
+			Exprs: []expr.Any{
+				&expr.Verdict{
+					Kind: expr.VerdictAccept,
+				},
+			},
+		})
+	}
+
+	if err := m.rConn.Flush(); err != nil {
+		log.Debugf("failed to create default IPv6 allow rules: %s", err)
+		return err
+	}
+	return nil
+}
+
+// protoExpressions returns the expressions matching the transport protocol. The IPv6 rules match the layer 4 protocol
+// of the packet, as extension headers can precede the transport header
+func (m *AclManager) protoExpressions(proto firewall.Protocol) ([]expr.Any, error) {
+	var protoData []byte
+	switch proto {
+	case firewall.ProtocolTCP:
+		protoData = []byte{unix.IPPROTO_TCP}
+	case firewall.ProtocolUDP:
+		protoData = []byte{unix.IPPROTO_UDP}
+	case firewall.ProtocolICMP:
+		protoData = []byte{unix.IPPROTO_ICMP}
+		if m.ipv6 {
+			protoData = []byte{unix.IPPROTO_ICMPV6}
+		}
+	default:
+		return nil, fmt.Errorf("unsupported protocol: %s", proto)
+	}
+
+	var load expr.Any = &expr.Payload{
+		DestRegister: 1,
+		Base:         expr.PayloadBaseNetworkHeader,
+		Offset:       uint32(9),
+		Len:          uint32(1),
+	}
+	if m.ipv6 {
+		load = &expr.Meta{Key: expr.MetaKeyL4PROTO, Register: 1}
+	}
+
+	return []expr.Any{
+		load,
+		&expr.Cmp{
+			Register: 1,
+			Op:       expr.CmpOpEq,
+			Data:     protoData,
+		},
+	}, nil
+}
+
+// rawIP returns the IP in the address format of the table
+func (m *AclManager) rawIP(ip net.IP) []byte {
+	if m.ipv6 {
+		return ip.To16()
+	}
+	return ip.To4()
+}
+
 func generateRuleId(
 	ip net.IP,
 	sPort *firewall.Port,
@@ -1183,9 +1315,37 @@ func shouldAddToPrerouting(proto firewall.Protocol, dPort *firewall.Port, direct
 	return true
 }
 
-func encodePort(port firewall.Port) []byte {
+// portExpressions returns expressions matching the port located at the given offset of the transport header.
+// A port range is matched natively with a single range expression
+func portExpressions(port firewall.Port, offset uint32) []expr.Any {
+	expressions := []expr.Any{
+		&expr.Payload{
+			DestRegister: 1,
+			Base:         expr.PayloadBaseTransportHeader,
+			Offset:       offset,
+			Len:          2,
+		},
+	}
+
+	if port.IsRange && len(port.Values) == 2 {
+		return append(expressions, &expr.Range{
+			Op:       expr.CmpOpEq,
+			Register: 1,
+			FromData: encodePortValue(port.Values[0]),
+			ToData:   encodePortValue(port.Values[1]),
+		})
+	}
+
+	return append(expressions, &expr.Cmp{
+		Op:       expr.CmpOpEq,
+		Register: 1,
+		Data:     encodePortValue(port.Values[0]),
+	})
+}
+
+func encodePortValue(port int) []byte {
 	bs := make([]byte, 2)
-	binary.BigEndian.PutUint16(bs, uint16(port.Values[0]))
+	binary.BigEndian.PutUint16(bs, uint16(port))
 	return bs
 }
 
@@ -27,6 +27,8 @@ type Manager struct {
 
 	router     *router
 	aclManager *AclManager
+	// aclManager6 applies the rules of the IPv6 addresses, nil when the IPv6 table can't be created
+	aclManager6 *AclManager
 }
 
 // Create nftables firewall manager
@@ -36,7 +38,7 @@ func Create(context context.Context, wgIface iFaceMapper) (*Manager, error) {
 		wgIface: wgIface,
 	}
 
-	workTable, err := m.createWorkTable()
+	workTable, err := m.createWorkTable(nftables.TableFamilyIPv4)
 	if err != nil {
 		return nil, err
 	}
@@ -51,6 +53,17 @@ func Create(context context.Context, wgIface iFaceMapper) (*Manager, error) {
 		return nil, err
 	}
 
+	workTable6, err := m.createWorkTable(nftables.TableFamilyIPv6)
+	if err != nil {
+		log.Warnf("failed to create the IPv6 table, the IPv6 traffic won't be filtered: %v", err)
+		return m, nil
+	}
+
+	m.aclManager6, err = newAclManager(workTable6, wgIface, "")
+	if err != nil {
+		log.Warnf("failed to create the IPv6 chains, the IPv6 traffic won't be filtered: %v", err)
+	}
+
 	return m, nil
 }
 
@@ -71,12 +84,14 @@ func (m *Manager) AddFiltering(
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
 
-	rawIP := ip.To4()
-	if rawIP == nil {
-		return nil, fmt.Errorf("unsupported IP version: %s", ip.String())
+	if ip.To4() != nil {
+		return m.aclManager.AddFiltering(ip, proto, sPort, dPort, direction, action, ipsetName, comment)
 	}
 
-	return m.aclManager.AddFiltering(ip, proto, sPort, dPort, direction, action, ipsetName, comment)
+	if m.aclManager6 == nil {
+		return nil, fmt.Errorf("unsupported IP version: %s", ip.String())
+	}
+	return m.aclManager6.AddFiltering(ip, proto, sPort, dPort, direction, action, ipsetName, comment)
 }
 
 // DeleteRule from the firewall by rule definition
@@ -84,6 +99,9 @@ func (m *Manager) DeleteRule(rule firewall.Rule) error {
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
 
+	if r, ok := rule.(*Rule); ok && r.ip.To4() == nil && m.aclManager6 != nil {
+		return m.aclManager6.DeleteRule(rule)
+	}
 	return m.aclManager.DeleteRule(rule)
 }
 
@@ -91,6 +109,11 @@ func (m *Manager) IsServerRouteSupported() bool {
 	return true
 }
 
+// IsIPv6Supported returns true when the IPv6 table was created, the rules of the IPv6 addresses are applied in it
+func (m *Manager) IsIPv6Supported() bool {
+	return m.aclManager6 != nil
+}
+
 func (m *Manager) InsertRoutingRules(pair firewall.RouterPair) error {
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
@@ -119,7 +142,23 @@ func (m *Manager) AllowNetbird() error {
 		return fmt.Errorf("failed to create default allow rules: %v", err)
 	}
 
-	chains, err := m.rConn.ListChainsOfTableFamily(nftables.TableFamilyIPv4)
+	if err := m.allowNetbirdInput(nftables.TableFamilyIPv4); err != nil {
+		return err
+	}
+
+	if m.aclManager6 == nil {
+		return nil
+	}
+
+	if err := m.aclManager6.createDefaultAllowRules(); err != nil {
+		return fmt.Errorf("failed to create default IPv6 allow rules: %v", err)
+	}
+	return m.allowNetbirdInput(nftables.TableFamilyIPv6)
+}
+
+// allowNetbirdInput accepts the netbird interface traffic in the INPUT chain of the filter table of the family
+func (m *Manager) allowNetbirdInput(family nftables.TableFamily) error {
+	chains, err := m.rConn.ListChainsOfTableFamily(family)
 	if err != nil {
 		return fmt.Errorf("list of chains: %w", err)
 	}
@@ -208,11 +247,18 @@ func (m *Manager) Flush() error {
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
 
-	return m.aclManager.Flush()
+	if err := m.aclManager.Flush(); err != nil {
+		return err
+	}
+
+	if m.aclManager6 == nil {
+		return nil
+	}
+	return m.aclManager6.Flush()
 }
 
-func (m *Manager) createWorkTable() (*nftables.Table, error) {
-	tables, err := m.rConn.ListTablesOfFamily(nftables.TableFamilyIPv4)
+func (m *Manager) createWorkTable(family nftables.TableFamily) (*nftables.Table, error) {
+	tables, err := m.rConn.ListTablesOfFamily(family)
 	if err != nil {
 		return nil, fmt.Errorf("list of tables: %w", err)
 	}
@@ -223,7 +269,7 @@ func (m *Manager) createWorkTable() (*nftables.Table, error) {
 		}
 	}
 
-	table := m.rConn.AddTable(&nftables.Table{Name: tableName, Family: nftables.TableFamilyIPv4})
+	table := m.rConn.AddTable(&nftables.Table{Name: tableName, Family: family})
 	err = m.rConn.Flush()
 	return table, err
 }
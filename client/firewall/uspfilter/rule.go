@@ -18,8 +18,11 @@ type Rule struct {
 	direction  firewall.RuleDirection
 	sPort      uint16
 	dPort      uint16
-	drop       bool
-	comment    string
+	// sPortEnd and dPortEnd are set when the rule matches a range of ports starting at sPort or dPort
+	sPortEnd uint16
+	dPortEnd uint16
+	drop     bool
+	comment  string
 
 	udpHook func([]byte) bool
 }
@@ -28,3 +31,11 @@ type Rule struct {
 func (r *Rule) GetRuleID() string {
 	return r.id
 }
+
+// matchPort checks if the port matches the single rule port or falls into the rule port range
+func matchPort(start, end, port uint16) bool {
+	if end == 0 {
+		return start == port
+	}
+	return port >= start && port <= end
+}
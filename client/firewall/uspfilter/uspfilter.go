@@ -34,6 +34,7 @@ type Manager struct {
 	outgoingRules  map[string]RuleSet
 	incomingRules  map[string]RuleSet
 	wgNetwork      *net.IPNet
+	wgNetworkV6    *net.IPNet
 	decoders       sync.Pool
 	wgIface        IFaceMapper
 	nativeFirewall firewall.Manager
@@ -52,6 +53,7 @@ type decoder struct {
 	icmp6   layers.ICMPv6
 	decoded []gopacket.LayerType
 	parser  *gopacket.DecodingLayerParser
+	parser6 *gopacket.DecodingLayerParser
 }
 
 // Create userspace firewall manager constructor
@@ -81,6 +83,11 @@ func create(iface IFaceMapper) (*Manager, error) {
 					&d.eth, &d.ip4, &d.ip6, &d.icmp4, &d.icmp6, &d.tcp, &d.udp,
 				)
 				d.parser.IgnoreUnsupported = true
+				d.parser6 = gopacket.NewDecodingLayerParser(
+					layers.LayerTypeIPv6,
+					&d.eth, &d.ip4, &d.ip6, &d.icmp4, &d.icmp6, &d.tcp, &d.udp,
+				)
+				d.parser6.IgnoreUnsupported = true
 				return d
 			},
 		},
@@ -103,6 +110,11 @@ func (m *Manager) IsServerRouteSupported() bool {
 	}
 }
 
+// IsIPv6Supported returns true, the packets of both IP versions are filtered
+func (m *Manager) IsIPv6Supported() bool {
+	return true
+}
+
 func (m *Manager) InsertRoutingRules(pair firewall.RouterPair) error {
 	if m.nativeFirewall == nil {
 		return errRouteNotSupported
@@ -152,10 +164,14 @@ func (m *Manager) AddFiltering(
 
 	if sPort != nil && len(sPort.Values) == 1 {
 		r.sPort = uint16(sPort.Values[0])
+	} else if sPort != nil && sPort.IsRange && len(sPort.Values) == 2 {
+		r.sPort, r.sPortEnd = uint16(sPort.Values[0]), uint16(sPort.Values[1])
 	}
 
 	if dPort != nil && len(dPort.Values) == 1 {
 		r.dPort = uint16(dPort.Values[0])
+	} else if dPort != nil && dPort.IsRange && len(dPort.Values) == 2 {
+		r.dPort, r.dPortEnd = uint16(dPort.Values[0]), uint16(dPort.Values[1])
 	}
 
 	switch proto {
@@ -236,7 +252,12 @@ func (m *Manager) dropFilter(packetData []byte, rules map[string]RuleSet, isInco
 	d := m.decoders.Get().(*decoder)
 	defer m.decoders.Put(d)
 
-	if err := d.parser.DecodeLayers(packetData, &d.decoded); err != nil {
+	parser := d.parser
+	if len(packetData) > 0 && packetData[0]>>4 == 6 {
+		parser = d.parser6
+	}
+
+	if err := parser.DecodeLayers(packetData, &d.decoded); err != nil {
 		log.Tracef("couldn't decode layer, err: %s", err)
 		return true
 	}
@@ -254,7 +275,7 @@ func (m *Manager) dropFilter(packetData []byte, rules map[string]RuleSet, isInco
 			return false
 		}
 	case layers.LayerTypeIPv6:
-		if !m.wgNetwork.Contains(d.ip6.SrcIP) || !m.wgNetwork.Contains(d.ip6.DstIP) {
+		if m.wgNetworkV6 == nil || !m.wgNetworkV6.Contains(d.ip6.SrcIP) || !m.wgNetworkV6.Contains(d.ip6.DstIP) {
 			return false
 		}
 	default:
@@ -306,7 +327,7 @@ func validateRule(ip net.IP, packetData []byte, rules map[string]Rule, d *decode
 			return rule.drop, true
 		}
 
-		if payloadLayer != rule.protoLayer {
+		if payloadLayer != rule.protoLayer && !(isICMP(payloadLayer) && isICMP(rule.protoLayer)) {
 			continue
 		}
 
@@ -315,10 +336,10 @@ func validateRule(ip net.IP, packetData []byte, rules map[string]Rule, d *decode
 			if rule.sPort == 0 && rule.dPort == 0 {
 				return rule.drop, true
 			}
-			if rule.sPort != 0 && rule.sPort == uint16(d.tcp.SrcPort) {
+			if rule.sPort != 0 && matchPort(rule.sPort, rule.sPortEnd, uint16(d.tcp.SrcPort)) {
 				return rule.drop, true
 			}
-			if rule.dPort != 0 && rule.dPort == uint16(d.tcp.DstPort) {
+			if rule.dPort != 0 && matchPort(rule.dPort, rule.dPortEnd, uint16(d.tcp.DstPort)) {
 				return rule.drop, true
 			}
 		case layers.LayerTypeUDP:
@@ -331,10 +352,10 @@ func validateRule(ip net.IP, packetData []byte, rules map[string]Rule, d *decode
 			if rule.sPort == 0 && rule.dPort == 0 {
 				return rule.drop, true
 			}
-			if rule.sPort != 0 && rule.sPort == uint16(d.udp.SrcPort) {
+			if rule.sPort != 0 && matchPort(rule.sPort, rule.sPortEnd, uint16(d.udp.SrcPort)) {
 				return rule.drop, true
 			}
-			if rule.dPort != 0 && rule.dPort == uint16(d.udp.DstPort) {
+			if rule.dPort != 0 && matchPort(rule.dPort, rule.dPortEnd, uint16(d.udp.DstPort)) {
 				return rule.drop, true
 			}
 		case layers.LayerTypeICMPv4, layers.LayerTypeICMPv6:
@@ -349,6 +370,19 @@ func (m *Manager) SetNetwork(network *net.IPNet) {
 	m.wgNetwork = network
 }
 
+// SetNetworkV6 of the wireguard interface to which IPv6 filtering applied
+func (m *Manager) SetNetworkV6(network *net.IPNet) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.wgNetworkV6 = network
+}
+
+// isICMP reports whether the layer is ICMP of either IP version, an ICMP rule matches the messages of both
+func isICMP(layerType gopacket.LayerType) bool {
+	return layerType == layers.LayerTypeICMPv4 || layerType == layers.LayerTypeICMPv6
+}
+
 // AddUDPPacketHook calls hook when UDP packet from given direction matched
 //
 // Hook function returns flag which indicates should be the matched package dropped or not
@@ -335,6 +335,161 @@ func TestNotMatchByIP(t *testing.T) {
 	}
 }
 
+func TestPortRangeFiltering(t *testing.T) {
+	ifaceMock := &IFaceMock{
+		SetFilterFunc: func(iface.PacketFilter) error { return nil },
+	}
+
+	m, err := Create(ifaceMock)
+	if err != nil {
+		t.Errorf("failed to create Manager: %v", err)
+		return
+	}
+	m.wgNetwork = &net.IPNet{
+		IP:   net.ParseIP("100.10.0.0"),
+		Mask: net.CIDRMask(16, 32),
+	}
+
+	ip := net.ParseIP("100.10.0.1")
+	port := &fw.Port{IsRange: true, Values: []int{30000, 32767}}
+	_, err = m.AddFiltering(ip, fw.ProtocolTCP, nil, port, fw.RuleDirectionIN, fw.ActionAccept, "", "range rule")
+	if err != nil {
+		t.Errorf("failed to add filtering: %v", err)
+		return
+	}
+
+	tests := []struct {
+		name    string
+		dstPort layers.TCPPort
+		drop    bool
+	}{
+		{name: "range start", dstPort: 30000, drop: false},
+		{name: "inside range", dstPort: 31000, drop: false},
+		{name: "range end", dstPort: 32767, drop: false},
+		{name: "below range", dstPort: 29999, drop: true},
+		{name: "above range", dstPort: 32768, drop: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ipv4 := &layers.IPv4{
+				TTL:      64,
+				Version:  4,
+				SrcIP:    ip,
+				DstIP:    net.ParseIP("100.10.0.100"),
+				Protocol: layers.IPProtocolTCP,
+			}
+			tcp := &layers.TCP{
+				SrcPort: 51334,
+				DstPort: tt.dstPort,
+			}
+
+			if err := tcp.SetNetworkLayerForChecksum(ipv4); err != nil {
+				t.Errorf("failed to set network layer for checksum: %v", err)
+				return
+			}
+
+			buf := gopacket.NewSerializeBuffer()
+			opts := gopacket.SerializeOptions{
+				ComputeChecksums: true,
+				FixLengths:       true,
+			}
+			if err = gopacket.SerializeLayers(buf, opts, ipv4, tcp, gopacket.Payload("test")); err != nil {
+				t.Errorf("failed to serialize packet: %v", err)
+				return
+			}
+
+			if drop := m.dropFilter(buf.Bytes(), m.incomingRules, true); drop != tt.drop {
+				t.Errorf("expected drop %v for port %d, got %v", tt.drop, tt.dstPort, drop)
+			}
+		})
+	}
+
+	if err = m.Reset(); err != nil {
+		t.Errorf("failed to reset Manager: %v", err)
+		return
+	}
+}
+
+func TestIPv6Filtering(t *testing.T) {
+	ifaceMock := &IFaceMock{
+		SetFilterFunc: func(iface.PacketFilter) error { return nil },
+	}
+
+	m, err := Create(ifaceMock)
+	if err != nil {
+		t.Errorf("failed to create Manager: %v", err)
+		return
+	}
+	m.wgNetwork = &net.IPNet{
+		IP:   net.ParseIP("100.10.0.0"),
+		Mask: net.CIDRMask(16, 32),
+	}
+	m.SetNetworkV6(&net.IPNet{
+		IP:   net.ParseIP("fd12:3456:789a::"),
+		Mask: net.CIDRMask(64, 128),
+	})
+
+	ip := net.ParseIP("fd12:3456:789a::1")
+	_, err = m.AddFiltering(ip, fw.ProtocolTCP, nil, &fw.Port{Values: []int{22}}, fw.RuleDirectionIN, fw.ActionAccept, "", "ssh rule")
+	if err != nil {
+		t.Errorf("failed to add filtering: %v", err)
+		return
+	}
+
+	tests := []struct {
+		name    string
+		srcIP   net.IP
+		dstPort layers.TCPPort
+		drop    bool
+	}{
+		{name: "allowed peer and port", srcIP: ip, dstPort: 22, drop: false},
+		{name: "other port", srcIP: ip, dstPort: 80, drop: true},
+		{name: "other peer", srcIP: net.ParseIP("fd12:3456:789a::2"), dstPort: 22, drop: true},
+		{name: "outside of the network", srcIP: net.ParseIP("2001:db8::1"), dstPort: 80, drop: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ipv6 := &layers.IPv6{
+				Version:    6,
+				HopLimit:   64,
+				SrcIP:      tt.srcIP,
+				DstIP:      net.ParseIP("fd12:3456:789a::100"),
+				NextHeader: layers.IPProtocolTCP,
+			}
+			tcp := &layers.TCP{
+				SrcPort: 51334,
+				DstPort: tt.dstPort,
+			}
+
+			if err := tcp.SetNetworkLayerForChecksum(ipv6); err != nil {
+				t.Errorf("failed to set network layer for checksum: %v", err)
+				return
+			}
+
+			buf := gopacket.NewSerializeBuffer()
+			opts := gopacket.SerializeOptions{
+				ComputeChecksums: true,
+				FixLengths:       true,
+			}
+			if err = gopacket.SerializeLayers(buf, opts, ipv6, tcp, gopacket.Payload("test")); err != nil {
+				t.Errorf("failed to serialize packet: %v", err)
+				return
+			}
+
+			if drop := m.dropFilter(buf.Bytes(), m.incomingRules, true); drop != tt.drop {
+				t.Errorf("expected drop %v for %s port %d, got %v", tt.drop, tt.srcIP, tt.dstPort, drop)
+			}
+		})
+	}
+
+	if err = m.Reset(); err != nil {
+		t.Errorf("failed to reset Manager: %v", err)
+		return
+	}
+}
+
 // TestRemovePacketHook tests the functionality of the RemovePacketHook method
 func TestRemovePacketHook(t *testing.T) {
 	// creating mock iface
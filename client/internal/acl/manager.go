@@ -5,13 +5,16 @@ import (
 	"encoding/hex"
 	"fmt"
 	"net"
+	"net/netip"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
 	log "github.com/sirupsen/logrus"
 
 	firewall "github.com/netbirdio/netbird/client/firewall/manager"
+	"github.com/netbirdio/netbird/client/internal/perftest"
 	"github.com/netbirdio/netbird/client/ssh"
 	mgmProto "github.com/netbirdio/netbird/management/proto"
 )
@@ -19,6 +22,8 @@ import (
 // Manager is a ACL rules manager
 type Manager interface {
 	ApplyFiltering(networkMap *mgmProto.NetworkMap)
+	// IsPeerAllowed returns true if the policies allow the peer to connect to this peer on any port
+	IsPeerAllowed(ip net.IP) bool
 }
 
 // DefaultManager uses firewall manager to handle
@@ -27,13 +32,39 @@ type DefaultManager struct {
 	ipsetCounter int
 	rulesPairs   map[string][]firewall.Rule
 	mutex        sync.Mutex
+
+	// peerTestRulesPairs open the port of the peer test responder, they are kept apart from the policy rules
+	peerTestRulesPairs map[string][]firewall.Rule
+	// allowedPeers are the peers allowed to connect to this peer on any port, 0.0.0.0 allows all peers
+	allowedPeers map[string]struct{}
+	// ipv6Supported is set when the firewall filters IPv6 traffic, the rules of IPv6 peer addresses are skipped otherwise
+	ipv6Supported bool
+	// peerTestResponder opens the port of the peer test responder of this peer, the outbound peer test traffic is
+	// always allowed
+	peerTestResponder bool
 }
 
-func NewDefaultManager(fm firewall.Manager) *DefaultManager {
+func NewDefaultManager(fm firewall.Manager, peerTestResponder bool) *DefaultManager {
 	return &DefaultManager{
-		firewall:   fm,
-		rulesPairs: make(map[string][]firewall.Rule),
+		firewall:           fm,
+		rulesPairs:         make(map[string][]firewall.Rule),
+		peerTestRulesPairs: make(map[string][]firewall.Rule),
+		allowedPeers:       make(map[string]struct{}),
+		ipv6Supported:      fm != nil && fm.IsIPv6Supported(),
+		peerTestResponder:  peerTestResponder,
+	}
+}
+
+// IsPeerAllowed returns true if the policies allow the peer to connect to this peer on any port
+func (d *DefaultManager) IsPeerAllowed(ip net.IP) bool {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if _, ok := d.allowedPeers[net.IPv4zero.String()]; ok {
+		return true
 	}
+	_, ok := d.allowedPeers[ip.String()]
+	return ok
 }
 
 // ApplyFiltering firewall rules to the local firewall manager processed by ACL policy.
@@ -147,6 +178,92 @@ func (d *DefaultManager) ApplyFiltering(networkMap *mgmProto.NetworkMap) {
 		}
 	}
 	d.rulesPairs = newRulePairs
+
+	d.applyPeerTestRules(rules)
+}
+
+// applyPeerTestRules allows the peer test traffic with the peers the rules allow all ports for: to the responder of
+// this peer from the peers allowed to connect to it when the responder is enabled, and to the responders of the peers
+// this peer may connect to
+func (d *DefaultManager) applyPeerTestRules(rules []*mgmProto.FirewallRule) {
+	allowedPeers := make(map[string]struct{})
+	newRulePairs := make(map[string][]firewall.Rule)
+	port := &firewall.Port{Values: []int{perftest.DefaultPort}}
+
+	for _, r := range peerTestRules(rules) {
+		if r.Direction == mgmProto.FirewallRule_IN {
+			allowedPeers[r.PeerIP] = struct{}{}
+			if !d.peerTestResponder {
+				continue
+			}
+		}
+
+		ip := net.ParseIP(r.PeerIP)
+		if ip == nil {
+			continue
+		}
+
+		for _, protocol := range []firewall.Protocol{firewall.ProtocolTCP, firewall.ProtocolUDP} {
+			if r.Protocol != mgmProto.FirewallRule_ALL && string(protocol) != strings.ToLower(r.Protocol.String()) {
+				continue
+			}
+
+			ruleID := d.getRuleID(ip, protocol, int(r.Direction), port, firewall.ActionAccept, "")
+			if rulesPair, ok := d.peerTestRulesPairs[ruleID]; ok {
+				newRulePairs[ruleID] = rulesPair
+				continue
+			}
+
+			var rulesPair []firewall.Rule
+			var err error
+			if r.Direction == mgmProto.FirewallRule_IN {
+				rulesPair, err = d.addInRules(ip, protocol, port, firewall.ActionAccept, "", "")
+			} else {
+				rulesPair, err = d.addOutRules(ip, protocol, port, firewall.ActionAccept, "", "")
+			}
+			if err != nil {
+				log.Errorf("failed to add peer test firewall rule: %v", err)
+				continue
+			}
+			newRulePairs[ruleID] = rulesPair
+		}
+	}
+
+	for ruleID, rulesPair := range d.peerTestRulesPairs {
+		if _, ok := newRulePairs[ruleID]; ok {
+			continue
+		}
+		for _, rule := range rulesPair {
+			if err := d.firewall.DeleteRule(rule); err != nil {
+				log.Errorf("failed to delete peer test firewall rule: %v", err)
+			}
+		}
+	}
+
+	d.peerTestRulesPairs = newRulePairs
+	d.allowedPeers = allowedPeers
+}
+
+// peerTestRules returns the accept rules of the TCP and UDP traffic without port restriction, one per peer and direction
+func peerTestRules(rules []*mgmProto.FirewallRule) []*mgmProto.FirewallRule {
+	var result []*mgmProto.FirewallRule
+	seen := make(map[string]struct{})
+	for _, r := range rules {
+		if r.Action != mgmProto.FirewallRule_ACCEPT || r.Port != "" || r.PortInfo != nil {
+			continue
+		}
+		if r.Protocol != mgmProto.FirewallRule_ALL && r.Protocol != mgmProto.FirewallRule_TCP && r.Protocol != mgmProto.FirewallRule_UDP {
+			continue
+		}
+
+		key := fmt.Sprintf("%s:%d:%d", r.PeerIP, r.Direction, r.Protocol)
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		result = append(result, r)
+	}
+	return result
 }
 
 func (d *DefaultManager) protoRuleToFirewallRule(
@@ -168,15 +285,9 @@ func (d *DefaultManager) protoRuleToFirewallRule(
 		return "", nil, fmt.Errorf("skipping firewall rule: %s", err)
 	}
 
-	var port *firewall.Port
-	if r.Port != "" {
-		value, err := strconv.Atoi(r.Port)
-		if err != nil {
-			return "", nil, fmt.Errorf("invalid port, skipping firewall rule")
-		}
-		port = &firewall.Port{
-			Values: []int{value},
-		}
+	port, err := convertPortInfo(r)
+	if err != nil {
+		return "", nil, fmt.Errorf("skipping firewall rule: %s", err)
 	}
 
 	ruleID := d.getRuleID(ip, protocol, int(r.Direction), port, action, "")
@@ -284,10 +395,14 @@ func (d *DefaultManager) getRuleID(
 func (d *DefaultManager) squashAcceptRules(
 	networkMap *mgmProto.NetworkMap,
 ) ([]*mgmProto.FirewallRule, map[mgmProto.FirewallRuleProtocol]struct{}) {
+	firewallRules := d.supportedRules(networkMap.FirewallRules)
+
 	totalIPs := 0
 	for _, p := range append(networkMap.RemotePeers, networkMap.OfflinePeers...) {
-		for range p.AllowedIps {
-			totalIPs++
+		for _, allowedIP := range p.AllowedIps {
+			if d.ipv6Supported || !isIPv6Prefix(allowedIP) {
+				totalIPs++
+			}
 		}
 	}
 
@@ -308,7 +423,7 @@ func (d *DefaultManager) squashAcceptRules(
 	//
 	// We zeroed this to notify squash function that this protocol can't be squashed.
 	addRuleToCalculationMap := func(i int, r *mgmProto.FirewallRule, protocols protoMatch) {
-		drop := r.Action == mgmProto.FirewallRule_DROP || r.Port != ""
+		drop := r.Action == mgmProto.FirewallRule_DROP || r.Port != "" || r.PortInfo != nil
 		if drop {
 			protocols[r.Protocol] = map[string]int{}
 			return
@@ -334,7 +449,7 @@ func (d *DefaultManager) squashAcceptRules(
 		ipset[r.PeerIP] = i
 	}
 
-	for i, r := range networkMap.FirewallRules {
+	for i, r := range firewallRules {
 		// calculate squash for different directions
 		if r.Direction == mgmProto.FirewallRule_IN {
 			addRuleToCalculationMap(i, r, in)
@@ -387,13 +502,13 @@ func (d *DefaultManager) squashAcceptRules(
 	}
 
 	if len(squashedRules) == 0 {
-		return networkMap.FirewallRules, squashedProtocols
+		return firewallRules, squashedProtocols
 	}
 
 	var rules []*mgmProto.FirewallRule
 	// filter out rules which was squashed from final list
 	// if we also have other not squashed rules.
-	for i, r := range networkMap.FirewallRules {
+	for i, r := range firewallRules {
 		if _, ok := squashedProtocols[r.Protocol]; ok {
 			if m, ok := in[r.Protocol]; ok && m[r.PeerIP] == i {
 				continue
@@ -407,6 +522,28 @@ func (d *DefaultManager) squashAcceptRules(
 	return append(rules, squashedRules...), squashedProtocols
 }
 
+// supportedRules returns the rules the firewall can apply, the rules of IPv6 peer addresses are dropped when it
+// doesn't filter IPv6 traffic as the peers are not reachable over IPv6 then
+func (d *DefaultManager) supportedRules(rules []*mgmProto.FirewallRule) []*mgmProto.FirewallRule {
+	if d.ipv6Supported {
+		return rules
+	}
+
+	supported := make([]*mgmProto.FirewallRule, 0, len(rules))
+	for _, r := range rules {
+		if ip := net.ParseIP(r.PeerIP); ip != nil && ip.To4() == nil {
+			continue
+		}
+		supported = append(supported, r)
+	}
+	return supported
+}
+
+func isIPv6Prefix(allowedIP string) bool {
+	prefix, err := netip.ParsePrefix(allowedIP)
+	return err == nil && !prefix.Addr().Unmap().Is4()
+}
+
 // getRuleGroupingSelector takes all rule properties except IP address to build selector
 func (d *DefaultManager) getRuleGroupingSelector(rule *mgmProto.FirewallRule) string {
 	return fmt.Sprintf("%v:%v:%v:%s", strconv.Itoa(int(rule.Direction)), rule.Action, rule.Protocol, rule.Port)
@@ -438,6 +575,35 @@ func convertToFirewallProtocol(protocol mgmProto.FirewallRuleProtocol) (firewall
 	}
 }
 
+// convertPortInfo returns the port of the rule, preferring PortInfo over the legacy Port field.
+// Returns nil if the rule applies to all ports
+func convertPortInfo(r *mgmProto.FirewallRule) (*firewall.Port, error) {
+	if r.PortInfo == nil {
+		if r.Port == "" {
+			return nil, nil
+		}
+		value, err := strconv.Atoi(r.Port)
+		if err != nil {
+			return nil, fmt.Errorf("invalid port %s", r.Port)
+		}
+		return &firewall.Port{Values: []int{value}}, nil
+	}
+
+	if portRange := r.PortInfo.GetRange(); portRange != nil {
+		start, end := portRange.GetStart(), portRange.GetEnd()
+		if start == 0 || end > 65535 || start > end {
+			return nil, fmt.Errorf("invalid port range %d-%d", start, end)
+		}
+		return &firewall.Port{IsRange: true, Values: []int{int(start), int(end)}}, nil
+	}
+
+	port := r.PortInfo.GetPort()
+	if port == 0 || port > 65535 {
+		return nil, fmt.Errorf("invalid port %d", port)
+	}
+	return &firewall.Port{Values: []int{int(port)}}, nil
+}
+
 func shouldSkipInvertedRule(protocol firewall.Protocol, port *firewall.Port) bool {
 	return protocol == firewall.ProtocolALL || protocol == firewall.ProtocolICMP || port == nil
 }
@@ -2,7 +2,10 @@ package acl
 
 import (
 	"context"
+	"fmt"
 	"net"
+	"reflect"
+	"sort"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -60,7 +63,7 @@ func TestDefaultManager(t *testing.T) {
 	defer func(fw manager.Manager) {
 		_ = fw.Reset()
 	}(fw)
-	acl := NewDefaultManager(fw)
+	acl := NewDefaultManager(fw, false)
 
 	t.Run("apply firewall rules", func(t *testing.T) {
 		acl.ApplyFiltering(networkMap)
@@ -293,6 +296,57 @@ func TestDefaultManagerSquashRulesNoAffect(t *testing.T) {
 	}
 }
 
+func TestDefaultManagerSquashRulesIPv6(t *testing.T) {
+	networkMap := &mgmProto.NetworkMap{
+		RemotePeers: []*mgmProto.RemotePeerConfig{
+			{AllowedIps: []string{"10.93.0.1/32", "fd00::1/128"}},
+			{AllowedIps: []string{"10.93.0.2/32", "fd00::2/128"}},
+		},
+		FirewallRules: []*mgmProto.FirewallRule{
+			{
+				PeerIP:    "10.93.0.1",
+				Direction: mgmProto.FirewallRule_IN,
+				Action:    mgmProto.FirewallRule_ACCEPT,
+				Protocol:  mgmProto.FirewallRule_ALL,
+			},
+			{
+				PeerIP:    "fd00::1",
+				Direction: mgmProto.FirewallRule_IN,
+				Action:    mgmProto.FirewallRule_ACCEPT,
+				Protocol:  mgmProto.FirewallRule_ALL,
+			},
+			{
+				PeerIP:    "10.93.0.2",
+				Direction: mgmProto.FirewallRule_IN,
+				Action:    mgmProto.FirewallRule_ACCEPT,
+				Protocol:  mgmProto.FirewallRule_ALL,
+			},
+		},
+	}
+
+	for _, ipv6Supported := range []bool{true, false} {
+		t.Run(fmt.Sprintf("IPv6 supported %t", ipv6Supported), func(t *testing.T) {
+			manager := &DefaultManager{ipv6Supported: ipv6Supported}
+			rules, _ := manager.squashAcceptRules(networkMap)
+
+			var peerIPs []string
+			for _, r := range rules {
+				peerIPs = append(peerIPs, r.PeerIP)
+			}
+			sort.Strings(peerIPs)
+
+			// the rules don't cover the IPv6 address of the second peer, they can only be squashed without IPv6
+			expected := []string{"0.0.0.0"}
+			if ipv6Supported {
+				expected = []string{"10.93.0.1", "10.93.0.2", "fd00::1"}
+			}
+			if !reflect.DeepEqual(peerIPs, expected) {
+				t.Errorf("expected rules for %v, got %v", expected, peerIPs)
+			}
+		})
+	}
+}
+
 func TestDefaultManagerEnableSSHRules(t *testing.T) {
 	networkMap := &mgmProto.NetworkMap{
 		PeerConfig: &mgmProto.PeerConfig{
@@ -353,7 +407,7 @@ func TestDefaultManagerEnableSSHRules(t *testing.T) {
 	defer func(fw manager.Manager) {
 		_ = fw.Reset()
 	}(fw)
-	acl := NewDefaultManager(fw)
+	acl := NewDefaultManager(fw, false)
 
 	acl.ApplyFiltering(networkMap)
 
@@ -362,3 +416,172 @@ func TestDefaultManagerEnableSSHRules(t *testing.T) {
 		return
 	}
 }
+
+func TestDefaultManagerPeerTestRules(t *testing.T) {
+	networkMap := &mgmProto.NetworkMap{
+		RemotePeers: []*mgmProto.RemotePeerConfig{
+			{AllowedIps: []string{"10.93.0.1"}},
+			{AllowedIps: []string{"10.93.0.2"}},
+			{AllowedIps: []string{"10.93.0.3"}},
+		},
+		FirewallRules: []*mgmProto.FirewallRule{
+			{
+				PeerIP:    "10.93.0.1",
+				Direction: mgmProto.FirewallRule_IN,
+				Action:    mgmProto.FirewallRule_ACCEPT,
+				Protocol:  mgmProto.FirewallRule_ALL,
+			},
+			{
+				PeerIP:    "10.93.0.2",
+				Direction: mgmProto.FirewallRule_IN,
+				Action:    mgmProto.FirewallRule_ACCEPT,
+				Protocol:  mgmProto.FirewallRule_TCP,
+				Port:      "443",
+			},
+			{
+				PeerIP:    "10.93.0.3",
+				Direction: mgmProto.FirewallRule_OUT,
+				Action:    mgmProto.FirewallRule_ACCEPT,
+				Protocol:  mgmProto.FirewallRule_UDP,
+			},
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ifaceMock := mocks.NewMockIFaceMapper(ctrl)
+	ifaceMock.EXPECT().IsUserspaceBind().Return(true).AnyTimes()
+	ifaceMock.EXPECT().SetFilter(gomock.Any())
+	ip, network, err := net.ParseCIDR("172.0.0.1/32")
+	if err != nil {
+		t.Fatalf("failed to parse IP address: %v", err)
+	}
+
+	ifaceMock.EXPECT().Name().Return("lo").AnyTimes()
+	ifaceMock.EXPECT().Address().Return(iface.WGAddress{
+		IP:      ip,
+		Network: network,
+	}).AnyTimes()
+
+	fw, err := firewall.NewFirewall(context.Background(), ifaceMock)
+	if err != nil {
+		t.Errorf("create firewall: %v", err)
+		return
+	}
+	defer func(fw manager.Manager) {
+		_ = fw.Reset()
+	}(fw)
+	acl := NewDefaultManager(fw, true)
+
+	acl.ApplyFiltering(networkMap)
+
+	if len(acl.rulesPairs) != 3 {
+		t.Errorf("expect 3 policy rules, got: %d", len(acl.rulesPairs))
+	}
+	// TCP and UDP in from the first peer, UDP out to the third peer
+	if len(acl.peerTestRulesPairs) != 3 {
+		t.Errorf("expect 3 peer test rules, got: %d", len(acl.peerTestRulesPairs))
+	}
+
+	if !acl.IsPeerAllowed(net.ParseIP("10.93.0.1")) {
+		t.Errorf("peer allowed by a policy without port restriction should be allowed")
+	}
+	if acl.IsPeerAllowed(net.ParseIP("10.93.0.2")) {
+		t.Errorf("peer allowed on a single port should not be allowed")
+	}
+	if acl.IsPeerAllowed(net.ParseIP("10.93.0.3")) {
+		t.Errorf("peer without an inbound policy should not be allowed")
+	}
+
+	rules := networkMap.FirewallRules
+	networkMap.FirewallRules = rules[1:]
+	acl.ApplyFiltering(networkMap)
+
+	if len(acl.peerTestRulesPairs) != 1 {
+		t.Errorf("expect the peer test rules of the removed policy to be deleted, got: %d", len(acl.peerTestRulesPairs))
+	}
+	if acl.IsPeerAllowed(net.ParseIP("10.93.0.1")) {
+		t.Errorf("peer of the removed policy should not be allowed")
+	}
+
+	networkMap.FirewallRules = nil
+	acl.ApplyFiltering(networkMap)
+
+	networkMap.FirewallRules = rules
+	noResponder := NewDefaultManager(fw, false)
+	noResponder.ApplyFiltering(networkMap)
+
+	// only UDP out to the third peer
+	if len(noResponder.peerTestRulesPairs) != 1 {
+		t.Errorf("expect no inbound peer test rules without the responder, got: %d", len(noResponder.peerTestRulesPairs))
+	}
+	if !noResponder.IsPeerAllowed(net.ParseIP("10.93.0.1")) {
+		t.Errorf("peer allowed by a policy without port restriction should be allowed")
+	}
+}
+
+func TestConvertPortInfo(t *testing.T) {
+	tests := []struct {
+		name     string
+		rule     *mgmProto.FirewallRule
+		expected *manager.Port
+		wantErr  bool
+	}{
+		{
+			name: "no port",
+			rule: &mgmProto.FirewallRule{},
+		},
+		{
+			name:     "legacy port",
+			rule:     &mgmProto.FirewallRule{Port: "80"},
+			expected: &manager.Port{Values: []int{80}},
+		},
+		{
+			name: "single port",
+			rule: &mgmProto.FirewallRule{
+				Port:     "80",
+				PortInfo: &mgmProto.PortInfo{PortSelection: &mgmProto.PortInfo_Port{Port: 80}},
+			},
+			expected: &manager.Port{Values: []int{80}},
+		},
+		{
+			name: "port range",
+			rule: &mgmProto.FirewallRule{
+				Port:     "30000-32767",
+				PortInfo: &mgmProto.PortInfo{PortSelection: &mgmProto.PortInfo_Range_{Range: &mgmProto.PortInfo_Range{Start: 30000, End: 32767}}},
+			},
+			expected: &manager.Port{IsRange: true, Values: []int{30000, 32767}},
+		},
+		{
+			name: "invalid port range",
+			rule: &mgmProto.FirewallRule{
+				PortInfo: &mgmProto.PortInfo{PortSelection: &mgmProto.PortInfo_Range_{Range: &mgmProto.PortInfo_Range{Start: 32767, End: 30000}}},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "legacy port range",
+			rule:    &mgmProto.FirewallRule{Port: "30000-32767"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			port, err := convertPortInfo(tt.rule)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("expected error, got port %v", port)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(port, tt.expected) {
+				t.Errorf("expected port %v, got %v", tt.expected, port)
+			}
+		})
+	}
+}
@@ -17,9 +17,12 @@ import (
 // HostedGrantType grant type for device flow on Hosted
 const (
 	HostedGrantType = "urn:ietf:params:oauth:grant-type:device_code"
+	// RefreshTokenGrantType grant type for renewing a token with a refresh token
+	RefreshTokenGrantType = "refresh_token"
 )
 
 var _ OAuthFlow = &DeviceAuthorizationFlow{}
+var _ TokenRefresher = &DeviceAuthorizationFlow{}
 
 // DeviceAuthorizationFlow implements the OAuthFlow interface,
 // for the Device Authorization Flow.
@@ -120,6 +123,10 @@ func (d *DeviceAuthorizationFlow) requestToken(info AuthFlowInfo) (TokenRequestR
 	form.Add("grant_type", HostedGrantType)
 	form.Add("device_code", info.DeviceCode)
 
+	return d.postTokenRequest(form)
+}
+
+func (d *DeviceAuthorizationFlow) postTokenRequest(form url.Values) (TokenRequestResponse, error) {
 	req, err := http.NewRequest("POST", d.providerConfig.TokenEndpoint, strings.NewReader(form.Encode()))
 	if err != nil {
 		return TokenRequestResponse{}, fmt.Errorf("failed to create request access token: %v", err)
@@ -202,3 +209,43 @@ func (d *DeviceAuthorizationFlow) WaitToken(ctx context.Context, info AuthFlowIn
 		}
 	}
 }
+
+// RefreshToken exchanges the refresh token of a previous login for a new access token
+func (d *DeviceAuthorizationFlow) RefreshToken(ctx context.Context, refreshToken string) (TokenInfo, error) {
+	if err := ctx.Err(); err != nil {
+		return TokenInfo{}, err
+	}
+
+	form := url.Values{}
+	form.Add("client_id", d.providerConfig.ClientID)
+	form.Add("grant_type", RefreshTokenGrantType)
+	form.Add("refresh_token", refreshToken)
+
+	tokenResponse, err := d.postTokenRequest(form)
+	if err != nil {
+		return TokenInfo{}, fmt.Errorf("refreshing token failed with error: %v", err)
+	}
+
+	if tokenResponse.Error != "" {
+		return TokenInfo{}, fmt.Errorf("refreshing token failed: %s %s", tokenResponse.Error, tokenResponse.ErrorDescription)
+	}
+
+	tokenInfo := TokenInfo{
+		AccessToken:  tokenResponse.AccessToken,
+		TokenType:    tokenResponse.TokenType,
+		RefreshToken: tokenResponse.RefreshToken,
+		IDToken:      tokenResponse.IDToken,
+		ExpiresIn:    tokenResponse.ExpiresIn,
+		UseIDToken:   d.providerConfig.UseIDToken,
+	}
+	// providers that don't rotate the refresh token keep accepting the previous one
+	if tokenInfo.RefreshToken == "" {
+		tokenInfo.RefreshToken = refreshToken
+	}
+
+	if err := isValidAccessToken(tokenInfo.GetTokenToUse(), d.providerConfig.Audience); err != nil {
+		return TokenInfo{}, fmt.Errorf("validate access token failed with error: %v", err)
+	}
+
+	return tokenInfo, nil
+}
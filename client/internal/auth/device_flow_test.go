@@ -304,3 +304,39 @@ func TestHosted_WaitToken(t *testing.T) {
 		})
 	}
 }
+
+func TestHosted_RefreshToken(t *testing.T) {
+	audience := "ok"
+	clientID := "bla"
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"aud": audience})
+	var hmacSampleSecret []byte
+	tokenString, _ := token.SignedString(hmacSampleSecret)
+
+	form := url.Values{}
+	form.Add("client_id", clientID)
+	form.Add("grant_type", RefreshTokenGrantType)
+	form.Add("refresh_token", "refresh")
+
+	httpClient := &mockHTTPClient{
+		code:    200,
+		resBody: fmt.Sprintf("{\"access_token\":\"%s\"}", tokenString),
+	}
+	deviceFlow := &DeviceAuthorizationFlow{
+		providerConfig: internal.DeviceAuthProviderConfig{
+			Audience:      audience,
+			ClientID:      clientID,
+			TokenEndpoint: "test.hosted.com/token",
+		},
+		HTTPClient: httpClient,
+	}
+
+	tokenInfo, err := deviceFlow.RefreshToken(context.Background(), "refresh")
+	require.NoError(t, err)
+	require.Equal(t, form.Encode(), httpClient.reqBody)
+	require.Equal(t, tokenString, tokenInfo.AccessToken)
+	require.Equal(t, "refresh", tokenInfo.RefreshToken, "the refresh token is kept when the provider does not rotate it")
+
+	httpClient.resBody = "{\"error\":\"invalid_grant\",\"error_description\":\"refresh token expired\"}"
+	_, err = deviceFlow.RefreshToken(context.Background(), "refresh")
+	require.ErrorContains(t, err, "invalid_grant")
+}
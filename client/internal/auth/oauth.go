@@ -20,6 +20,12 @@ type OAuthFlow interface {
 	GetClientID(ctx context.Context) string
 }
 
+// TokenRefresher is implemented by the flows that can renew the login with the refresh token of a previous one,
+// without the user interaction
+type TokenRefresher interface {
+	RefreshToken(ctx context.Context, refreshToken string) (TokenInfo, error)
+}
+
 // HTTPClient http client interface for API calls
 type HTTPClient interface {
 	Do(req *http.Request) (*http.Response, error)
@@ -23,6 +23,7 @@ import (
 )
 
 var _ OAuthFlow = &PKCEAuthorizationFlow{}
+var _ TokenRefresher = &PKCEAuthorizationFlow{}
 
 const (
 	queryState                = "state"
@@ -198,6 +199,25 @@ func (p *PKCEAuthorizationFlow) handleRequest(req *http.Request) (*oauth2.Token,
 	)
 }
 
+// RefreshToken exchanges the refresh token of a previous login for a new access token
+func (p *PKCEAuthorizationFlow) RefreshToken(ctx context.Context, refreshToken string) (TokenInfo, error) {
+	if cert := p.providerConfig.ClientCertPair; cert != nil {
+		tr := &http.Transport{
+			TLSClientConfig: &tls.Config{
+				Certificates: []tls.Certificate{*cert},
+			},
+		}
+		ctx = context.WithValue(ctx, oauth2.HTTPClient, &http.Client{Transport: tr})
+	}
+
+	token, err := p.oAuthConfig.TokenSource(ctx, &oauth2.Token{RefreshToken: refreshToken}).Token()
+	if err != nil {
+		return TokenInfo{}, fmt.Errorf("refreshing token failed with error: %v", err)
+	}
+
+	return p.parseOAuthToken(token)
+}
+
 func (p *PKCEAuthorizationFlow) parseOAuthToken(token *oauth2.Token) (TokenInfo, error) {
 	tokenInfo := TokenInfo{
 		AccessToken:  token.AccessToken,
@@ -17,6 +17,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/netbirdio/netbird/client/internal/routemanager/dynamic"
+	"github.com/netbirdio/netbird/client/internal/statefile"
 	"github.com/netbirdio/netbird/client/ssh"
 	"github.com/netbirdio/netbird/iface"
 	mgm "github.com/netbirdio/netbird/management/client"
@@ -58,6 +59,8 @@ type ConfigInput struct {
 	DisableAutoConnect  *bool
 	ExtraIFaceBlackList []string
 	DNSRouteInterval    *time.Duration
+	DNSQueryLog         *bool
+	PeerTestResponder   *bool
 	ClientCertPath      string
 	ClientCertKeyPath   string
 }
@@ -105,6 +108,13 @@ type Config struct {
 
 	// DNSRouteInterval is the interval in which the DNS routes are updated
 	DNSRouteInterval time.Duration
+
+	// DNSQueryLog enables the log of the queries answered by the DNS server
+	DNSQueryLog bool
+
+	// PeerTestResponder enables the responder of the connection tests of the peers, it is off by default
+	PeerTestResponder bool
+
 	//Path to a certificate used for mTLS authentication
 	ClientCertPath string
 
@@ -112,6 +122,9 @@ type Config struct {
 	ClientCertKeyPath string
 
 	ClientCertKeyPair *tls.Certificate `json:"-"`
+
+	// path of the config file, empty for in-memory configs
+	path string
 }
 
 // ReadConfig read config file and return with Config. If it is not exists create a new with default values
@@ -121,6 +134,7 @@ func ReadConfig(configPath string) (*Config, error) {
 		if _, err := util.ReadJson(configPath, config); err != nil {
 			return nil, err
 		}
+		config.path = configPath
 		// initialize through apply() without changes
 		if changed, err := config.apply(ConfigInput{}); err != nil {
 			return nil, err
@@ -137,6 +151,7 @@ func ReadConfig(configPath string) (*Config, error) {
 	if err != nil {
 		return nil, err
 	}
+	cfg.path = configPath
 
 	err = WriteOutConfig(configPath, cfg)
 	return cfg, err
@@ -159,6 +174,7 @@ func UpdateOrCreateConfig(input ConfigInput) (*Config, error) {
 		if err != nil {
 			return nil, err
 		}
+		cfg.path = input.ConfigPath
 		err = WriteOutConfig(input.ConfigPath, cfg)
 		return cfg, err
 	}
@@ -179,6 +195,15 @@ func WriteOutConfig(path string, config *Config) error {
 	return util.WriteJson(path, config)
 }
 
+// StatePath returns the path of the client state file kept next to the config file.
+// It is empty for in-memory configs.
+func (config *Config) StatePath() string {
+	if config.path == "" {
+		return ""
+	}
+	return statefile.Path(config.path)
+}
+
 // createNewConfig creates a new config generating a new Wireguard key and saving to file
 func createNewConfig(input ConfigInput) (*Config, error) {
 	config := &Config{
@@ -199,6 +224,7 @@ func update(input ConfigInput) (*Config, error) {
 	if _, err := util.ReadJson(input.ConfigPath, config); err != nil {
 		return nil, err
 	}
+	config.path = input.ConfigPath
 
 	updated, err := config.apply(input)
 	if err != nil {
@@ -395,6 +421,18 @@ func (config *Config) apply(input ConfigInput) (updated bool, err error) {
 
 	}
 
+	if input.DNSQueryLog != nil && *input.DNSQueryLog != config.DNSQueryLog {
+		log.Infof("switching DNS query log to %t", *input.DNSQueryLog)
+		config.DNSQueryLog = *input.DNSQueryLog
+		updated = true
+	}
+
+	if input.PeerTestResponder != nil && *input.PeerTestResponder != config.PeerTestResponder {
+		log.Infof("switching peer test responder to %t", *input.PeerTestResponder)
+		config.PeerTestResponder = *input.PeerTestResponder
+		updated = true
+	}
+
 	if input.ClientCertKeyPath != "" {
 		config.ClientCertKeyPath = input.ClientCertKeyPath
 		updated = true
@@ -105,6 +105,21 @@ func TestExtraIFaceBlackList(t *testing.T) {
 	assert.Contains(t, readConf.(*Config).IFaceBlackList, "eth1")
 }
 
+func TestStatePath(t *testing.T) {
+	dir := t.TempDir()
+	config, err := UpdateOrCreateConfig(ConfigInput{ConfigPath: filepath.Join(dir, "config.json")})
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "state.json"), config.StatePath())
+
+	config, err = ReadConfig(filepath.Join(dir, "config.json"))
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "state.json"), config.StatePath())
+
+	config, err = CreateInMemoryConfig(ConfigInput{})
+	require.NoError(t, err)
+	assert.Empty(t, config.StatePath(), "in-memory configs should not persist state")
+}
+
 func TestHiddenPreSharedKey(t *testing.T) {
 	hidden := "**********"
 	samplePreSharedKey := "mysecretpresharedkey"
@@ -206,6 +206,7 @@ func (c *ConnectClient) run(
 			PubKey:          myPrivateKey.PublicKey().String(),
 			KernelInterface: iface.WireGuardModuleIsLoaded(),
 			FQDN:            loginResp.GetPeerConfig().GetFqdn(),
+			LoginExpiresAt:  loginExpiresAt(loginResp.GetPeerConfig()),
 		}
 		c.statusRecorder.UpdateLocalPeerState(localPeerState)
 
@@ -367,6 +368,7 @@ func createEngineConfig(key wgtypes.Key, config *Config, peerConfig *mgmProto.Pe
 	engineConf := &EngineConfig{
 		WgIfaceName:          config.WgIface,
 		WgAddr:               peerConfig.Address,
+		WgAddrV6:             peerConfig.GetAddressV6(),
 		IFaceBlackList:       config.IFaceBlackList,
 		DisableIPv6Discovery: config.DisableIPv6Discovery,
 		WgPrivateKey:         key,
@@ -379,6 +381,9 @@ func createEngineConfig(key wgtypes.Key, config *Config, peerConfig *mgmProto.Pe
 		RosenpassPermissive:  config.RosenpassPermissive,
 		ServerSSHAllowed:     util.ReturnBoolWithDefaultTrue(config.ServerSSHAllowed),
 		DNSRouteInterval:     config.DNSRouteInterval,
+		DNSQueryLog:          config.DNSQueryLog,
+		PeerTestResponder:    config.PeerTestResponder,
+		StatePath:            config.StatePath(),
 	}
 
 	if config.PreSharedKey != "" {
@@ -0,0 +1,228 @@
+package dns
+
+import (
+	"container/list"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/miekg/dns"
+)
+
+const (
+	// defaultCacheSize is the maximum number of responses kept in the cache
+	defaultCacheSize = 4096
+	// cacheMaxTTL caps the time a positive response is cached
+	cacheMaxTTL = time.Hour
+	// negativeCacheMaxTTL caps the time a NXDOMAIN or NODATA response is cached
+	negativeCacheMaxTTL = 5 * time.Minute
+	// prefetchMinHits is the number of hits after which an entry is refreshed before it expires
+	prefetchMinHits = 3
+	// prefetchRemainingRatio is the part of the TTL left when a hot entry is prefetched
+	prefetchRemainingRatio = 0.1
+)
+
+// cacheKey identifies a question. The DO and CD bits change the response of DNSSEC aware upstreams.
+type cacheKey struct {
+	name  string
+	qType uint16
+	class uint16
+	do    bool
+	cd    bool
+}
+
+func newCacheKey(r *dns.Msg) cacheKey {
+	question := r.Question[0]
+	key := cacheKey{
+		name:  strings.ToLower(question.Name),
+		qType: question.Qtype,
+		class: question.Qclass,
+		cd:    r.CheckingDisabled,
+	}
+	if opt := r.IsEdns0(); opt != nil {
+		key.do = opt.Do()
+	}
+	return key
+}
+
+type cacheEntry struct {
+	key         cacheKey
+	msg         *dns.Msg
+	storedAt    time.Time
+	ttl         time.Duration
+	hits        int
+	prefetching bool
+}
+
+// responseCache is a LRU cache of upstream responses shared by the upstream handlers.
+// Positive responses are kept for their lowest TTL, negative ones for the SOA minimum as described in RFC 2308.
+type responseCache struct {
+	mu         sync.Mutex
+	maxEntries int
+	entries    map[cacheKey]*list.Element
+	lru        *list.List
+	now        func() time.Time
+}
+
+func newResponseCache(maxEntries int) *responseCache {
+	return &responseCache{
+		maxEntries: maxEntries,
+		entries:    make(map[cacheKey]*list.Element),
+		lru:        list.New(),
+		now:        time.Now,
+	}
+}
+
+// get returns a response to the request from the cache with the TTLs reduced by the time spent in it.
+// prefetch is true when the entry is hot and about to expire, the caller should refresh it.
+func (c *responseCache) get(key cacheKey, r *dns.Msg) (resp *dns.Msg, prefetch bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	entry := element.Value.(*cacheEntry)
+
+	elapsed := c.now().Sub(entry.storedAt)
+	if elapsed >= entry.ttl {
+		c.removeElement(element)
+		return nil, false
+	}
+	c.lru.MoveToFront(element)
+	entry.hits++
+
+	resp = entry.msg.Copy()
+	resp.Id = r.Id
+	resp.Question = r.Question
+	decreaseTTLs(resp, uint32(elapsed/time.Second))
+
+	if entry.hits >= prefetchMinHits && !entry.prefetching &&
+		entry.ttl-elapsed <= time.Duration(float64(entry.ttl)*prefetchRemainingRatio) {
+		entry.prefetching = true
+		prefetch = true
+	}
+
+	return resp, prefetch
+}
+
+// store caches the response if it is cacheable
+func (c *responseCache) store(key cacheKey, resp *dns.Msg) {
+	ttl, ok := cacheTTL(resp)
+	if !ok {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if element, ok := c.entries[key]; ok {
+		c.removeElement(element)
+	}
+
+	c.entries[key] = c.lru.PushFront(&cacheEntry{
+		key:      key,
+		msg:      resp.Copy(),
+		storedAt: c.now(),
+		ttl:      ttl,
+	})
+
+	for c.lru.Len() > c.maxEntries {
+		c.removeElement(c.lru.Back())
+	}
+}
+
+// prefetchDone allows the entry to be prefetched again, e.g. after the prefetch failed
+func (c *responseCache) prefetchDone(key cacheKey) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if element, ok := c.entries[key]; ok {
+		element.Value.(*cacheEntry).prefetching = false
+	}
+}
+
+// flush removes all the cached responses
+func (c *responseCache) flush() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries = make(map[cacheKey]*list.Element)
+	c.lru.Init()
+}
+
+func (c *responseCache) len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.lru.Len()
+}
+
+func (c *responseCache) removeElement(element *list.Element) {
+	c.lru.Remove(element)
+	delete(c.entries, element.Value.(*cacheEntry).key)
+}
+
+// cacheTTL returns how long the response can be cached
+func cacheTTL(resp *dns.Msg) (time.Duration, bool) {
+	if resp.Truncated || len(resp.Question) == 0 {
+		return 0, false
+	}
+
+	switch {
+	case resp.Rcode == dns.RcodeSuccess && len(resp.Answer) > 0:
+		ttl, ok := minTTL(resp.Answer, resp.Ns)
+		if !ok {
+			return 0, false
+		}
+		return min(time.Duration(ttl)*time.Second, cacheMaxTTL), true
+	case resp.Rcode == dns.RcodeNameError || resp.Rcode == dns.RcodeSuccess:
+		// negative responses are cached only with a SOA record telling for how long
+		for _, rr := range resp.Ns {
+			if soa, ok := rr.(*dns.SOA); ok {
+				ttl := min(soa.Hdr.Ttl, soa.Minttl)
+				if ttl == 0 {
+					return 0, false
+				}
+				return min(time.Duration(ttl)*time.Second, negativeCacheMaxTTL), true
+			}
+		}
+		return 0, false
+	default:
+		return 0, false
+	}
+}
+
+// minTTL returns the lowest TTL of the records, false when a record must not be cached
+func minTTL(sections ...[]dns.RR) (uint32, bool) {
+	var lowest uint32
+	found := false
+	for _, section := range sections {
+		for _, rr := range section {
+			ttl := rr.Header().Ttl
+			if !found || ttl < lowest {
+				lowest = ttl
+				found = true
+			}
+		}
+	}
+	return lowest, found && lowest > 0
+}
+
+// decreaseTTLs reduces the TTLs of the records by the seconds the response spent in the cache
+func decreaseTTLs(resp *dns.Msg, elapsed uint32) {
+	for _, section := range [][]dns.RR{resp.Answer, resp.Ns, resp.Extra} {
+		for _, rr := range section {
+			header := rr.Header()
+			if header.Rrtype == dns.TypeOPT {
+				continue
+			}
+			if header.Ttl > elapsed {
+				header.Ttl -= elapsed
+			} else {
+				header.Ttl = 0
+			}
+		}
+	}
+}
@@ -0,0 +1,194 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestResponse(t *testing.T, question string, qType uint16, rcode int, records ...string) *dns.Msg {
+	t.Helper()
+
+	resp := new(dns.Msg).SetQuestion(question, qType)
+	resp.Response = true
+	resp.Rcode = rcode
+	for _, record := range records {
+		rr, err := dns.NewRR(record)
+		require.NoError(t, err)
+		if rr.Header().Rrtype == dns.TypeSOA {
+			resp.Ns = append(resp.Ns, rr)
+			continue
+		}
+		resp.Answer = append(resp.Answer, rr)
+	}
+	return resp
+}
+
+func TestResponseCache(t *testing.T) {
+	now := time.Now()
+	cache := newResponseCache(defaultCacheSize)
+	cache.now = func() time.Time { return now }
+
+	request := new(dns.Msg).SetQuestion("Example.COM.", dns.TypeA)
+	key := newCacheKey(request)
+
+	cache.store(key, newTestResponse(t, "example.com.", dns.TypeA, dns.RcodeSuccess,
+		"example.com. 300 IN A 10.0.0.1", "example.com. 60 IN A 10.0.0.2"))
+
+	now = now.Add(20 * time.Second)
+	resp, prefetch := cache.get(newCacheKey(new(dns.Msg).SetQuestion("example.com.", dns.TypeA)), request)
+	require.NotNil(t, resp, "the key should ignore the case of the name")
+	assert.False(t, prefetch)
+	assert.Equal(t, request.Id, resp.Id)
+	assert.Equal(t, "Example.COM.", resp.Question[0].Name, "the question of the request should be kept")
+	assert.Equal(t, uint32(280), resp.Answer[0].Header().Ttl, "the TTLs should be reduced by the time spent in the cache")
+	assert.Equal(t, uint32(40), resp.Answer[1].Header().Ttl)
+
+	resp.Answer[0].Header().Ttl = 1
+	resp, _ = cache.get(key, request)
+	assert.Equal(t, uint32(280), resp.Answer[0].Header().Ttl, "the cached response should not be changed by the callers")
+
+	now = now.Add(40 * time.Second)
+	resp, _ = cache.get(key, request)
+	assert.Nil(t, resp, "the response should expire with its lowest TTL")
+	assert.Zero(t, cache.len())
+}
+
+func TestResponseCache_Negative(t *testing.T) {
+	now := time.Now()
+	cache := newResponseCache(defaultCacheSize)
+	cache.now = func() time.Time { return now }
+
+	soa := "example.com. 3600 IN SOA ns.example.com. admin.example.com. 1 7200 3600 1209600 30"
+
+	nxRequest := new(dns.Msg).SetQuestion("missing.example.com.", dns.TypeA)
+	cache.store(newCacheKey(nxRequest), newTestResponse(t, "missing.example.com.", dns.TypeA, dns.RcodeNameError, soa))
+
+	noDataRequest := new(dns.Msg).SetQuestion("example.com.", dns.TypeAAAA)
+	cache.store(newCacheKey(noDataRequest), newTestResponse(t, "example.com.", dns.TypeAAAA, dns.RcodeSuccess, soa))
+
+	noSOARequest := new(dns.Msg).SetQuestion("nosoa.example.com.", dns.TypeA)
+	cache.store(newCacheKey(noSOARequest), newTestResponse(t, "nosoa.example.com.", dns.TypeA, dns.RcodeNameError))
+
+	failureRequest := new(dns.Msg).SetQuestion("failure.example.com.", dns.TypeA)
+	cache.store(newCacheKey(failureRequest), newTestResponse(t, "failure.example.com.", dns.TypeA, dns.RcodeServerFailure, soa))
+
+	truncatedResponse := newTestResponse(t, "truncated.example.com.", dns.TypeA, dns.RcodeSuccess, "truncated.example.com. 300 IN A 10.0.0.1")
+	truncatedResponse.Truncated = true
+	cache.store(newCacheKey(new(dns.Msg).SetQuestion("truncated.example.com.", dns.TypeA)), truncatedResponse)
+
+	assert.Equal(t, 2, cache.len(), "only the negative responses with a SOA record should be cached")
+
+	resp, _ := cache.get(newCacheKey(nxRequest), nxRequest)
+	require.NotNil(t, resp)
+	assert.Equal(t, dns.RcodeNameError, resp.Rcode)
+
+	now = now.Add(30 * time.Second)
+	resp, _ = cache.get(newCacheKey(noDataRequest), noDataRequest)
+	assert.Nil(t, resp, "negative responses should expire with the SOA minimum")
+}
+
+func TestResponseCache_Eviction(t *testing.T) {
+	cache := newResponseCache(2)
+
+	keys := make([]cacheKey, 0, 3)
+	for i := 0; i < 3; i++ {
+		name := fmt.Sprintf("host%d.example.com.", i)
+		request := new(dns.Msg).SetQuestion(name, dns.TypeA)
+		keys = append(keys, newCacheKey(request))
+		cache.store(keys[i], newTestResponse(t, name, dns.TypeA, dns.RcodeSuccess, name+" 300 IN A 10.0.0.1"))
+		if i == 1 {
+			// mark the first entry as the recently used one
+			resp, _ := cache.get(keys[0], request)
+			require.NotNil(t, resp)
+		}
+	}
+
+	assert.Equal(t, 2, cache.len())
+	resp, _ := cache.get(keys[1], new(dns.Msg))
+	assert.Nil(t, resp, "the least recently used entry should be evicted")
+
+	cache.flush()
+	assert.Zero(t, cache.len())
+}
+
+func TestResponseCache_Prefetch(t *testing.T) {
+	now := time.Now()
+	cache := newResponseCache(defaultCacheSize)
+	cache.now = func() time.Time { return now }
+
+	request := new(dns.Msg).SetQuestion("example.com.", dns.TypeA)
+	key := newCacheKey(request)
+	cache.store(key, newTestResponse(t, "example.com.", dns.TypeA, dns.RcodeSuccess, "example.com. 100 IN A 10.0.0.1"))
+
+	for i := 0; i < prefetchMinHits; i++ {
+		_, prefetch := cache.get(key, request)
+		assert.False(t, prefetch, "entries should not be prefetched before they expire")
+	}
+
+	now = now.Add(95 * time.Second)
+	_, prefetch := cache.get(key, request)
+	assert.True(t, prefetch, "hot entries should be prefetched before they expire")
+
+	_, prefetch = cache.get(key, request)
+	assert.False(t, prefetch, "only one prefetch should run at a time")
+
+	cache.prefetchDone(key)
+	_, prefetch = cache.get(key, request)
+	assert.True(t, prefetch)
+}
+
+type countingUpstream struct {
+	queries atomic.Int32
+	resp    *dns.Msg
+}
+
+func (c *countingUpstream) exchange(_ context.Context, _ string, r *dns.Msg) (*dns.Msg, time.Duration, error) {
+	c.queries.Add(1)
+	resp := c.resp.Copy()
+	resp.Id = r.Id
+	return resp, time.Millisecond, nil
+}
+
+func TestUpstreamResolver_Cache(t *testing.T) {
+	upstream := &countingUpstream{
+		resp: newTestResponse(t, "example.com.", dns.TypeA, dns.RcodeSuccess, "example.com. 300 IN A 10.0.0.1"),
+	}
+	resolver := &upstreamResolverBase{
+		ctx:             context.Background(),
+		upstreamClient:  upstream,
+		upstreamServers: []string{"10.0.0.53:53"},
+		upstreamTimeout: upstreamTimeout,
+		failsTillDeact:  failsTillDeact,
+		cache:           newResponseCache(defaultCacheSize),
+	}
+
+	var answers []*dns.Msg
+	responseWriter := &mockResponseWriter{
+		WriteMsgFunc: func(m *dns.Msg) error {
+			answers = append(answers, m)
+			return nil
+		},
+	}
+
+	for i := 0; i < 3; i++ {
+		resolver.ServeDNS(responseWriter, new(dns.Msg).SetQuestion("example.com.", dns.TypeA))
+	}
+
+	require.Len(t, answers, 3)
+	assert.Equal(t, int32(1), upstream.queries.Load(), "the repeated questions should be answered from the cache")
+	for _, answer := range answers {
+		require.Len(t, answer.Answer, 1)
+		assert.Contains(t, answer.Answer[0].String(), "10.0.0.1")
+	}
+
+	resolver.cache.flush()
+	resolver.ServeDNS(responseWriter, new(dns.Msg).SetQuestion("example.com.", dns.TypeA))
+	assert.Equal(t, int32(2), upstream.queries.Load(), "the upstream should be queried after a flush")
+}
@@ -2,6 +2,7 @@ package dns
 
 import (
 	"fmt"
+	"strings"
 	"sync"
 
 	"github.com/miekg/dns"
@@ -10,11 +11,19 @@ import (
 	nbdns "github.com/netbirdio/netbird/dns"
 )
 
+// maxCNAMEChain limits the local CNAME records followed for a query
+const maxCNAMEChain = 8
+
 type registrationMap map[string]struct{}
 
 type localResolver struct {
 	registeredMap registrationMap
-	records       sync.Map
+
+	mu      sync.RWMutex
+	records map[string][]dns.RR
+	// names counts the records of each name, a name without records of the queried type gets an empty answer
+	// instead of NXDOMAIN
+	names map[string]int
 }
 
 func (d *localResolver) stop() {
@@ -28,10 +37,10 @@ func (d *localResolver) ServeDNS(w dns.ResponseWriter, r *dns.Msg) {
 	replyMessage.RecursionAvailable = true
 	replyMessage.Rcode = dns.RcodeSuccess
 
-	response := d.lookupRecord(r)
-	if response != nil {
-		replyMessage.Answer = append(replyMessage.Answer, response)
-	} else {
+	response := d.lookupRecords(r.Question[0])
+	if len(response) > 0 {
+		replyMessage.Answer = append(replyMessage.Answer, response...)
+	} else if !d.hasName(r.Question[0].Name) {
 		replyMessage.Rcode = dns.RcodeNameError
 	}
 
@@ -41,36 +50,127 @@ func (d *localResolver) ServeDNS(w dns.ResponseWriter, r *dns.Msg) {
 	}
 }
 
-func (d *localResolver) lookupRecord(r *dns.Msg) dns.RR {
-	question := r.Question[0]
-	record, found := d.records.Load(buildRecordKey(question.Name, question.Qclass, question.Qtype))
-	if !found {
-		return nil
+// lookupRecords returns the records of the question, a CNAME is followed while its target is a local name
+func (d *localResolver) lookupRecords(question dns.Question) []dns.RR {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	records := d.records[buildRecordKey(question.Name, question.Qclass, question.Qtype)]
+	if len(records) > 0 || question.Qtype == dns.TypeCNAME {
+		return records
 	}
 
-	return record.(dns.RR)
+	var answer []dns.RR
+	name := question.Name
+	for i := 0; i < maxCNAMEChain; i++ {
+		cnames := d.records[buildRecordKey(name, question.Qclass, dns.TypeCNAME)]
+		if len(cnames) == 0 {
+			break
+		}
+		answer = append(answer, cnames[0])
+
+		name = cnames[0].(*dns.CNAME).Target
+		if records := d.records[buildRecordKey(name, question.Qclass, question.Qtype)]; len(records) > 0 {
+			return append(answer, records...)
+		}
+	}
+
+	// the target is resolved by the client when it is not a local name
+	return answer
+}
+
+func (d *localResolver) hasName(name string) bool {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	return d.names[strings.ToLower(dns.Fqdn(name))] > 0
 }
 
+// registerRecord adds the record to the records of the same name, class and type
 func (d *localResolver) registerRecord(record nbdns.SimpleRecord) error {
-	fullRecord, err := dns.NewRR(record.String())
+	fullRecord, err := newRR(record)
 	if err != nil {
-		return fmt.Errorf("register record: %w", err)
+		return err
 	}
 
-	fullRecord.Header().Rdlength = record.Len()
+	d.mu.Lock()
+	defer d.mu.Unlock()
 
 	header := fullRecord.Header()
-	d.records.Store(buildRecordKey(header.Name, header.Class, header.Rrtype), fullRecord)
+	key := buildRecordKey(header.Name, header.Class, header.Rrtype)
+	d.setRecordsLocked(key, append(d.records[key], fullRecord))
 
 	return nil
 }
 
+// setRecords replaces the records of the key, the records that can't be parsed are skipped
+func (d *localResolver) setRecords(key string, records []nbdns.SimpleRecord) {
+	rrs := make([]dns.RR, 0, len(records))
+	for _, record := range records {
+		fullRecord, err := newRR(record)
+		if err != nil {
+			log.Warnf("got an error while registering the record (%s), error: %v", record.String(), err)
+			continue
+		}
+		rrs = append(rrs, fullRecord)
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.setRecordsLocked(key, rrs)
+}
+
+func (d *localResolver) setRecordsLocked(key string, records []dns.RR) {
+	d.deleteRecordLocked(key)
+	if len(records) == 0 {
+		return
+	}
+
+	if d.records == nil {
+		d.records = make(map[string][]dns.RR)
+		d.names = make(map[string]int)
+	}
+	d.records[key] = records
+	d.names[strings.ToLower(records[0].Header().Name)] += len(records)
+}
+
 func (d *localResolver) deleteRecord(recordKey string) {
-	d.records.Delete(dns.Fqdn(recordKey))
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.deleteRecordLocked(recordKey)
+}
+
+func (d *localResolver) deleteRecordLocked(recordKey string) {
+	records, found := d.records[recordKey]
+	if !found {
+		return
+	}
+	delete(d.records, recordKey)
+
+	name := strings.ToLower(records[0].Header().Name)
+	d.names[name] -= len(records)
+	if d.names[name] <= 0 {
+		delete(d.names, name)
+	}
+}
+
+func newRR(record nbdns.SimpleRecord) (dns.RR, error) {
+	fullRecord, err := dns.NewRR(record.String())
+	if err != nil {
+		return nil, fmt.Errorf("register record: %w", err)
+	}
+	if fullRecord == nil {
+		return nil, fmt.Errorf("register record: empty record %s", record.String())
+	}
+
+	fullRecord.Header().Rdlength = record.Len()
+	return fullRecord, nil
 }
 
 func buildRecordKey(name string, class, qType uint16) string {
-	key := fmt.Sprintf("%s_%d_%d", name, class, qType)
+	key := fmt.Sprintf("%s_%d_%d", strings.ToLower(dns.Fqdn(name)), class, qType)
 	return key
 }
 
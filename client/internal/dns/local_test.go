@@ -86,3 +86,107 @@ func TestLocalResolver_ServeDNS(t *testing.T) {
 		})
 	}
 }
+
+func TestLocalResolver_CustomRecords(t *testing.T) {
+	resolver := &localResolver{
+		registeredMap: make(registrationMap),
+	}
+
+	records := []nbdns.SimpleRecord{
+		{Name: "peera.netbird.cloud.", Type: int(dns.TypeA), Class: nbdns.DefaultClass, TTL: 300, RData: "100.64.0.1"},
+		{Name: "db.netbird.cloud.", Type: int(dns.TypeA), Class: nbdns.DefaultClass, TTL: 300, RData: "100.64.0.2"},
+		{Name: "db.netbird.cloud.", Type: int(dns.TypeA), Class: nbdns.DefaultClass, TTL: 300, RData: "100.64.0.3"},
+		{Name: "db.internal.netbird.cloud.", Type: int(dns.TypeCNAME), Class: nbdns.DefaultClass, TTL: 300, RData: "peera.netbird.cloud."},
+		{Name: "web.netbird.cloud.", Type: int(dns.TypeCNAME), Class: nbdns.DefaultClass, TTL: 300, RData: "www.netbird.io."},
+		{Name: "db.netbird.cloud.", Type: int(dns.TypeTXT), Class: nbdns.DefaultClass, TTL: 300, RData: `"env=prod" "owner=ops"`},
+		{Name: "_postgres._tcp.netbird.cloud.", Type: int(dns.TypeSRV), Class: nbdns.DefaultClass, TTL: 300, RData: "10 5 5432 db.netbird.cloud."},
+	}
+	for _, record := range records {
+		if err := resolver.registerRecord(record); err != nil {
+			t.Fatalf("failed to register record %s: %v", record.String(), err)
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		question      *dns.Msg
+		expectedRcode int
+		expected      []string
+	}{
+		{
+			name:          "Should Resolve All A Records Of A Name",
+			question:      new(dns.Msg).SetQuestion("DB.netbird.cloud.", dns.TypeA),
+			expectedRcode: dns.RcodeSuccess,
+			expected:      []string{"100.64.0.2", "100.64.0.3"},
+		},
+		{
+			name:          "Should Follow A Local CNAME",
+			question:      new(dns.Msg).SetQuestion("db.internal.netbird.cloud.", dns.TypeA),
+			expectedRcode: dns.RcodeSuccess,
+			expected:      []string{"CNAME\tpeera.netbird.cloud.", "100.64.0.1"},
+		},
+		{
+			name:          "Should Answer The CNAME Of An External Target",
+			question:      new(dns.Msg).SetQuestion("web.netbird.cloud.", dns.TypeAAAA),
+			expectedRcode: dns.RcodeSuccess,
+			expected:      []string{"CNAME\twww.netbird.io."},
+		},
+		{
+			name:          "Should Resolve TXT Record",
+			question:      new(dns.Msg).SetQuestion("db.netbird.cloud.", dns.TypeTXT),
+			expectedRcode: dns.RcodeSuccess,
+			expected:      []string{`"env=prod" "owner=ops"`},
+		},
+		{
+			name:          "Should Resolve SRV Record",
+			question:      new(dns.Msg).SetQuestion("_postgres._tcp.netbird.cloud.", dns.TypeSRV),
+			expectedRcode: dns.RcodeSuccess,
+			expected:      []string{"10 5 5432 db.netbird.cloud."},
+		},
+		{
+			name:          "Should Answer No Data For A Missing Type Of A Known Name",
+			question:      new(dns.Msg).SetQuestion("db.netbird.cloud.", dns.TypeAAAA),
+			expectedRcode: dns.RcodeSuccess,
+		},
+		{
+			name:          "Should Answer NXDOMAIN For An Unknown Name",
+			question:      new(dns.Msg).SetQuestion("unknown.netbird.cloud.", dns.TypeA),
+			expectedRcode: dns.RcodeNameError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var responseMSG *dns.Msg
+			responseWriter := &mockResponseWriter{
+				WriteMsgFunc: func(m *dns.Msg) error {
+					responseMSG = m
+					return nil
+				},
+			}
+
+			resolver.ServeDNS(responseWriter, testCase.question)
+
+			if responseMSG == nil {
+				t.Fatalf("should write a response message")
+			}
+			if responseMSG.Rcode != testCase.expectedRcode {
+				t.Fatalf("unexpected rcode, want %d, got %d", testCase.expectedRcode, responseMSG.Rcode)
+			}
+			if len(responseMSG.Answer) != len(testCase.expected) {
+				t.Fatalf("unexpected number of answers, want %d, got %d: %v", len(testCase.expected), len(responseMSG.Answer), responseMSG.Answer)
+			}
+			for i, expected := range testCase.expected {
+				if !strings.Contains(responseMSG.Answer[i].String(), expected) {
+					t.Fatalf("answer %d doesn't contain %s: %s", i, expected, responseMSG.Answer[i].String())
+				}
+			}
+		})
+	}
+
+	resolver.deleteRecord(buildRecordKey("db.netbird.cloud.", dns.ClassINET, dns.TypeA))
+	resolver.deleteRecord(buildRecordKey("db.netbird.cloud.", dns.ClassINET, dns.TypeTXT))
+	if resolver.hasName("db.netbird.cloud.") {
+		t.Fatalf("the name should be removed with its last record")
+	}
+}
@@ -11,6 +11,8 @@ type MockServer struct {
 	InitializeFunc      func() error
 	StopFunc            func()
 	UpdateDNSServerFunc func(serial uint64, update nbdns.Config) error
+	FlushCacheFunc      func()
+	QueryLogFunc        func() *QueryLog
 }
 
 // Initialize mock implementation of Initialize from Server interface
@@ -52,3 +54,18 @@ func (m *MockServer) SearchDomains() []string {
 // ProbeAvailability mocks implementation of ProbeAvailability from the Server interface
 func (m *MockServer) ProbeAvailability() {
 }
+
+// FlushCache mock implementation of FlushCache from Server interface
+func (m *MockServer) FlushCache() {
+	if m.FlushCacheFunc != nil {
+		m.FlushCacheFunc()
+	}
+}
+
+// QueryLog mock implementation of QueryLog from Server interface
+func (m *MockServer) QueryLog() *QueryLog {
+	if m.QueryLogFunc != nil {
+		return m.QueryLogFunc()
+	}
+	return nil
+}
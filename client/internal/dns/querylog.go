@@ -0,0 +1,231 @@
+package dns
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/miekg/dns"
+)
+
+const (
+	// defaultQueryLogSize is the number of queries kept by the query log
+	defaultQueryLogSize = 1000
+
+	// HandlerLocal answers from the records of the local zones
+	HandlerLocal = "local"
+	// HandlerNameserverGroup forwards to the nameservers of a nameserver group
+	HandlerNameserverGroup = "nameserver-group"
+	// HandlerHost forwards to the nameservers of the host, used by the permanent servers of the mobile clients
+	HandlerHost = "host"
+
+	// UpstreamCache is reported as upstream when the response came from the cache
+	UpstreamCache = "cache"
+)
+
+// QueryLogEntry is a query answered by the DNS server
+type QueryLogEntry struct {
+	Time     time.Time
+	Question dns.Question
+	// Handler is the kind of handler that matched the question
+	Handler string
+	// Zone is the pattern of the matched handler
+	Zone string
+	// Upstream is the nameserver that answered, empty for the local zones
+	Upstream string
+	Latency  time.Duration
+	// Rcode is the code of the response, -1 when no response was written
+	Rcode int
+}
+
+// RcodeString returns the name of the response code
+func (e QueryLogEntry) RcodeString() string {
+	if e.Rcode < 0 {
+		return "NORESPONSE"
+	}
+	if rcode, ok := dns.RcodeToString[e.Rcode]; ok {
+		return rcode
+	}
+	return fmt.Sprintf("RCODE%d", e.Rcode)
+}
+
+// String formats the entry as a log line, the question is printed as Go syntax like in the client logs
+func (e QueryLogEntry) String() string {
+	line := fmt.Sprintf("%s %#v type=%s handler=%s zone=%s", e.Time.Format(time.RFC3339Nano), e.Question, dns.TypeToString[e.Question.Qtype], e.Handler, e.Zone)
+	if e.Upstream != "" {
+		line += " upstream=" + e.Upstream
+	}
+	return fmt.Sprintf("%s latency=%s rcode=%s", line, e.Latency, e.RcodeString())
+}
+
+// DomainStats summarizes the queries of a domain in the log
+type DomainStats struct {
+	Domain   string
+	Queries  int
+	NXDomain int
+	// Failures counts the queries without a response or answered with an error other than NXDOMAIN
+	Failures   int
+	AvgLatency time.Duration
+	LastQuery  time.Time
+}
+
+// QueryLog is a ring buffer of the last queries answered by the DNS server. It records nothing until enabled.
+type QueryLog struct {
+	enabled atomic.Bool
+
+	mu      sync.Mutex
+	entries []QueryLogEntry
+	next    int
+	full    bool
+}
+
+// NewQueryLog returns a disabled query log keeping the given number of queries
+func NewQueryLog(size int) *QueryLog {
+	return &QueryLog{
+		entries: make([]QueryLogEntry, size),
+	}
+}
+
+// SetEnabled starts or stops recording the queries
+func (l *QueryLog) SetEnabled(enabled bool) {
+	l.enabled.Store(enabled)
+}
+
+// Enabled returns true when the queries are recorded
+func (l *QueryLog) Enabled() bool {
+	return l.enabled.Load()
+}
+
+func (l *QueryLog) add(entry QueryLogEntry) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.entries[l.next] = entry
+	l.next = (l.next + 1) % len(l.entries)
+	if l.next == 0 {
+		l.full = true
+	}
+}
+
+// Entries returns the recorded queries from the oldest to the newest
+func (l *QueryLog) Entries() []QueryLogEntry {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if !l.full {
+		return append([]QueryLogEntry(nil), l.entries[:l.next]...)
+	}
+	entries := make([]QueryLogEntry, 0, len(l.entries))
+	entries = append(entries, l.entries[l.next:]...)
+	return append(entries, l.entries[:l.next]...)
+}
+
+// Clear removes the recorded queries
+func (l *QueryLog) Clear() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.entries = make([]QueryLogEntry, len(l.entries))
+	l.next = 0
+	l.full = false
+}
+
+// DomainQueryStats returns the statistics of the recorded queries per domain, sorted by number of queries
+func DomainQueryStats(entries []QueryLogEntry) []DomainStats {
+	statsByDomain := make(map[string]*DomainStats)
+	latencies := make(map[string]time.Duration)
+	for _, entry := range entries {
+		domain := strings.ToLower(entry.Question.Name)
+		stats, ok := statsByDomain[domain]
+		if !ok {
+			stats = &DomainStats{Domain: domain}
+			statsByDomain[domain] = stats
+		}
+
+		stats.Queries++
+		switch entry.Rcode {
+		case dns.RcodeSuccess:
+		case dns.RcodeNameError:
+			stats.NXDomain++
+		default:
+			stats.Failures++
+		}
+		latencies[domain] += entry.Latency
+		if entry.Time.After(stats.LastQuery) {
+			stats.LastQuery = entry.Time
+		}
+	}
+
+	result := make([]DomainStats, 0, len(statsByDomain))
+	for domain, stats := range statsByDomain {
+		stats.AvgLatency = latencies[domain] / time.Duration(stats.Queries)
+		result = append(result, *stats)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Queries != result[j].Queries {
+			return result[i].Queries > result[j].Queries
+		}
+		return result[i].Domain < result[j].Domain
+	})
+	return result
+}
+
+// upstreamReporter is implemented by the response writer of the query log, the handlers use it to report the upstream that answered
+type upstreamReporter interface {
+	setUpstream(upstream string)
+}
+
+// reportUpstream tells the query log which upstream answered the question
+func reportUpstream(w dns.ResponseWriter, upstream string) {
+	if reporter, ok := w.(upstreamReporter); ok {
+		reporter.setUpstream(upstream)
+	}
+}
+
+// queryLogHandler records the queries answered by the wrapped handler when the query log is enabled
+type queryLogHandler struct {
+	handler  dns.Handler
+	queryLog *QueryLog
+	kind     string
+	zone     string
+}
+
+func (h *queryLogHandler) ServeDNS(w dns.ResponseWriter, r *dns.Msg) {
+	if !h.queryLog.Enabled() || len(r.Question) == 0 {
+		h.handler.ServeDNS(w, r)
+		return
+	}
+
+	question := r.Question[0]
+	writer := &queryLogResponseWriter{ResponseWriter: w, rcode: -1}
+	start := time.Now()
+	h.handler.ServeDNS(writer, r)
+
+	h.queryLog.add(QueryLogEntry{
+		Time:     start,
+		Question: question,
+		Handler:  h.kind,
+		Zone:     h.zone,
+		Upstream: writer.upstream,
+		Latency:  time.Since(start),
+		Rcode:    writer.rcode,
+	})
+}
+
+type queryLogResponseWriter struct {
+	dns.ResponseWriter
+	rcode    int
+	upstream string
+}
+
+func (w *queryLogResponseWriter) WriteMsg(m *dns.Msg) error {
+	w.rcode = m.Rcode
+	return w.ResponseWriter.WriteMsg(m)
+}
+
+func (w *queryLogResponseWriter) setUpstream(upstream string) {
+	w.upstream = upstream
+}
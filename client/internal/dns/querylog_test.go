@@ -0,0 +1,123 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/client/anonymize"
+	nbdns "github.com/netbirdio/netbird/dns"
+)
+
+func TestQueryLog_RingBuffer(t *testing.T) {
+	queryLog := NewQueryLog(3)
+	assert.Empty(t, queryLog.Entries())
+
+	for i := 0; i < 5; i++ {
+		queryLog.add(QueryLogEntry{Question: dns.Question{Name: fmt.Sprintf("host%d.example.com.", i)}})
+	}
+
+	entries := queryLog.Entries()
+	require.Len(t, entries, 3, "the oldest queries should be overwritten")
+	for i, entry := range entries {
+		assert.Equal(t, fmt.Sprintf("host%d.example.com.", i+2), entry.Question.Name, "the queries should be ordered from the oldest")
+	}
+
+	queryLog.Clear()
+	assert.Empty(t, queryLog.Entries())
+}
+
+func TestQueryLogHandler(t *testing.T) {
+	local := &localResolver{registeredMap: make(registrationMap)}
+	require.NoError(t, local.registerRecord(nbdns.SimpleRecord{Name: "peer1.netbird.cloud.", Type: int(dns.TypeA), Class: nbdns.DefaultClass, TTL: 300, RData: "100.64.0.1"}))
+
+	upstream := &upstreamResolverBase{
+		ctx: context.Background(),
+		upstreamClient: &countingUpstream{
+			resp: newTestResponse(t, "example.com.", dns.TypeA, dns.RcodeSuccess, "example.com. 300 IN A 10.0.0.1"),
+		},
+		upstreamServers: []string{"10.0.0.53:53"},
+		upstreamTimeout: upstreamTimeout,
+		failsTillDeact:  failsTillDeact,
+		cache:           newResponseCache(defaultCacheSize),
+	}
+
+	server := &DefaultServer{queryLog: NewQueryLog(defaultQueryLogSize)}
+	localHandler := server.withQueryLog("netbird.cloud.", HandlerLocal, local)
+	upstreamHandler := server.withQueryLog("example.com.", HandlerNameserverGroup, upstream)
+	responseWriter := &mockResponseWriter{}
+
+	localHandler.ServeDNS(responseWriter, new(dns.Msg).SetQuestion("peer1.netbird.cloud.", dns.TypeA))
+	assert.Empty(t, server.QueryLog().Entries(), "nothing should be recorded while the query log is disabled")
+
+	server.QueryLog().SetEnabled(true)
+	localHandler.ServeDNS(responseWriter, new(dns.Msg).SetQuestion("peer1.netbird.cloud.", dns.TypeA))
+	localHandler.ServeDNS(responseWriter, new(dns.Msg).SetQuestion("missing.netbird.cloud.", dns.TypeA))
+	upstreamHandler.ServeDNS(responseWriter, new(dns.Msg).SetQuestion("example.com.", dns.TypeA))
+	upstreamHandler.ServeDNS(responseWriter, new(dns.Msg).SetQuestion("example.com.", dns.TypeA))
+
+	entries := server.QueryLog().Entries()
+	require.Len(t, entries, 4)
+
+	assert.Equal(t, HandlerLocal, entries[0].Handler)
+	assert.Equal(t, "netbird.cloud.", entries[0].Zone)
+	assert.Empty(t, entries[0].Upstream)
+	assert.Equal(t, dns.RcodeSuccess, entries[0].Rcode)
+	assert.Equal(t, dns.RcodeNameError, entries[1].Rcode)
+
+	assert.Equal(t, HandlerNameserverGroup, entries[2].Handler)
+	assert.Equal(t, "10.0.0.53:53", entries[2].Upstream)
+	assert.Equal(t, UpstreamCache, entries[3].Upstream, "the cached responses should be reported")
+
+	stats := DomainQueryStats(entries)
+	require.Len(t, stats, 3)
+	assert.Equal(t, "example.com.", stats[0].Domain, "the most queried domain should be first")
+	assert.Equal(t, 2, stats[0].Queries)
+	assert.Equal(t, DomainStats{Domain: "missing.netbird.cloud.", Queries: 1, NXDomain: 1, AvgLatency: entries[1].Latency, LastQuery: entries[1].Time}, stats[1])
+}
+
+func TestDomainQueryStats(t *testing.T) {
+	now := time.Now()
+	stats := DomainQueryStats([]QueryLogEntry{
+		{Time: now, Question: dns.Question{Name: "Example.com."}, Latency: 10 * time.Millisecond, Rcode: dns.RcodeSuccess},
+		{Time: now.Add(time.Second), Question: dns.Question{Name: "example.com."}, Latency: 30 * time.Millisecond, Rcode: dns.RcodeServerFailure},
+		{Time: now, Question: dns.Question{Name: "example.com."}, Latency: 20 * time.Millisecond, Rcode: -1},
+	})
+
+	require.Len(t, stats, 1, "the domains should be case insensitive")
+	assert.Equal(t, DomainStats{
+		Domain:     "example.com.",
+		Queries:    3,
+		Failures:   2,
+		AvgLatency: 20 * time.Millisecond,
+		LastQuery:  now.Add(time.Second),
+	}, stats[0])
+}
+
+func TestQueryLogEntry_String(t *testing.T) {
+	entry := QueryLogEntry{
+		Time:     time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC),
+		Question: dns.Question{Name: "example.com.", Qtype: dns.TypeAAAA, Qclass: dns.ClassINET},
+		Handler:  HandlerNameserverGroup,
+		Zone:     "example.com.",
+		Upstream: "10.0.0.53:53",
+		Latency:  12 * time.Millisecond,
+		Rcode:    dns.RcodeServerFailure,
+	}
+
+	line := entry.String()
+	assert.Equal(t, `2024-05-01T10:00:00Z dns.Question{Name:"example.com.", Qtype:0x1c, Qclass:0x1} type=AAAA handler=nameserver-group zone=example.com. upstream=10.0.0.53:53 latency=12ms rcode=SERVFAIL`, line)
+
+	anonymized := anonymize.NewAnonymizer(anonymize.DefaultAddresses()).AnonymizeString(line)
+	assert.NotContains(t, anonymized, `Name:"example.com."`, "the lines should be anonymizable")
+	assert.True(t, strings.HasPrefix(anonymized, `2024-05-01T10:00:00Z dns.Question{Name:"`))
+
+	entry.Rcode = -1
+	assert.Contains(t, entry.String(), "rcode=NORESPONSE")
+}
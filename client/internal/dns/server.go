@@ -36,6 +36,8 @@ type Server interface {
 	OnUpdatedHostDNSServer(strings []string)
 	SearchDomains() []string
 	ProbeAvailability()
+	FlushCache()
+	QueryLog() *QueryLog
 }
 
 type registeredHandlerMap map[string]handlerWithStop
@@ -48,6 +50,8 @@ type DefaultServer struct {
 	service            service
 	dnsMuxMap          registeredHandlerMap
 	localResolver      *localResolver
+	dnsCache           *responseCache
+	queryLog           *QueryLog
 	wgInterface        WGIface
 	hostManager        hostManager
 	updateSerial       uint64
@@ -145,6 +149,8 @@ func newDefaultServer(ctx context.Context, wgInterface WGIface, dnsService servi
 		localResolver: &localResolver{
 			registeredMap: make(registrationMap),
 		},
+		dnsCache:       newResponseCache(defaultCacheSize),
+		queryLog:       NewQueryLog(defaultQueryLogSize),
 		wgInterface:    wgInterface,
 		statusRecorder: statusRecorder,
 		hostsDNSHolder: newHostsDNSHolder(),
@@ -248,6 +254,9 @@ func (s *DefaultServer) UpdateDNSServer(serial uint64, update nbdns.Config) erro
 			return nil
 		}
 
+		// the cached responses can come from upstreams that are no longer configured
+		s.dnsCache.flush()
+
 		if err := s.applyConfiguration(update); err != nil {
 			return fmt.Errorf("apply configuration: %w", err)
 		}
@@ -259,6 +268,17 @@ func (s *DefaultServer) UpdateDNSServer(serial uint64, update nbdns.Config) erro
 	}
 }
 
+// FlushCache removes the cached upstream responses
+func (s *DefaultServer) FlushCache() {
+	s.dnsCache.flush()
+	log.Debugf("flushed the DNS cache")
+}
+
+// QueryLog returns the log of the queries answered by the server
+func (s *DefaultServer) QueryLog() *QueryLog {
+	return s.queryLog
+}
+
 func (s *DefaultServer) SearchDomains() []string {
 	var searchDomains []string
 
@@ -331,9 +351,9 @@ func (s *DefaultServer) applyConfiguration(update nbdns.Config) error {
 	return nil
 }
 
-func (s *DefaultServer) buildLocalHandlerUpdate(customZones []nbdns.CustomZone) ([]muxUpdate, map[string]nbdns.SimpleRecord, error) {
+func (s *DefaultServer) buildLocalHandlerUpdate(customZones []nbdns.CustomZone) ([]muxUpdate, map[string][]nbdns.SimpleRecord, error) {
 	var muxUpdates []muxUpdate
-	localRecords := make(map[string]nbdns.SimpleRecord, 0)
+	localRecords := make(map[string][]nbdns.SimpleRecord, 0)
 
 	for _, customZone := range customZones {
 
@@ -352,7 +372,7 @@ func (s *DefaultServer) buildLocalHandlerUpdate(customZones []nbdns.CustomZone)
 				return nil, nil, fmt.Errorf("received an invalid class type: %s", record.Class)
 			}
 			key := buildRecordKey(record.Name, class, uint16(record.Type))
-			localRecords[key] = record
+			localRecords[key] = append(localRecords[key], record)
 		}
 	}
 	return muxUpdates, localRecords, nil
@@ -378,13 +398,23 @@ func (s *DefaultServer) buildUpstreamHandlerUpdate(nameServerGroups []*nbdns.Nam
 		if err != nil {
 			return nil, fmt.Errorf("unable to create a new upstream resolver, error: %v", err)
 		}
+		handler.cache = s.dnsCache
 		for _, ns := range nsGroup.NameServers {
-			if ns.NSType != nbdns.UDPNameServerType {
-				log.Warnf("skipping nameserver %s with type %s, this peer supports only %s",
-					ns.IP.String(), ns.NSType.String(), nbdns.UDPNameServerType.String())
-				continue
+			switch {
+			case ns.NSType == nbdns.UDPNameServerType:
+				handler.upstreamServers = append(handler.upstreamServers, getNSHostPort(ns))
+			case ns.NSType.IsEncrypted():
+				upstream, client, err := newEncryptedUpstreamClient(ns, handler.dialUpstream)
+				if err != nil {
+					log.Warnf("skipping nameserver %s with type %s: %v", ns.IP.String(), ns.NSType.String(), err)
+					continue
+				}
+				handler.addEncryptedUpstream(upstream, client)
+			default:
+				log.Warnf("skipping nameserver %s with type %s, this peer supports only %s, %s and %s",
+					ns.IP.String(), ns.NSType.String(), nbdns.UDPNameServerType.String(),
+					nbdns.TLSNameServerType.String(), nbdns.HTTPSNameServerType.String())
 			}
-			handler.upstreamServers = append(handler.upstreamServers, getNSHostPort(ns))
 		}
 
 		if len(handler.upstreamServers) == 0 {
@@ -437,7 +467,7 @@ func (s *DefaultServer) updateMux(muxUpdates []muxUpdate) {
 	var isContainRootUpdate bool
 
 	for _, update := range muxUpdates {
-		s.service.RegisterMux(update.domain, update.handler)
+		s.registerMux(update.domain, update.handler)
 		muxUpdateMap[update.domain] = update.handler
 		if existingHandler, ok := s.dnsMuxMap[update.domain]; ok {
 			existingHandler.stop()
@@ -464,7 +494,7 @@ func (s *DefaultServer) updateMux(muxUpdates []muxUpdate) {
 	s.dnsMuxMap = muxUpdateMap
 }
 
-func (s *DefaultServer) updateLocalResolver(update map[string]nbdns.SimpleRecord) {
+func (s *DefaultServer) updateLocalResolver(update map[string][]nbdns.SimpleRecord) {
 	for key := range s.localResolver.registeredMap {
 		_, found := update[key]
 		if !found {
@@ -473,19 +503,21 @@ func (s *DefaultServer) updateLocalResolver(update map[string]nbdns.SimpleRecord
 	}
 
 	updatedMap := make(registrationMap)
-	for key, record := range update {
-		err := s.localResolver.registerRecord(record)
-		if err != nil {
-			log.Warnf("got an error while registering the record (%s), error: %v", record.String(), err)
-		}
+	for key, records := range update {
+		s.localResolver.setRecords(key, records)
 		updatedMap[key] = struct{}{}
 	}
 
 	s.localResolver.registeredMap = updatedMap
 }
 
+// getNSHostPort returns the address of the nameserver, the encrypted nameservers are prefixed with their type
 func getNSHostPort(ns nbdns.NameServer) string {
-	return fmt.Sprintf("%s:%d", ns.IP.String(), ns.Port)
+	hostPort := fmt.Sprintf("%s:%d", ns.IP.String(), ns.Port)
+	if ns.NSType.IsEncrypted() {
+		return ns.NSType.String() + "://" + hostPort
+	}
+	return hostPort
 }
 
 // upstreamCallbacks returns two functions, the first one is used to deactivate
@@ -530,7 +562,7 @@ func (s *DefaultServer) upstreamCallbacks(
 		}
 
 		s.updateNSState(nsGroup, err, false)
-
+		s.publishUpstreamDisabledEvent(nsGroup, err)
 	}
 	reactivate = func() {
 		s.mux.Lock()
@@ -541,7 +573,7 @@ func (s *DefaultServer) upstreamCallbacks(
 				continue
 			}
 			s.currentConfig.Domains[i].Disabled = false
-			s.service.RegisterMux(domain, handler)
+			s.registerMux(domain, handler)
 		}
 
 		l := log.WithField("nameservers", nsGroup.NameServers)
@@ -549,7 +581,7 @@ func (s *DefaultServer) upstreamCallbacks(
 
 		if nsGroup.Primary {
 			s.currentConfig.RouteAll = true
-			s.service.RegisterMux(nbdns.RootZone, handler)
+			s.registerMux(nbdns.RootZone, handler)
 		}
 		if err := s.hostManager.applyDNSConfig(s.currentConfig); err != nil {
 			l.WithError(err).Error("reactivate temporary disabled nameserver group, DNS update apply")
@@ -574,13 +606,32 @@ func (s *DefaultServer) addHostRootZone() {
 		return
 	}
 
+	handler.cache = s.dnsCache
 	handler.upstreamServers = make([]string, 0)
 	for k := range s.hostsDNSHolder.get() {
 		handler.upstreamServers = append(handler.upstreamServers, k)
 	}
 	handler.deactivate = func(error) {}
 	handler.reactivate = func() {}
-	s.service.RegisterMux(nbdns.RootZone, handler)
+	s.service.RegisterMux(nbdns.RootZone, s.withQueryLog(nbdns.RootZone, HandlerHost, handler))
+}
+
+// registerMux registers the handler of the zone, wrapped to be recorded by the query log
+func (s *DefaultServer) registerMux(zone string, handler dns.Handler) {
+	kind := HandlerNameserverGroup
+	if _, ok := handler.(*localResolver); ok {
+		kind = HandlerLocal
+	}
+	s.service.RegisterMux(zone, s.withQueryLog(zone, kind, handler))
+}
+
+func (s *DefaultServer) withQueryLog(zone, kind string, handler dns.Handler) dns.Handler {
+	return &queryLogHandler{
+		handler:  handler,
+		queryLog: s.queryLog,
+		kind:     kind,
+		zone:     zone,
+	}
 }
 
 func (s *DefaultServer) updateNSGroupStates(groups []*nbdns.NameServerGroup) {
@@ -589,7 +640,7 @@ func (s *DefaultServer) updateNSGroupStates(groups []*nbdns.NameServerGroup) {
 	for _, group := range groups {
 		var servers []string
 		for _, ns := range group.NameServers {
-			servers = append(servers, fmt.Sprintf("%s:%d", ns.IP, ns.Port))
+			servers = append(servers, getNSHostPort(ns))
 		}
 
 		state := peer.NSGroupState{
@@ -618,6 +669,29 @@ func (s *DefaultServer) updateNSState(nsGroup *nbdns.NameServerGroup, err error,
 	s.statusRecorder.UpdateDNSStates(states)
 }
 
+func (s *DefaultServer) publishUpstreamDisabledEvent(nsGroup *nbdns.NameServerGroup, err error) {
+	var servers []string
+	for _, ns := range nsGroup.NameServers {
+		servers = append(servers, fmt.Sprintf("%s:%d", ns.IP, ns.Port))
+	}
+
+	domains := nsGroup.Domains
+	if nsGroup.Primary {
+		domains = append([]string{nbdns.RootZone}, domains...)
+	}
+	metadata := map[string]string{
+		"servers": strings.Join(servers, ","),
+		"domains": strings.Join(domains, ","),
+	}
+	if err != nil {
+		metadata["error"] = err.Error()
+	}
+
+	s.statusRecorder.PublishEvent(peer.EventDNSUpstreamDisabled, peer.EventSeverityWarning,
+		fmt.Sprintf("DNS servers %s are not responding, they are disabled for %s", strings.Join(servers, ", "), reactivatePeriod),
+		metadata)
+}
+
 func generateGroupKey(nsGroup *nbdns.NameServerGroup) string {
 	var servers []string
 	for _, ns := range nsGroup.NameServers {
@@ -36,10 +36,14 @@ type UpstreamResolver interface {
 }
 
 type upstreamResolverBase struct {
-	ctx              context.Context
-	cancel           context.CancelFunc
-	upstreamClient   upstreamClient
-	upstreamServers  []string
+	ctx             context.Context
+	cancel          context.CancelFunc
+	upstreamClient  upstreamClient
+	upstreamServers []string
+	// encryptedClients query the DNS over TLS and DNS over HTTPS upstreams instead of the upstreamClient
+	encryptedClients map[string]upstreamClient
+	// cache is shared by the upstream handlers of the server, nil disables caching
+	cache            *responseCache
 	disabled         bool
 	failsCount       atomic.Int32
 	successCount     atomic.Int32
@@ -69,6 +73,37 @@ func newUpstreamResolverBase(ctx context.Context, statusRecorder *peer.Status) *
 func (u *upstreamResolverBase) stop() {
 	log.Debugf("stopping serving DNS for upstreams %s", u.upstreamServers)
 	u.cancel()
+
+	for _, client := range u.encryptedClients {
+		if c, ok := client.(interface{ close() }); ok {
+			c.close()
+		}
+	}
+}
+
+// addEncryptedUpstream adds an upstream that is queried over TLS or HTTPS
+func (u *upstreamResolverBase) addEncryptedUpstream(upstream string, client upstreamClient) {
+	if u.encryptedClients == nil {
+		u.encryptedClients = make(map[string]upstreamClient)
+	}
+	u.encryptedClients[upstream] = client
+	u.upstreamServers = append(u.upstreamServers, upstream)
+}
+
+// clientFor returns the client that queries the upstream
+func (u *upstreamResolverBase) clientFor(upstream string) upstreamClient {
+	if client, ok := u.encryptedClients[upstream]; ok {
+		return client
+	}
+	return u.upstreamClient
+}
+
+// probeTimeoutFor returns the probe timeout of the upstream, the encrypted upstreams need the time of the handshakes
+func (u *upstreamResolverBase) probeTimeoutFor(upstream string) time.Duration {
+	if _, ok := u.encryptedClients[upstream]; ok {
+		return encryptedProbeTimeout
+	}
+	return 500 * time.Millisecond
 }
 
 // ServeDNS handles a DNS request
@@ -79,12 +114,25 @@ func (u *upstreamResolverBase) ServeDNS(w dns.ResponseWriter, r *dns.Msg) {
 	}()
 
 	log.WithField("question", r.Question[0]).Trace("received an upstream question")
-	// set the AuthenticatedData flag and the EDNS0 buffer size to 4096 bytes to support larger dns records
-	if r.Extra == nil {
-		r.SetEdns0(4096, false)
-		r.MsgHdr.AuthenticatedData = true
+
+	var key cacheKey
+	if u.cache != nil {
+		key = newCacheKey(r)
+		if rm, prefetch := u.cache.get(key, r); rm != nil {
+			log.WithField("question", r.Question[0]).Trace("answering the upstream question from the cache")
+			if prefetch {
+				go u.prefetch(key, r.Copy())
+			}
+			reportUpstream(w, UpstreamCache)
+			if err := w.WriteMsg(rm); err != nil {
+				log.WithError(err).Error("got an error while writing the cached upstream response")
+			}
+			return
+		}
 	}
 
+	prepareUpstreamRequest(r)
+
 	select {
 	case <-u.ctx.Done():
 		return
@@ -98,7 +146,7 @@ func (u *upstreamResolverBase) ServeDNS(w dns.ResponseWriter, r *dns.Msg) {
 		func() {
 			ctx, cancel := context.WithTimeout(u.ctx, u.upstreamTimeout)
 			defer cancel()
-			rm, t, err = u.upstreamClient.exchange(ctx, upstream, r)
+			rm, t, err = u.clientFor(upstream).exchange(ctx, upstream, r)
 		}()
 
 		if err != nil {
@@ -128,6 +176,11 @@ func (u *upstreamResolverBase) ServeDNS(w dns.ResponseWriter, r *dns.Msg) {
 		u.successCount.Add(1)
 		log.Tracef("took %s to query the upstream %s", t, upstream)
 
+		if u.cache != nil {
+			u.cache.store(key, rm)
+		}
+
+		reportUpstream(w, upstream)
 		err = w.WriteMsg(rm)
 		if err != nil {
 			log.WithError(err).Error("got an error while writing the upstream resolver response")
@@ -140,6 +193,36 @@ func (u *upstreamResolverBase) ServeDNS(w dns.ResponseWriter, r *dns.Msg) {
 	log.Error("all queries to the upstream nameservers failed with timeout")
 }
 
+// prefetch refreshes a hot cache entry before it expires. The fails aren't counted, the next query does it.
+func (u *upstreamResolverBase) prefetch(key cacheKey, r *dns.Msg) {
+	defer u.cache.prefetchDone(key)
+
+	prepareUpstreamRequest(r)
+
+	for _, upstream := range u.upstreamServers {
+		ctx, cancel := context.WithTimeout(u.ctx, u.upstreamTimeout)
+		rm, _, err := u.clientFor(upstream).exchange(ctx, upstream, r)
+		cancel()
+
+		if err != nil || rm == nil || !rm.Response {
+			log.Tracef("prefetching %s from upstream %s failed: %v", key.name, upstream, err)
+			continue
+		}
+
+		u.cache.store(key, rm)
+		return
+	}
+}
+
+// prepareUpstreamRequest sets the AuthenticatedData flag and the EDNS0 buffer size to 4096 bytes
+// to support larger dns records
+func prepareUpstreamRequest(r *dns.Msg) {
+	if r.Extra == nil {
+		r.SetEdns0(4096, false)
+		r.MsgHdr.AuthenticatedData = true
+	}
+}
+
 // checkUpstreamFails counts fails and disables or enables upstream resolving
 //
 // If fails count is greater that failsTillDeact, upstream resolving
@@ -190,7 +273,7 @@ func (u *upstreamResolverBase) probeAvailability() {
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
-			err := u.testNameserver(upstream, 500*time.Millisecond)
+			err := u.testNameserver(upstream, u.probeTimeoutFor(upstream))
 			if err != nil {
 				errors = multierror.Append(errors, err)
 				log.Warnf("probing upstream nameserver %s: %s", upstream, err)
@@ -285,6 +368,6 @@ func (u *upstreamResolverBase) testNameserver(server string, timeout time.Durati
 
 	r := new(dns.Msg).SetQuestion(testRecord, dns.TypeSOA)
 
-	_, _, err := u.upstreamClient.exchange(ctx, server, r)
+	_, _, err := u.clientFor(server).exchange(ctx, server, r)
 	return err
 }
@@ -60,20 +60,33 @@ func (u *upstreamResolver) exchangeWithoutVPN(ctx context.Context, upstream stri
 	}
 	dialTimeout := timeout
 
+	upstreamExchangeClient := &dns.Client{
+		Dialer: protectedDialer(dialTimeout),
+	}
+
+	return upstreamExchangeClient.Exchange(r, upstream)
+}
+
+// dialUpstream dials the connections to the encrypted upstreams, the sockets to the local resolvers are protected
+// like in exchangeWithoutVPN
+func (u *upstreamResolver) dialUpstream(ctx context.Context, network, address string) (net.Conn, error) {
+	if u.isLocalResolver(address) {
+		return protectedDialer(0).DialContext(ctx, network, address)
+	}
+	var dialer net.Dialer
+	return dialer.DialContext(ctx, network, address)
+}
+
+// protectedDialer returns a dialer that protects the sockets by the Android SDK to avoid to go through the VPN
+func protectedDialer(dialTimeout time.Duration) *net.Dialer {
 	nbDialer := nbnet.NewDialer()
 
-	dialer := &net.Dialer{
+	return &net.Dialer{
 		Control: func(network, address string, c syscall.RawConn) error {
 			return nbDialer.Control(network, address, c)
 		},
 		Timeout: dialTimeout,
 	}
-
-	upstreamExchangeClient := &dns.Client{
-		Dialer: dialer,
-	}
-
-	return upstreamExchangeClient.Exchange(r, upstream)
 }
 
 func (u *upstreamResolver) isLocalResolver(upstream string) bool {
@@ -0,0 +1,186 @@
+package dns
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/miekg/dns"
+
+	nbdns "github.com/netbirdio/netbird/dns"
+)
+
+const (
+	// dohPath is the path of the DNS over HTTPS endpoint, RFC 8484 recommends it and the public resolvers use it
+	dohPath      = "/dns-query"
+	dohMediaType = "application/dns-message"
+	// encryptedProbeTimeout leaves time for the TCP and the TLS handshakes when an encrypted upstream is probed
+	encryptedProbeTimeout = 2 * time.Second
+	encryptedIdleTimeout  = 30 * time.Second
+)
+
+// upstreamDialFunc dials the connections to an encrypted upstream. The upstream resolver of each platform provides it
+// to protect or bind the sockets the same way as for the plain upstreams, so the queries do not loop into the tunnel.
+type upstreamDialFunc func(ctx context.Context, network, address string) (net.Conn, error)
+
+// newEncryptedUpstreamClient returns the address the upstream is known by and the client that queries it over TLS
+// or HTTPS. The connections are always made to the IP of the nameserver, the hostname is used only for the
+// verification of the certificate, so resolving the upstream does not depend on DNS.
+func newEncryptedUpstreamClient(ns nbdns.NameServer, dial upstreamDialFunc) (string, upstreamClient, error) {
+	tlsConfig, err := upstreamTLSConfig(ns)
+	if err != nil {
+		return "", nil, err
+	}
+
+	address := net.JoinHostPort(ns.IP.String(), strconv.Itoa(ns.Port))
+	switch ns.NSType {
+	case nbdns.TLSNameServerType:
+		return getNSHostPort(ns), &tlsUpstreamClient{address: address, tlsConfig: tlsConfig, dial: dial}, nil
+	case nbdns.HTTPSNameServerType:
+		return getNSHostPort(ns), newHTTPSUpstreamClient(ns, address, tlsConfig, dial), nil
+	default:
+		return "", nil, fmt.Errorf("nameserver type %s is not encrypted", ns.NSType)
+	}
+}
+
+// upstreamTLSConfig verifies the certificate against the hostname of the nameserver or, when a fingerprint is
+// pinned, only against the fingerprint
+func upstreamTLSConfig(ns nbdns.NameServer) (*tls.Config, error) {
+	serverName := ns.Hostname
+	if serverName == "" {
+		serverName = ns.IP.String()
+	}
+
+	tlsConfig := &tls.Config{
+		ServerName: serverName,
+		MinVersion: tls.VersionTLS12,
+	}
+	if ns.CertFingerprint == "" {
+		return tlsConfig, nil
+	}
+
+	fingerprint, err := nbdns.ParseCertFingerprint(ns.CertFingerprint)
+	if err != nil {
+		return nil, err
+	}
+
+	// the pinned certificate may be self-signed, it replaces the verification against the system roots
+	tlsConfig.InsecureSkipVerify = true //nolint:gosec
+	tlsConfig.VerifyConnection = func(state tls.ConnectionState) error {
+		if len(state.PeerCertificates) == 0 {
+			return errors.New("nameserver did not present a certificate")
+		}
+		sum := sha256.Sum256(state.PeerCertificates[0].Raw)
+		if subtle.ConstantTimeCompare(sum[:], fingerprint) != 1 {
+			return fmt.Errorf("certificate of nameserver %s does not match the pinned fingerprint", serverName)
+		}
+		return nil
+	}
+	return tlsConfig, nil
+}
+
+// tlsUpstreamClient queries a DNS over TLS (RFC 7858) upstream
+type tlsUpstreamClient struct {
+	address   string
+	tlsConfig *tls.Config
+	dial      upstreamDialFunc
+}
+
+func (c *tlsUpstreamClient) exchange(ctx context.Context, _ string, r *dns.Msg) (*dns.Msg, time.Duration, error) {
+	conn, err := c.dial(ctx, "tcp", c.address)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	tlsConn := tls.Client(conn, c.tlsConfig)
+	defer func() {
+		_ = tlsConn.Close()
+	}()
+	if err := tlsConn.HandshakeContext(ctx); err != nil {
+		return nil, 0, fmt.Errorf("TLS handshake with %s: %w", c.address, err)
+	}
+
+	client := &dns.Client{Net: "tcp-tls"}
+	return client.ExchangeWithConnContext(ctx, r, &dns.Conn{Conn: tlsConn})
+}
+
+// httpsUpstreamClient queries a DNS over HTTPS (RFC 8484) upstream, the connections are kept open between the queries
+type httpsUpstreamClient struct {
+	url    string
+	client *http.Client
+}
+
+func newHTTPSUpstreamClient(ns nbdns.NameServer, address string, tlsConfig *tls.Config, dial upstreamDialFunc) *httpsUpstreamClient {
+	host := ns.Hostname
+	if host == "" {
+		host = ns.IP.String()
+	}
+
+	transport := &http.Transport{
+		DialContext: func(ctx context.Context, network, _ string) (net.Conn, error) {
+			return dial(ctx, network, address)
+		},
+		TLSClientConfig:   tlsConfig,
+		ForceAttemptHTTP2: true,
+		MaxIdleConns:      2,
+		IdleConnTimeout:   encryptedIdleTimeout,
+	}
+
+	return &httpsUpstreamClient{
+		url:    "https://" + net.JoinHostPort(host, strconv.Itoa(ns.Port)) + dohPath,
+		client: &http.Client{Transport: transport},
+	}
+}
+
+func (c *httpsUpstreamClient) exchange(ctx context.Context, _ string, r *dns.Msg) (*dns.Msg, time.Duration, error) {
+	start := time.Now()
+
+	packed, err := r.Pack()
+	if err != nil {
+		return nil, 0, fmt.Errorf("pack dns query: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(packed))
+	if err != nil {
+		return nil, 0, fmt.Errorf("create DNS over HTTPS request: %w", err)
+	}
+	req.Header.Set("Content-Type", dohMediaType)
+	req.Header.Set("Accept", dohMediaType)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, 0, fmt.Errorf("DNS over HTTPS upstream %s responded with status %s", c.url, resp.Status)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, dns.MaxMsgSize))
+	if err != nil {
+		return nil, 0, fmt.Errorf("read DNS over HTTPS response: %w", err)
+	}
+
+	rm := new(dns.Msg)
+	if err := rm.Unpack(body); err != nil {
+		return nil, 0, fmt.Errorf("unpack DNS over HTTPS response: %w", err)
+	}
+
+	return rm, time.Since(start), nil
+}
+
+func (c *httpsUpstreamClient) close() {
+	c.client.CloseIdleConnections()
+}
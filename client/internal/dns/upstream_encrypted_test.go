@@ -0,0 +1,190 @@
+package dns
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	nbdns "github.com/netbirdio/netbird/dns"
+)
+
+func answerA(r *dns.Msg) *dns.Msg {
+	m := new(dns.Msg).SetReply(r)
+	m.Answer = append(m.Answer, &dns.A{
+		Hdr: dns.RR_Header{Name: r.Question[0].Name, Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: 60},
+		A:   net.ParseIP("10.0.0.1"),
+	})
+	return m
+}
+
+func startDoHServer(t *testing.T) (*httptest.Server, nbdns.NameServer) {
+	t.Helper()
+
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != dohPath || req.Header.Get("Content-Type") != dohMediaType {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		body, err := io.ReadAll(req.Body)
+		require.NoError(t, err)
+
+		r := new(dns.Msg)
+		require.NoError(t, r.Unpack(body))
+		packed, err := answerA(r).Pack()
+		require.NoError(t, err)
+
+		w.Header().Set("Content-Type", dohMediaType)
+		_, _ = w.Write(packed)
+	}))
+	t.Cleanup(srv.Close)
+
+	addrPort := netip.MustParseAddrPort(srv.Listener.Addr().String())
+	return srv, nbdns.NameServer{
+		IP:     addrPort.Addr(),
+		NSType: nbdns.HTTPSNameServerType,
+		Port:   int(addrPort.Port()),
+	}
+}
+
+func startDoTServer(t *testing.T, tlsConfig *tls.Config) nbdns.NameServer {
+	t.Helper()
+
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", tlsConfig)
+	require.NoError(t, err)
+
+	server := &dns.Server{
+		Listener: listener,
+		Net:      "tcp-tls",
+		Handler: dns.HandlerFunc(func(w dns.ResponseWriter, r *dns.Msg) {
+			_ = w.WriteMsg(answerA(r))
+		}),
+	}
+	go func() {
+		_ = server.ActivateAndServe()
+	}()
+	t.Cleanup(func() {
+		_ = server.Shutdown()
+	})
+
+	_, port, err := net.SplitHostPort(listener.Addr().String())
+	require.NoError(t, err)
+	portNum, err := strconv.Atoi(port)
+	require.NoError(t, err)
+
+	return nbdns.NameServer{
+		IP:     netip.MustParseAddr("127.0.0.1"),
+		NSType: nbdns.TLSNameServerType,
+		Port:   portNum,
+	}
+}
+
+func certFingerprint(cert []byte) string {
+	sum := sha256.Sum256(cert)
+	return strings.ToUpper(hex.EncodeToString(sum[:]))
+}
+
+func TestEncryptedUpstreamClient(t *testing.T) {
+	dohServer, dohNS := startDoHServer(t)
+	dotNS := startDoTServer(t, dohServer.TLS)
+	fingerprint := certFingerprint(dohServer.Certificate().Raw)
+
+	for _, ns := range []nbdns.NameServer{dohNS, dotNS} {
+		t.Run(ns.NSType.String(), func(t *testing.T) {
+			query := new(dns.Msg).SetQuestion("peer.netbird.cloud.", dns.TypeA)
+
+			// the connections go through the dialer of the platform
+			var dials atomic.Int32
+			dial := func(ctx context.Context, network, address string) (net.Conn, error) {
+				dials.Add(1)
+				var dialer net.Dialer
+				return dialer.DialContext(ctx, network, address)
+			}
+
+			upstream, client, err := newEncryptedUpstreamClient(ns, dial)
+			require.NoError(t, err)
+			assert.Equal(t, ns.NSType.String()+"://"+net.JoinHostPort(ns.IP.String(), strconv.Itoa(ns.Port)), upstream)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			_, _, err = client.exchange(ctx, upstream, query)
+			require.Error(t, err, "the self-signed certificate is not trusted without the pin")
+
+			ns.CertFingerprint = fingerprint
+			_, client, err = newEncryptedUpstreamClient(ns, dial)
+			require.NoError(t, err)
+
+			rm, _, err := client.exchange(ctx, upstream, query)
+			require.NoError(t, err)
+			require.Len(t, rm.Answer, 1)
+			assert.Equal(t, query.Id, rm.Id)
+			assert.Contains(t, rm.Answer[0].String(), "10.0.0.1")
+			assert.Positive(t, dials.Load(), "the connections should be made with the dialer of the platform")
+
+			ns.CertFingerprint = strings.Repeat("00", sha256.Size)
+			_, client, err = newEncryptedUpstreamClient(ns, dial)
+			require.NoError(t, err)
+
+			_, _, err = client.exchange(ctx, upstream, query)
+			require.ErrorContains(t, err, "pinned fingerprint")
+		})
+	}
+}
+
+func TestUpstreamResolver_EncryptedUpstreams(t *testing.T) {
+	dohServer, dohNS := startDoHServer(t)
+	dohNS.CertFingerprint = certFingerprint(dohServer.Certificate().Raw)
+
+	resolver, err := newUpstreamResolver(context.Background(), "", net.IP{}, &net.IPNet{}, nil, nil)
+	require.NoError(t, err)
+	defer resolver.stop()
+
+	upstream, client, err := newEncryptedUpstreamClient(dohNS, resolver.dialUpstream)
+	require.NoError(t, err)
+	resolver.addEncryptedUpstream(upstream, client)
+
+	// the probe succeeds while one of the upstreams is reachable
+	_, unreachable, err := newEncryptedUpstreamClient(nbdns.NameServer{
+		IP:     netip.MustParseAddr("127.0.0.1"),
+		NSType: nbdns.TLSNameServerType,
+		Port:   1,
+	}, resolver.dialUpstream)
+	require.NoError(t, err)
+	resolver.addEncryptedUpstream("tls://127.0.0.1:1", unreachable)
+
+	assert.Equal(t, encryptedProbeTimeout, resolver.probeTimeoutFor(upstream))
+	assert.Equal(t, 500*time.Millisecond, resolver.probeTimeoutFor("1.1.1.1:53"))
+
+	var deactivated bool
+	resolver.deactivate = func(error) { deactivated = true }
+	resolver.reactivate = func() {}
+	resolver.probeAvailability()
+	assert.False(t, deactivated, "one of the upstreams answers the probe")
+
+	var responseMSG *dns.Msg
+	responseWriter := &mockResponseWriter{
+		WriteMsgFunc: func(m *dns.Msg) error {
+			responseMSG = m
+			return nil
+		},
+	}
+	resolver.ServeDNS(responseWriter, new(dns.Msg).SetQuestion("peer.netbird.cloud.", dns.TypeA))
+	require.NotNil(t, responseMSG)
+	require.Len(t, responseMSG.Answer, 1)
+	assert.Contains(t, responseMSG.Answer[0].String(), "10.0.0.1")
+}
@@ -36,3 +36,9 @@ func (u *upstreamResolver) exchange(ctx context.Context, upstream string, r *dns
 	upstreamExchangeClient := &dns.Client{}
 	return upstreamExchangeClient.ExchangeContext(ctx, r, upstream)
 }
+
+// dialUpstream dials the connections to the encrypted upstreams, like the plain queries they use the default route
+func (u *upstreamResolver) dialUpstream(ctx context.Context, network, address string) (net.Conn, error) {
+	var dialer net.Dialer
+	return dialer.DialContext(ctx, network, address)
+}
@@ -57,8 +57,7 @@ func (u *upstreamResolverIOS) exchange(ctx context.Context, upstream string, r *
 	}
 	client.DialTimeout = timeout
 
-	upstreamIP := net.ParseIP(upstreamHost)
-	if u.lNet.Contains(upstreamIP) || net.IP.IsPrivate(upstreamIP) {
+	if u.isPrivateUpstream(upstreamHost) {
 		log.Debugf("using private client to query upstream: %s", upstream)
 		client, err = GetClientPrivate(u.lIP, u.interfaceName, timeout)
 		if err != nil {
@@ -70,9 +69,51 @@ func (u *upstreamResolverIOS) exchange(ctx context.Context, upstream string, r *
 	return client.Exchange(r, upstream)
 }
 
+// dialUpstream dials the connections to the encrypted upstreams, the sockets to the private upstreams are bound to
+// the Netbird interface like in exchange
+func (u *upstreamResolverIOS) dialUpstream(ctx context.Context, network, address string) (net.Conn, error) {
+	upstreamHost, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, fmt.Errorf("error while parsing upstream host: %s", err)
+	}
+
+	if !u.isPrivateUpstream(upstreamHost) {
+		var dialer net.Dialer
+		return dialer.DialContext(ctx, network, address)
+	}
+
+	log.Debugf("using private dialer to connect to upstream: %s", address)
+	dialer, err := privateDialer(&net.TCPAddr{IP: u.lIP}, u.interfaceName, 0)
+	if err != nil {
+		return nil, fmt.Errorf("error while creating private dialer: %s", err)
+	}
+	return dialer.DialContext(ctx, network, address)
+}
+
+func (u *upstreamResolverIOS) isPrivateUpstream(upstreamHost string) bool {
+	upstreamIP := net.ParseIP(upstreamHost)
+	return u.lNet.Contains(upstreamIP) || net.IP.IsPrivate(upstreamIP)
+}
+
 // GetClientPrivate returns a new DNS client bound to the local IP address of the Netbird interface
 // This method is needed for iOS
 func GetClientPrivate(ip net.IP, interfaceName string, dialTimeout time.Duration) (*dns.Client, error) {
+	dialer, err := privateDialer(&net.UDPAddr{
+		IP:   ip,
+		Port: 0, // Let the OS pick a free port
+	}, interfaceName, dialTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	client := &dns.Client{
+		Dialer: dialer,
+	}
+	return client, nil
+}
+
+// privateDialer returns a dialer with the sockets bound to the local address and to the Netbird interface
+func privateDialer(localAddr net.Addr, interfaceName string, dialTimeout time.Duration) (*net.Dialer, error) {
 	index, err := getInterfaceIndex(interfaceName)
 	if err != nil {
 		log.Debugf("unable to get interface index for %s: %s", interfaceName, err)
@@ -80,11 +121,8 @@ func GetClientPrivate(ip net.IP, interfaceName string, dialTimeout time.Duration
 	}
 
 	dialer := &net.Dialer{
-		LocalAddr: &net.UDPAddr{
-			IP:   ip,
-			Port: 0, // Let the OS pick a free port
-		},
-		Timeout: dialTimeout,
+		LocalAddr: localAddr,
+		Timeout:   dialTimeout,
 		Control: func(network, address string, c syscall.RawConn) error {
 			var operr error
 			fn := func(s uintptr) {
@@ -102,10 +140,7 @@ func GetClientPrivate(ip net.IP, interfaceName string, dialTimeout time.Duration
 			return operr
 		},
 	}
-	client := &dns.Client{
-		Dialer: dialer,
-	}
-	return client, nil
+	return dialer, nil
 }
 
 func getInterfaceIndex(interfaceName string) (int, error) {
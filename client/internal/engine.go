@@ -28,6 +28,7 @@ import (
 
 	"github.com/netbirdio/netbird/client/internal/networkmonitor"
 	"github.com/netbirdio/netbird/client/internal/peer"
+	"github.com/netbirdio/netbird/client/internal/perftest"
 	"github.com/netbirdio/netbird/client/internal/relay"
 	"github.com/netbirdio/netbird/client/internal/rosenpass"
 	"github.com/netbirdio/netbird/client/internal/routemanager"
@@ -38,6 +39,7 @@ import (
 	nbdns "github.com/netbirdio/netbird/dns"
 	"github.com/netbirdio/netbird/iface"
 	"github.com/netbirdio/netbird/iface/bind"
+	"github.com/netbirdio/netbird/iface/netstack"
 	mgm "github.com/netbirdio/netbird/management/client"
 	"github.com/netbirdio/netbird/management/domain"
 	mgmProto "github.com/netbirdio/netbird/management/proto"
@@ -69,6 +71,9 @@ type EngineConfig struct {
 	// WgAddr is a Wireguard local address (Netbird Network IP)
 	WgAddr string
 
+	// WgAddrV6 is an optional Wireguard local IPv6 address (Netbird Network IPv6)
+	WgAddrV6 string
+
 	// WgPrivateKey is a Wireguard private key of our peer (it MUST never leave the machine)
 	WgPrivateKey wgtypes.Key
 
@@ -100,6 +105,15 @@ type EngineConfig struct {
 	ServerSSHAllowed bool
 
 	DNSRouteInterval time.Duration
+
+	// DNSQueryLog enables the log of the queries answered by the DNS server
+	DNSQueryLog bool
+
+	// PeerTestResponder enables the responder of the connection tests of the peers and opens its port to them
+	PeerTestResponder bool
+
+	// StatePath is the path of the state file the route selection is persisted to, empty to not persist it
+	StatePath string
 }
 
 // Engine is a mechanism responsible for reacting on Signal and Management stream events and managing connections to the remote peers.
@@ -159,6 +173,9 @@ type Engine struct {
 	routeManager routemanager.Manager
 	acl          acl.Manager
 
+	// peerTestResponder answers the latency and throughput tests of the remote peers
+	peerTestResponder *perftest.Responder
+
 	dnsServer dns.Server
 
 	probes *ProbeHolder
@@ -317,8 +334,11 @@ func (e *Engine) Start() error {
 		return fmt.Errorf("create dns server: %w", err)
 	}
 	e.dnsServer = dnsServer
+	if queryLog := dnsServer.QueryLog(); queryLog != nil {
+		queryLog.SetEnabled(e.config.DNSQueryLog)
+	}
 
-	e.routeManager = routemanager.NewManager(e.ctx, e.config.WgPrivateKey.PublicKey().String(), e.config.DNSRouteInterval, e.wgInterface, e.statusRecorder, e.relayManager, initialRoutes)
+	e.routeManager = routemanager.NewManager(e.ctx, e.config.WgPrivateKey.PublicKey().String(), e.config.DNSRouteInterval, e.wgInterface, e.statusRecorder, e.relayManager, initialRoutes, e.config.StatePath)
 	beforePeerHook, afterPeerHook, err := e.routeManager.Init()
 	if err != nil {
 		log.Errorf("Failed to initialize route manager: %s", err)
@@ -341,6 +361,15 @@ func (e *Engine) Start() error {
 		log.Errorf("failed creating firewall manager: %s", err)
 	}
 
+	// the IPv6 address is set once the firewall is known, it is only used when the policies can be enforced on it
+	if e.config.WgAddrV6 != "" {
+		if !e.ipv6Enabled() {
+			log.Warnf("not using the IPv6 address %s, the firewall does not filter IPv6 traffic", e.config.WgAddrV6)
+		} else if err := e.wgInterface.UpdateAddrV6(e.config.WgAddrV6); err != nil {
+			log.Warnf("failed to set IPv6 address %s on the interface: %v", e.config.WgAddrV6, err)
+		}
+	}
+
 	if e.firewall != nil && e.firewall.IsServerRouteSupported() {
 		err = e.routeManager.EnableServerRouter(e.firewall)
 		if err != nil {
@@ -357,9 +386,11 @@ func (e *Engine) Start() error {
 	}
 
 	if e.firewall != nil {
-		e.acl = acl.NewDefaultManager(e.firewall)
+		e.acl = acl.NewDefaultManager(e.firewall, e.config.PeerTestResponder)
 	}
 
+	e.startPeerTestResponder()
+
 	err = e.dnsServer.Initialize()
 	if err != nil {
 		e.close()
@@ -385,7 +416,7 @@ func (e *Engine) modifyPeers(peersUpdate []*mgmProto.RemotePeerConfig) error {
 	for _, p := range peersUpdate {
 		peerPubKey := p.GetWgPubKey()
 		if peerConn, ok := e.peerConns[peerPubKey]; ok {
-			if peerConn.WgConfig().AllowedIps != strings.Join(p.AllowedIps, ",") {
+			if peerConn.WgConfig().AllowedIps != e.peerAllowedIPs(p) {
 				modified = append(modified, p)
 				continue
 			}
@@ -607,6 +638,24 @@ func (e *Engine) updateConfig(conf *mgmProto.PeerConfig) error {
 		}
 		e.config.WgAddr = conf.Address
 		log.Infof("updated peer address from %s to %s", oldAddr, conf.Address)
+
+		// the responder listens on the peer address
+		e.startPeerTestResponder()
+	}
+
+	if conf.GetAddressV6() != e.config.WgAddrV6 && conf.GetAddressV6() != "" && !e.ipv6Enabled() {
+		log.Warnf("not using the IPv6 address %s, the firewall does not filter IPv6 traffic", conf.GetAddressV6())
+	}
+	e.config.WgAddrV6 = conf.GetAddressV6()
+
+	if addrV6 := e.overlayAddressV6(conf.GetAddressV6()); e.wgInterface.Address().StringV6() != addrV6 {
+		oldAddr := e.wgInterface.Address().StringV6()
+		log.Debugf("updating peer IPv6 address from %s to %s", oldAddr, addrV6)
+		err := e.wgInterface.UpdateAddrV6(addrV6)
+		if err != nil {
+			return err
+		}
+		log.Infof("updated peer IPv6 address from %s to %s", oldAddr, addrV6)
 	}
 
 	if conf.GetSshConfig() != nil {
@@ -621,11 +670,21 @@ func (e *Engine) updateConfig(conf *mgmProto.PeerConfig) error {
 		PubKey:          e.config.WgPrivateKey.PublicKey().String(),
 		KernelInterface: iface.WireGuardModuleIsLoaded(),
 		FQDN:            conf.GetFqdn(),
+		LoginExpiresAt:  loginExpiresAt(conf),
 	})
 
 	return nil
 }
 
+// loginExpiresAt returns the deadline of the login of the peer, zero when the login doesn't expire or the management
+// doesn't send it
+func loginExpiresAt(conf *mgmProto.PeerConfig) time.Time {
+	if conf.GetLoginExpiresAt() == nil {
+		return time.Time{}
+	}
+	return conf.GetLoginExpiresAt().AsTime()
+}
+
 // receiveManagementEvents connects to the Management Service event stream to receive updates from the management service
 // E.g. when a new peer has been registered and we are allowed to connect to it.
 func (e *Engine) receiveManagementEvents() {
@@ -688,6 +747,19 @@ func (e *Engine) updateTURNs(turns []*mgmProto.ProtectedHostConfig) error {
 	return nil
 }
 
+// sameRoutedNetworks reports whether both route maps route the same networks
+func sameRoutedNetworks(a, b route.HAMap) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for id := range a {
+		if _, ok := b[id]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
 func (e *Engine) updateNetworkMap(networkMap *mgmProto.NetworkMap) error {
 
 	// intentionally leave it before checking serial because for now it can happen that peer IP changed but serial didn't
@@ -715,6 +787,7 @@ func (e *Engine) updateNetworkMap(networkMap *mgmProto.NetworkMap) error {
 	}
 
 	e.clientRoutesMu.Lock()
+	routesChanged := !sameRoutedNetworks(e.clientRoutes, clientRoutes)
 	e.clientRoutes = clientRoutes
 	e.clientRoutesMu.Unlock()
 
@@ -765,6 +838,11 @@ func (e *Engine) updateNetworkMap(networkMap *mgmProto.NetworkMap) error {
 		protoDNSConfig = &mgmProto.DNSConfig{}
 	}
 
+	// the routes can change how the upstreams and the resolved resources are reached
+	if routesChanged {
+		e.dnsServer.FlushCache()
+	}
+
 	err = e.dnsServer.UpdateDNSServer(serial, toDNSConfig(protoDNSConfig))
 	if err != nil {
 		log.Errorf("failed to update dns server, err: %v", err)
@@ -804,6 +882,7 @@ func toRoutes(protoRoutes []*mgmProto.Route) []*route.Route {
 			Metric:      int(protoRoute.Metric),
 			Masquerade:  protoRoute.Masquerade,
 			KeepRoute:   protoRoute.KeepRoute,
+			Strategy:    route.Strategy(protoRoute.Strategy),
 		}
 		routes = append(routes, convertedRoute)
 	}
@@ -842,9 +921,11 @@ func toDNSConfig(protoDNSConfig *mgmProto.DNSConfig) nbdns.Config {
 		}
 		for _, ns := range nsGroup.GetNameServers() {
 			dnsNS := nbdns.NameServer{
-				IP:     netip.MustParseAddr(ns.GetIP()),
-				NSType: nbdns.NameServerType(ns.GetNSType()),
-				Port:   int(ns.GetPort()),
+				IP:              netip.MustParseAddr(ns.GetIP()),
+				NSType:          nbdns.NameServerType(ns.GetNSType()),
+				Port:            int(ns.GetPort()),
+				Hostname:        ns.GetHostname(),
+				CertFingerprint: ns.GetCertFingerprint(),
 			}
 			dnsNSGroup.NameServers = append(dnsNSGroup.NameServers, dnsNS)
 		}
@@ -883,9 +964,8 @@ func (e *Engine) addNewPeers(peersUpdate []*mgmProto.RemotePeerConfig) error {
 // addNewPeer add peer if connection doesn't exist
 func (e *Engine) addNewPeer(peerConfig *mgmProto.RemotePeerConfig) error {
 	peerKey := peerConfig.GetWgPubKey()
-	peerIPs := peerConfig.GetAllowedIps()
 	if _, ok := e.peerConns[peerKey]; !ok {
-		conn, err := e.createPeerConn(peerKey, strings.Join(peerIPs, ","))
+		conn, err := e.createPeerConn(peerKey, e.peerAllowedIPs(peerConfig))
 		if err != nil {
 			return fmt.Errorf("create peer connection: %w", err)
 		}
@@ -906,6 +986,40 @@ func (e *Engine) addNewPeer(peerConfig *mgmProto.RemotePeerConfig) error {
 	return nil
 }
 
+// ipv6Enabled reports whether the overlay IPv6 addresses are used. They are only used when the firewall enforces the
+// policies on the IPv6 traffic, the peers would reach each other over IPv6 unfiltered otherwise. The mobile and the
+// netstack interfaces don't get the IPv6 address, so they don't use it either.
+func (e *Engine) ipv6Enabled() bool {
+	if runtime.GOOS == "android" || runtime.GOOS == "ios" || netstack.IsEnabled() {
+		return false
+	}
+	return e.firewall != nil && e.firewall.IsIPv6Supported()
+}
+
+// overlayAddressV6 returns the IPv6 address to set on the interface, empty when IPv6 is not enabled
+func (e *Engine) overlayAddressV6(address string) string {
+	if !e.ipv6Enabled() {
+		return ""
+	}
+	return address
+}
+
+// peerAllowedIPs returns the allowed IPs of the remote peer, without the IPv6 addresses when IPv6 is not enabled
+func (e *Engine) peerAllowedIPs(peerConfig *mgmProto.RemotePeerConfig) string {
+	if e.ipv6Enabled() {
+		return strings.Join(peerConfig.GetAllowedIps(), ",")
+	}
+
+	allowedIPs := make([]string, 0, len(peerConfig.GetAllowedIps()))
+	for _, allowedIP := range peerConfig.GetAllowedIps() {
+		if prefix, err := netip.ParsePrefix(allowedIP); err == nil && !prefix.Addr().Unmap().Is4() {
+			continue
+		}
+		allowedIPs = append(allowedIPs, allowedIP)
+	}
+	return strings.Join(allowedIPs, ",")
+}
+
 func (e *Engine) createPeerConn(pubKey string, allowedIPs string) (*peer.Conn, error) {
 	log.Debugf("creating peer connection %s", pubKey)
 
@@ -1138,6 +1252,8 @@ func (e *Engine) close() {
 		}
 	}
 
+	e.stopPeerTestResponder()
+
 	if e.firewall != nil {
 		err := e.firewall.Reset()
 		if err != nil {
@@ -1150,6 +1266,42 @@ func (e *Engine) close() {
 	}
 }
 
+// startPeerTestResponder (re)starts the responder of the peer tests on the address of the interface when it is enabled
+func (e *Engine) startPeerTestResponder() {
+	e.stopPeerTestResponder()
+
+	if !e.config.PeerTestResponder {
+		return
+	}
+
+	addr := fmt.Sprintf("%s:%d", e.wgInterface.Address().IP.String(), perftest.DefaultPort)
+	responder, err := perftest.NewResponder(addr, e.isPeerTestAllowed)
+	if err != nil {
+		log.Warnf("failed starting the peer test responder, the remote peers can't test the connection to this peer: %v", err)
+		return
+	}
+	e.peerTestResponder = responder
+}
+
+func (e *Engine) stopPeerTestResponder() {
+	if e.peerTestResponder == nil {
+		return
+	}
+	if err := e.peerTestResponder.Close(); err != nil {
+		log.Warnf("failed stopping the peer test responder: %v", err)
+	}
+	e.peerTestResponder = nil
+}
+
+// isPeerTestAllowed allows the tests of the peers of the network the policies allow to connect to this peer. Without a
+// firewall the policies are not enforced, every peer of the network is allowed.
+func (e *Engine) isPeerTestAllowed(ip net.IP) bool {
+	if !e.wgInterface.Address().Network.Contains(ip) {
+		return false
+	}
+	return e.acl == nil || e.acl.IsPeerAllowed(ip)
+}
+
 func (e *Engine) readInitialSettings() ([]*route.Route, *nbdns.Config, error) {
 	info := system.GetInfo(e.ctx)
 	netMap, err := e.mgmClient.GetNetworkMap(info)
@@ -1255,6 +1407,38 @@ func (e *Engine) GetRouteManager() routemanager.Manager {
 	return e.routeManager
 }
 
+// FlushDNSCache removes the upstream responses cached by the DNS server
+func (e *Engine) FlushDNSCache() error {
+	e.syncMsgMux.Lock()
+	defer e.syncMsgMux.Unlock()
+
+	if e.dnsServer == nil {
+		return fmt.Errorf("DNS server is not running")
+	}
+	e.dnsServer.FlushCache()
+	return nil
+}
+
+// DNSQueryLog returns the log of the queries answered by the DNS server
+func (e *Engine) DNSQueryLog() (*dns.QueryLog, error) {
+	e.syncMsgMux.Lock()
+	defer e.syncMsgMux.Unlock()
+
+	if e.dnsServer == nil || e.dnsServer.QueryLog() == nil {
+		return nil, fmt.Errorf("DNS server is not running")
+	}
+	return e.dnsServer.QueryLog(), nil
+}
+
+// GetPeerConn returns the connection to a remote peer
+func (e *Engine) GetPeerConn(pubKey string) (*peer.Conn, bool) {
+	e.syncMsgMux.Lock()
+	defer e.syncMsgMux.Unlock()
+
+	conn, ok := e.peerConns[pubKey]
+	return conn, ok
+}
+
 func findIPFromInterfaceName(ifaceName string) (net.IP, error) {
 	iface, err := net.InterfaceByName(ifaceName)
 	if err != nil {
@@ -248,7 +248,7 @@ func TestEngine_UpdateNetworkMap(t *testing.T) {
 		},
 	}
 	engine.wgInterface = wgIface
-	engine.routeManager = routemanager.NewManager(ctx, key.PublicKey().String(), time.Minute, engine.wgInterface, engine.statusRecorder, relayMgr, nil)
+	engine.routeManager = routemanager.NewManager(ctx, key.PublicKey().String(), time.Minute, engine.wgInterface, engine.statusRecorder, relayMgr, nil, "")
 	engine.dnsServer = &dns.MockServer{
 		UpdateDNSServerFunc: func(serial uint64, update nbdns.Config) error { return nil },
 	}
@@ -932,6 +932,49 @@ loop:
 	}
 }
 
+func Test_SameRoutedNetworks(t *testing.T) {
+	lan := route.HAUniqueID("lan|192.168.0.0/24")
+	office := route.HAUniqueID("office|10.0.0.0/16")
+
+	testCases := []struct {
+		name     string
+		previous route.HAMap
+		current  route.HAMap
+		expected bool
+	}{
+		{
+			name:     "No Routes Should Be Same",
+			previous: nil,
+			current:  route.HAMap{},
+			expected: true,
+		},
+		{
+			name:     "Same Networks Should Be Same",
+			previous: route.HAMap{lan: {}},
+			current:  route.HAMap{lan: {}},
+			expected: true,
+		},
+		{
+			name:     "Added Network Should Differ",
+			previous: route.HAMap{lan: {}},
+			current:  route.HAMap{lan: {}, office: {}},
+			expected: false,
+		},
+		{
+			name:     "Replaced Network Should Differ",
+			previous: route.HAMap{lan: {}},
+			current:  route.HAMap{office: {}},
+			expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, sameRoutedNetworks(testCase.previous, testCase.current))
+		})
+	}
+}
+
 func Test_ParseNATExternalIPMappings(t *testing.T) {
 	ifaceList, err := net.Interfaces()
 	if err != nil {
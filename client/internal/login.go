@@ -3,6 +3,7 @@ package internal
 import (
 	"context"
 	"net/url"
+	"time"
 
 	"github.com/google/uuid"
 	log "github.com/sirupsen/logrus"
@@ -77,6 +78,43 @@ func Login(ctx context.Context, config *Config, setupKey string, jwtToken string
 	return err
 }
 
+// Reauthenticate renews the login of a registered peer with a new JWT. It runs next to the engine and does not
+// change the connection, it returns the new login expiration deadline, zero if the login doesn't expire.
+func Reauthenticate(ctx context.Context, config *Config, jwtToken string) (time.Time, error) {
+	mgmClient, err := getMgmClient(ctx, config.PrivateKey, config.ManagementURL)
+	if err != nil {
+		return time.Time{}, err
+	}
+	defer func() {
+		err = mgmClient.Close()
+		if err != nil {
+			cStatus, ok := status.FromError(err)
+			if !ok || ok && cStatus.Code() != codes.Canceled {
+				log.Warnf("failed to close the Management service client, err: %v", err)
+			}
+		}
+	}()
+
+	pubSSHKey, err := ssh.GeneratePublicKey([]byte(config.SSHKey))
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	serverKey, err := mgmClient.GetServerPublicKey()
+	if err != nil {
+		log.Errorf("failed while getting Management Service public key: %v", err)
+		return time.Time{}, err
+	}
+
+	loginResp, err := mgmClient.RenewLogin(*serverKey, jwtToken, system.GetInfo(ctx), pubSSHKey)
+	if err != nil {
+		log.Errorf("failed renewing the login on Management Service: %v", err)
+		return time.Time{}, err
+	}
+
+	return loginExpiresAt(loginResp.GetPeerConfig()), nil
+}
+
 func getMgmClient(ctx context.Context, privateKey string, mgmURL *url.URL) (*mgm.GrpcClient, error) {
 	// validate our peer's Wireguard PRIVATE key
 	myPrivateKey, err := wgtypes.ParseKey(privateKey)
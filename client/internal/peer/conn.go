@@ -2,6 +2,8 @@ package peer
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"math/rand"
 	"net"
 	"os"
@@ -20,6 +22,7 @@ import (
 	"github.com/netbirdio/netbird/iface"
 	relayClient "github.com/netbirdio/netbird/relay/client"
 	"github.com/netbirdio/netbird/route"
+	signal "github.com/netbirdio/netbird/signal/client"
 	nbnet "github.com/netbirdio/netbird/util/net"
 )
 
@@ -107,12 +110,14 @@ type Conn struct {
 	// for reconnection operations
 	iCEDisconnected   chan bool
 	relayDisconnected chan bool
+
+	events *connEvents
 }
 
 // NewConn creates a new not opened Conn to the remote peer.
 // To establish a connection run Conn.Open
 func NewConn(engineCtx context.Context, config ConnConfig, statusRecorder *Status, wgProxyFactory *wgproxy.Factory, signaler *Signaler, iFaceDiscover stdnet.ExternalIFaceDiscover, relayManager *relayClient.Manager) (*Conn, error) {
-	_, allowedIPsIP, err := net.ParseCIDR(config.WgConfig.AllowedIps)
+	allowedIPsIP, err := overlayIPv4Net(config.WgConfig.AllowedIps)
 	if err != nil {
 		log.Errorf("failed to parse allowedIPS: %v", err)
 		return nil, err
@@ -135,6 +140,7 @@ func NewConn(engineCtx context.Context, config ConnConfig, statusRecorder *Statu
 		statusICE:         StatusDisconnected,
 		iCEDisconnected:   make(chan bool, 1),
 		relayDisconnected: make(chan bool, 1),
+		events:            newConnEvents(),
 	}
 
 	rFns := WorkerRelayCallbacks{
@@ -167,6 +173,21 @@ func NewConn(engineCtx context.Context, config ConnConfig, statusRecorder *Statu
 	return conn, nil
 }
 
+// overlayIPv4Net returns the first IPv4 network of the comma separated allowed IPs, the overlay IPv4 address of the peer.
+// The allowed IPs can contain the overlay IPv6 address of the peer as well
+func overlayIPv4Net(allowedIPs string) (*net.IPNet, error) {
+	for _, allowedIP := range strings.Split(allowedIPs, ",") {
+		ip, ipNet, err := net.ParseCIDR(strings.TrimSpace(allowedIP))
+		if err != nil {
+			return nil, err
+		}
+		if ip.To4() != nil {
+			return ipNet, nil
+		}
+	}
+	return nil, fmt.Errorf("no IPv4 address in the allowed IPs %q", allowedIPs)
+}
+
 // Open opens connection to the remote peer
 // It will try to establish a connection using ICE and in parallel with relay. The higher priority connection type will
 // be used.
@@ -175,6 +196,7 @@ func (conn *Conn) Open() {
 	conn.mu.Lock()
 	defer conn.mu.Unlock()
 	conn.opened = true
+	conn.events.add("connection opened")
 
 	peerState := State{
 		PubKey:           conn.config.Key,
@@ -196,7 +218,7 @@ func (conn *Conn) startHandshakeAndReconnect() {
 
 	err := conn.handshaker.sendOffer()
 	if err != nil {
-		conn.log.Errorf("failed to send initial offer: %v", err)
+		conn.logOfferError("failed to send initial offer", err)
 	}
 
 	if conn.workerRelay.IsController() {
@@ -213,6 +235,7 @@ func (conn *Conn) Close() {
 
 	conn.log.Infof("close peer connection")
 	conn.ctxCancel()
+	conn.events.add("connection closed")
 
 	if !conn.opened {
 		conn.log.Debugf("ignore close connection to peer")
@@ -257,6 +280,7 @@ func (conn *Conn) Close() {
 // doesn't block, discards the message if connection wasn't ready
 func (conn *Conn) OnRemoteAnswer(answer OfferAnswer) bool {
 	conn.log.Debugf("OnRemoteAnswer, status ICE: %s, status relay: %s", conn.statusICE, conn.statusRelay)
+	conn.events.add("received answer from the remote peer")
 	return conn.handshaker.OnRemoteAnswer(answer)
 }
 
@@ -284,6 +308,7 @@ func (conn *Conn) SetOnDisconnected(handler func(remotePeer string, wgIP string)
 
 func (conn *Conn) OnRemoteOffer(offer OfferAnswer) bool {
 	conn.log.Debugf("OnRemoteOffer, on status ICE: %s, status Relay: %s", conn.statusICE, conn.statusRelay)
+	conn.events.add("received offer from the remote peer")
 	return conn.handshaker.OnRemoteOffer(offer)
 }
 
@@ -303,6 +328,26 @@ func (conn *Conn) GetKey() string {
 	return conn.config.Key
 }
 
+// Diagnostics returns the ICE candidates, the WireGuard stats and the last state transitions of the connection
+func (conn *Conn) Diagnostics() ConnDiagnostics {
+	wgStats, err := conn.config.WgConfig.WgInterface.GetStats(conn.config.WgConfig.RemoteKey)
+	if err != nil {
+		conn.log.Debugf("failed to get wg stats: %v", err)
+	}
+
+	events, _ := conn.events.since(0)
+	return ConnDiagnostics{
+		ICE:       conn.workerICE.Diagnostics(),
+		WireGuard: wgStats,
+		Events:    events,
+	}
+}
+
+// Events returns the state transitions after the given sequence number and a channel closed on the next transition
+func (conn *Conn) Events(since uint64) ([]ConnEvent, <-chan struct{}) {
+	return conn.events.since(since)
+}
+
 func (conn *Conn) reconnectLoopWithRetry() {
 	// Give chance to the peer to establish the initial connection.
 	// With it, we can decrease to send necessary offer
@@ -339,7 +384,7 @@ func (conn *Conn) reconnectLoopWithRetry() {
 
 			err := conn.handshaker.sendOffer()
 			if err != nil {
-				conn.log.Errorf("failed to do handshake: %v", err)
+				conn.logOfferError("failed to do handshake", err)
 			}
 		case changed := <-conn.relayDisconnected:
 			if !changed {
@@ -403,11 +448,21 @@ func (conn *Conn) reconnectLoopForOnDisconnectedEvent() {
 
 		err := conn.handshaker.SendOffer()
 		if err != nil {
-			conn.log.Errorf("failed to do handshake: %v", err)
+			conn.logOfferError("failed to do handshake", err)
 		}
 	}
 }
 
+// logOfferError logs the failed offer. The remote peer being offline is expected, it will send an offer when it connects.
+func (conn *Conn) logOfferError(msg string, err error) {
+	conn.events.add("%s: %v", msg, err)
+	if errors.Is(err, signal.ErrPeerNotConnected) {
+		conn.log.Debugf("%s: %v", msg, err)
+		return
+	}
+	conn.log.Errorf("%s: %v", msg, err)
+}
+
 // configureConnection starts proxying traffic from/to local Wireguard and sets connection status to StatusConnected
 func (conn *Conn) iCEConnectionIsReady(priority ConnPriority, iceConnInfo ICEConnInfo) {
 	conn.mu.Lock()
@@ -423,7 +478,10 @@ func (conn *Conn) iCEConnectionIsReady(priority ConnPriority, iceConnInfo ICECon
 
 	defer conn.updateIceState(iceConnInfo)
 
+	conn.events.add("ICE connection ready, local %s candidate %s, remote %s candidate %s", iceConnInfo.LocalIceCandidateType,
+		iceConnInfo.LocalIceCandidateEndpoint, iceConnInfo.RemoteIceCandidateType, iceConnInfo.RemoteIceCandidateEndpoint)
 	if conn.currentConnPriority > priority {
+		conn.events.add("ICE connection is not used, the current connection has higher priority")
 		return
 	}
 
@@ -466,6 +524,7 @@ func (conn *Conn) iCEConnectionIsReady(priority ConnPriority, iceConnInfo ICECon
 	conn.wgProxyICE = wgProxy
 
 	conn.currentConnPriority = priority
+	conn.events.add("switched to the ICE connection")
 
 	conn.doOnConnected(iceConnInfo.RosenpassPubKey, iceConnInfo.RosenpassAddr)
 }
@@ -480,6 +539,7 @@ func (conn *Conn) onWorkerICEStateDisconnected(newState ConnStatus) {
 	}
 
 	conn.log.Tracef("ICE connection state changed to %s", newState)
+	conn.events.add("ICE connection %s", strings.ToLower(newState.String()))
 
 	// switch back to relay connection
 	if conn.endpointRelay != nil && conn.currentConnPriority != connPriorityRelay {
@@ -490,6 +550,7 @@ func (conn *Conn) onWorkerICEStateDisconnected(newState ConnStatus) {
 		}
 		conn.workerRelay.EnableWgWatcher(conn.ctx)
 		conn.currentConnPriority = connPriorityRelay
+		conn.events.add("switched back to the relay connection")
 	}
 
 	changed := conn.statusICE != newState && newState != StatusConnecting
@@ -523,6 +584,7 @@ func (conn *Conn) relayConnectionIsReady(rci RelayConnInfo) {
 
 	conn.log.Debugf("Relay connection is ready to use")
 	conn.statusRelay = StatusConnected
+	conn.events.add("relay connection ready via %s", rci.relayedConn.RemoteAddr())
 
 	wgProxy := conn.wgProxyFactory.GetProxy(conn.ctx)
 	endpoint, err := wgProxy.AddTurnConn(rci.relayedConn)
@@ -540,6 +602,7 @@ func (conn *Conn) relayConnectionIsReady(rci RelayConnInfo) {
 	if conn.currentConnPriority > connPriorityRelay {
 		if conn.statusICE == StatusConnected {
 			log.Debugf("do not switch to relay because current priority is: %v", conn.currentConnPriority)
+			conn.events.add("relay connection is not used, the ICE connection has higher priority")
 			return
 		}
 	}
@@ -571,6 +634,7 @@ func (conn *Conn) relayConnectionIsReady(rci RelayConnInfo) {
 	conn.currentConnPriority = connPriorityRelay
 
 	conn.log.Infof("start to communicate with peer via relay")
+	conn.events.add("switched to the relay connection")
 	conn.doOnConnected(rci.rosenpassPubKey, rci.rosenpassAddr)
 }
 
@@ -596,6 +660,7 @@ func (conn *Conn) onWorkerRelayStateDisconnected() {
 
 	changed := conn.statusRelay != StatusDisconnected
 	conn.statusRelay = StatusDisconnected
+	conn.events.add("relay connection disconnected")
 
 	select {
 	case conn.relayDisconnected <- changed:
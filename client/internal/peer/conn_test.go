@@ -166,3 +166,29 @@ func TestConn_Status(t *testing.T) {
 		})
 	}
 }
+
+func TestOverlayIPv4Net(t *testing.T) {
+	tables := []struct {
+		name       string
+		allowedIPs string
+		want       string
+	}{
+		{"IPv4Only", "100.64.0.10/32", "100.64.0.10/32"},
+		{"IPv4First", "100.64.0.10/32,fd00:1234::a/128", "100.64.0.10/32"},
+		{"IPv6First", "fd00:1234::a/128,100.64.0.10/32", "100.64.0.10/32"},
+	}
+
+	for _, table := range tables {
+		t.Run(table.name, func(t *testing.T) {
+			got, err := overlayIPv4Net(table.allowedIPs)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			assert.Equal(t, got.String(), table.want, "they should be equal")
+		})
+	}
+
+	if _, err := overlayIPv4Net("fd00:1234::a/128"); err == nil {
+		t.Error("expected an error without an IPv4 address")
+	}
+}
@@ -0,0 +1,186 @@
+package peer
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pion/ice/v3"
+
+	"github.com/netbirdio/netbird/iface"
+)
+
+// maxConnEvents is the number of state transitions kept per peer connection
+const maxConnEvents = 50
+
+const (
+	pairFailureNoResponse = "no response to the connectivity checks"
+	pairFailureTimeout    = "connectivity checks did not complete before the ICE timeout"
+)
+
+// ConnEvent is a state transition of a peer connection
+type ConnEvent struct {
+	Seq     uint64
+	Time    time.Time
+	Message string
+}
+
+// ICECandidateInfo describes a local or remote ICE candidate
+type ICECandidateInfo struct {
+	ID            string
+	Type          string
+	Network       string
+	Address       string
+	Port          int
+	RelayProtocol string
+	// IgnoredReason is set for remote candidates not handed to the ICE agent
+	IgnoredReason string
+}
+
+// Endpoint returns the address and port of the candidate
+func (c ICECandidateInfo) Endpoint() string {
+	return fmt.Sprintf("%s:%d", c.Address, c.Port)
+}
+
+// ICECandidatePairInfo describes a candidate pair checked by the ICE agent
+type ICECandidatePairInfo struct {
+	Local         ICECandidateInfo
+	Remote        ICECandidateInfo
+	State         string
+	Nominated     bool
+	Selected      bool
+	FailureReason string
+}
+
+// ICEDiagnostics describes the current or the last ICE connection attempt with a peer
+type ICEDiagnostics struct {
+	AgentState       string
+	StartedAt        time.Time
+	LocalCandidates  []ICECandidateInfo
+	RemoteCandidates []ICECandidateInfo
+	Pairs            []ICECandidatePairInfo
+	// Error is the last error of the attempt, e.g. a failed candidate gathering
+	Error string
+}
+
+// ConnDiagnostics describes how a peer connection is established
+type ConnDiagnostics struct {
+	ICE       ICEDiagnostics
+	WireGuard iface.WGStats
+	Events    []ConnEvent
+}
+
+// connEvents keeps the last state transitions of a peer connection
+type connEvents struct {
+	mu      sync.Mutex
+	events  []ConnEvent
+	seq     uint64
+	changed chan struct{}
+}
+
+func newConnEvents() *connEvents {
+	return &connEvents{
+		changed: make(chan struct{}),
+	}
+}
+
+func (e *connEvents) add(format string, args ...any) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.seq++
+	e.events = append(e.events, ConnEvent{
+		Seq:     e.seq,
+		Time:    time.Now(),
+		Message: fmt.Sprintf(format, args...),
+	})
+	if len(e.events) > maxConnEvents {
+		e.events = e.events[len(e.events)-maxConnEvents:]
+	}
+
+	close(e.changed)
+	e.changed = make(chan struct{})
+}
+
+// since returns the events after the given sequence number and a channel closed on the next event
+func (e *connEvents) since(seq uint64) ([]ConnEvent, <-chan struct{}) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	var events []ConnEvent
+	for _, event := range e.events {
+		if event.Seq > seq {
+			events = append(events, event)
+		}
+	}
+	return events, e.changed
+}
+
+// iceAttempt snapshots the candidates and the candidate pairs of an ICE agent
+func iceAttempt(agent *ice.Agent, state ice.ConnectionState, startedAt time.Time, ignored []ICECandidateInfo) ICEDiagnostics {
+	diag := ICEDiagnostics{
+		AgentState: state.String(),
+		StartedAt:  startedAt,
+	}
+
+	locals := make(map[string]ICECandidateInfo)
+	for _, stats := range agent.GetLocalCandidatesStats() {
+		c := candidateInfo(stats)
+		locals[c.ID] = c
+		diag.LocalCandidates = append(diag.LocalCandidates, c)
+	}
+
+	remotes := make(map[string]ICECandidateInfo)
+	for _, stats := range agent.GetRemoteCandidatesStats() {
+		c := candidateInfo(stats)
+		remotes[c.ID] = c
+		diag.RemoteCandidates = append(diag.RemoteCandidates, c)
+	}
+	diag.RemoteCandidates = append(diag.RemoteCandidates, ignored...)
+
+	var selectedLocal, selectedRemote string
+	if pair, err := agent.GetSelectedCandidatePair(); err == nil && pair != nil {
+		selectedLocal, selectedRemote = pair.Local.ID(), pair.Remote.ID()
+	}
+
+	agentFailed := state == ice.ConnectionStateFailed
+	for _, stats := range agent.GetCandidatePairsStats() {
+		pair := ICECandidatePairInfo{
+			Local:     locals[stats.LocalCandidateID],
+			Remote:    remotes[stats.RemoteCandidateID],
+			State:     stats.State.String(),
+			Nominated: stats.Nominated,
+			Selected:  stats.LocalCandidateID == selectedLocal && stats.RemoteCandidateID == selectedRemote,
+		}
+		switch {
+		case stats.State == ice.CandidatePairStateFailed:
+			pair.FailureReason = pairFailureNoResponse
+		case agentFailed && stats.State != ice.CandidatePairStateSucceeded:
+			pair.FailureReason = pairFailureTimeout
+		}
+		diag.Pairs = append(diag.Pairs, pair)
+	}
+
+	return diag
+}
+
+func candidateInfo(stats ice.CandidateStats) ICECandidateInfo {
+	return ICECandidateInfo{
+		ID:            stats.ID,
+		Type:          stats.CandidateType.String(),
+		Network:       stats.NetworkType.String(),
+		Address:       stats.IP,
+		Port:          stats.Port,
+		RelayProtocol: stats.RelayProtocol,
+	}
+}
+
+func iceCandidateInfo(candidate ice.Candidate) ICECandidateInfo {
+	return ICECandidateInfo{
+		ID:      candidate.ID(),
+		Type:    candidate.Type().String(),
+		Network: candidate.NetworkType().String(),
+		Address: candidate.Address(),
+		Port:    candidate.Port(),
+	}
+}
@@ -0,0 +1,52 @@
+package peer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConnEvents_Since(t *testing.T) {
+	events := newConnEvents()
+	events.add("connection opened")
+	events.add("relay connection ready via %s", "rels://relay.netbird.io:443")
+
+	all, changed := events.since(0)
+	require.Len(t, all, 2)
+	assert.Equal(t, "connection opened", all[0].Message)
+	assert.Equal(t, "relay connection ready via rels://relay.netbird.io:443", all[1].Message)
+
+	newEvents, _ := events.since(all[1].Seq)
+	assert.Empty(t, newEvents)
+
+	select {
+	case <-changed:
+		t.Fatal("changed should not be closed before a new event")
+	default:
+	}
+
+	events.add("switched to the relay connection")
+
+	select {
+	case <-changed:
+	default:
+		t.Fatal("changed should be closed on a new event")
+	}
+
+	newEvents, _ = events.since(all[1].Seq)
+	require.Len(t, newEvents, 1)
+	assert.Equal(t, "switched to the relay connection", newEvents[0].Message)
+}
+
+func TestConnEvents_KeepsLastEvents(t *testing.T) {
+	events := newConnEvents()
+	for i := 0; i < maxConnEvents+10; i++ {
+		events.add("event %d", i)
+	}
+
+	all, _ := events.since(0)
+	require.Len(t, all, maxConnEvents)
+	assert.Equal(t, "event 10", all[0].Message)
+	assert.Equal(t, uint64(maxConnEvents+10), all[len(all)-1].Seq)
+}
@@ -0,0 +1,155 @@
+package peer
+
+import (
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+)
+
+// eventBufferSize is the number of events a subscriber may fall behind before the new events are dropped for it
+const eventBufferSize = 100
+
+// EventType is the kind of an event of the client
+type EventType int
+
+const (
+	EventPeerConnected EventType = iota + 1
+	EventPeerDisconnected
+	EventRouteActivated
+	EventRouteFailedOver
+	EventRouteDeactivated
+	EventDNSUpstreamDisabled
+	EventLoginExpired
+	EventManagementDisconnected
+	EventLoginExpiring
+	EventLoginRenewed
+)
+
+// EventSeverity tells how important an event is for the user
+type EventSeverity int
+
+const (
+	EventSeverityInfo EventSeverity = iota
+	EventSeverityWarning
+	EventSeverityError
+)
+
+// Event is a change of the client state the user may be notified about
+type Event struct {
+	ID       string
+	Type     EventType
+	Severity EventSeverity
+	Time     time.Time
+	Message  string
+	// Metadata describes the subject of the event, e.g. the peer or the network
+	Metadata map[string]string
+}
+
+// EventSubscription receives the events published after it was created
+type EventSubscription struct {
+	id     string
+	events chan Event
+}
+
+// Events returns the channel of the events, it is closed when the subscription is removed
+func (s *EventSubscription) Events() <-chan Event {
+	return s.events
+}
+
+// eventPublisher is ready to use as a zero value
+type eventPublisher struct {
+	mu          sync.Mutex
+	subscribers map[string]*EventSubscription
+}
+
+func (p *eventPublisher) subscribe() *EventSubscription {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.subscribers == nil {
+		p.subscribers = make(map[string]*EventSubscription)
+	}
+	sub := &EventSubscription{
+		id:     uuid.NewString(),
+		events: make(chan Event, eventBufferSize),
+	}
+	p.subscribers[sub.id] = sub
+	return sub
+}
+
+func (p *eventPublisher) unsubscribe(sub *EventSubscription) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, ok := p.subscribers[sub.id]; !ok {
+		return
+	}
+	delete(p.subscribers, sub.id)
+	close(sub.events)
+}
+
+// publish never blocks, a subscriber that does not keep up misses the events
+func (p *eventPublisher) publish(event Event) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, sub := range p.subscribers {
+		select {
+		case sub.events <- event:
+		default:
+			log.Debugf("dropped event %s for subscriber %s, the buffer is full", event.ID, sub.id)
+		}
+	}
+}
+
+// SubscribeToEvents returns a subscription that receives the events published from now on. It must be removed
+// with UnsubscribeFromEvents when it is no longer read.
+func (d *Status) SubscribeToEvents() *EventSubscription {
+	return d.eventPublisher.subscribe()
+}
+
+// UnsubscribeFromEvents removes the subscription and closes its channel
+func (d *Status) UnsubscribeFromEvents(sub *EventSubscription) {
+	d.eventPublisher.unsubscribe(sub)
+}
+
+// PublishEvent sends an event to all subscribers
+func (d *Status) PublishEvent(eventType EventType, severity EventSeverity, message string, metadata map[string]string) {
+	event := Event{
+		ID:       uuid.NewString(),
+		Type:     eventType,
+		Severity: severity,
+		Time:     time.Now(),
+		Message:  message,
+		Metadata: metadata,
+	}
+	d.eventPublisher.publish(event)
+}
+
+// publishPeerConnStatusEvent publishes the transitions of a peer from and to the connected state
+func (d *Status) publishPeerConnStatusEvent(prev, curr State) {
+	metadata := map[string]string{
+		"pubkey": curr.PubKey,
+		"fqdn":   curr.FQDN,
+		"ip":     curr.IP,
+	}
+
+	switch {
+	case prev.ConnStatus != StatusConnected && curr.ConnStatus == StatusConnected:
+		d.PublishEvent(EventPeerConnected, EventSeverityInfo, "Connected to peer "+peerName(curr), metadata)
+	case prev.ConnStatus == StatusConnected && curr.ConnStatus != StatusConnected:
+		d.PublishEvent(EventPeerDisconnected, EventSeverityInfo, "Disconnected from peer "+peerName(curr), metadata)
+	}
+}
+
+func peerName(state State) string {
+	if state.FQDN != "" {
+		return state.FQDN
+	}
+	if state.IP != "" {
+		return state.IP
+	}
+	return state.PubKey
+}
@@ -0,0 +1,70 @@
+package peer
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatus_PeerConnStatusEvents(t *testing.T) {
+	key := "abc"
+	status := NewRecorder("https://mgm")
+	require.NoError(t, status.AddPeer(key, "abc.netbird"))
+
+	sub := status.SubscribeToEvents()
+	defer status.UnsubscribeFromEvents(sub)
+
+	transitions := []ConnStatus{StatusConnecting, StatusConnected, StatusConnected, StatusDisconnected, StatusDisconnected}
+	for _, connStatus := range transitions {
+		err := status.UpdatePeerState(State{PubKey: key, IP: "100.64.0.1", ConnStatus: connStatus, Mux: new(sync.RWMutex)})
+		require.NoError(t, err)
+	}
+
+	require.Len(t, sub.Events(), 2)
+
+	event := <-sub.Events()
+	assert.Equal(t, EventPeerConnected, event.Type)
+	assert.Equal(t, "abc.netbird", event.Metadata["fqdn"])
+	assert.NotEmpty(t, event.ID)
+
+	event = <-sub.Events()
+	assert.Equal(t, EventPeerDisconnected, event.Type)
+}
+
+func TestStatus_ManagementDisconnectedEvent(t *testing.T) {
+	status := NewRecorder("https://mgm")
+	sub := status.SubscribeToEvents()
+	defer status.UnsubscribeFromEvents(sub)
+
+	status.MarkManagementDisconnected(errors.New("unavailable"))
+	assert.Empty(t, sub.Events(), "the management was never connected")
+
+	status.MarkManagementConnected()
+	status.MarkManagementDisconnected(errors.New("unavailable"))
+	require.Len(t, sub.Events(), 1)
+
+	event := <-sub.Events()
+	assert.Equal(t, EventManagementDisconnected, event.Type)
+	assert.Equal(t, EventSeverityWarning, event.Severity)
+	assert.Equal(t, "unavailable", event.Metadata["error"])
+}
+
+func TestStatus_EventSubscription(t *testing.T) {
+	status := NewRecorder("https://mgm")
+	sub := status.SubscribeToEvents()
+
+	for i := 0; i < eventBufferSize+10; i++ {
+		status.PublishEvent(EventRouteActivated, EventSeverityInfo, "route activated", nil)
+	}
+	assert.Len(t, sub.Events(), eventBufferSize, "a slow subscriber must not block the publisher")
+
+	status.UnsubscribeFromEvents(sub)
+	status.UnsubscribeFromEvents(sub)
+	for range sub.Events() {
+	}
+
+	status.PublishEvent(EventRouteActivated, EventSeverityInfo, "route activated", nil)
+}
@@ -77,6 +77,8 @@ type LocalPeerState struct {
 	KernelInterface bool
 	FQDN            string
 	Routes          map[string]struct{}
+	// LoginExpiresAt is the deadline of the SSO login of the peer, zero when the login doesn't expire
+	LoginExpiresAt time.Time
 }
 
 // SignalState contains the latest state of a signal connection
@@ -146,6 +148,8 @@ type Status struct {
 	peerListChangedForNotification bool
 
 	relayMgr *relayClient.Manager
+
+	eventPublisher eventPublisher
 }
 
 // NewRecorder returns a new Status instance
@@ -232,6 +236,7 @@ func (d *Status) UpdatePeerState(receivedState State) error {
 	if !ok {
 		return errors.New("peer doesn't exist")
 	}
+	prevState := peerState
 
 	if receivedState.IP != "" {
 		peerState.IP = receivedState.IP
@@ -256,6 +261,7 @@ func (d *Status) UpdatePeerState(receivedState State) error {
 	}
 
 	d.peers[receivedState.PubKey] = peerState
+	d.publishPeerConnStatusEvent(prevState, peerState)
 
 	if skipNotification {
 		return nil
@@ -279,6 +285,7 @@ func (d *Status) UpdatePeerICEState(receivedState State) error {
 	if !ok {
 		return errors.New("peer doesn't exist")
 	}
+	prevState := peerState
 
 	if receivedState.IP != "" {
 		peerState.IP = receivedState.IP
@@ -296,6 +303,7 @@ func (d *Status) UpdatePeerICEState(receivedState State) error {
 	peerState.RosenpassEnabled = receivedState.RosenpassEnabled
 
 	d.peers[receivedState.PubKey] = peerState
+	d.publishPeerConnStatusEvent(prevState, peerState)
 
 	if skipNotification {
 		return nil
@@ -319,6 +327,7 @@ func (d *Status) UpdatePeerRelayedState(receivedState State) error {
 	if !ok {
 		return errors.New("peer doesn't exist")
 	}
+	prevState := peerState
 
 	skipNotification := shouldSkipNotify(receivedState.ConnStatus, peerState)
 
@@ -329,6 +338,7 @@ func (d *Status) UpdatePeerRelayedState(receivedState State) error {
 	peerState.RosenpassEnabled = receivedState.RosenpassEnabled
 
 	d.peers[receivedState.PubKey] = peerState
+	d.publishPeerConnStatusEvent(prevState, peerState)
 
 	if skipNotification {
 		return nil
@@ -352,6 +362,7 @@ func (d *Status) UpdatePeerRelayedStateToDisconnected(receivedState State) error
 	if !ok {
 		return errors.New("peer doesn't exist")
 	}
+	prevState := peerState
 
 	skipNotification := shouldSkipNotify(receivedState.ConnStatus, peerState)
 
@@ -361,6 +372,7 @@ func (d *Status) UpdatePeerRelayedStateToDisconnected(receivedState State) error
 	peerState.RelayServerAddress = ""
 
 	d.peers[receivedState.PubKey] = peerState
+	d.publishPeerConnStatusEvent(prevState, peerState)
 
 	if skipNotification {
 		return nil
@@ -384,6 +396,7 @@ func (d *Status) UpdatePeerICEStateToDisconnected(receivedState State) error {
 	if !ok {
 		return errors.New("peer doesn't exist")
 	}
+	prevState := peerState
 
 	skipNotification := shouldSkipNotify(receivedState.ConnStatus, peerState)
 
@@ -396,6 +409,7 @@ func (d *Status) UpdatePeerICEStateToDisconnected(receivedState State) error {
 	peerState.RemoteIceCandidateEndpoint = receivedState.RemoteIceCandidateEndpoint
 
 	d.peers[receivedState.PubKey] = peerState
+	d.publishPeerConnStatusEvent(prevState, peerState)
 
 	if skipNotification {
 		return nil
@@ -501,6 +515,14 @@ func (d *Status) UpdateLocalPeerState(localPeerState LocalPeerState) {
 	d.notifyAddressChanged()
 }
 
+// UpdateLoginExpiration updates the deadline of the login of the local peer
+func (d *Status) UpdateLoginExpiration(expiresAt time.Time) {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+
+	d.localPeer.LoginExpiresAt = expiresAt
+}
+
 // CleanLocalPeerState cleans local peer status
 func (d *Status) CleanLocalPeerState() {
 	d.mux.Lock()
@@ -516,6 +538,12 @@ func (d *Status) MarkManagementDisconnected(err error) {
 	defer d.mux.Unlock()
 	defer d.onConnectionChanged()
 
+	if d.managementState {
+		d.PublishEvent(EventManagementDisconnected, EventSeverityWarning,
+			"Disconnected from the management service, the network configuration is not updated",
+			map[string]string{"url": d.mgmAddress, "error": errorString(err)})
+	}
+
 	d.managementState = false
 	d.managementError = err
 }
@@ -755,3 +783,10 @@ func (d *Status) notifyAddressChanged() {
 func (d *Status) numOfPeers() int {
 	return len(d.peers) + len(d.offlinePeers)
 }
+
+func errorString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
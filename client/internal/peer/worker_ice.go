@@ -89,6 +89,14 @@ type WorkerICE struct {
 
 	localUfrag string
 	localPwd   string
+
+	// diagMu guards the state of the current ICE attempt kept for the connection diagnostics
+	diagMu            sync.Mutex
+	agentState        ice.ConnectionState
+	agentStartedAt    time.Time
+	ignoredCandidates []ICECandidateInfo
+	lastError         string
+	lastAttempt       *ICEDiagnostics
 }
 
 func NewWorkerICE(ctx context.Context, log *log.Entry, config ConnConfig, signaler *Signaler, ifaceDiscover stdnet.ExternalIFaceDiscover, statusRecorder *Status, hasRelayOnLocally bool, callBacks WorkerICECallbacks) (*WorkerICE, error) {
@@ -142,10 +150,18 @@ func (w *WorkerICE) OnNewOffer(remoteOfferAnswer *OfferAnswer) {
 	w.agent = agent
 	w.muxAgent.Unlock()
 
+	w.diagMu.Lock()
+	w.agentState = ice.ConnectionStateNew
+	w.agentStartedAt = time.Now()
+	w.ignoredCandidates = nil
+	w.lastError = ""
+	w.diagMu.Unlock()
+
 	w.log.Debugf("gather candidates")
 	err = w.agent.GatherCandidates()
 	if err != nil {
 		w.log.Debugf("failed to gather candidates: %s", err)
+		w.setLastError("failed to gather candidates: %s", err)
 		return
 	}
 
@@ -156,6 +172,9 @@ func (w *WorkerICE) OnNewOffer(remoteOfferAnswer *OfferAnswer) {
 	remoteConn, err := w.turnAgentDial(agentCtx, remoteOfferAnswer)
 	if err != nil {
 		w.log.Debugf("failed to dial the remote peer: %s", err)
+		if agentCtx.Err() == nil {
+			w.setLastError("failed to dial the remote peer: %s", err)
+		}
 		return
 	}
 	w.log.Debugf("agent dial succeeded")
@@ -201,7 +220,12 @@ func (w *WorkerICE) OnRemoteCandidate(candidate ice.Candidate, haRoutes route.HA
 		return
 	}
 
-	if candidateViaRoutes(candidate, haRoutes) {
+	if prefix, ok := candidateViaRoutes(candidate, haRoutes); ok {
+		ignored := iceCandidateInfo(candidate)
+		ignored.IgnoredReason = fmt.Sprintf("address is part of the routed network %s", prefix)
+		w.diagMu.Lock()
+		w.ignoredCandidates = append(w.ignoredCandidates, ignored)
+		w.diagMu.Unlock()
 		return
 	}
 
@@ -218,6 +242,31 @@ func (w *WorkerICE) GetLocalUserCredentials() (frag string, pwd string) {
 	return w.localUfrag, w.localPwd
 }
 
+// Diagnostics returns the candidates and the candidate pairs of the current ICE attempt, or of the last one when the
+// agent has failed
+func (w *WorkerICE) Diagnostics() ICEDiagnostics {
+	w.muxAgent.Lock()
+	agent := w.agent
+	w.muxAgent.Unlock()
+
+	w.diagMu.Lock()
+	state, startedAt, lastErr := w.agentState, w.agentStartedAt, w.lastError
+	ignored := append([]ICECandidateInfo(nil), w.ignoredCandidates...)
+	lastAttempt := w.lastAttempt
+	w.diagMu.Unlock()
+
+	if agent == nil {
+		if lastAttempt == nil {
+			return ICEDiagnostics{AgentState: "not started"}
+		}
+		return *lastAttempt
+	}
+
+	diag := iceAttempt(agent, state, startedAt, ignored)
+	diag.Error = lastErr
+	return diag
+}
+
 func (w *WorkerICE) Close() {
 	w.muxAgent.Lock()
 	defer w.muxAgent.Unlock()
@@ -277,7 +326,12 @@ func (w *WorkerICE) reCreateAgent(agentCancel context.CancelFunc, relaySupport [
 
 	err = agent.OnConnectionStateChange(func(state ice.ConnectionState) {
 		w.log.Debugf("ICE ConnectionState has changed to %s", state.String())
+		w.diagMu.Lock()
+		w.agentState = state
+		w.diagMu.Unlock()
+
 		if state == ice.ConnectionStateFailed || state == ice.ConnectionStateDisconnected {
+			w.keepLastAttempt(agent, state)
 			w.conn.OnStatusChanged(StatusDisconnected)
 
 			w.muxAgent.Lock()
@@ -311,6 +365,27 @@ func (w *WorkerICE) reCreateAgent(agentCancel context.CancelFunc, relaySupport [
 	return agent, nil
 }
 
+// keepLastAttempt snapshots the failed agent before it is closed
+func (w *WorkerICE) keepLastAttempt(agent *ice.Agent, state ice.ConnectionState) {
+	w.diagMu.Lock()
+	startedAt, lastErr := w.agentStartedAt, w.lastError
+	ignored := append([]ICECandidateInfo(nil), w.ignoredCandidates...)
+	w.diagMu.Unlock()
+
+	attempt := iceAttempt(agent, state, startedAt, ignored)
+	attempt.Error = lastErr
+
+	w.diagMu.Lock()
+	w.lastAttempt = &attempt
+	w.diagMu.Unlock()
+}
+
+func (w *WorkerICE) setLastError(format string, args ...any) {
+	w.diagMu.Lock()
+	defer w.diagMu.Unlock()
+	w.lastError = fmt.Sprintf(format, args...)
+}
+
 func (w *WorkerICE) punchRemoteWGPort(pair *ice.CandidatePair, remoteWgPort int) {
 	// wait local endpoint configuration
 	time.Sleep(time.Second)
@@ -402,7 +477,8 @@ func extraSrflxCandidate(candidate ice.Candidate) (*ice.CandidateServerReflexive
 	})
 }
 
-func candidateViaRoutes(candidate ice.Candidate, clientRoutes route.HAMap) bool {
+// candidateViaRoutes returns the routed network the candidate address is part of
+func candidateViaRoutes(candidate ice.Candidate, clientRoutes route.HAMap) (netip.Prefix, bool) {
 	var routePrefixes []netip.Prefix
 	for _, routes := range clientRoutes {
 		if len(routes) > 0 && routes[0] != nil {
@@ -413,7 +489,7 @@ func candidateViaRoutes(candidate ice.Candidate, clientRoutes route.HAMap) bool
 	addr, err := netip.ParseAddr(candidate.Address())
 	if err != nil {
 		log.Errorf("Failed to parse IP address %s: %v", candidate.Address(), err)
-		return false
+		return netip.Prefix{}, false
 	}
 
 	for _, prefix := range routePrefixes {
@@ -424,10 +500,10 @@ func candidateViaRoutes(candidate ice.Candidate, clientRoutes route.HAMap) bool
 
 		if prefix.Contains(addr) {
 			log.Debugf("Ignoring candidate [%s], its address is part of routed network %s", candidate.String(), prefix)
-			return true
+			return prefix, true
 		}
 	}
-	return false
+	return netip.Prefix{}, false
 }
 
 func candidateTypes() []ice.CandidateType {
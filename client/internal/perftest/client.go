@@ -0,0 +1,446 @@
+package perftest
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultDuration       = 5 * time.Second
+	defaultPings          = 10
+	defaultPingInterval   = 200 * time.Millisecond
+	defaultStreamPackets  = 200
+	defaultStreamInterval = 5 * time.Millisecond
+	// replyTimeout is how long the client waits for the late replies
+	replyTimeout = time.Second
+	dialTimeout  = 5 * time.Second
+)
+
+// Options configures a test
+type Options struct {
+	// Duration of the throughput test of each direction
+	Duration       time.Duration
+	Pings          int
+	PingInterval   time.Duration
+	StreamPackets  int
+	StreamInterval time.Duration
+}
+
+func (o Options) withDefaults() Options {
+	if o.Duration <= 0 {
+		o.Duration = defaultDuration
+	}
+	if o.Duration > MaxDuration {
+		o.Duration = MaxDuration
+	}
+	if o.Pings <= 0 {
+		o.Pings = defaultPings
+	}
+	if o.PingInterval <= 0 {
+		o.PingInterval = defaultPingInterval
+	}
+	if o.StreamPackets <= 0 {
+		o.StreamPackets = defaultStreamPackets
+	}
+	if o.StreamInterval <= 0 {
+		o.StreamInterval = defaultStreamInterval
+	}
+	return o
+}
+
+// LatencyResult is the round trip time measured with UDP echo packets
+type LatencyResult struct {
+	Sent     int
+	Received int
+	Min      time.Duration
+	Avg      time.Duration
+	Max      time.Duration
+	// Jitter is the mean difference of consecutive round trip times
+	Jitter time.Duration
+}
+
+// Loss returns the ratio of the lost packets
+func (l LatencyResult) Loss() float64 {
+	return loss(l.Sent, l.Received)
+}
+
+// DirectionResult is the throughput, the jitter and the loss of one direction
+type DirectionResult struct {
+	Bytes           int64
+	Duration        time.Duration
+	PacketsSent     int
+	PacketsReceived int
+	Jitter          time.Duration
+	// Err is set when a part of the test failed, the results of the other parts are still valid
+	Err error
+}
+
+// Mbps returns the throughput in megabits per second
+func (d DirectionResult) Mbps() float64 {
+	if d.Duration <= 0 {
+		return 0
+	}
+	return float64(d.Bytes*8) / d.Duration.Seconds() / 1e6
+}
+
+// Loss returns the ratio of the lost packets
+func (d DirectionResult) Loss() float64 {
+	return loss(d.PacketsSent, d.PacketsReceived)
+}
+
+// Result is the result of a test against a remote peer
+type Result struct {
+	Latency LatencyResult
+	// Upload is the direction from this peer to the remote peer
+	Upload DirectionResult
+	// Download is the direction from the remote peer to this peer
+	Download DirectionResult
+}
+
+// Client runs tests against the responder of a remote peer
+type Client struct {
+	addr string
+	opts Options
+}
+
+// NewClient creates a client for the responder of the peer with the IP
+func NewClient(ip net.IP, opts Options) *Client {
+	return newClient(net.JoinHostPort(ip.String(), strconv.Itoa(DefaultPort)), opts)
+}
+
+func newClient(addr string, opts Options) *Client {
+	return &Client{
+		addr: addr,
+		opts: opts.withDefaults(),
+	}
+}
+
+// Run measures the latency and both directions. It fails only when the responder can't be reached at all.
+func (c *Client) Run(ctx context.Context) (*Result, error) {
+	udpConn, err := net.ListenPacket("udp", ":0")
+	if err != nil {
+		return nil, fmt.Errorf("listen udp: %w", err)
+	}
+	defer func() {
+		_ = udpConn.Close()
+	}()
+
+	var result Result
+	result.Latency, err = c.ping(ctx, udpConn)
+	if err != nil {
+		return nil, err
+	}
+
+	result.Upload = c.upload(ctx)
+	if isUnreachable(result.Upload.Err) && result.Latency.Received == 0 {
+		return nil, fmt.Errorf("the peer test responder is not reachable, the peer may run an older version or the "+
+			"policies may not allow the connection: %w", result.Upload.Err)
+	}
+	result.Download = c.download(ctx, udpConn)
+
+	return &result, ctx.Err()
+}
+
+func (c *Client) ping(ctx context.Context, udpConn net.PacketConn) (LatencyResult, error) {
+	session := newSession()
+	result := LatencyResult{Sent: c.opts.Pings}
+	replies := make(chan time.Duration, c.opts.Pings)
+
+	go func() {
+		seen := make(map[uint32]bool)
+		buf := make([]byte, 2048)
+		for {
+			n, _, err := udpConn.ReadFrom(buf)
+			if err != nil {
+				close(replies)
+				return
+			}
+			p, err := unmarshalPacket(buf[:n])
+			if err != nil || p.kind != kindPong || p.session != session || seen[p.seq] {
+				continue
+			}
+			seen[p.seq] = true
+			replies <- time.Since(p.sent)
+			if len(seen) == c.opts.Pings {
+				close(replies)
+				return
+			}
+		}
+	}()
+
+	addr, err := net.ResolveUDPAddr("udp", c.addr)
+	if err != nil {
+		return result, err
+	}
+	for seq := 0; seq < c.opts.Pings; seq++ {
+		p := packet{kind: kindPing, session: session, seq: uint32(seq), sent: time.Now()}
+		if _, err := udpConn.WriteTo(p.marshal(headerSize), addr); err != nil {
+			return result, fmt.Errorf("send ping: %w", err)
+		}
+		if err := sleep(ctx, c.opts.PingInterval); err != nil {
+			return result, err
+		}
+	}
+
+	_ = udpConn.SetReadDeadline(time.Now().Add(replyTimeout))
+	var rtts []time.Duration
+	for rtt := range replies {
+		rtts = append(rtts, rtt)
+	}
+	_ = udpConn.SetReadDeadline(time.Time{})
+
+	result.Received = len(rtts)
+	result.Min, result.Avg, result.Max, result.Jitter = rttStats(rtts)
+	return result, nil
+}
+
+func (c *Client) upload(ctx context.Context) DirectionResult {
+	var result DirectionResult
+
+	err := c.control(ctx, request{Test: testUpload, Duration: c.opts.Duration}, func(conn *net.TCPConn, reader *bufio.Reader) error {
+		buf := make([]byte, 32*1024)
+		if _, err := rand.Read(buf); err != nil {
+			return err
+		}
+
+		deadline := time.Now().Add(c.opts.Duration)
+		_ = conn.SetWriteDeadline(deadline)
+		for time.Now().Before(deadline) && ctx.Err() == nil {
+			if _, err := conn.Write(buf); err != nil {
+				if isTimeout(err) {
+					break
+				}
+				return err
+			}
+		}
+		if err := conn.CloseWrite(); err != nil {
+			return err
+		}
+
+		// the responder measures the received bytes
+		resp, err := readResponse(conn, reader, replyTimeout+controlTimeout)
+		if err != nil {
+			return err
+		}
+		result.Bytes, result.Duration = resp.Bytes, resp.Duration
+		return nil
+	})
+	if err != nil {
+		result.Err = fmt.Errorf("throughput: %w", err)
+		return result
+	}
+
+	session := newSession()
+	req := request{Test: testUDPUpload, Session: session, Count: c.opts.StreamPackets, Interval: c.opts.StreamInterval}
+	err = c.control(ctx, req, func(conn *net.TCPConn, reader *bufio.Reader) error {
+		if _, err := readResponse(conn, reader, controlTimeout); err != nil {
+			return err
+		}
+
+		udpConn, err := net.Dial("udp", c.addr)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = udpConn.Close()
+		}()
+
+		ticker := time.NewTicker(c.opts.StreamInterval)
+		defer ticker.Stop()
+		for seq := 0; seq < c.opts.StreamPackets; seq++ {
+			p := packet{kind: kindStream, session: session, seq: uint32(seq), sent: time.Now()}
+			if _, err := udpConn.Write(p.marshal(streamPacketSize)); err != nil {
+				return err
+			}
+			result.PacketsSent++
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+
+		resp, err := readResponse(conn, reader, streamGrace+replyTimeout)
+		if err != nil {
+			return err
+		}
+		result.PacketsReceived, result.Jitter = resp.Received, resp.Jitter
+		return nil
+	})
+	if err != nil {
+		result.Err = fmt.Errorf("packet stream: %w", err)
+	}
+	return result
+}
+
+func (c *Client) download(ctx context.Context, udpConn net.PacketConn) DirectionResult {
+	var result DirectionResult
+
+	err := c.control(ctx, request{Test: testDownload, Duration: c.opts.Duration}, func(conn *net.TCPConn, reader *bufio.Reader) error {
+		_ = conn.SetReadDeadline(time.Now().Add(c.opts.Duration + controlTimeout))
+
+		start := time.Now()
+		n, err := io.Copy(io.Discard, reader)
+		result.Bytes, result.Duration = n, time.Since(start)
+		return err
+	})
+	if err != nil {
+		result.Err = fmt.Errorf("throughput: %w", err)
+		return result
+	}
+
+	session := newSession()
+	port := udpConn.LocalAddr().(*net.UDPAddr).Port
+	req := request{Test: testUDPDownload, Session: session, Count: c.opts.StreamPackets, Interval: c.opts.StreamInterval, Port: port}
+
+	received := make(chan streamStats, 1)
+	go func() {
+		var stats streamStats
+		seen := make(map[uint32]bool)
+		buf := make([]byte, 2048)
+		for len(seen) < c.opts.StreamPackets {
+			n, _, err := udpConn.ReadFrom(buf)
+			if err != nil {
+				break
+			}
+			arrived := time.Now()
+			p, err := unmarshalPacket(buf[:n])
+			if err != nil || p.kind != kindStream || p.session != session || seen[p.seq] {
+				continue
+			}
+			seen[p.seq] = true
+			stats.add(p.sent, arrived)
+		}
+		received <- stats
+	}()
+
+	err = c.control(ctx, req, func(conn *net.TCPConn, reader *bufio.Reader) error {
+		timeout := time.Duration(c.opts.StreamPackets)*c.opts.StreamInterval + controlTimeout
+		_, err := readResponse(conn, reader, timeout)
+		return err
+	})
+	result.PacketsSent = c.opts.StreamPackets
+
+	// wait for the packets still in flight
+	_ = udpConn.SetReadDeadline(time.Now().Add(replyTimeout))
+	stats := <-received
+	_ = udpConn.SetReadDeadline(time.Time{})
+
+	if err != nil {
+		result.Err = fmt.Errorf("packet stream: %w", err)
+		return result
+	}
+	result.PacketsReceived, result.Jitter = stats.received, stats.jitterDuration()
+	return result
+}
+
+// control runs a test on a new control connection
+func (c *Client) control(ctx context.Context, req request, run func(*net.TCPConn, *bufio.Reader) error) error {
+	dialer := net.Dialer{Timeout: dialTimeout}
+	conn, err := dialer.DialContext(ctx, "tcp", c.addr)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+	stop := context.AfterFunc(ctx, func() { _ = conn.Close() })
+	defer stop()
+
+	data, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	if _, err := conn.Write(append(data, '\n')); err != nil {
+		return err
+	}
+
+	return run(conn.(*net.TCPConn), bufio.NewReader(conn))
+}
+
+func readResponse(conn net.Conn, reader *bufio.Reader, timeout time.Duration) (response, error) {
+	_ = conn.SetReadDeadline(time.Now().Add(timeout))
+	line, err := reader.ReadBytes('\n')
+	if err != nil {
+		return response{}, fmt.Errorf("read response: %w", err)
+	}
+
+	var resp response
+	if err := json.Unmarshal(line, &resp); err != nil {
+		return response{}, fmt.Errorf("invalid response: %w", err)
+	}
+	if resp.Error != "" {
+		return response{}, errors.New(resp.Error)
+	}
+	return resp, nil
+}
+
+func rttStats(rtts []time.Duration) (minRTT, avgRTT, maxRTT, jitter time.Duration) {
+	if len(rtts) == 0 {
+		return 0, 0, 0, 0
+	}
+
+	var sum, diffs time.Duration
+	minRTT, maxRTT = rtts[0], rtts[0]
+	for i, rtt := range rtts {
+		sum += rtt
+		minRTT = min(minRTT, rtt)
+		maxRTT = max(maxRTT, rtt)
+		if i > 0 {
+			d := rtt - rtts[i-1]
+			if d < 0 {
+				d = -d
+			}
+			diffs += d
+		}
+	}
+
+	avgRTT = sum / time.Duration(len(rtts))
+	if len(rtts) > 1 {
+		jitter = diffs / time.Duration(len(rtts)-1)
+	}
+	return minRTT, avgRTT, maxRTT, jitter
+}
+
+func loss(sent, received int) float64 {
+	if sent == 0 {
+		return 0
+	}
+	return float64(sent-received) / float64(sent)
+}
+
+func newSession() uint64 {
+	var b [8]byte
+	_, _ = rand.Read(b[:])
+	return binary.BigEndian.Uint64(b[:])
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func isTimeout(err error) bool {
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+func isUnreachable(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
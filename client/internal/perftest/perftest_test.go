@@ -0,0 +1,107 @@
+package perftest
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testOptions = Options{
+	Duration:       200 * time.Millisecond,
+	Pings:          5,
+	PingInterval:   10 * time.Millisecond,
+	StreamPackets:  50,
+	StreamInterval: time.Millisecond,
+}
+
+func TestClient_Run(t *testing.T) {
+	responder, err := NewResponder("127.0.0.1:0", func(net.IP) bool { return true })
+	require.NoError(t, err)
+	defer func() {
+		_ = responder.Close()
+	}()
+
+	result, err := newClient(responder.Addr().String(), testOptions).Run(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, 5, result.Latency.Sent)
+	assert.Equal(t, 5, result.Latency.Received)
+	assert.Positive(t, result.Latency.Avg)
+	assert.LessOrEqual(t, result.Latency.Min, result.Latency.Max)
+
+	for name, direction := range map[string]DirectionResult{"upload": result.Upload, "download": result.Download} {
+		require.NoError(t, direction.Err, name)
+		assert.Positive(t, direction.Bytes, name)
+		assert.Positive(t, direction.Mbps(), name)
+		assert.Equal(t, 50, direction.PacketsSent, name)
+		assert.Positive(t, direction.PacketsReceived, name)
+	}
+}
+
+func TestClient_RunNotAuthorized(t *testing.T) {
+	responder, err := NewResponder("127.0.0.1:0", func(net.IP) bool { return false })
+	require.NoError(t, err)
+	defer func() {
+		_ = responder.Close()
+	}()
+
+	result, err := newClient(responder.Addr().String(), testOptions).Run(context.Background())
+	require.NoError(t, err, "the connection is accepted before the peer is authorized")
+
+	assert.Zero(t, result.Latency.Received)
+	assert.Error(t, result.Upload.Err)
+	assert.Error(t, result.Download.Err)
+}
+
+func TestClient_RunUnreachable(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := listener.Addr().String()
+	require.NoError(t, listener.Close())
+
+	_, err = newClient(addr, testOptions).Run(context.Background())
+	assert.Error(t, err)
+}
+
+func TestRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     request
+		wantErr bool
+	}{
+		{"upload", request{Test: testUpload, Duration: time.Second}, false},
+		{"upload too long", request{Test: testUpload, Duration: MaxDuration + time.Second}, true},
+		{"udp download", request{Test: testUDPDownload, Count: 10, Interval: time.Millisecond, Port: 5000}, false},
+		{"udp download without port", request{Test: testUDPDownload, Count: 10, Interval: time.Millisecond}, true},
+		{"udp upload too many packets", request{Test: testUDPUpload, Count: maxStreamPackets + 1, Interval: time.Millisecond}, true},
+		{"unknown", request{Test: "flood"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.req.validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestStreamStats_Jitter(t *testing.T) {
+	var stats streamStats
+	start := time.Now()
+	for i := 0; i < 100; i++ {
+		sent := start.Add(time.Duration(i) * 10 * time.Millisecond)
+		stats.add(sent, sent.Add(5*time.Millisecond))
+	}
+	assert.Equal(t, 100, stats.received)
+	assert.Zero(t, stats.jitterDuration(), "a constant transit time has no jitter")
+
+	stats.add(start.Add(time.Second), start.Add(time.Second+21*time.Millisecond))
+	assert.Equal(t, time.Millisecond, stats.jitterDuration())
+}
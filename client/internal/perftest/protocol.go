@@ -0,0 +1,126 @@
+// Package perftest measures the latency, the jitter, the packet loss and the throughput between two peers through the
+// WireGuard tunnel. Every daemon runs a Responder on its NetBird IP, the Client of the testing peer connects to it.
+//
+// The throughput is measured over a TCP connection, each test starts with a JSON encoded request line. The latency is
+// measured with UDP echo packets and the jitter and the loss of each direction with a paced stream of UDP packets.
+package perftest
+
+import (
+	"encoding/binary"
+	"errors"
+	"time"
+)
+
+// DefaultPort is the TCP and UDP port of the responder
+const DefaultPort = 44339
+
+const (
+	// MaxDuration limits the duration of a throughput test
+	MaxDuration = 30 * time.Second
+	// maxStreamPackets limits the number of packets of a UDP stream
+	maxStreamPackets = 10000
+	// maxConcurrentTests limits the tests a responder runs at the same time
+	maxConcurrentTests = 4
+
+	// streamPacketSize fits in the MTU of the WireGuard interface
+	streamPacketSize = 1000
+	headerSize       = 21
+)
+
+const (
+	testUpload      = "upload"
+	testDownload    = "download"
+	testUDPUpload   = "udp-upload"
+	testUDPDownload = "udp-download"
+)
+
+type packetKind byte
+
+const (
+	kindPing packetKind = iota + 1
+	kindPong
+	kindStream
+)
+
+var errInvalidPacket = errors.New("invalid packet")
+
+// request starts a test on the control connection
+type request struct {
+	Test     string        `json:"test"`
+	Duration time.Duration `json:"duration,omitempty"`
+	Session  uint64        `json:"session,omitempty"`
+	Count    int           `json:"count,omitempty"`
+	Interval time.Duration `json:"interval,omitempty"`
+	// Port is the UDP port of the client the stream of a UDP download is sent to
+	Port int `json:"port,omitempty"`
+}
+
+// response ends a test on the control connection
+type response struct {
+	Error    string        `json:"error,omitempty"`
+	Bytes    int64         `json:"bytes,omitempty"`
+	Duration time.Duration `json:"duration,omitempty"`
+	Received int           `json:"received,omitempty"`
+	Jitter   time.Duration `json:"jitter,omitempty"`
+}
+
+// packet is the header of the UDP packets
+type packet struct {
+	kind    packetKind
+	session uint64
+	seq     uint32
+	sent    time.Time
+}
+
+func (p packet) marshal(size int) []byte {
+	if size < headerSize {
+		size = headerSize
+	}
+	buf := make([]byte, size)
+	buf[0] = byte(p.kind)
+	binary.BigEndian.PutUint64(buf[1:9], p.session)
+	binary.BigEndian.PutUint32(buf[9:13], p.seq)
+	binary.BigEndian.PutUint64(buf[13:21], uint64(p.sent.UnixNano()))
+	return buf
+}
+
+func unmarshalPacket(buf []byte) (packet, error) {
+	if len(buf) < headerSize {
+		return packet{}, errInvalidPacket
+	}
+	p := packet{
+		kind:    packetKind(buf[0]),
+		session: binary.BigEndian.Uint64(buf[1:9]),
+		seq:     binary.BigEndian.Uint32(buf[9:13]),
+		sent:    time.Unix(0, int64(binary.BigEndian.Uint64(buf[13:21]))),
+	}
+	if p.kind < kindPing || p.kind > kindStream {
+		return packet{}, errInvalidPacket
+	}
+	return p, nil
+}
+
+// streamStats collects the packets of a UDP stream. The jitter is the interarrival jitter of RFC 3550, it does not
+// depend on the offset between the clocks of the peers.
+type streamStats struct {
+	received    int
+	lastTransit time.Duration
+	jitter      float64
+}
+
+func (s *streamStats) add(sent, arrived time.Time) {
+	transit := arrived.Sub(sent)
+	if s.received > 0 {
+		d := transit - s.lastTransit
+		if d < 0 {
+			d = -d
+		}
+		s.jitter += (float64(d) - s.jitter) / 16
+	}
+	s.lastTransit = transit
+	s.received++
+}
+
+func (s *streamStats) jitterDuration() time.Duration {
+	return time.Duration(s.jitter)
+}
@@ -0,0 +1,358 @@
+package perftest
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// controlTimeout limits the wait for a test request and a response
+	controlTimeout = 5 * time.Second
+	// streamGrace is how long the packets of a UDP stream are awaited after the expected end of the stream
+	streamGrace = 2 * time.Second
+)
+
+// Authorizer returns true if the peer with the IP is allowed to run tests against this peer
+type Authorizer func(ip net.IP) bool
+
+// Responder answers the tests of the remote peers
+type Responder struct {
+	ctx       context.Context
+	cancel    context.CancelFunc
+	authorize Authorizer
+
+	listener net.Listener
+	udpConn  net.PacketConn
+	wg       sync.WaitGroup
+
+	tests chan struct{}
+
+	mu       sync.Mutex
+	sessions map[uint64]*udpSession
+}
+
+type udpSession struct {
+	ip    net.IP
+	stats streamStats
+	count int
+	done  chan struct{}
+}
+
+// NewResponder listens on the TCP and UDP port of the address. The tests of the peers the authorizer rejects are
+// ignored.
+func NewResponder(addr string, authorize Authorizer) (*Responder, error) {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("listen tcp: %w", err)
+	}
+
+	// the UDP port is the one of the TCP listener, the address may ask for a random port
+	udpConn, err := net.ListenPacket("udp", listener.Addr().String())
+	if err != nil {
+		_ = listener.Close()
+		return nil, fmt.Errorf("listen udp: %w", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r := &Responder{
+		ctx:       ctx,
+		cancel:    cancel,
+		authorize: authorize,
+		listener:  listener,
+		udpConn:   udpConn,
+		tests:     make(chan struct{}, maxConcurrentTests),
+		sessions:  make(map[uint64]*udpSession),
+	}
+
+	r.wg.Add(2)
+	go r.acceptLoop()
+	go r.udpLoop()
+
+	log.Infof("started the peer test responder on %s", listener.Addr())
+	return r, nil
+}
+
+// Addr returns the address the responder listens on
+func (r *Responder) Addr() net.Addr {
+	return r.listener.Addr()
+}
+
+// Close stops the responder and the running tests
+func (r *Responder) Close() error {
+	r.cancel()
+	err := errors.Join(r.listener.Close(), r.udpConn.Close())
+	r.wg.Wait()
+	return err
+}
+
+func (r *Responder) acceptLoop() {
+	defer r.wg.Done()
+	for {
+		conn, err := r.listener.Accept()
+		if err != nil {
+			if r.ctx.Err() == nil {
+				log.Errorf("failed accepting peer test connection: %v", err)
+			}
+			return
+		}
+
+		ip := remoteIP(conn.RemoteAddr())
+		if !r.authorize(ip) {
+			log.Debugf("rejected peer test from %s, not allowed by the policies", ip)
+			_ = conn.Close()
+			continue
+		}
+
+		select {
+		case r.tests <- struct{}{}:
+		default:
+			log.Debugf("rejected peer test from %s, too many tests are running", ip)
+			writeResponse(conn, response{Error: "too many tests are running, try again later"})
+			_ = conn.Close()
+			continue
+		}
+
+		r.wg.Add(1)
+		go func() {
+			defer r.wg.Done()
+			defer func() { <-r.tests }()
+			r.handleConn(conn, ip)
+		}()
+	}
+}
+
+func (r *Responder) handleConn(conn net.Conn, ip net.IP) {
+	defer func() {
+		if err := conn.Close(); err != nil {
+			log.Debugf("failed closing peer test connection: %v", err)
+		}
+	}()
+
+	// close the connection when the responder stops
+	stop := context.AfterFunc(r.ctx, func() { _ = conn.Close() })
+	defer stop()
+
+	reader := bufio.NewReader(conn)
+	_ = conn.SetReadDeadline(time.Now().Add(controlTimeout))
+	line, err := reader.ReadBytes('\n')
+	if err != nil {
+		log.Debugf("failed reading peer test request from %s: %v", ip, err)
+		return
+	}
+	_ = conn.SetReadDeadline(time.Time{})
+
+	var req request
+	if err := json.Unmarshal(line, &req); err != nil {
+		writeResponse(conn, response{Error: "invalid request"})
+		return
+	}
+	if err := req.validate(); err != nil {
+		writeResponse(conn, response{Error: err.Error()})
+		return
+	}
+
+	log.Debugf("running %s peer test for %s", req.Test, ip)
+	switch req.Test {
+	case testUpload:
+		r.receive(conn, reader, req)
+	case testDownload:
+		r.send(conn, req)
+	case testUDPUpload:
+		r.receiveStream(conn, ip, req)
+	case testUDPDownload:
+		r.sendStream(conn, ip, req)
+	}
+}
+
+// receive counts the bytes until the client closes its side of the connection
+func (r *Responder) receive(conn net.Conn, reader io.Reader, req request) {
+	_ = conn.SetReadDeadline(time.Now().Add(req.Duration + controlTimeout))
+
+	start := time.Now()
+	n, err := io.Copy(io.Discard, reader)
+	elapsed := time.Since(start)
+	if err != nil {
+		writeResponse(conn, response{Error: err.Error()})
+		return
+	}
+	writeResponse(conn, response{Bytes: n, Duration: elapsed})
+}
+
+// send writes random data for the duration of the test
+func (r *Responder) send(conn net.Conn, req request) {
+	buf := make([]byte, 32*1024)
+	if _, err := rand.Read(buf); err != nil {
+		return
+	}
+
+	deadline := time.Now().Add(req.Duration)
+	_ = conn.SetWriteDeadline(deadline)
+	for time.Now().Before(deadline) {
+		if _, err := conn.Write(buf); err != nil {
+			return
+		}
+	}
+}
+
+// receiveStream collects the UDP packets of the session until all arrived or the stream timed out
+func (r *Responder) receiveStream(conn net.Conn, ip net.IP, req request) {
+	session := &udpSession{ip: ip, count: req.Count, done: make(chan struct{})}
+	r.mu.Lock()
+	if _, ok := r.sessions[req.Session]; ok {
+		r.mu.Unlock()
+		writeResponse(conn, response{Error: "session already exists"})
+		return
+	}
+	r.sessions[req.Session] = session
+	r.mu.Unlock()
+
+	defer func() {
+		r.mu.Lock()
+		delete(r.sessions, req.Session)
+		r.mu.Unlock()
+	}()
+
+	// the client starts the stream after the ready response
+	writeResponse(conn, response{})
+
+	timeout := time.NewTimer(time.Duration(req.Count)*req.Interval + streamGrace)
+	defer timeout.Stop()
+	select {
+	case <-session.done:
+	case <-timeout.C:
+	case <-r.ctx.Done():
+		return
+	}
+
+	r.mu.Lock()
+	resp := response{Received: session.stats.received, Jitter: session.stats.jitterDuration()}
+	r.mu.Unlock()
+	writeResponse(conn, resp)
+}
+
+// sendStream sends the paced UDP packets of the session to the client
+func (r *Responder) sendStream(conn net.Conn, ip net.IP, req request) {
+	addr := &net.UDPAddr{IP: ip, Port: req.Port}
+
+	ticker := time.NewTicker(req.Interval)
+	defer ticker.Stop()
+	for seq := 0; seq < req.Count; seq++ {
+		p := packet{kind: kindStream, session: req.Session, seq: uint32(seq), sent: time.Now()}
+		if _, err := r.udpConn.WriteTo(p.marshal(streamPacketSize), addr); err != nil {
+			writeResponse(conn, response{Error: err.Error()})
+			return
+		}
+
+		select {
+		case <-ticker.C:
+		case <-r.ctx.Done():
+			return
+		}
+	}
+	writeResponse(conn, response{})
+}
+
+func (r *Responder) udpLoop() {
+	defer r.wg.Done()
+
+	buf := make([]byte, 2048)
+	for {
+		n, addr, err := r.udpConn.ReadFrom(buf)
+		if err != nil {
+			if r.ctx.Err() == nil {
+				log.Errorf("failed reading peer test packet: %v", err)
+			}
+			return
+		}
+		arrived := time.Now()
+
+		p, err := unmarshalPacket(buf[:n])
+		if err != nil {
+			continue
+		}
+
+		ip := remoteIP(addr)
+		switch p.kind {
+		case kindPing:
+			if !r.authorize(ip) {
+				continue
+			}
+			p.kind = kindPong
+			if _, err := r.udpConn.WriteTo(p.marshal(n), addr); err != nil {
+				log.Debugf("failed answering peer test ping of %s: %v", ip, err)
+			}
+		case kindStream:
+			r.addStreamPacket(p, ip, arrived)
+		}
+	}
+}
+
+func (r *Responder) addStreamPacket(p packet, ip net.IP, arrived time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// the sessions are created by authorized peers only
+	session, ok := r.sessions[p.session]
+	if !ok || !session.ip.Equal(ip) || session.stats.received >= session.count {
+		return
+	}
+
+	session.stats.add(p.sent, arrived)
+	if session.stats.received == session.count {
+		close(session.done)
+	}
+}
+
+func (req request) validate() error {
+	switch req.Test {
+	case testUpload, testDownload:
+		if req.Duration <= 0 || req.Duration > MaxDuration {
+			return fmt.Errorf("the duration must be between 0 and %s", MaxDuration)
+		}
+	case testUDPUpload, testUDPDownload:
+		if req.Count <= 0 || req.Count > maxStreamPackets {
+			return fmt.Errorf("the packet count must be between 1 and %d", maxStreamPackets)
+		}
+		if req.Interval <= 0 || time.Duration(req.Count)*req.Interval > MaxDuration {
+			return fmt.Errorf("the stream must be shorter than %s", MaxDuration)
+		}
+		if req.Test == testUDPDownload && (req.Port <= 0 || req.Port > 65535) {
+			return errors.New("invalid port")
+		}
+	default:
+		return fmt.Errorf("unknown test %q", req.Test)
+	}
+	return nil
+}
+
+func writeResponse(conn net.Conn, resp response) {
+	data, err := json.Marshal(resp)
+	if err != nil {
+		return
+	}
+	_ = conn.SetWriteDeadline(time.Now().Add(controlTimeout))
+	if _, err := conn.Write(append(data, '\n')); err != nil {
+		log.Debugf("failed writing peer test response: %v", err)
+	}
+}
+
+func remoteIP(addr net.Addr) net.IP {
+	switch a := addr.(type) {
+	case *net.TCPAddr:
+		return a.IP
+	case *net.UDPAddr:
+		return a.IP
+	default:
+		return nil
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"slices"
+	"sort"
 	"time"
 
 	"github.com/hashicorp/go-multierror"
@@ -19,6 +21,9 @@ import (
 	"github.com/netbirdio/netbird/route"
 )
 
+// latencyHysteresis is the latency difference required to switch to another routing peer, it avoids flapping between them
+const latencyHysteresis = 10 * time.Millisecond
+
 type routerPeerStatus struct {
 	connected bool
 	relayed   bool
@@ -39,6 +44,12 @@ type RouteHandler interface {
 	RemoveAllowedIPs() error
 }
 
+// balancedRouteHandler is implemented by the handlers able to spread their network across multiple routing peers
+type balancedRouteHandler interface {
+	RouteHandler
+	AddBalancedAllowedIPs(peerKeys []string) error
+}
+
 type clientNetwork struct {
 	ctx                 context.Context
 	cancel              context.CancelFunc
@@ -51,6 +62,9 @@ type clientNetwork struct {
 	currentChosen       *route.Route
 	handler             RouteHandler
 	updateSerial        uint64
+
+	// balancedPeers are the routing peers of a load balanced network, sorted
+	balancedPeers []string
 }
 
 func newClientNetworkWatcher(ctx context.Context, dnsRouteInterval time.Duration, wgInterface iface.IWGIface, statusRecorder *peer.Status, rt *route.Route, routeRefCounter *refcounter.RouteRefCounter, allowedIPsRefCounter *refcounter.AllowedIPsRefCounter) *clientNetwork {
@@ -87,7 +101,30 @@ func (c *clientNetwork) getRouterPeerStatuses() map[route.ID]routerPeerStatus {
 	return routePeerStatuses
 }
 
-// getBestRouteFromStatuses determines the most optimal route from the available routes
+// getBestRouteFromStatuses determines the route to use with the strategy of the network.
+// Only routes with connected peers are considered. It returns the ID of the selected route, empty if none is connected.
+func (c *clientNetwork) getBestRouteFromStatuses(routePeerStatuses map[route.ID]routerPeerStatus) route.ID {
+	var chosen route.ID
+	switch c.strategy() {
+	case route.StrategyMetric:
+		chosen = c.getLowestMetricRoute(routePeerStatuses)
+	case route.StrategyLatency:
+		chosen = c.getLowestLatencyRoute(routePeerStatuses)
+	case route.StrategySticky:
+		if c.currentChosen != nil && routePeerStatuses[c.currentChosen.ID].connected && c.routes[c.currentChosen.ID] != nil {
+			return c.currentChosen.ID
+		}
+		return c.getBestRouteByScore(routePeerStatuses)
+	default:
+		// the load balanced networks use the default strategy when their handler can't be balanced
+		return c.getBestRouteByScore(routePeerStatuses)
+	}
+
+	c.logChosenRoute(chosen)
+	return chosen
+}
+
+// getBestRouteByScore determines the most optimal route from the available routes
 // within a clientNetwork, taking into account peer connection status, route metrics, and
 // preference for non-relayed and direct connections.
 //
@@ -100,7 +137,7 @@ func (c *clientNetwork) getRouterPeerStatuses() map[route.ID]routerPeerStatus {
 // * Stability: In case of equal scores, the currently active route (if any) is maintained.
 //
 // It returns the ID of the selected optimal route.
-func (c *clientNetwork) getBestRouteFromStatuses(routePeerStatuses map[route.ID]routerPeerStatus) route.ID {
+func (c *clientNetwork) getBestRouteByScore(routePeerStatuses map[route.ID]routerPeerStatus) route.ID {
 	chosen := route.ID("")
 	chosenScore := float64(0)
 	currScore := float64(0)
@@ -152,15 +189,10 @@ func (c *clientNetwork) getBestRouteFromStatuses(routePeerStatuses map[route.ID]
 
 	switch {
 	case chosen == "":
-		var peers []string
-		for _, r := range c.routes {
-			peers = append(peers, r.Peer)
-		}
-
-		log.Warnf("The network [%v] has not been assigned a routing peer as no peers from the list %s are currently connected", c.handler, peers)
+		c.logNoConnectedPeers()
 	case chosen != currID:
 		// we compare the current score + 10ms to the chosen score to avoid flapping between routes
-		if currScore != 0 && currScore+0.01 > chosenScore {
+		if currScore != 0 && currScore+latencyHysteresis.Seconds() > chosenScore {
 			log.Debugf("Keeping current routing peer because the score difference with latency is less than 0.01(10ms), current: %f, new: %f", currScore, chosenScore)
 			return currID
 		}
@@ -174,6 +206,115 @@ func (c *clientNetwork) getBestRouteFromStatuses(routePeerStatuses map[route.ID]
 	return chosen
 }
 
+// getLowestMetricRoute returns the connected route with the lowest metric, the current route is kept on equal metrics
+func (c *clientNetwork) getLowestMetricRoute(routePeerStatuses map[route.ID]routerPeerStatus) route.ID {
+	var chosen *route.Route
+	for _, r := range c.connectedRoutes(routePeerStatuses) {
+		switch {
+		case chosen == nil || r.Metric < chosen.Metric:
+			chosen = r
+		case r.Metric == chosen.Metric && c.isCurrentChosen(r.ID):
+			chosen = r
+		}
+	}
+
+	if chosen == nil {
+		return ""
+	}
+	return chosen.ID
+}
+
+// getLowestLatencyRoute returns the connected route with the lowest latency. The current route is kept unless
+// another one is faster by more than the hysteresis.
+func (c *clientNetwork) getLowestLatencyRoute(routePeerStatuses map[route.ID]routerPeerStatus) route.ID {
+	var chosen *route.Route
+	var chosenLatency time.Duration
+	for _, r := range c.connectedRoutes(routePeerStatuses) {
+		latency := routePeerStatuses[r.ID].latency
+		if latency == 0 {
+			// in some temporal cases latency can be 0, the route is avoided but not blocked
+			latency = time.Second
+		}
+		if chosen == nil || latency < chosenLatency {
+			chosen = r
+			chosenLatency = latency
+		}
+	}
+
+	if chosen == nil {
+		return ""
+	}
+
+	if c.currentChosen != nil && c.currentChosen.ID != chosen.ID {
+		if status, ok := routePeerStatuses[c.currentChosen.ID]; ok && status.connected && c.routes[c.currentChosen.ID] != nil &&
+			status.latency != 0 && status.latency-chosenLatency < latencyHysteresis {
+			log.Debugf("Keeping current routing peer because the latency difference is less than %s, current: %s, new: %s",
+				latencyHysteresis, status.latency, chosenLatency)
+			return c.currentChosen.ID
+		}
+	}
+	return chosen.ID
+}
+
+// connectedRoutes returns the routes with a connected peer sorted by ID
+func (c *clientNetwork) connectedRoutes(routePeerStatuses map[route.ID]routerPeerStatus) []*route.Route {
+	var routes []*route.Route
+	for _, r := range c.routes {
+		if status, ok := routePeerStatuses[r.ID]; ok && status.connected {
+			routes = append(routes, r)
+		}
+	}
+	sort.Slice(routes, func(i, j int) bool {
+		return routes[i].ID < routes[j].ID
+	})
+	return routes
+}
+
+func (c *clientNetwork) isCurrentChosen(id route.ID) bool {
+	return c.currentChosen != nil && c.currentChosen.ID == id
+}
+
+func (c *clientNetwork) logChosenRoute(chosen route.ID) {
+	switch {
+	case chosen == "":
+		c.logNoConnectedPeers()
+	case !c.isCurrentChosen(chosen):
+		var p string
+		if rt := c.routes[chosen]; rt != nil {
+			p = rt.Peer
+		}
+		log.Infof("New chosen route is %s with peer %s with strategy %s for network [%v]", chosen, p, c.strategy(), c.handler)
+	}
+}
+
+func (c *clientNetwork) logNoConnectedPeers() {
+	var peers []string
+	for _, r := range c.routes {
+		peers = append(peers, r.Peer)
+	}
+
+	log.Warnf("The network [%v] has not been assigned a routing peer as no peers from the list %s are currently connected", c.handler, peers)
+}
+
+// strategy returns the routing peer selection strategy of the network. The routes of a network are expected to share
+// the strategy, otherwise the first one set in the order of the route IDs is used.
+func (c *clientNetwork) strategy() route.Strategy {
+	ids := make([]route.ID, 0, len(c.routes))
+	for id := range c.routes {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+
+	for _, id := range ids {
+		if strategy := c.routes[id].Strategy; strategy != route.StrategyDefault {
+			return strategy
+		}
+	}
+	return route.StrategyDefault
+}
+
 func (c *clientNetwork) watchPeerStatusChanges(ctx context.Context, peerKey string, peerStateUpdate chan struct{}, closer chan struct{}) {
 	for {
 		select {
@@ -203,7 +344,12 @@ func (c *clientNetwork) startPeersStatusChangeWatcher() {
 }
 
 func (c *clientNetwork) removeRouteFromWireguardPeer() error {
-	c.removeStateRoute()
+	if c.currentChosen != nil {
+		c.removeStateRoute(c.currentChosen.Peer)
+	}
+	for _, peerKey := range c.balancedPeers {
+		c.removeStateRoute(peerKey)
+	}
 
 	if err := c.handler.RemoveAllowedIPs(); err != nil {
 		return fmt.Errorf("remove allowed IPs: %w", err)
@@ -212,14 +358,14 @@ func (c *clientNetwork) removeRouteFromWireguardPeer() error {
 }
 
 func (c *clientNetwork) removeRouteFromPeerAndSystem() error {
-	if c.currentChosen == nil {
+	if c.currentChosen == nil && len(c.balancedPeers) == 0 {
 		return nil
 	}
 
 	var merr *multierror.Error
 
 	if err := c.removeRouteFromWireguardPeer(); err != nil {
-		merr = multierror.Append(merr, fmt.Errorf("remove allowed IPs for peer %s: %w", c.currentChosen.Peer, err))
+		merr = multierror.Append(merr, fmt.Errorf("remove allowed IPs for peers %s: %w", c.routingPeers(), err))
 	}
 	if err := c.handler.RemoveRoute(); err != nil {
 		merr = multierror.Append(merr, fmt.Errorf("remove route: %w", err))
@@ -231,6 +377,18 @@ func (c *clientNetwork) removeRouteFromPeerAndSystem() error {
 func (c *clientNetwork) recalculateRouteAndUpdatePeerAndSystem() error {
 	routerPeerStatuses := c.getRouterPeerStatuses()
 
+	if handler, ok := c.handler.(balancedRouteHandler); ok && c.strategy() == route.StrategyLoadBalance {
+		return c.recalculateBalancedRoutes(handler, routerPeerStatuses)
+	}
+
+	if len(c.balancedPeers) > 0 {
+		// the network is not load balanced anymore, start over with a single routing peer
+		if err := c.removeRouteFromPeerAndSystem(); err != nil {
+			return fmt.Errorf("remove load balanced route: %w", err)
+		}
+		c.balancedPeers = nil
+	}
+
 	newChosenID := c.getBestRouteFromStatuses(routerPeerStatuses)
 
 	// If no route is chosen, remove the route from the peer and system
@@ -239,6 +397,10 @@ func (c *clientNetwork) recalculateRouteAndUpdatePeerAndSystem() error {
 			return fmt.Errorf("remove route for peer %s: %w", c.currentChosen.Peer, err)
 		}
 
+		if c.currentChosen != nil {
+			c.publishRouteEvent(peer.EventRouteDeactivated, peer.EventSeverityWarning, c.currentChosen.Peer,
+				fmt.Sprintf("Route %s is deactivated, no routing peer is available", c.handler))
+		}
 		c.currentChosen = nil
 
 		return nil
@@ -262,19 +424,122 @@ func (c *clientNetwork) recalculateRouteAndUpdatePeerAndSystem() error {
 		}
 	}
 
+	prevChosen := c.currentChosen
 	c.currentChosen = c.routes[newChosenID]
 
 	if err := c.handler.AddAllowedIPs(c.currentChosen.Peer); err != nil {
 		return fmt.Errorf("add allowed IPs for peer %s: %w", c.currentChosen.Peer, err)
 	}
 
-	c.addStateRoute()
+	c.addStateRoute(c.currentChosen.Peer)
+
+	switch {
+	case prevChosen == nil:
+		c.publishRouteEvent(peer.EventRouteActivated, peer.EventSeverityInfo, c.currentChosen.Peer,
+			fmt.Sprintf("Route %s is activated", c.handler))
+	case prevChosen.Peer != c.currentChosen.Peer:
+		c.publishRouteEvent(peer.EventRouteFailedOver, peer.EventSeverityWarning, c.currentChosen.Peer,
+			fmt.Sprintf("Route %s failed over to another routing peer", c.handler))
+	}
+
+	return nil
+}
+
+// recalculateBalancedRoutes spreads the network across all the connected routing peers
+func (c *clientNetwork) recalculateBalancedRoutes(handler balancedRouteHandler, routePeerStatuses map[route.ID]routerPeerStatus) error {
+	if c.currentChosen != nil {
+		// the network was routed by a single peer, start over with the load balanced peers
+		if err := c.removeRouteFromPeerAndSystem(); err != nil {
+			return fmt.Errorf("remove route for peer %s: %w", c.currentChosen.Peer, err)
+		}
+		c.currentChosen = nil
+	}
+
+	var peerKeys []string
+	for _, r := range c.connectedRoutes(routePeerStatuses) {
+		if !slices.Contains(peerKeys, r.Peer) {
+			peerKeys = append(peerKeys, r.Peer)
+		}
+	}
+	sort.Strings(peerKeys)
+
+	prevPeers := c.balancedPeers
+	if slices.Equal(peerKeys, prevPeers) {
+		return nil
+	}
+
+	if len(peerKeys) == 0 {
+		c.logNoConnectedPeers()
+		if err := c.removeRouteFromPeerAndSystem(); err != nil {
+			return fmt.Errorf("remove route for peers %s: %w", prevPeers, err)
+		}
+		c.balancedPeers = nil
+		c.publishRouteEvent(peer.EventRouteDeactivated, peer.EventSeverityWarning, prevPeers[0],
+			fmt.Sprintf("Route %s is deactivated, no routing peer is available", c.handler))
+		return nil
+	}
+
+	if len(prevPeers) == 0 {
+		if err := handler.AddRoute(c.ctx); err != nil {
+			return fmt.Errorf("add route: %w", err)
+		}
+	}
+
+	// the parts of the network are moved before updating the state, the errors are partial
+	err := handler.AddBalancedAllowedIPs(peerKeys)
+	c.balancedPeers = peerKeys
+
+	var removedPeer string
+	for _, peerKey := range prevPeers {
+		if !slices.Contains(peerKeys, peerKey) {
+			c.removeStateRoute(peerKey)
+			removedPeer = peerKey
+		}
+	}
+	for _, peerKey := range peerKeys {
+		if !slices.Contains(prevPeers, peerKey) {
+			c.addStateRoute(peerKey)
+		}
+	}
 
+	log.Infof("Network [%v] is load balanced across the routing peers %s", c.handler, peerKeys)
+
+	switch {
+	case len(prevPeers) == 0:
+		c.publishRouteEvent(peer.EventRouteActivated, peer.EventSeverityInfo, peerKeys[0],
+			fmt.Sprintf("Route %s is activated, load balanced across %d routing peers", c.handler, len(peerKeys)))
+	case removedPeer != "":
+		c.publishRouteEvent(peer.EventRouteFailedOver, peer.EventSeverityWarning, removedPeer,
+			fmt.Sprintf("Route %s failed over to the %d remaining routing peers", c.handler, len(peerKeys)))
+	}
+
+	if err != nil {
+		return fmt.Errorf("add allowed IPs for peers %s: %w", peerKeys, err)
+	}
 	return nil
 }
 
-func (c *clientNetwork) addStateRoute() {
-	state, err := c.statusRecorder.GetPeer(c.currentChosen.Peer)
+// routingPeers returns the peers currently routing the network
+func (c *clientNetwork) routingPeers() []string {
+	if c.currentChosen != nil {
+		return []string{c.currentChosen.Peer}
+	}
+	return c.balancedPeers
+}
+
+func (c *clientNetwork) publishRouteEvent(eventType peer.EventType, severity peer.EventSeverity, peerKey, message string) {
+	metadata := map[string]string{
+		"network": c.handler.String(),
+		"peer":    peerKey,
+	}
+	if state, err := c.statusRecorder.GetPeer(peerKey); err == nil && state.FQDN != "" {
+		metadata["peer"] = state.FQDN
+	}
+	c.statusRecorder.PublishEvent(eventType, severity, message, metadata)
+}
+
+func (c *clientNetwork) addStateRoute(peerKey string) {
+	state, err := c.statusRecorder.GetPeer(peerKey)
 	if err != nil {
 		log.Errorf("Failed to get peer state: %v", err)
 		return
@@ -286,8 +551,8 @@ func (c *clientNetwork) addStateRoute() {
 	}
 }
 
-func (c *clientNetwork) removeStateRoute() {
-	state, err := c.statusRecorder.GetPeer(c.currentChosen.Peer)
+func (c *clientNetwork) removeStateRoute(peerKey string) {
+	state, err := c.statusRecorder.GetPeer(peerKey)
 	if err != nil {
 		log.Errorf("Failed to get peer state: %v", err)
 		return
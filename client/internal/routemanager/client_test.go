@@ -1,10 +1,16 @@
 package routemanager
 
 import (
+	"context"
 	"net/netip"
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/client/internal/peer"
+	"github.com/netbirdio/netbird/client/internal/routemanager/refcounter"
 	"github.com/netbirdio/netbird/client/internal/routemanager/static"
 	"github.com/netbirdio/netbird/route"
 )
@@ -310,3 +316,209 @@ func TestGetBestrouteFromStatuses(t *testing.T) {
 		})
 	}
 }
+
+func TestGetBestRouteFromStatuses_Strategies(t *testing.T) {
+	routes := func(strategy route.Strategy) map[route.ID]*route.Route {
+		return map[route.ID]*route.Route{
+			"route1": {ID: "route1", Metric: 100, Peer: "peer1", Strategy: strategy},
+			"route2": {ID: "route2", Metric: 200, Peer: "peer2", Strategy: strategy},
+			"route3": {ID: "route3", Metric: 100, Peer: "peer3", Strategy: strategy},
+		}
+	}
+
+	testCases := []struct {
+		name            string
+		strategy        route.Strategy
+		statuses        map[route.ID]routerPeerStatus
+		currentRoute    route.ID
+		expectedRouteID route.ID
+	}{
+		{
+			name:     "metric ignores the latency",
+			strategy: route.StrategyMetric,
+			statuses: map[route.ID]routerPeerStatus{
+				"route1": {connected: true, relayed: true, latency: 200 * time.Millisecond},
+				"route2": {connected: true, latency: 5 * time.Millisecond},
+			},
+			expectedRouteID: "route1",
+		},
+		{
+			name:     "metric keeps the current route on equal metrics",
+			strategy: route.StrategyMetric,
+			statuses: map[route.ID]routerPeerStatus{
+				"route1": {connected: true, latency: 5 * time.Millisecond},
+				"route3": {connected: true, latency: 200 * time.Millisecond},
+			},
+			currentRoute:    "route3",
+			expectedRouteID: "route3",
+		},
+		{
+			name:     "latency ignores the metric",
+			strategy: route.StrategyLatency,
+			statuses: map[route.ID]routerPeerStatus{
+				"route1": {connected: true, latency: 50 * time.Millisecond},
+				"route2": {connected: true, latency: 5 * time.Millisecond},
+			},
+			expectedRouteID: "route2",
+		},
+		{
+			name:     "latency keeps the current route within the hysteresis",
+			strategy: route.StrategyLatency,
+			statuses: map[route.ID]routerPeerStatus{
+				"route1": {connected: true, latency: 12 * time.Millisecond},
+				"route2": {connected: true, latency: 5 * time.Millisecond},
+			},
+			currentRoute:    "route1",
+			expectedRouteID: "route1",
+		},
+		{
+			name:     "latency switches when the current peer is disconnected",
+			strategy: route.StrategyLatency,
+			statuses: map[route.ID]routerPeerStatus{
+				"route1": {connected: false, latency: 1 * time.Millisecond},
+				"route2": {connected: true, latency: 50 * time.Millisecond},
+			},
+			currentRoute:    "route1",
+			expectedRouteID: "route2",
+		},
+		{
+			name:     "sticky keeps the connected current route",
+			strategy: route.StrategySticky,
+			statuses: map[route.ID]routerPeerStatus{
+				"route1": {connected: true, latency: 5 * time.Millisecond},
+				"route2": {connected: true, relayed: true, latency: 300 * time.Millisecond},
+			},
+			currentRoute:    "route2",
+			expectedRouteID: "route2",
+		},
+		{
+			name:     "sticky fails over with the default score",
+			strategy: route.StrategySticky,
+			statuses: map[route.ID]routerPeerStatus{
+				"route1": {connected: true, latency: 5 * time.Millisecond},
+				"route2": {connected: false},
+			},
+			currentRoute:    "route2",
+			expectedRouteID: "route1",
+		},
+		{
+			name:     "no connected peers",
+			strategy: route.StrategyMetric,
+			statuses: map[route.ID]routerPeerStatus{
+				"route1": {connected: false},
+			},
+			expectedRouteID: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := &clientNetwork{
+				handler: static.NewRoute(&route.Route{Network: netip.MustParsePrefix("192.168.0.0/24")}, nil, nil),
+				routes:  routes(tc.strategy),
+			}
+			if tc.currentRoute != "" {
+				client.currentChosen = client.routes[tc.currentRoute]
+			}
+
+			assert.Equal(t, tc.expectedRouteID, client.getBestRouteFromStatuses(tc.statuses))
+		})
+	}
+}
+
+func TestClientNetwork_LoadBalance(t *testing.T) {
+	statusRecorder := peer.NewRecorder("")
+	for _, peerKey := range []string{"peer1", "peer2"} {
+		require.NoError(t, statusRecorder.AddPeer(peerKey, peerKey+".netbird.cloud"))
+	}
+	setConnected := func(peerKey string, connected bool) {
+		state, err := statusRecorder.GetPeer(peerKey)
+		require.NoError(t, err)
+		state.ConnStatus = peer.StatusDisconnected
+		if connected {
+			state.ConnStatus = peer.StatusConnected
+		}
+		require.NoError(t, statusRecorder.UpdatePeerState(state))
+	}
+
+	allowedIPs := make(map[netip.Prefix]string)
+	allowedIPsRefCounter := refcounter.New(
+		func(prefix netip.Prefix, peerKey string) (string, error) {
+			allowedIPs[prefix] = peerKey
+			return peerKey, nil
+		},
+		func(prefix netip.Prefix, _ string) error {
+			delete(allowedIPs, prefix)
+			return nil
+		},
+	)
+	systemRoutes := make(map[netip.Prefix]struct{})
+	routeRefCounter := refcounter.New(
+		func(prefix netip.Prefix, _ any) (any, error) {
+			systemRoutes[prefix] = struct{}{}
+			return nil, nil
+		},
+		func(prefix netip.Prefix, _ any) error {
+			delete(systemRoutes, prefix)
+			return nil
+		},
+	)
+
+	network := netip.MustParsePrefix("10.0.0.0/16")
+	client := &clientNetwork{
+		ctx:            context.Background(),
+		statusRecorder: statusRecorder,
+		handler:        static.NewRoute(&route.Route{Network: network}, routeRefCounter, allowedIPsRefCounter),
+		routes: map[route.ID]*route.Route{
+			"route1": {ID: "route1", Network: network, Metric: 100, Peer: "peer1", Strategy: route.StrategyLoadBalance},
+			"route2": {ID: "route2", Network: network, Metric: 100, Peer: "peer2", Strategy: route.StrategyLoadBalance},
+		},
+	}
+	peersOf := func() map[string]int {
+		peers := make(map[string]int)
+		for _, peerKey := range allowedIPs {
+			peers[peerKey]++
+		}
+		return peers
+	}
+
+	setConnected("peer1", true)
+	setConnected("peer2", true)
+	require.NoError(t, client.recalculateRouteAndUpdatePeerAndSystem())
+	assert.Contains(t, systemRoutes, network)
+	assert.Len(t, allowedIPs, 256, "the network should be split in parts")
+	peers := peersOf()
+	assert.Greater(t, peers["peer1"], 64, "the parts should be spread across the peers")
+	assert.Greater(t, peers["peer2"], 64, "the parts should be spread across the peers")
+	assert.Equal(t, []string{"peer1", "peer2"}, client.balancedPeers)
+
+	peer1Parts := make(map[netip.Prefix]struct{})
+	for part, peerKey := range allowedIPs {
+		if peerKey == "peer1" {
+			peer1Parts[part] = struct{}{}
+		}
+	}
+
+	setConnected("peer2", false)
+	require.NoError(t, client.recalculateRouteAndUpdatePeerAndSystem())
+	assert.Len(t, allowedIPs, 256)
+	assert.Equal(t, map[string]int{"peer1": 256}, peersOf(), "the remaining peer should route the whole network")
+
+	setConnected("peer2", true)
+	require.NoError(t, client.recalculateRouteAndUpdatePeerAndSystem())
+	for part := range peer1Parts {
+		assert.Equal(t, "peer1", allowedIPs[part], "the parts should keep their peer when another peer comes back")
+	}
+
+	for _, r := range client.routes {
+		r.Strategy = route.StrategyDefault
+	}
+	require.NoError(t, client.recalculateRouteAndUpdatePeerAndSystem())
+	require.NotNil(t, client.currentChosen)
+	assert.Equal(t, map[netip.Prefix]string{network: client.currentChosen.Peer}, allowedIPs, "a single peer should route the network")
+	assert.Empty(t, client.balancedPeers)
+
+	require.NoError(t, client.removeRouteFromPeerAndSystem())
+	assert.Empty(t, allowedIPs)
+	assert.Empty(t, systemRoutes)
+}
@@ -7,6 +7,7 @@ import (
 	"net"
 	"net/netip"
 	"net/url"
+	"reflect"
 	"runtime"
 	"sync"
 	"time"
@@ -21,6 +22,7 @@ import (
 	"github.com/netbirdio/netbird/client/internal/routemanager/systemops"
 	"github.com/netbirdio/netbird/client/internal/routemanager/vars"
 	"github.com/netbirdio/netbird/client/internal/routeselector"
+	"github.com/netbirdio/netbird/client/internal/statefile"
 	"github.com/netbirdio/netbird/iface"
 	relayClient "github.com/netbirdio/netbird/relay/client"
 	"github.com/netbirdio/netbird/route"
@@ -57,6 +59,12 @@ type DefaultManager struct {
 	routeRefCounter      *refcounter.RouteRefCounter
 	allowedIPsRefCounter *refcounter.AllowedIPsRefCounter
 	dnsRouteInterval     time.Duration
+	// updateSerial is the serial of the latest routes update from the management service
+	updateSerial uint64
+	// statePath is the path of the state file the route selection is persisted to, empty to not persist it
+	statePath string
+	// savedSelection is the last route selection read from or written to the state file
+	savedSelection *routeselector.State
 }
 
 func NewManager(
@@ -67,6 +75,7 @@ func NewManager(
 	statusRecorder *peer.Status,
 	relayMgr *relayClient.Manager,
 	initialRoutes []*route.Route,
+	statePath string,
 ) *DefaultManager {
 	mCTX, cancel := context.WithCancel(ctx)
 	notifier := notifier.NewNotifier()
@@ -84,6 +93,7 @@ func NewManager(
 		wgInterface:      wgInterface,
 		pubKey:           pubKey,
 		notifier:         notifier,
+		statePath:        statePath,
 	}
 
 	dm.routeRefCounter = refcounter.New(
@@ -111,6 +121,8 @@ func NewManager(
 		},
 	)
 
+	dm.loadRouteSelection()
+
 	if runtime.GOOS == "android" {
 		cr := dm.clientRoutes(initialRoutes)
 		dm.notifier.SetInitialClientRoutes(cr)
@@ -191,8 +203,12 @@ func (m *DefaultManager) UpdateRoutes(updateSerial uint64, newRoutes []*route.Ro
 		m.mux.Lock()
 		defer m.mux.Unlock()
 
+		m.updateSerial = updateSerial
 		newServerRoutesMap, newClientRoutesIDMap := m.classifyRoutes(newRoutes)
 
+		m.routeSelector.Reconcile(newClientRoutesIDMap, time.Now().UTC())
+		m.saveRouteSelection()
+
 		filteredClientRoutes := m.routeSelector.FilterSelected(newClientRoutesIDMap)
 		m.updateClientNetworks(updateSerial, filteredClientRoutes)
 		m.notifier.OnNewRoutes(filteredClientRoutes)
@@ -233,6 +249,9 @@ func (m *DefaultManager) TriggerSelection(networks route.HAMap) {
 	m.mux.Lock()
 	defer m.mux.Unlock()
 
+	m.routeSelector.Reconcile(networks, time.Now().UTC())
+	m.saveRouteSelection()
+
 	networks = m.routeSelector.FilterSelected(networks)
 
 	m.notifier.OnNewRoutes(networks)
@@ -240,8 +259,9 @@ func (m *DefaultManager) TriggerSelection(networks route.HAMap) {
 	m.stopObsoleteClients(networks)
 
 	for id, routes := range networks {
-		if _, found := m.clientNetworks[id]; found {
-			// don't touch existing client network watchers
+		if clientNetworkWatcher, found := m.clientNetworks[id]; found {
+			// existing watchers only recalculate if the selection changed their routes, e.g. the exit node peer
+			clientNetworkWatcher.sendUpdateToClientNetworkWatcher(routesUpdate{updateSerial: m.updateSerial, routes: routes})
 			continue
 		}
 
@@ -252,6 +272,47 @@ func (m *DefaultManager) TriggerSelection(networks route.HAMap) {
 	}
 }
 
+// loadRouteSelection restores the route selection persisted in the state file
+func (m *DefaultManager) loadRouteSelection() {
+	if m.statePath == "" {
+		return
+	}
+
+	state, err := statefile.Read(m.statePath)
+	if err != nil {
+		log.Warnf("failed to restore route selection: %v", err)
+		return
+	}
+	if state.RouteSelector == nil {
+		return
+	}
+
+	m.routeSelector.SetState(state.RouteSelector)
+	m.savedSelection = m.routeSelector.GetState()
+	log.Debugf("restored route selection from %s", m.statePath)
+}
+
+// saveRouteSelection persists the route selection to the state file if it changed since it was last saved
+func (m *DefaultManager) saveRouteSelection() {
+	if m.statePath == "" {
+		return
+	}
+
+	selection := m.routeSelector.GetState()
+	if reflect.DeepEqual(selection, m.savedSelection) {
+		return
+	}
+
+	err := statefile.Update(m.statePath, func(state *statefile.State) {
+		state.RouteSelector = selection
+	})
+	if err != nil {
+		log.Errorf("failed to persist route selection: %v", err)
+		return
+	}
+	m.savedSelection = selection
+}
+
 // stopObsoleteClients stops the client network watcher for the networks that are not in the new list
 func (m *DefaultManager) stopObsoleteClients(networks route.HAMap) {
 	for id, client := range m.clientNetworks {
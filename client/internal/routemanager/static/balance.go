@@ -0,0 +1,105 @@
+package static
+
+import (
+	"fmt"
+	"hash/fnv"
+	"net/netip"
+
+	"github.com/hashicorp/go-multierror"
+
+	nberrors "github.com/netbirdio/netbird/client/errors"
+)
+
+// balanceSplitBits is the maximum number of bits the network is split by to be load balanced, i.e. up to 256 parts
+const balanceSplitBits = 8
+
+// AddBalancedAllowedIPs splits the network in parts and spreads them across the peers by destination hash.
+// A part keeps its peer as long as the peer is in the list, only the parts of the removed peers or won by the new
+// peers are moved.
+func (r *Route) AddBalancedAllowedIPs(peerKeys []string) error {
+	assignment := assignParts(splitPrefix(r.route.Network, balanceSplitBits), peerKeys)
+	if r.balanced == nil {
+		r.balanced = make(map[netip.Prefix]string)
+	}
+
+	var merr *multierror.Error
+	for part, peerKey := range r.balanced {
+		if assignment[part] == peerKey {
+			continue
+		}
+		if _, err := r.allowedIPsRefcounter.Decrement(part); err != nil {
+			merr = multierror.Append(merr, fmt.Errorf("remove allowed IP %s: %w", part, err))
+		}
+		delete(r.balanced, part)
+	}
+
+	for part, peerKey := range assignment {
+		if _, ok := r.balanced[part]; ok {
+			continue
+		}
+		if _, err := r.allowedIPsRefcounter.Increment(part, peerKey); err != nil {
+			merr = multierror.Append(merr, fmt.Errorf("add allowed IP %s: %w", part, err))
+			continue
+		}
+		r.balanced[part] = peerKey
+	}
+
+	return nberrors.FormatErrorOrNil(merr)
+}
+
+func (r *Route) removeBalancedAllowedIPs() error {
+	var merr *multierror.Error
+	for part := range r.balanced {
+		if _, err := r.allowedIPsRefcounter.Decrement(part); err != nil {
+			merr = multierror.Append(merr, fmt.Errorf("remove allowed IP %s: %w", part, err))
+		}
+	}
+	r.balanced = nil
+
+	return nberrors.FormatErrorOrNil(merr)
+}
+
+// splitPrefix splits the prefix in 2^bits parts, less if the prefix is too small
+func splitPrefix(prefix netip.Prefix, bits int) []netip.Prefix {
+	prefix = prefix.Masked()
+	bits = min(bits, prefix.Addr().BitLen()-prefix.Bits())
+
+	parts := make([]netip.Prefix, 0, 1<<bits)
+	for i := 0; i < 1<<bits; i++ {
+		addr := prefix.Addr().AsSlice()
+		for j := 0; j < bits; j++ {
+			if i&(1<<(bits-1-j)) == 0 {
+				continue
+			}
+			pos := prefix.Bits() + j
+			addr[pos/8] |= 0x80 >> (pos % 8)
+		}
+		partAddr, _ := netip.AddrFromSlice(addr)
+		parts = append(parts, netip.PrefixFrom(partAddr, prefix.Bits()+bits))
+	}
+	return parts
+}
+
+// assignParts assigns every part to the peer with the highest hash of the part and the peer (rendezvous hashing)
+func assignParts(parts []netip.Prefix, peerKeys []string) map[netip.Prefix]string {
+	assignment := make(map[netip.Prefix]string, len(parts))
+	if len(peerKeys) == 0 {
+		return assignment
+	}
+
+	for _, part := range parts {
+		var chosen string
+		var chosenScore uint64
+		for _, peerKey := range peerKeys {
+			h := fnv.New64a()
+			_, _ = h.Write([]byte(part.String()))
+			_, _ = h.Write([]byte(peerKey))
+			if score := h.Sum64(); chosen == "" || score > chosenScore {
+				chosen = peerKey
+				chosenScore = score
+			}
+		}
+		assignment[part] = chosen
+	}
+	return assignment
+}
@@ -0,0 +1,49 @@
+package static
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitPrefix(t *testing.T) {
+	parts := splitPrefix(netip.MustParsePrefix("10.0.0.0/16"), balanceSplitBits)
+	assert.Len(t, parts, 256)
+	assert.Equal(t, netip.MustParsePrefix("10.0.0.0/24"), parts[0])
+	assert.Equal(t, netip.MustParsePrefix("10.0.1.0/24"), parts[1])
+	assert.Equal(t, netip.MustParsePrefix("10.0.255.0/24"), parts[255])
+
+	parts = splitPrefix(netip.MustParsePrefix("10.0.0.0/30"), balanceSplitBits)
+	assert.Equal(t, []netip.Prefix{
+		netip.MustParsePrefix("10.0.0.0/32"),
+		netip.MustParsePrefix("10.0.0.1/32"),
+		netip.MustParsePrefix("10.0.0.2/32"),
+		netip.MustParsePrefix("10.0.0.3/32"),
+	}, parts, "small networks should be split in host routes")
+
+	parts = splitPrefix(netip.MustParsePrefix("10.0.0.1/32"), balanceSplitBits)
+	assert.Equal(t, []netip.Prefix{netip.MustParsePrefix("10.0.0.1/32")}, parts, "host routes can't be split")
+
+	parts = splitPrefix(netip.MustParsePrefix("fd00:1234::/60"), balanceSplitBits)
+	assert.Len(t, parts, 256)
+	assert.Equal(t, netip.MustParsePrefix("fd00:1234:0:0::/68"), parts[0])
+	assert.Equal(t, netip.MustParsePrefix("fd00:1234:0:f:f000::/68"), parts[255])
+}
+
+func TestAssignParts(t *testing.T) {
+	parts := splitPrefix(netip.MustParsePrefix("10.0.0.0/16"), balanceSplitBits)
+
+	assert.Empty(t, assignParts(parts, nil))
+
+	assignment := assignParts(parts, []string{"peer1", "peer2", "peer3"})
+	assert.Len(t, assignment, len(parts))
+	assert.Equal(t, assignment, assignParts(parts, []string{"peer3", "peer1", "peer2"}), "the assignment should not depend on the order of the peers")
+
+	reduced := assignParts(parts, []string{"peer1", "peer2"})
+	for part, peerKey := range assignment {
+		if peerKey != "peer3" {
+			assert.Equal(t, peerKey, reduced[part], "only the parts of the removed peer should move")
+		}
+	}
+}
@@ -3,6 +3,7 @@ package static
 import (
 	"context"
 	"fmt"
+	"net/netip"
 
 	log "github.com/sirupsen/logrus"
 
@@ -14,6 +15,8 @@ type Route struct {
 	route                *route.Route
 	routeRefCounter      *refcounter.RouteRefCounter
 	allowedIPsRefcounter *refcounter.AllowedIPsRefCounter
+	// balanced maps the parts of the network to their peer while the network is load balanced
+	balanced map[netip.Prefix]string
 }
 
 func NewRoute(rt *route.Route, routeRefCounter *refcounter.RouteRefCounter, allowedIPsRefCounter *refcounter.AllowedIPsRefCounter) *Route {
@@ -52,6 +55,9 @@ func (r *Route) AddAllowedIPs(peerKey string) error {
 }
 
 func (r *Route) RemoveAllowedIPs() error {
+	if r.balanced != nil {
+		return r.removeBalancedAllowedIPs()
+	}
 	_, err := r.allowedIPsRefcounter.Decrement(r.route.Network)
 	return err
 }
@@ -3,24 +3,67 @@ package routeselector
 import (
 	"fmt"
 	"slices"
+	"time"
 
 	"github.com/hashicorp/go-multierror"
+	log "github.com/sirupsen/logrus"
 	"golang.org/x/exp/maps"
 
 	"github.com/netbirdio/netbird/client/errors"
 	route "github.com/netbirdio/netbird/route"
 )
 
+// ExitNode is the exit node preference applied to the default routes instead of the route selection
+type ExitNode struct {
+	// NetID of the default route to use, empty to not route traffic through any exit node
+	NetID route.NetID `json:"net_id"`
+	// Peer is the public key of the routing peer to use among the HA peers of the route, empty to use the best one
+	Peer string `json:"peer,omitempty"`
+}
+
+const (
+	// staleNetworkTimeout is how long the selection of a network that disappeared is kept in case it comes back
+	staleNetworkTimeout = 30 * 24 * time.Hour
+	// lastSeenResolution limits how often the last seen time of a network is updated to avoid frequent state writes
+	lastSeenResolution = 24 * time.Hour
+)
+
+// State is the persistable state of the route selector
+type State struct {
+	// SelectAll is true when all networks, including new ones, are selected except the deselected ones
+	SelectAll bool `json:"select_all"`
+	// Selected are the selected networks when SelectAll is false
+	Selected []route.NetID `json:"selected,omitempty"`
+	// Deselected are the deselected networks when SelectAll is true
+	Deselected []route.NetID `json:"deselected,omitempty"`
+	// Networks are the known details of the selected or deselected networks
+	Networks map[route.NetID]*NetworkState `json:"networks,omitempty"`
+	// ExitNode is the exit node chosen by the user, nil if default routes follow the route selection
+	ExitNode *ExitNode `json:"exit_node,omitempty"`
+}
+
+// NetworkState keeps the route IDs of a network to follow it when it is renamed and the last time it was seen
+// to forget it once it was removed for good
+type NetworkState struct {
+	RouteIDs []route.ID `json:"route_ids,omitempty"`
+	LastSeen time.Time  `json:"last_seen"`
+}
+
 type RouteSelector struct {
-	selectedRoutes map[route.NetID]struct{}
-	selectAll      bool
+	selectedRoutes   map[route.NetID]struct{}
+	deselectedRoutes map[route.NetID]struct{}
+	selectAll        bool
+	exitNode         *ExitNode
+	networks         map[route.NetID]*NetworkState
 }
 
 func NewRouteSelector() *RouteSelector {
 	return &RouteSelector{
-		selectedRoutes: map[route.NetID]struct{}{},
+		selectedRoutes:   map[route.NetID]struct{}{},
+		deselectedRoutes: map[route.NetID]struct{}{},
 		// default selects all routes
 		selectAll: true,
+		networks:  map[route.NetID]*NetworkState{},
 	}
 }
 
@@ -40,6 +83,7 @@ func (rs *RouteSelector) SelectRoutes(routes []route.NetID, appendRoute bool, al
 		rs.selectedRoutes[route] = struct{}{}
 	}
 	rs.selectAll = false
+	rs.deselectedRoutes = map[route.NetID]struct{}{}
 
 	return errors.FormatErrorOrNil(err)
 }
@@ -48,19 +92,12 @@ func (rs *RouteSelector) SelectRoutes(routes []route.NetID, appendRoute bool, al
 func (rs *RouteSelector) SelectAllRoutes() {
 	rs.selectAll = true
 	rs.selectedRoutes = map[route.NetID]struct{}{}
+	rs.deselectedRoutes = map[route.NetID]struct{}{}
 }
 
 // DeselectRoutes removes specific routes from the selection.
-// If the selector is in "select all" mode, it will transition to "select specific" mode.
+// If the selector is in "select all" mode, the routes are excluded and new routes are still selected.
 func (rs *RouteSelector) DeselectRoutes(routes []route.NetID, allRoutes []route.NetID) error {
-	if rs.selectAll {
-		rs.selectAll = false
-		rs.selectedRoutes = map[route.NetID]struct{}{}
-		for _, route := range allRoutes {
-			rs.selectedRoutes[route] = struct{}{}
-		}
-	}
-
 	var err *multierror.Error
 
 	for _, route := range routes {
@@ -68,7 +105,11 @@ func (rs *RouteSelector) DeselectRoutes(routes []route.NetID, allRoutes []route.
 			err = multierror.Append(err, fmt.Errorf("route '%s' is not available", route))
 			continue
 		}
-		delete(rs.selectedRoutes, route)
+		if rs.selectAll {
+			rs.deselectedRoutes[route] = struct{}{}
+		} else {
+			delete(rs.selectedRoutes, route)
+		}
 	}
 
 	return errors.FormatErrorOrNil(err)
@@ -78,28 +119,212 @@ func (rs *RouteSelector) DeselectRoutes(routes []route.NetID, allRoutes []route.
 func (rs *RouteSelector) DeselectAllRoutes() {
 	rs.selectAll = false
 	rs.selectedRoutes = map[route.NetID]struct{}{}
+	rs.deselectedRoutes = map[route.NetID]struct{}{}
 }
 
 // IsSelected checks if a specific route is selected.
 func (rs *RouteSelector) IsSelected(routeID route.NetID) bool {
 	if rs.selectAll {
-		return true
+		_, deselected := rs.deselectedRoutes[routeID]
+		return !deselected
 	}
 	_, selected := rs.selectedRoutes[routeID]
 	return selected
 }
 
+// GetState returns the persistable state of the selection
+func (rs *RouteSelector) GetState() *State {
+	state := &State{
+		SelectAll: rs.selectAll,
+		Networks:  make(map[route.NetID]*NetworkState, len(rs.networks)),
+		ExitNode:  rs.GetExitNode(),
+	}
+	for id := range rs.selectedRoutes {
+		state.Selected = append(state.Selected, id)
+	}
+	for id := range rs.deselectedRoutes {
+		state.Deselected = append(state.Deselected, id)
+	}
+	slices.Sort(state.Selected)
+	slices.Sort(state.Deselected)
+
+	for id, network := range rs.networks {
+		state.Networks[id] = &NetworkState{
+			RouteIDs: slices.Clone(network.RouteIDs),
+			LastSeen: network.LastSeen,
+		}
+	}
+	return state
+}
+
+// SetState restores a selection returned by GetState
+func (rs *RouteSelector) SetState(state *State) {
+	rs.selectAll = state.SelectAll
+	rs.selectedRoutes = map[route.NetID]struct{}{}
+	rs.deselectedRoutes = map[route.NetID]struct{}{}
+	rs.networks = map[route.NetID]*NetworkState{}
+	rs.SetExitNode(state.ExitNode)
+
+	for _, id := range state.Selected {
+		rs.selectedRoutes[id] = struct{}{}
+	}
+	for _, id := range state.Deselected {
+		rs.deselectedRoutes[id] = struct{}{}
+	}
+	for id, network := range state.Networks {
+		if network == nil {
+			continue
+		}
+		rs.networks[id] = &NetworkState{
+			RouteIDs: slices.Clone(network.RouteIDs),
+			LastSeen: network.LastSeen,
+		}
+	}
+}
+
+// Reconcile updates the selection with the networks currently received from the management service.
+// The selection of a renamed network moves to its new network ID, found by its route IDs,
+// and the selection of a network missing for longer than staleNetworkTimeout is dropped.
+// It returns true if the selection state changed.
+func (rs *RouteSelector) Reconcile(routes route.HAMap, now time.Time) bool {
+	current := make(map[route.NetID][]route.ID)
+	byRouteID := make(map[route.ID]route.NetID)
+	for id, rts := range routes {
+		netID := id.NetID()
+		for _, r := range rts {
+			current[netID] = append(current[netID], r.ID)
+			byRouteID[r.ID] = netID
+		}
+	}
+
+	changed := false
+	for _, selection := range []map[route.NetID]struct{}{rs.selectedRoutes, rs.deselectedRoutes} {
+		for netID := range selection {
+			if rs.reconcileNetwork(selection, netID, current, byRouteID, now) {
+				changed = true
+			}
+		}
+	}
+
+	for netID := range rs.networks {
+		_, selected := rs.selectedRoutes[netID]
+		_, deselected := rs.deselectedRoutes[netID]
+		if !selected && !deselected {
+			delete(rs.networks, netID)
+			changed = true
+		}
+	}
+
+	return changed
+}
+
+func (rs *RouteSelector) reconcileNetwork(selection map[route.NetID]struct{}, netID route.NetID, current map[route.NetID][]route.ID, byRouteID map[route.ID]route.NetID, now time.Time) bool {
+	network := rs.networks[netID]
+
+	if routeIDs, ok := current[netID]; ok {
+		slices.Sort(routeIDs)
+		if network != nil && slices.Equal(network.RouteIDs, routeIDs) && now.Sub(network.LastSeen) < lastSeenResolution {
+			return false
+		}
+		rs.networks[netID] = &NetworkState{RouteIDs: routeIDs, LastSeen: now}
+		return true
+	}
+
+	if network == nil {
+		// the network was selected before its routes were known, start tracking it from now
+		rs.networks[netID] = &NetworkState{LastSeen: now}
+		return true
+	}
+
+	for _, routeID := range network.RouteIDs {
+		newID, ok := byRouteID[routeID]
+		if !ok {
+			continue
+		}
+		delete(selection, netID)
+		delete(rs.networks, netID)
+		if _, exists := selection[newID]; !exists {
+			log.Infof("network %s was renamed to %s, moving its route selection", netID, newID)
+			selection[newID] = struct{}{}
+		}
+		return true
+	}
+
+	if now.Sub(network.LastSeen) > staleNetworkTimeout {
+		log.Infof("network %s was not seen since %s, dropping its route selection", netID, network.LastSeen)
+		delete(selection, netID)
+		delete(rs.networks, netID)
+		return true
+	}
+
+	return false
+}
+
+// SetExitNode sets the exit node preference. A nil exit node makes default routes follow the route selection again.
+func (rs *RouteSelector) SetExitNode(exitNode *ExitNode) {
+	if exitNode != nil {
+		exitNodeCopy := *exitNode
+		exitNode = &exitNodeCopy
+	}
+	rs.exitNode = exitNode
+}
+
+// GetExitNode returns the exit node preference or nil if default routes follow the route selection.
+func (rs *RouteSelector) GetExitNode() *ExitNode {
+	if rs.exitNode == nil {
+		return nil
+	}
+	exitNode := *rs.exitNode
+	return &exitNode
+}
+
+// IsExitNodeSelected checks if the routes of a default route network are used as the exit node.
+// If a peer is set, only the route of that routing peer is used.
+func (rs *RouteSelector) IsExitNodeSelected(netID route.NetID, peer string) bool {
+	if rs.exitNode == nil {
+		return rs.IsSelected(netID)
+	}
+	return rs.exitNode.NetID == netID && (rs.exitNode.Peer == "" || rs.exitNode.Peer == peer)
+}
+
 // FilterSelected removes unselected routes from the provided map.
+// When an exit node is set, default routes other than the exit node's are removed regardless of the route selection.
 func (rs *RouteSelector) FilterSelected(routes route.HAMap) route.HAMap {
-	if rs.selectAll {
+	if rs.selectAll && len(rs.deselectedRoutes) == 0 && rs.exitNode == nil {
 		return maps.Clone(routes)
 	}
 
 	filtered := route.HAMap{}
 	for id, rt := range routes {
+		if rs.exitNode != nil && len(rt) > 0 && rt[0].IsDefaultRoute() {
+			if exitRoutes := rs.filterExitNode(id.NetID(), rt); len(exitRoutes) > 0 {
+				filtered[id] = exitRoutes
+			}
+			continue
+		}
+
 		if rs.IsSelected(id.NetID()) {
 			filtered[id] = rt
 		}
 	}
 	return filtered
 }
+
+// filterExitNode returns the routes of the exit node among the routes of a default route network
+func (rs *RouteSelector) filterExitNode(netID route.NetID, routes []*route.Route) []*route.Route {
+	if rs.exitNode.NetID != netID {
+		return nil
+	}
+
+	if rs.exitNode.Peer == "" {
+		return routes
+	}
+
+	var filtered []*route.Route
+	for _, r := range routes {
+		if r.Peer == rs.exitNode.Peer {
+			filtered = append(filtered, r)
+		}
+	}
+	return filtered
+}
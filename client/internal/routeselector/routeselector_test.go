@@ -1,8 +1,10 @@
 package routeselector_test
 
 import (
+	"net/netip"
 	"slices"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -273,3 +275,223 @@ func TestRouteSelector_FilterSelected(t *testing.T) {
 		"route2|192.168.0.0/16": {},
 	}, filtered)
 }
+
+func TestRouteSelector_ExitNode(t *testing.T) {
+	exitRoutes := func(netID route.NetID, peers ...string) []*route.Route {
+		var routes []*route.Route
+		for _, p := range peers {
+			routes = append(routes, &route.Route{
+				ID:      route.ID(string(netID) + p),
+				NetID:   netID,
+				Network: netip.MustParsePrefix("0.0.0.0/0"),
+				Peer:    p,
+			})
+		}
+		return routes
+	}
+
+	routes := route.HAMap{
+		"exit1|0.0.0.0/0":   exitRoutes("exit1", "peerA", "peerB"),
+		"exit2|0.0.0.0/0":   exitRoutes("exit2", "peerC"),
+		"route1|10.0.0.0/8": {{NetID: "route1", Network: netip.MustParsePrefix("10.0.0.0/8")}},
+	}
+
+	tests := []struct {
+		name     string
+		exitNode *routeselector.ExitNode
+		want     map[route.HAUniqueID][]string
+	}{
+		{
+			name: "No exit node follows the route selection",
+			want: map[route.HAUniqueID][]string{
+				"exit1|0.0.0.0/0":   {"peerA", "peerB"},
+				"exit2|0.0.0.0/0":   {"peerC"},
+				"route1|10.0.0.0/8": {""},
+			},
+		},
+		{
+			name:     "Exit node uses all routing peers",
+			exitNode: &routeselector.ExitNode{NetID: "exit1"},
+			want: map[route.HAUniqueID][]string{
+				"exit1|0.0.0.0/0":   {"peerA", "peerB"},
+				"route1|10.0.0.0/8": {""},
+			},
+		},
+		{
+			name:     "Exit node pinned to a routing peer",
+			exitNode: &routeselector.ExitNode{NetID: "exit1", Peer: "peerB"},
+			want: map[route.HAUniqueID][]string{
+				"exit1|0.0.0.0/0":   {"peerB"},
+				"route1|10.0.0.0/8": {""},
+			},
+		},
+		{
+			name:     "Exit node pinned to an unknown routing peer",
+			exitNode: &routeselector.ExitNode{NetID: "exit2", Peer: "peerA"},
+			want: map[route.HAUniqueID][]string{
+				"route1|10.0.0.0/8": {""},
+			},
+		},
+		{
+			name:     "Exit nodes turned off",
+			exitNode: &routeselector.ExitNode{},
+			want: map[route.HAUniqueID][]string{
+				"route1|10.0.0.0/8": {""},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rs := routeselector.NewRouteSelector()
+			rs.SetExitNode(tt.exitNode)
+			assert.Equal(t, tt.exitNode, rs.GetExitNode())
+
+			got := map[route.HAUniqueID][]string{}
+			for id, rt := range rs.FilterSelected(routes) {
+				for _, r := range rt {
+					got[id] = append(got[id], r.Peer)
+				}
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRouteSelector_ExitNodeIgnoresRouteSelection(t *testing.T) {
+	rs := routeselector.NewRouteSelector()
+	rs.DeselectAllRoutes()
+	rs.SetExitNode(&routeselector.ExitNode{NetID: "exit1"})
+
+	routes := route.HAMap{
+		"exit1|0.0.0.0/0": {{NetID: "exit1", Network: netip.MustParsePrefix("0.0.0.0/0"), Peer: "peerA"}},
+	}
+	assert.Len(t, rs.FilterSelected(routes), 1)
+	assert.True(t, rs.IsExitNodeSelected("exit1", "peerA"))
+	assert.False(t, rs.IsSelected("exit1"))
+}
+
+func TestRouteSelector_DeselectKeepsNewRoutesSelected(t *testing.T) {
+	rs := routeselector.NewRouteSelector()
+
+	err := rs.DeselectRoutes([]route.NetID{"route1"}, []route.NetID{"route1", "route2"})
+	require.NoError(t, err)
+
+	assert.False(t, rs.IsSelected("route1"))
+	assert.True(t, rs.IsSelected("route2"))
+	assert.True(t, rs.IsSelected("route3"), "routes added after the deselection should be selected")
+
+	routes := route.HAMap{
+		"route1|10.0.0.0/8":     {},
+		"route3|172.16.0.0/12":  {},
+		"route2|192.168.0.0/16": {},
+	}
+	assert.Equal(t, route.HAMap{
+		"route3|172.16.0.0/12":  {},
+		"route2|192.168.0.0/16": {},
+	}, rs.FilterSelected(routes))
+}
+
+func TestRouteSelector_State(t *testing.T) {
+	allRoutes := []route.NetID{"route1", "route2", "route3"}
+
+	tests := []struct {
+		name   string
+		modify func(rs *routeselector.RouteSelector) error
+	}{
+		{
+			name:   "Default selection",
+			modify: func(rs *routeselector.RouteSelector) error { return nil },
+		},
+		{
+			name: "Deselected routes",
+			modify: func(rs *routeselector.RouteSelector) error {
+				return rs.DeselectRoutes([]route.NetID{"route1"}, allRoutes)
+			},
+		},
+		{
+			name: "Selected routes",
+			modify: func(rs *routeselector.RouteSelector) error {
+				return rs.SelectRoutes([]route.NetID{"route2", "route3"}, false, allRoutes)
+			},
+		},
+		{
+			name: "All deselected",
+			modify: func(rs *routeselector.RouteSelector) error {
+				rs.DeselectAllRoutes()
+				return nil
+			},
+		},
+		{
+			name: "Exit node",
+			modify: func(rs *routeselector.RouteSelector) error {
+				rs.SetExitNode(&routeselector.ExitNode{NetID: "route1", Peer: "peerKey"})
+				return nil
+			},
+		},
+		{
+			name: "Exit node turned off",
+			modify: func(rs *routeselector.RouteSelector) error {
+				rs.SetExitNode(&routeselector.ExitNode{})
+				return nil
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rs := routeselector.NewRouteSelector()
+			require.NoError(t, tt.modify(rs))
+
+			restored := routeselector.NewRouteSelector()
+			restored.SetState(rs.GetState())
+
+			assert.Equal(t, rs.GetState(), restored.GetState())
+			assert.Equal(t, rs.GetExitNode(), restored.GetExitNode())
+			for _, id := range append(allRoutes, "route4") {
+				assert.Equal(t, rs.IsSelected(id), restored.IsSelected(id), id)
+				assert.Equal(t, rs.IsExitNodeSelected(id, "peerKey"), restored.IsExitNodeSelected(id, "peerKey"), id)
+			}
+		})
+	}
+}
+
+func TestRouteSelector_Reconcile(t *testing.T) {
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	allRoutes := []route.NetID{"route1", "route2", "route3"}
+	routes := route.HAMap{
+		"route1|10.0.0.0/8":     {{ID: "id1", NetID: "route1"}},
+		"route2|192.168.0.0/16": {{ID: "id2", NetID: "route2"}},
+		"route3|172.16.0.0/12":  {{ID: "id3", NetID: "route3"}},
+	}
+
+	rs := routeselector.NewRouteSelector()
+	require.NoError(t, rs.SelectRoutes([]route.NetID{"route1", "route2"}, false, allRoutes))
+
+	assert.True(t, rs.Reconcile(routes, now), "first reconcile should record the networks")
+	assert.False(t, rs.Reconcile(routes, now.Add(time.Hour)), "nothing changed")
+
+	// route1 was renamed on the management side
+	renamed := route.HAMap{
+		"office|10.0.0.0/8":     {{ID: "id1", NetID: "office"}},
+		"route2|192.168.0.0/16": {{ID: "id2", NetID: "route2"}},
+		"route3|172.16.0.0/12":  {{ID: "id3", NetID: "route3"}},
+	}
+	assert.True(t, rs.Reconcile(renamed, now.Add(2*time.Hour)))
+	assert.True(t, rs.IsSelected("office"))
+	assert.False(t, rs.IsSelected("route1"))
+	assert.True(t, rs.IsSelected("route2"))
+	assert.False(t, rs.IsSelected("route3"))
+
+	// route2 disappeared, its selection is kept for a while in case it comes back
+	removed := route.HAMap{
+		"office|10.0.0.0/8":    {{ID: "id1", NetID: "office"}},
+		"route3|172.16.0.0/12": {{ID: "id3", NetID: "route3"}},
+	}
+	rs.Reconcile(removed, now.Add(7*24*time.Hour))
+	assert.True(t, rs.IsSelected("route2"))
+
+	assert.True(t, rs.Reconcile(removed, now.Add(60*24*time.Hour)))
+	assert.False(t, rs.IsSelected("route2"))
+	assert.Equal(t, []route.NetID{"office"}, rs.GetState().Selected)
+}
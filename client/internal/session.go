@@ -2,11 +2,14 @@ package internal
 
 import (
 	"context"
+	"fmt"
 	"os/exec"
 	"strings"
 	"sync"
 	"time"
 
+	log "github.com/sirupsen/logrus"
+
 	"github.com/netbirdio/netbird/client/internal/peer"
 )
 
@@ -19,8 +22,16 @@ type SessionWatcher struct {
 
 	sendNotification bool
 	onExpireListener func()
+
+	onExpiringListener func(expiresAt time.Time)
+	// warnedExpiresAt and warnedBefore track the last warning, a deadline is warned about once per threshold
+	warnedExpiresAt time.Time
+	warnedBefore    time.Duration
 }
 
+// loginExpiryWarnings are the durations before the login expires the user is warned at
+var loginExpiryWarnings = []time.Duration{time.Hour, 10 * time.Minute}
+
 // NewSessionWatcher creates a new instance of SessionWatcher.
 func NewSessionWatcher(ctx context.Context, peerStatusRecorder *peer.Status) *SessionWatcher {
 	s := &SessionWatcher{
@@ -39,6 +50,14 @@ func (s *SessionWatcher) SetOnExpireListener(onExpire func()) {
 	s.onExpireListener = onExpire
 }
 
+// SetOnExpiringListener sets the callback func to be called when the login is about to expire. Without a
+// listener the watcher publishes the warning itself.
+func (s *SessionWatcher) SetOnExpiringListener(onExpiring func(expiresAt time.Time)) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.onExpiringListener = onExpiring
+}
+
 // startWatcher continuously checks if the session requires login and
 // calls the onExpireListener if login is required.
 func (s *SessionWatcher) startWatcher() {
@@ -54,16 +73,70 @@ func (s *SessionWatcher) startWatcher() {
 			}
 
 			isLoginRequired := s.peerStatusRecorder.IsLoginRequired()
-			if isLoginRequired && s.sendNotification && s.onExpireListener != nil {
+			if isLoginRequired && s.sendNotification {
+				s.peerStatusRecorder.PublishEvent(peer.EventLoginExpired, peer.EventSeverityError,
+					"NetBird connection session expired, please re-authenticate to connect to the network", nil)
+
 				s.mutex.Lock()
-				s.onExpireListener()
+				if s.onExpireListener != nil {
+					s.onExpireListener()
+				}
 				s.sendNotification = false
 				s.mutex.Unlock()
 			}
+
+			s.checkLoginExpiration(time.Now())
 		}
 	}
 }
 
+// checkLoginExpiration warns when the login deadline passes one of the warning thresholds
+func (s *SessionWatcher) checkLoginExpiration(now time.Time) {
+	expiresAt := s.peerStatusRecorder.GetLocalPeerState().LoginExpiresAt
+	if expiresAt.IsZero() {
+		return
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if !expiresAt.Equal(s.warnedExpiresAt) {
+		s.warnedExpiresAt = expiresAt
+		s.warnedBefore = 0
+	}
+
+	remaining := expiresAt.Sub(now)
+	if remaining <= 0 {
+		return
+	}
+
+	var threshold time.Duration
+	for _, warning := range loginExpiryWarnings {
+		if remaining <= warning && (threshold == 0 || warning < threshold) {
+			threshold = warning
+		}
+	}
+	if threshold == 0 || (s.warnedBefore != 0 && threshold >= s.warnedBefore) {
+		return
+	}
+	s.warnedBefore = threshold
+
+	if s.onExpiringListener != nil {
+		go s.onExpiringListener(expiresAt)
+		return
+	}
+	PublishLoginExpiring(s.peerStatusRecorder, expiresAt)
+}
+
+// PublishLoginExpiring warns the user that the login expires soon and has to be renewed
+func PublishLoginExpiring(statusRecorder *peer.Status, expiresAt time.Time) {
+	remaining := time.Until(expiresAt).Round(time.Minute)
+	log.Warnf("the login of this peer expires in %s, run netbird login --reauth to renew it", remaining)
+	statusRecorder.PublishEvent(peer.EventLoginExpiring, peer.EventSeverityWarning,
+		fmt.Sprintf("NetBird login expires in %s, please re-authenticate to stay connected", remaining),
+		map[string]string{"expires_at": expiresAt.UTC().Format(time.RFC3339)})
+}
+
 // CheckUIApp checks whether UI application is running.
 func CheckUIApp() bool {
 	cmd := exec.Command("ps", "-ef")
@@ -0,0 +1,63 @@
+package internal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/client/internal/peer"
+)
+
+func TestSessionWatcher_CheckLoginExpiration(t *testing.T) {
+	statusRecorder := peer.NewRecorder("https://mgm")
+	sub := statusRecorder.SubscribeToEvents()
+	defer statusRecorder.UnsubscribeFromEvents(sub)
+
+	watcher := &SessionWatcher{peerStatusRecorder: statusRecorder}
+
+	now := time.Now()
+	watcher.checkLoginExpiration(now)
+	assert.Empty(t, sub.Events(), "the login does not expire")
+
+	expiresAt := now.Add(2 * time.Hour)
+	statusRecorder.UpdateLoginExpiration(expiresAt)
+
+	steps := []struct {
+		now      time.Time
+		expected int
+	}{
+		{now: now, expected: 0},
+		{now: expiresAt.Add(-50 * time.Minute), expected: 1},
+		{now: expiresAt.Add(-40 * time.Minute), expected: 0},
+		{now: expiresAt.Add(-5 * time.Minute), expected: 1},
+		{now: expiresAt.Add(-time.Minute), expected: 0},
+		{now: expiresAt.Add(time.Minute), expected: 0},
+	}
+	for i, step := range steps {
+		watcher.checkLoginExpiration(step.now)
+		require.Len(t, sub.Events(), step.expected, "step %d", i)
+		for j := 0; j < step.expected; j++ {
+			event := <-sub.Events()
+			assert.Equal(t, peer.EventLoginExpiring, event.Type)
+			assert.Equal(t, expiresAt.UTC().Format(time.RFC3339), event.Metadata["expires_at"])
+		}
+	}
+
+	renewed := make(chan time.Time, 1)
+	watcher.SetOnExpiringListener(func(expiresAt time.Time) {
+		renewed <- expiresAt
+	})
+
+	newExpiresAt := expiresAt.Add(24 * time.Hour)
+	statusRecorder.UpdateLoginExpiration(newExpiresAt)
+	watcher.checkLoginExpiration(newExpiresAt.Add(-30 * time.Minute))
+	select {
+	case got := <-renewed:
+		assert.Equal(t, newExpiresAt, got)
+	case <-time.After(time.Second):
+		t.Fatal("the listener was not called for the new deadline")
+	}
+	assert.Empty(t, sub.Events(), "the listener handles the warning")
+}
@@ -0,0 +1,67 @@
+package statefile
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/netbirdio/netbird/client/internal/routeselector"
+	"github.com/netbirdio/netbird/util"
+)
+
+// fileName is the name of the state file created in the directory of the config file
+const fileName = "state.json"
+
+// mu serializes read-modify-write cycles of the state file
+var mu sync.Mutex
+
+// State is the client state that is kept across restarts but is not part of the user configuration
+type State struct {
+	// RouteSelector is the route selection of the user
+	RouteSelector *routeselector.State `json:"route_selector,omitempty"`
+}
+
+// Path returns the path of the state file that belongs to a config file
+func Path(configPath string) string {
+	return filepath.Join(filepath.Dir(configPath), fileName)
+}
+
+// Read reads the state file. A missing file results in an empty state.
+func Read(path string) (*State, error) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	return read(path)
+}
+
+// Update applies the update function to the state file and writes it back
+func Update(path string, update func(state *State)) error {
+	mu.Lock()
+	defer mu.Unlock()
+
+	state, err := read(path)
+	if err != nil {
+		return err
+	}
+
+	update(state)
+
+	if err := util.WriteJson(path, state); err != nil {
+		return fmt.Errorf("write state file %s: %w", path, err)
+	}
+	return nil
+}
+
+func read(path string) (*State, error) {
+	state := &State{}
+	if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) {
+		return state, nil
+	}
+
+	if _, err := util.ReadJson(path, state); err != nil {
+		return nil, fmt.Errorf("read state file %s: %w", path, err)
+	}
+	return state, nil
+}
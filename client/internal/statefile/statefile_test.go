@@ -0,0 +1,40 @@
+package statefile
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/client/internal/routeselector"
+	"github.com/netbirdio/netbird/route"
+)
+
+func TestPath(t *testing.T) {
+	assert.Equal(t, filepath.Join("etc", "netbird", "state.json"), Path(filepath.Join("etc", "netbird", "config.json")))
+}
+
+func TestReadMissingFile(t *testing.T) {
+	state, err := Read(filepath.Join(t.TempDir(), "state.json"))
+	require.NoError(t, err)
+	assert.Nil(t, state.RouteSelector)
+}
+
+func TestUpdate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state.json")
+	selection := &routeselector.State{
+		SelectAll:  true,
+		Deselected: []route.NetID{"route1"},
+		ExitNode:   &routeselector.ExitNode{NetID: "exit1", Peer: "peerKey"},
+	}
+
+	err := Update(path, func(state *State) {
+		state.RouteSelector = selection
+	})
+	require.NoError(t, err)
+
+	state, err := Read(path)
+	require.NoError(t, err)
+	assert.Equal(t, selection, state.RouteSelector)
+}
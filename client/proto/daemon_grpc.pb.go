@@ -37,12 +37,28 @@ type DaemonServiceClient interface {
 	SelectRoutes(ctx context.Context, in *SelectRoutesRequest, opts ...grpc.CallOption) (*SelectRoutesResponse, error)
 	// Deselect specific routes
 	DeselectRoutes(ctx context.Context, in *SelectRoutesRequest, opts ...grpc.CallOption) (*SelectRoutesResponse, error)
+	// List available exit nodes, i.e. default routes, with their routing peers
+	ListExitNodes(ctx context.Context, in *ListExitNodesRequest, opts ...grpc.CallOption) (*ListExitNodesResponse, error)
+	// Use a single exit node for the default routes, the choice is persisted in the configuration
+	SelectExitNode(ctx context.Context, in *SelectExitNodeRequest, opts ...grpc.CallOption) (*SelectExitNodeResponse, error)
+	// Stop routing traffic through any exit node
+	DeselectExitNode(ctx context.Context, in *DeselectExitNodeRequest, opts ...grpc.CallOption) (*DeselectExitNodeResponse, error)
 	// DebugBundle creates a debug bundle
 	DebugBundle(ctx context.Context, in *DebugBundleRequest, opts ...grpc.CallOption) (*DebugBundleResponse, error)
 	// GetLogLevel gets the log level of the daemon
 	GetLogLevel(ctx context.Context, in *GetLogLevelRequest, opts ...grpc.CallOption) (*GetLogLevelResponse, error)
 	// SetLogLevel sets the log level of the daemon
 	SetLogLevel(ctx context.Context, in *SetLogLevelRequest, opts ...grpc.CallOption) (*SetLogLevelResponse, error)
+	// DebugPeer returns how the connection to a peer is established, with follow it streams the updates
+	DebugPeer(ctx context.Context, in *DebugPeerRequest, opts ...grpc.CallOption) (DaemonService_DebugPeerClient, error)
+	// TestPeer measures the latency and the throughput of the connection to a peer in both directions
+	TestPeer(ctx context.Context, in *TestPeerRequest, opts ...grpc.CallOption) (*TestPeerResponse, error)
+	// SubscribeEvents streams the events of the client, e.g. a peer connected or a route failed over
+	SubscribeEvents(ctx context.Context, in *SubscribeEventsRequest, opts ...grpc.CallOption) (DaemonService_SubscribeEventsClient, error)
+	// FlushDNSCache removes the upstream responses cached by the DNS server
+	FlushDNSCache(ctx context.Context, in *FlushDNSCacheRequest, opts ...grpc.CallOption) (*FlushDNSCacheResponse, error)
+	// GetDNSQueryLog returns the last queries answered by the DNS server and the statistics per domain
+	GetDNSQueryLog(ctx context.Context, in *GetDNSQueryLogRequest, opts ...grpc.CallOption) (*GetDNSQueryLogResponse, error)
 }
 
 type daemonServiceClient struct {
@@ -134,6 +150,33 @@ func (c *daemonServiceClient) DeselectRoutes(ctx context.Context, in *SelectRout
 	return out, nil
 }
 
+func (c *daemonServiceClient) ListExitNodes(ctx context.Context, in *ListExitNodesRequest, opts ...grpc.CallOption) (*ListExitNodesResponse, error) {
+	out := new(ListExitNodesResponse)
+	err := c.cc.Invoke(ctx, "/daemon.DaemonService/ListExitNodes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonServiceClient) SelectExitNode(ctx context.Context, in *SelectExitNodeRequest, opts ...grpc.CallOption) (*SelectExitNodeResponse, error) {
+	out := new(SelectExitNodeResponse)
+	err := c.cc.Invoke(ctx, "/daemon.DaemonService/SelectExitNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonServiceClient) DeselectExitNode(ctx context.Context, in *DeselectExitNodeRequest, opts ...grpc.CallOption) (*DeselectExitNodeResponse, error) {
+	out := new(DeselectExitNodeResponse)
+	err := c.cc.Invoke(ctx, "/daemon.DaemonService/DeselectExitNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *daemonServiceClient) DebugBundle(ctx context.Context, in *DebugBundleRequest, opts ...grpc.CallOption) (*DebugBundleResponse, error) {
 	out := new(DebugBundleResponse)
 	err := c.cc.Invoke(ctx, "/daemon.DaemonService/DebugBundle", in, out, opts...)
@@ -161,6 +204,97 @@ func (c *daemonServiceClient) SetLogLevel(ctx context.Context, in *SetLogLevelRe
 	return out, nil
 }
 
+func (c *daemonServiceClient) DebugPeer(ctx context.Context, in *DebugPeerRequest, opts ...grpc.CallOption) (DaemonService_DebugPeerClient, error) {
+	stream, err := c.cc.NewStream(ctx, &DaemonService_ServiceDesc.Streams[0], "/daemon.DaemonService/DebugPeer", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &daemonServiceDebugPeerClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DaemonService_DebugPeerClient interface {
+	Recv() (*DebugPeerResponse, error)
+	grpc.ClientStream
+}
+
+type daemonServiceDebugPeerClient struct {
+	grpc.ClientStream
+}
+
+func (x *daemonServiceDebugPeerClient) Recv() (*DebugPeerResponse, error) {
+	m := new(DebugPeerResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *daemonServiceClient) TestPeer(ctx context.Context, in *TestPeerRequest, opts ...grpc.CallOption) (*TestPeerResponse, error) {
+	out := new(TestPeerResponse)
+	err := c.cc.Invoke(ctx, "/daemon.DaemonService/TestPeer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonServiceClient) SubscribeEvents(ctx context.Context, in *SubscribeEventsRequest, opts ...grpc.CallOption) (DaemonService_SubscribeEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &DaemonService_ServiceDesc.Streams[1], "/daemon.DaemonService/SubscribeEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &daemonServiceSubscribeEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DaemonService_SubscribeEventsClient interface {
+	Recv() (*SystemEvent, error)
+	grpc.ClientStream
+}
+
+type daemonServiceSubscribeEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *daemonServiceSubscribeEventsClient) Recv() (*SystemEvent, error) {
+	m := new(SystemEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *daemonServiceClient) FlushDNSCache(ctx context.Context, in *FlushDNSCacheRequest, opts ...grpc.CallOption) (*FlushDNSCacheResponse, error) {
+	out := new(FlushDNSCacheResponse)
+	err := c.cc.Invoke(ctx, "/daemon.DaemonService/FlushDNSCache", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonServiceClient) GetDNSQueryLog(ctx context.Context, in *GetDNSQueryLogRequest, opts ...grpc.CallOption) (*GetDNSQueryLogResponse, error) {
+	out := new(GetDNSQueryLogResponse)
+	err := c.cc.Invoke(ctx, "/daemon.DaemonService/GetDNSQueryLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DaemonServiceServer is the server API for DaemonService service.
 // All implementations must embed UnimplementedDaemonServiceServer
 // for forward compatibility
@@ -184,12 +318,28 @@ type DaemonServiceServer interface {
 	SelectRoutes(context.Context, *SelectRoutesRequest) (*SelectRoutesResponse, error)
 	// Deselect specific routes
 	DeselectRoutes(context.Context, *SelectRoutesRequest) (*SelectRoutesResponse, error)
+	// List available exit nodes, i.e. default routes, with their routing peers
+	ListExitNodes(context.Context, *ListExitNodesRequest) (*ListExitNodesResponse, error)
+	// Use a single exit node for the default routes, the choice is persisted in the configuration
+	SelectExitNode(context.Context, *SelectExitNodeRequest) (*SelectExitNodeResponse, error)
+	// Stop routing traffic through any exit node
+	DeselectExitNode(context.Context, *DeselectExitNodeRequest) (*DeselectExitNodeResponse, error)
 	// DebugBundle creates a debug bundle
 	DebugBundle(context.Context, *DebugBundleRequest) (*DebugBundleResponse, error)
 	// GetLogLevel gets the log level of the daemon
 	GetLogLevel(context.Context, *GetLogLevelRequest) (*GetLogLevelResponse, error)
 	// SetLogLevel sets the log level of the daemon
 	SetLogLevel(context.Context, *SetLogLevelRequest) (*SetLogLevelResponse, error)
+	// DebugPeer returns how the connection to a peer is established, with follow it streams the updates
+	DebugPeer(*DebugPeerRequest, DaemonService_DebugPeerServer) error
+	// TestPeer measures the latency and the throughput of the connection to a peer in both directions
+	TestPeer(context.Context, *TestPeerRequest) (*TestPeerResponse, error)
+	// SubscribeEvents streams the events of the client, e.g. a peer connected or a route failed over
+	SubscribeEvents(*SubscribeEventsRequest, DaemonService_SubscribeEventsServer) error
+	// FlushDNSCache removes the upstream responses cached by the DNS server
+	FlushDNSCache(context.Context, *FlushDNSCacheRequest) (*FlushDNSCacheResponse, error)
+	// GetDNSQueryLog returns the last queries answered by the DNS server and the statistics per domain
+	GetDNSQueryLog(context.Context, *GetDNSQueryLogRequest) (*GetDNSQueryLogResponse, error)
 	mustEmbedUnimplementedDaemonServiceServer()
 }
 
@@ -224,6 +374,15 @@ func (UnimplementedDaemonServiceServer) SelectRoutes(context.Context, *SelectRou
 func (UnimplementedDaemonServiceServer) DeselectRoutes(context.Context, *SelectRoutesRequest) (*SelectRoutesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeselectRoutes not implemented")
 }
+func (UnimplementedDaemonServiceServer) ListExitNodes(context.Context, *ListExitNodesRequest) (*ListExitNodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExitNodes not implemented")
+}
+func (UnimplementedDaemonServiceServer) SelectExitNode(context.Context, *SelectExitNodeRequest) (*SelectExitNodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SelectExitNode not implemented")
+}
+func (UnimplementedDaemonServiceServer) DeselectExitNode(context.Context, *DeselectExitNodeRequest) (*DeselectExitNodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeselectExitNode not implemented")
+}
 func (UnimplementedDaemonServiceServer) DebugBundle(context.Context, *DebugBundleRequest) (*DebugBundleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DebugBundle not implemented")
 }
@@ -233,6 +392,21 @@ func (UnimplementedDaemonServiceServer) GetLogLevel(context.Context, *GetLogLeve
 func (UnimplementedDaemonServiceServer) SetLogLevel(context.Context, *SetLogLevelRequest) (*SetLogLevelResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetLogLevel not implemented")
 }
+func (UnimplementedDaemonServiceServer) DebugPeer(*DebugPeerRequest, DaemonService_DebugPeerServer) error {
+	return status.Errorf(codes.Unimplemented, "method DebugPeer not implemented")
+}
+func (UnimplementedDaemonServiceServer) TestPeer(context.Context, *TestPeerRequest) (*TestPeerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TestPeer not implemented")
+}
+func (UnimplementedDaemonServiceServer) SubscribeEvents(*SubscribeEventsRequest, DaemonService_SubscribeEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeEvents not implemented")
+}
+func (UnimplementedDaemonServiceServer) FlushDNSCache(context.Context, *FlushDNSCacheRequest) (*FlushDNSCacheResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlushDNSCache not implemented")
+}
+func (UnimplementedDaemonServiceServer) GetDNSQueryLog(context.Context, *GetDNSQueryLogRequest) (*GetDNSQueryLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDNSQueryLog not implemented")
+}
 func (UnimplementedDaemonServiceServer) mustEmbedUnimplementedDaemonServiceServer() {}
 
 // UnsafeDaemonServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -408,6 +582,60 @@ func _DaemonService_DeselectRoutes_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DaemonService_ListExitNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExitNodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).ListExitNodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/daemon.DaemonService/ListExitNodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).ListExitNodes(ctx, req.(*ListExitNodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaemonService_SelectExitNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SelectExitNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).SelectExitNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/daemon.DaemonService/SelectExitNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).SelectExitNode(ctx, req.(*SelectExitNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaemonService_DeselectExitNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeselectExitNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).DeselectExitNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/daemon.DaemonService/DeselectExitNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).DeselectExitNode(ctx, req.(*DeselectExitNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DaemonService_DebugBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DebugBundleRequest)
 	if err := dec(in); err != nil {
@@ -462,6 +690,102 @@ func _DaemonService_SetLogLevel_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DaemonService_DebugPeer_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DebugPeerRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DaemonServiceServer).DebugPeer(m, &daemonServiceDebugPeerServer{stream})
+}
+
+type DaemonService_DebugPeerServer interface {
+	Send(*DebugPeerResponse) error
+	grpc.ServerStream
+}
+
+type daemonServiceDebugPeerServer struct {
+	grpc.ServerStream
+}
+
+func (x *daemonServiceDebugPeerServer) Send(m *DebugPeerResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _DaemonService_TestPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).TestPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/daemon.DaemonService/TestPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).TestPeer(ctx, req.(*TestPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaemonService_SubscribeEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DaemonServiceServer).SubscribeEvents(m, &daemonServiceSubscribeEventsServer{stream})
+}
+
+type DaemonService_SubscribeEventsServer interface {
+	Send(*SystemEvent) error
+	grpc.ServerStream
+}
+
+type daemonServiceSubscribeEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *daemonServiceSubscribeEventsServer) Send(m *SystemEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _DaemonService_FlushDNSCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlushDNSCacheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).FlushDNSCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/daemon.DaemonService/FlushDNSCache",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).FlushDNSCache(ctx, req.(*FlushDNSCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaemonService_GetDNSQueryLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDNSQueryLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).GetDNSQueryLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/daemon.DaemonService/GetDNSQueryLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).GetDNSQueryLog(ctx, req.(*GetDNSQueryLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DaemonService_ServiceDesc is the grpc.ServiceDesc for DaemonService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -505,6 +829,18 @@ var DaemonService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeselectRoutes",
 			Handler:    _DaemonService_DeselectRoutes_Handler,
 		},
+		{
+			MethodName: "ListExitNodes",
+			Handler:    _DaemonService_ListExitNodes_Handler,
+		},
+		{
+			MethodName: "SelectExitNode",
+			Handler:    _DaemonService_SelectExitNode_Handler,
+		},
+		{
+			MethodName: "DeselectExitNode",
+			Handler:    _DaemonService_DeselectExitNode_Handler,
+		},
 		{
 			MethodName: "DebugBundle",
 			Handler:    _DaemonService_DebugBundle_Handler,
@@ -517,7 +853,30 @@ var DaemonService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetLogLevel",
 			Handler:    _DaemonService_SetLogLevel_Handler,
 		},
+		{
+			MethodName: "TestPeer",
+			Handler:    _DaemonService_TestPeer_Handler,
+		},
+		{
+			MethodName: "FlushDNSCache",
+			Handler:    _DaemonService_FlushDNSCache_Handler,
+		},
+		{
+			MethodName: "GetDNSQueryLog",
+			Handler:    _DaemonService_GetDNSQueryLog_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "DebugPeer",
+			Handler:       _DaemonService_DebugPeer_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeEvents",
+			Handler:       _DaemonService_SubscribeEvents_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "daemon.proto",
 }
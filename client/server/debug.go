@@ -31,6 +31,7 @@ client.log: Most recent, anonymized log file of the NetBird client.
 routes.txt: Anonymized system routes, if --system-info flag was provided.
 interfaces.txt: Anonymized network interface information, if --system-info flag was provided.
 config.txt: Anonymized configuration information of the NetBird client.
+dns_queries.txt: Anonymized last queries answered by the NetBird DNS server and their statistics per domain, if the DNS query log is enabled.
 
 
 Anonymization Process
@@ -70,6 +71,9 @@ The config.txt file contains anonymized configuration information of the NetBird
 - CustomDNSAddress
 
 Other non-sensitive configuration options are included without anonymization.
+
+DNS Queries
+The queried domains, the matched zones and the upstream nameservers in the dns_queries.txt file are anonymized as described above.
 `
 
 // DebugBundle creates a debug bundle and returns the location.
@@ -122,6 +126,10 @@ func (s *Server) createArchive(bundlePath *os.File, req *proto.DebugBundleReques
 		return fmt.Errorf("add config: %w", err)
 	}
 
+	if err := s.addDNSQueryLog(req, anonymizer, archive); err != nil {
+		return fmt.Errorf("add DNS query log: %w", err)
+	}
+
 	if req.GetSystemInfo() {
 		if err := s.addRoutes(req, anonymizer, archive); err != nil {
 			return fmt.Errorf("add routes: %w", err)
@@ -217,6 +225,37 @@ func (s *Server) addCommonConfigFields(configContent *strings.Builder) {
 	}
 	configContent.WriteString(fmt.Sprintf("DisableAutoConnect: %v\n", s.config.DisableAutoConnect))
 	configContent.WriteString(fmt.Sprintf("DNSRouteInterval: %s\n", s.config.DNSRouteInterval))
+	configContent.WriteString(fmt.Sprintf("DNSQueryLog: %v\n", s.config.DNSQueryLog))
+	configContent.WriteString(fmt.Sprintf("PeerTestResponder: %v\n", s.config.PeerTestResponder))
+}
+
+func (s *Server) addDNSQueryLog(req *proto.DebugBundleRequest, anonymizer *anonymize.Anonymizer, archive *zip.Writer) error {
+	if s.connectClient == nil || s.connectClient.Engine() == nil {
+		return nil
+	}
+
+	queryLog, err := s.connectClient.Engine().DNSQueryLog()
+	if err != nil || !queryLog.Enabled() {
+		return nil
+	}
+
+	if !req.GetAnonymize() {
+		anonymizer = nil
+	}
+	resp := toDNSQueryLogResponse(queryLog, anonymizer)
+
+	var content strings.Builder
+	content.WriteString("Queries:\n")
+	for _, entry := range resp.GetEntries() {
+		content.WriteString(entry + "\n")
+	}
+	content.WriteString("\nStatistics:\n")
+	content.WriteString(formatDNSQueryStats(resp.GetStats()))
+
+	if err := addFileToZip(archive, strings.NewReader(content.String()), "dns_queries.txt"); err != nil {
+		return fmt.Errorf("add DNS query log file to zip: %w", err)
+	}
+	return nil
 }
 
 func (s *Server) addRoutes(req *proto.DebugBundleRequest, anonymizer *anonymize.Anonymizer, archive *zip.Writer) error {
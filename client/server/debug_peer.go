@@ -0,0 +1,180 @@
+package server
+
+import (
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	gstatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/netbirdio/netbird/client/internal"
+	"github.com/netbirdio/netbird/client/internal/peer"
+	"github.com/netbirdio/netbird/client/proto"
+)
+
+// debugPeerInterval is how often the WireGuard handshake and transfer counters are refreshed when following a peer
+const debugPeerInterval = 2 * time.Second
+
+// DebugPeer returns how the connection to a peer is established: the ICE candidates and pairs, the relay server and
+// the WireGuard stats. With follow it streams the state transitions of the connection until the client disconnects.
+func (s *Server) DebugPeer(req *proto.DebugPeerRequest, stream proto.DaemonService_DebugPeerServer) error {
+	engine, statusRecorder, err := s.connectedEngine()
+	if err != nil {
+		return err
+	}
+
+	pubKey, err := findPeer(statusRecorder, req.GetPeer())
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(debugPeerInterval)
+	defer ticker.Stop()
+
+	var lastEvent uint64
+	for {
+		conn, ok := engine.GetPeerConn(pubKey)
+		if !ok {
+			return gstatus.Errorf(codes.NotFound, "peer %s is no longer part of the network", req.GetPeer())
+		}
+
+		state, err := statusRecorder.GetPeer(pubKey)
+		if err != nil {
+			return gstatus.Errorf(codes.NotFound, "peer %s: %v", req.GetPeer(), err)
+		}
+
+		diag := conn.Diagnostics()
+		resp := toProtoDebugPeerResponse(state, diag, lastEvent)
+		if n := len(diag.Events); n > 0 {
+			lastEvent = diag.Events[n-1].Seq
+		}
+
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+
+		if !req.GetFollow() {
+			return nil
+		}
+
+		// a transition recorded after the diagnostics were taken is sent right away
+		if newEvents, eventsChanged := conn.Events(lastEvent); len(newEvents) == 0 {
+			select {
+			case <-stream.Context().Done():
+				return nil
+			case <-eventsChanged:
+			case <-statusRecorder.GetPeerStateChangeNotifier(pubKey):
+			case <-ticker.C:
+			}
+		}
+
+		if engine, statusRecorder, err = s.connectedEngine(); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *Server) connectedEngine() (*internal.Engine, *peer.Status, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.connectClient == nil || s.statusRecorder == nil {
+		return nil, nil, gstatus.Errorf(codes.FailedPrecondition, "not connected")
+	}
+
+	engine := s.connectClient.Engine()
+	if engine == nil {
+		return nil, nil, gstatus.Errorf(codes.FailedPrecondition, "not connected")
+	}
+	return engine, s.statusRecorder, nil
+}
+
+// findPeer returns the public key of the peer matching the name. The name is the FQDN, the hostname, the IP or the
+// public key of the peer.
+func findPeer(statusRecorder *peer.Status, name string) (string, error) {
+	if name == "" {
+		return "", gstatus.Errorf(codes.InvalidArgument, "peer name is required")
+	}
+	domainName := strings.TrimSuffix(strings.ToLower(name), ".")
+
+	var matches []string
+	for _, state := range statusRecorder.GetFullStatus().Peers {
+		fqdn := strings.TrimSuffix(strings.ToLower(state.FQDN), ".")
+		hostname, _, _ := strings.Cut(fqdn, ".")
+		switch {
+		case state.PubKey == name, state.IP == name, fqdn == domainName:
+			return state.PubKey, nil
+		case hostname == domainName:
+			matches = append(matches, state.PubKey)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return "", gstatus.Errorf(codes.NotFound, "peer %s not found", name)
+	case 1:
+		return matches[0], nil
+	default:
+		return "", gstatus.Errorf(codes.InvalidArgument, "hostname %s matches %d peers, use the FQDN", name, len(matches))
+	}
+}
+
+func toProtoDebugPeerResponse(state peer.State, diag peer.ConnDiagnostics, lastEvent uint64) *proto.DebugPeerResponse {
+	// the status recorder refreshes the WireGuard stats only on a status request
+	if !diag.WireGuard.LastHandshake.IsZero() {
+		state.LastWireguardHandshake = diag.WireGuard.LastHandshake
+		state.BytesRx = diag.WireGuard.RxBytes
+		state.BytesTx = diag.WireGuard.TxBytes
+	}
+
+	resp := &proto.DebugPeerResponse{
+		State: toProtoPeerState(state),
+		Ice: &proto.ICEDiagnostics{
+			AgentState: diag.ICE.AgentState,
+			Error:      diag.ICE.Error,
+		},
+	}
+	if !diag.ICE.StartedAt.IsZero() {
+		resp.Ice.StartedAt = timestamppb.New(diag.ICE.StartedAt)
+	}
+
+	for _, c := range diag.ICE.LocalCandidates {
+		resp.Ice.LocalCandidates = append(resp.Ice.LocalCandidates, toProtoICECandidate(c))
+	}
+	for _, c := range diag.ICE.RemoteCandidates {
+		resp.Ice.RemoteCandidates = append(resp.Ice.RemoteCandidates, toProtoICECandidate(c))
+	}
+	for _, pair := range diag.ICE.Pairs {
+		resp.Ice.Pairs = append(resp.Ice.Pairs, &proto.ICECandidatePair{
+			Local:         toProtoICECandidate(pair.Local),
+			Remote:        toProtoICECandidate(pair.Remote),
+			State:         pair.State,
+			Nominated:     pair.Nominated,
+			Selected:      pair.Selected,
+			FailureReason: pair.FailureReason,
+		})
+	}
+
+	for _, event := range diag.Events {
+		if event.Seq <= lastEvent {
+			continue
+		}
+		resp.Events = append(resp.Events, &proto.ConnEvent{
+			Timestamp: timestamppb.New(event.Time),
+			Message:   event.Message,
+		})
+	}
+	return resp
+}
+
+func toProtoICECandidate(c peer.ICECandidateInfo) *proto.ICECandidate {
+	return &proto.ICECandidate{
+		Type:          c.Type,
+		Network:       c.Network,
+		Address:       c.Address,
+		Port:          int32(c.Port),
+		RelayProtocol: c.RelayProtocol,
+		IgnoredReason: c.IgnoredReason,
+	}
+}
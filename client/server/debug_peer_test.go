@@ -0,0 +1,49 @@
+package server
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	gstatus "google.golang.org/grpc/status"
+
+	"github.com/netbirdio/netbird/client/internal/peer"
+)
+
+func TestFindPeer(t *testing.T) {
+	recorder := peer.NewRecorder("https://api.netbird.io")
+	peers := map[string]string{
+		"Zm9vYmFyYmF6cXV4Cg==": "peer-a.netbird.cloud",
+		"YmF6cXV4Zm9vYmFyCg==": "peer-b.netbird.cloud",
+		"cXV4Zm9vYmFyYmF6Cg==": "peer-b.other.cloud",
+	}
+	for pubKey, fqdn := range peers {
+		require.NoError(t, recorder.AddPeer(pubKey, fqdn))
+	}
+	require.NoError(t, recorder.UpdatePeerState(peer.State{PubKey: "Zm9vYmFyYmF6cXV4Cg==", IP: "100.64.0.10", Mux: new(sync.RWMutex)}))
+
+	tests := []struct {
+		name     string
+		peer     string
+		expected string
+		code     codes.Code
+	}{
+		{"fqdn", "peer-a.netbird.cloud", "Zm9vYmFyYmF6cXV4Cg==", codes.OK},
+		{"fqdn with trailing dot and upper case", "Peer-A.netbird.cloud.", "Zm9vYmFyYmF6cXV4Cg==", codes.OK},
+		{"hostname", "peer-a", "Zm9vYmFyYmF6cXV4Cg==", codes.OK},
+		{"ip", "100.64.0.10", "Zm9vYmFyYmF6cXV4Cg==", codes.OK},
+		{"public key", "YmF6cXV4Zm9vYmFyCg==", "YmF6cXV4Zm9vYmFyCg==", codes.OK},
+		{"ambiguous hostname", "peer-b", "", codes.InvalidArgument},
+		{"unknown", "peer-c", "", codes.NotFound},
+		{"empty", "", "", codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pubKey, err := findPeer(recorder, tt.peer)
+			assert.Equal(t, tt.code, gstatus.Code(err))
+			assert.Equal(t, tt.expected, pubKey)
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	gstatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/netbirdio/netbird/client/anonymize"
+	"github.com/netbirdio/netbird/client/internal/dns"
+	"github.com/netbirdio/netbird/client/proto"
+)
+
+// FlushDNSCache removes the upstream responses cached by the DNS server of the engine
+func (s *Server) FlushDNSCache(context.Context, *proto.FlushDNSCacheRequest) (*proto.FlushDNSCacheResponse, error) {
+	engine, _, err := s.connectedEngine()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := engine.FlushDNSCache(); err != nil {
+		return nil, gstatus.Errorf(codes.FailedPrecondition, "flush DNS cache: %v", err)
+	}
+
+	return &proto.FlushDNSCacheResponse{}, nil
+}
+
+// GetDNSQueryLog returns the queries recorded by the DNS server of the engine
+func (s *Server) GetDNSQueryLog(_ context.Context, req *proto.GetDNSQueryLogRequest) (*proto.GetDNSQueryLogResponse, error) {
+	engine, _, err := s.connectedEngine()
+	if err != nil {
+		return nil, err
+	}
+
+	queryLog, err := engine.DNSQueryLog()
+	if err != nil {
+		return nil, gstatus.Errorf(codes.FailedPrecondition, "get DNS query log: %v", err)
+	}
+
+	var anonymizer *anonymize.Anonymizer
+	if req.GetAnonymize() {
+		anonymizer = anonymize.NewAnonymizer(anonymize.DefaultAddresses())
+	}
+
+	return toDNSQueryLogResponse(queryLog, anonymizer), nil
+}
+
+// toDNSQueryLogResponse converts the query log, the domains and addresses are anonymized when an anonymizer is given.
+// The entries and the statistics share the anonymizer, so a domain is anonymized the same way in both.
+func toDNSQueryLogResponse(queryLog *dns.QueryLog, anonymizer *anonymize.Anonymizer) *proto.GetDNSQueryLogResponse {
+	entries := queryLog.Entries()
+	resp := &proto.GetDNSQueryLogResponse{
+		Enabled: queryLog.Enabled(),
+		Entries: make([]string, 0, len(entries)),
+	}
+
+	for _, entry := range entries {
+		if anonymizer != nil {
+			entry = anonymizeQueryLogEntry(anonymizer, entry)
+		}
+		resp.Entries = append(resp.Entries, entry.String())
+	}
+
+	for _, stats := range dns.DomainQueryStats(entries) {
+		domain := stats.Domain
+		if anonymizer != nil {
+			domain = anonymizeDNSName(anonymizer, domain)
+		}
+		resp.Stats = append(resp.Stats, &proto.DNSDomainStats{
+			Domain:     domain,
+			Queries:    int64(stats.Queries),
+			Nxdomain:   int64(stats.NXDomain),
+			Failures:   int64(stats.Failures),
+			AvgLatency: durationpb.New(stats.AvgLatency),
+			LastQuery:  timestamppb.New(stats.LastQuery),
+		})
+	}
+
+	return resp
+}
+
+// anonymizeQueryLogEntry anonymizes the name of the question, the zone and the address of the upstream of an entry
+func anonymizeQueryLogEntry(anonymizer *anonymize.Anonymizer, entry dns.QueryLogEntry) dns.QueryLogEntry {
+	entry.Question.Name = anonymizeDNSName(anonymizer, entry.Question.Name)
+	entry.Zone = anonymizeDNSName(anonymizer, entry.Zone)
+	if upstream, err := netip.ParseAddrPort(entry.Upstream); err == nil {
+		entry.Upstream = netip.AddrPortFrom(anonymizer.AnonymizeIP(upstream.Addr()), upstream.Port()).String()
+	}
+	return entry
+}
+
+// anonymizeDNSName anonymizes a fully qualified name, the root zone is kept
+func anonymizeDNSName(anonymizer *anonymize.Anonymizer, name string) string {
+	trimmed := strings.TrimSuffix(name, ".")
+	if trimmed == "" {
+		return name
+	}
+	anonymized := anonymizer.AnonymizeDomain(trimmed)
+	if strings.HasSuffix(name, ".") {
+		anonymized += "."
+	}
+	return anonymized
+}
+
+// formatDNSQueryStats formats the statistics per domain as a table
+func formatDNSQueryStats(stats []*proto.DNSDomainStats) string {
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf("%-50s %8s %8s %8s %12s\n", "DOMAIN", "QUERIES", "NXDOMAIN", "FAILURES", "AVG LATENCY"))
+	for _, domainStats := range stats {
+		builder.WriteString(fmt.Sprintf("%-50s %8d %8d %8d %12s\n",
+			domainStats.GetDomain(),
+			domainStats.GetQueries(),
+			domainStats.GetNxdomain(),
+			domainStats.GetFailures(),
+			domainStats.GetAvgLatency().AsDuration(),
+		))
+	}
+	return builder.String()
+}
@@ -0,0 +1,34 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/netbirdio/netbird/client/anonymize"
+	nbdns "github.com/netbirdio/netbird/client/internal/dns"
+)
+
+func TestAnonymizeQueryLogEntry(t *testing.T) {
+	anonymizer := anonymize.NewAnonymizer(anonymize.DefaultAddresses())
+	entry := nbdns.QueryLogEntry{
+		Question: dns.Question{Name: "www.example.com.", Qtype: dns.TypeA, Qclass: dns.ClassINET},
+		Handler:  nbdns.HandlerNameserverGroup,
+		Zone:     "example.com.",
+		Upstream: "203.0.113.1:53",
+	}
+
+	anonymized := anonymizeQueryLogEntry(anonymizer, entry)
+
+	// the statistics anonymize the domains with the same anonymizer
+	assert.Equal(t, anonymizeDNSName(anonymizer, "www.example.com."), anonymized.Question.Name)
+	assert.Equal(t, anonymizeDNSName(anonymizer, "example.com."), anonymized.Zone)
+	assert.Regexp(t, `^anon-[a-zA-Z0-9]+\.domain\.$`, anonymized.Zone)
+	assert.NotContains(t, anonymized.String(), "example.com")
+	assert.NotContains(t, anonymized.String(), "203.0.113.1")
+	assert.Equal(t, "www.example.com.", entry.Question.Name, "the entry of the log should not be modified")
+
+	entry.Upstream = nbdns.UpstreamCache
+	assert.Equal(t, nbdns.UpstreamCache, anonymizeQueryLogEntry(anonymizer, entry).Upstream)
+}
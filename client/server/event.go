@@ -0,0 +1,98 @@
+package server
+
+import (
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/netbirdio/netbird/client/internal/peer"
+	"github.com/netbirdio/netbird/client/proto"
+)
+
+// SubscribeEvents streams the events of the client until the subscriber disconnects
+func (s *Server) SubscribeEvents(_ *proto.SubscribeEventsRequest, stream proto.DaemonService_SubscribeEventsServer) error {
+	statusRecorder := s.eventStatusRecorder()
+
+	sub := statusRecorder.SubscribeToEvents()
+	defer statusRecorder.UnsubscribeFromEvents(sub)
+
+	log.Debug("client subscribed to events")
+	for {
+		select {
+		case event, ok := <-sub.Events():
+			if !ok {
+				return nil
+			}
+			if err := stream.Send(toProtoSystemEvent(event)); err != nil {
+				log.Debugf("failed sending event to subscriber: %v", err)
+				return err
+			}
+		case <-stream.Context().Done():
+			log.Debug("client unsubscribed from events")
+			return nil
+		}
+	}
+}
+
+// eventStatusRecorder returns the status recorder the events are published on, it outlives the engine
+func (s *Server) eventStatusRecorder() *peer.Status {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.statusRecorder == nil {
+		var mgmAddress string
+		if s.config != nil && s.config.ManagementURL != nil {
+			mgmAddress = s.config.ManagementURL.String()
+		}
+		s.statusRecorder = peer.NewRecorder(mgmAddress)
+	}
+	return s.statusRecorder
+}
+
+func toProtoSystemEvent(event peer.Event) *proto.SystemEvent {
+	return &proto.SystemEvent{
+		Id:        event.ID,
+		Type:      toProtoEventType(event.Type),
+		Severity:  toProtoEventSeverity(event.Severity),
+		Message:   event.Message,
+		Timestamp: timestamppb.New(event.Time),
+		Metadata:  event.Metadata,
+	}
+}
+
+func toProtoEventType(eventType peer.EventType) proto.SystemEvent_Type {
+	switch eventType {
+	case peer.EventPeerConnected:
+		return proto.SystemEvent_PEER_CONNECTED
+	case peer.EventPeerDisconnected:
+		return proto.SystemEvent_PEER_DISCONNECTED
+	case peer.EventRouteActivated:
+		return proto.SystemEvent_ROUTE_ACTIVATED
+	case peer.EventRouteFailedOver:
+		return proto.SystemEvent_ROUTE_FAILED_OVER
+	case peer.EventRouteDeactivated:
+		return proto.SystemEvent_ROUTE_DEACTIVATED
+	case peer.EventDNSUpstreamDisabled:
+		return proto.SystemEvent_DNS_UPSTREAM_DISABLED
+	case peer.EventLoginExpired:
+		return proto.SystemEvent_LOGIN_EXPIRED
+	case peer.EventManagementDisconnected:
+		return proto.SystemEvent_MANAGEMENT_DISCONNECTED
+	case peer.EventLoginExpiring:
+		return proto.SystemEvent_LOGIN_EXPIRING
+	case peer.EventLoginRenewed:
+		return proto.SystemEvent_LOGIN_RENEWED
+	default:
+		return proto.SystemEvent_UNKNOWN
+	}
+}
+
+func toProtoEventSeverity(severity peer.EventSeverity) proto.SystemEvent_Severity {
+	switch severity {
+	case peer.EventSeverityWarning:
+		return proto.SystemEvent_WARNING
+	case peer.EventSeverityError:
+		return proto.SystemEvent_ERROR
+	default:
+		return proto.SystemEvent_INFO
+	}
+}
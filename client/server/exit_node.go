@@ -0,0 +1,187 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/netbirdio/netbird/client/internal"
+	"github.com/netbirdio/netbird/client/internal/peer"
+	"github.com/netbirdio/netbird/client/internal/routeselector"
+	"github.com/netbirdio/netbird/client/internal/statefile"
+	"github.com/netbirdio/netbird/client/proto"
+	"github.com/netbirdio/netbird/route"
+)
+
+// ListExitNodes returns a list of the available exit nodes with their routing peers.
+func (s *Server) ListExitNodes(context.Context, *proto.ListExitNodesRequest) (*proto.ListExitNodesResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.connectClient == nil {
+		return nil, fmt.Errorf("not connected")
+	}
+
+	engine := s.connectClient.Engine()
+	if engine == nil {
+		return nil, fmt.Errorf("not connected")
+	}
+
+	routeSelector := engine.GetRouteManager().GetRouteSelector()
+	exitNodes := exitNodeRoutes(engine.GetClientRoutesWithNetID())
+
+	ids := make([]route.NetID, 0, len(exitNodes))
+	for id := range exitNodes {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	var pbExitNodes []*proto.ExitNode
+	for _, id := range ids {
+		routes := exitNodes[id]
+		pbExitNode := &proto.ExitNode{
+			ID:      string(id),
+			Network: routes[0].Network.String(),
+		}
+
+		for _, r := range routes {
+			pbPeer := &proto.ExitNodePeer{
+				PubKey:   r.Peer,
+				Selected: routeSelector.IsExitNodeSelected(id, r.Peer),
+			}
+			if state, err := s.statusRecorder.GetPeer(r.Peer); err == nil {
+				pbPeer.Fqdn = state.FQDN
+				pbPeer.IP = state.IP
+				pbPeer.Connected = state.ConnStatus == peer.StatusConnected
+				pbPeer.Latency = durationpb.New(state.Latency)
+			}
+			pbExitNode.Selected = pbExitNode.Selected || pbPeer.Selected
+			pbExitNode.Peers = append(pbExitNode.Peers, pbPeer)
+		}
+
+		sort.Slice(pbExitNode.Peers, func(i, j int) bool {
+			return pbExitNode.Peers[i].Fqdn < pbExitNode.Peers[j].Fqdn
+		})
+		pbExitNodes = append(pbExitNodes, pbExitNode)
+	}
+
+	exitNode := routeSelector.GetExitNode()
+	return &proto.ListExitNodesResponse{
+		ExitNodes: pbExitNodes,
+		Disabled:  exitNode != nil && exitNode.NetID == "",
+	}, nil
+}
+
+// SelectExitNode makes the given default route the only exit node, optionally pinned to one of its routing peers.
+func (s *Server) SelectExitNode(_ context.Context, req *proto.SelectExitNodeRequest) (*proto.SelectExitNodeResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.connectClient == nil {
+		return nil, fmt.Errorf("not connected")
+	}
+
+	engine := s.connectClient.Engine()
+	if engine == nil {
+		return nil, fmt.Errorf("not connected")
+	}
+
+	id := route.NetID(req.GetID())
+	routes, ok := exitNodeRoutes(engine.GetClientRoutesWithNetID())[id]
+	if !ok {
+		return nil, fmt.Errorf("exit node '%s' is not available", id)
+	}
+
+	exitNode := &routeselector.ExitNode{NetID: id}
+	if req.GetPeer() != "" {
+		peerKey, err := s.findExitNodePeer(routes, req.GetPeer())
+		if err != nil {
+			return nil, err
+		}
+		exitNode.Peer = peerKey
+	}
+
+	if err := s.setExitNode(exitNode); err != nil {
+		return nil, err
+	}
+
+	return &proto.SelectExitNodeResponse{}, nil
+}
+
+// DeselectExitNode stops routing traffic through any exit node.
+func (s *Server) DeselectExitNode(context.Context, *proto.DeselectExitNodeRequest) (*proto.DeselectExitNodeResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if err := s.setExitNode(&routeselector.ExitNode{}); err != nil {
+		return nil, err
+	}
+
+	return &proto.DeselectExitNodeResponse{}, nil
+}
+
+// setExitNode applies the exit node to the running engine, which persists it with the route selection. Without a
+// running engine the exit node is stored in the route selection of the state file for the next engine.
+func (s *Server) setExitNode(exitNode *routeselector.ExitNode) error {
+	var engine *internal.Engine
+	if s.connectClient != nil {
+		engine = s.connectClient.Engine()
+	}
+
+	if engine == nil {
+		if s.config == nil || s.config.StatePath() == "" {
+			return fmt.Errorf("not connected")
+		}
+		err := statefile.Update(s.config.StatePath(), func(state *statefile.State) {
+			if state.RouteSelector == nil {
+				state.RouteSelector = routeselector.NewRouteSelector().GetState()
+			}
+			state.RouteSelector.ExitNode = exitNode
+		})
+		if err != nil {
+			return fmt.Errorf("update route selection: %w", err)
+		}
+		log.Infof("exit node set to '%s'", exitNode.NetID)
+		return nil
+	}
+
+	routeManager := engine.GetRouteManager()
+	routeManager.GetRouteSelector().SetExitNode(exitNode)
+	routeManager.TriggerSelection(engine.GetClientRoutes())
+	log.Infof("exit node set to '%s'", exitNode.NetID)
+
+	return nil
+}
+
+// findExitNodePeer returns the public key of the routing peer matching the given FQDN, IP or public key
+func (s *Server) findExitNodePeer(routes []*route.Route, peerID string) (string, error) {
+	for _, r := range routes {
+		if r.Peer == peerID {
+			return r.Peer, nil
+		}
+
+		state, err := s.statusRecorder.GetPeer(r.Peer)
+		if err != nil {
+			continue
+		}
+		if state.IP == peerID || strings.EqualFold(state.FQDN, peerID) || strings.EqualFold(strings.Split(state.FQDN, ".")[0], peerID) {
+			return r.Peer, nil
+		}
+	}
+	return "", fmt.Errorf("peer '%s' is not a routing peer of exit node '%s'", peerID, routes[0].NetID)
+}
+
+// exitNodeRoutes returns the default routes grouped by network ID
+func exitNodeRoutes(routes map[route.NetID][]*route.Route) map[route.NetID][]*route.Route {
+	exitNodes := make(map[route.NetID][]*route.Route)
+	for id, rts := range routes {
+		if len(rts) > 0 && rts[0].IsDefaultRoute() {
+			exitNodes[id] = rts
+		}
+	}
+	return exitNodes
+}
@@ -0,0 +1,197 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	gstatus "google.golang.org/grpc/status"
+
+	"github.com/netbirdio/netbird/client/internal"
+	"github.com/netbirdio/netbird/client/internal/auth"
+	"github.com/netbirdio/netbird/client/internal/peer"
+	"github.com/netbirdio/netbird/client/proto"
+	"github.com/netbirdio/netbird/client/system"
+)
+
+// refreshTimeout is the time the silent renewal of the login may take
+const refreshTimeout = 30 * time.Second
+
+// reauthenticate starts an SSO login that renews the login of this peer. Unlike a regular login it leaves the
+// connection and the state of the client untouched.
+func (s *Server) reauthenticate(ctx context.Context, msg *proto.LoginRequest) (*proto.LoginResponse, error) {
+	s.mutex.Lock()
+	config := s.config
+	s.mutex.Unlock()
+
+	if config == nil {
+		return nil, gstatus.Errorf(codes.FailedPrecondition, "the client is not logged in, please run netbird login")
+	}
+
+	oAuthFlow, err := auth.NewOAuthFlow(ctx, config, msg.IsLinuxDesktopClient)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mutex.Lock()
+	if s.oauthAuthFlow.flow != nil && s.oauthAuthFlow.reauth && s.oauthAuthFlow.flow.GetClientID(ctx) == oAuthFlow.GetClientID(ctx) &&
+		s.oauthAuthFlow.expiresAt.After(time.Now().Add(90*time.Second)) {
+		info := s.oauthAuthFlow.info
+		s.mutex.Unlock()
+		log.Debugf("using previous oauth flow info")
+		return &proto.LoginResponse{
+			NeedsSSOLogin:           true,
+			VerificationURI:         info.VerificationURI,
+			VerificationURIComplete: info.VerificationURIComplete,
+			UserCode:                info.UserCode,
+		}, nil
+	}
+	if s.oauthAuthFlow.waitCancel != nil {
+		s.oauthAuthFlow.waitCancel()
+	}
+	s.mutex.Unlock()
+
+	authInfo, err := oAuthFlow.RequestAuthInfo(context.TODO())
+	if err != nil {
+		log.Errorf("getting a request OAuth flow failed: %v", err)
+		return nil, err
+	}
+
+	s.mutex.Lock()
+	s.oauthAuthFlow = oauthAuthFlow{
+		flow:      oAuthFlow,
+		info:      authInfo,
+		expiresAt: time.Now().Add(time.Duration(authInfo.ExpiresIn) * time.Second),
+		reauth:    true,
+	}
+	s.mutex.Unlock()
+
+	return &proto.LoginResponse{
+		NeedsSSOLogin:           true,
+		VerificationURI:         authInfo.VerificationURI,
+		VerificationURIComplete: authInfo.VerificationURIComplete,
+		UserCode:                authInfo.UserCode,
+	}, nil
+}
+
+// waitSSOReauthentication waits for the browser login started by reauthenticate and renews the login with its token
+func (s *Server) waitSSOReauthentication(callerCtx context.Context, msg *proto.WaitSSOLoginRequest) (*proto.WaitSSOLoginResponse, error) {
+	ctx := s.rootCtx
+	if md, ok := metadata.FromIncomingContext(callerCtx); ok {
+		ctx = metadata.NewOutgoingContext(ctx, md)
+	}
+	if msg.Hostname != "" {
+		// nolint
+		ctx = context.WithValue(ctx, system.DeviceNameCtxKey, msg.Hostname)
+	}
+
+	s.mutex.Lock()
+	flow := s.oauthAuthFlow.flow
+	flowInfo := s.oauthAuthFlow.info
+	config := s.config
+	if flowInfo.UserCode != msg.UserCode {
+		s.mutex.Unlock()
+		return nil, gstatus.Errorf(codes.InvalidArgument, "sso user code is invalid")
+	}
+	if s.oauthAuthFlow.waitCancel != nil {
+		s.oauthAuthFlow.waitCancel()
+	}
+	waitCTX, cancel := context.WithTimeout(ctx, time.Until(s.oauthAuthFlow.expiresAt))
+	defer cancel()
+	s.oauthAuthFlow.waitCancel = cancel
+	s.mutex.Unlock()
+
+	tokenInfo, err := flow.WaitToken(waitCTX, flowInfo)
+
+	s.mutex.Lock()
+	s.oauthAuthFlow.expiresAt = time.Now()
+	s.mutex.Unlock()
+
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return nil, nil //nolint:nilnil
+		}
+		log.Errorf("waiting for browser login failed: %v", err)
+		return nil, err
+	}
+
+	refresher, _ := flow.(auth.TokenRefresher)
+	if err := s.renewLogin(ctx, config, refresher, tokenInfo); err != nil {
+		return nil, err
+	}
+
+	return &proto.WaitSSOLoginResponse{}, nil
+}
+
+// renewLogin sends the new token to the management service and records the new login deadline
+func (s *Server) renewLogin(ctx context.Context, config *internal.Config, refresher auth.TokenRefresher, tokenInfo auth.TokenInfo) error {
+	expiresAt, err := internal.Reauthenticate(ctx, config, tokenInfo.GetTokenToUse())
+	if err != nil {
+		log.Errorf("failed to renew the login: %v", err)
+		return err
+	}
+	s.storeRefreshToken(refresher, tokenInfo)
+
+	statusRecorder := s.eventStatusRecorder()
+	statusRecorder.UpdateLoginExpiration(expiresAt)
+
+	message := "NetBird login renewed"
+	var eventMetadata map[string]string
+	if !expiresAt.IsZero() {
+		message += ", it expires at " + expiresAt.Local().Format(time.RFC1123)
+		eventMetadata = map[string]string{"expires_at": expiresAt.UTC().Format(time.RFC3339)}
+	}
+	log.Info(message)
+	statusRecorder.PublishEvent(peer.EventLoginRenewed, peer.EventSeverityInfo, message, eventMetadata)
+
+	return nil
+}
+
+// storeRefreshToken keeps the refresh token of the last SSO login in memory for the silent renewal
+func (s *Server) storeRefreshToken(refresher auth.TokenRefresher, tokenInfo auth.TokenInfo) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if refresher == nil || tokenInfo.RefreshToken == "" {
+		s.tokenRefresher = nil
+		s.refreshToken = ""
+		return
+	}
+	s.tokenRefresher = refresher
+	s.refreshToken = tokenInfo.RefreshToken
+}
+
+// onLoginExpiring renews the login with the refresh token of the last SSO login and warns the user when the
+// login can't be renewed without the user
+func (s *Server) onLoginExpiring(expiresAt time.Time) {
+	s.mutex.Lock()
+	refresher := s.tokenRefresher
+	refreshToken := s.refreshToken
+	config := s.config
+	s.mutex.Unlock()
+
+	if refresher != nil && config != nil {
+		err := s.refreshLogin(config, refresher, refreshToken)
+		if err == nil {
+			return
+		}
+		log.Warnf("failed to renew the login silently: %v", err)
+		s.storeRefreshToken(nil, auth.TokenInfo{})
+	}
+
+	internal.PublishLoginExpiring(s.eventStatusRecorder(), expiresAt)
+}
+
+func (s *Server) refreshLogin(config *internal.Config, refresher auth.TokenRefresher, refreshToken string) error {
+	ctx, cancel := context.WithTimeout(s.rootCtx, refreshTimeout)
+	defer cancel()
+
+	tokenInfo, err := refresher.RefreshToken(ctx, refreshToken)
+	if err != nil {
+		return err
+	}
+	return s.renewLogin(ctx, config, refresher, tokenInfo)
+}
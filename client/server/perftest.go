@@ -0,0 +1,76 @@
+package server
+
+import (
+	"context"
+	"net"
+
+	"google.golang.org/grpc/codes"
+	gstatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/netbirdio/netbird/client/internal/perftest"
+	"github.com/netbirdio/netbird/client/proto"
+)
+
+// TestPeer measures the latency, the jitter, the loss and the throughput of both directions against the responder of
+// a peer. The responder only answers when the policies allow this peer to connect to the remote peer.
+func (s *Server) TestPeer(ctx context.Context, req *proto.TestPeerRequest) (*proto.TestPeerResponse, error) {
+	_, statusRecorder, err := s.connectedEngine()
+	if err != nil {
+		return nil, err
+	}
+
+	pubKey, err := findPeer(statusRecorder, req.GetPeer())
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := statusRecorder.GetPeer(pubKey)
+	if err != nil {
+		return nil, gstatus.Errorf(codes.NotFound, "peer %s: %v", req.GetPeer(), err)
+	}
+
+	ip := net.ParseIP(state.IP)
+	if ip == nil {
+		return nil, gstatus.Errorf(codes.FailedPrecondition, "peer %s has no address yet", req.GetPeer())
+	}
+
+	if duration := req.GetDuration().AsDuration(); duration > perftest.MaxDuration {
+		return nil, gstatus.Errorf(codes.InvalidArgument, "the duration must not exceed %s", perftest.MaxDuration)
+	}
+
+	opts := perftest.Options{Duration: req.GetDuration().AsDuration()}
+	result, err := perftest.NewClient(ip, opts).Run(ctx)
+	if err != nil {
+		return nil, gstatus.Errorf(codes.Unavailable, "test peer %s: %v", req.GetPeer(), err)
+	}
+
+	return &proto.TestPeerResponse{
+		Fqdn: state.FQDN,
+		IP:   state.IP,
+		Latency: &proto.LatencyTestResult{
+			Sent:     int32(result.Latency.Sent),
+			Received: int32(result.Latency.Received),
+			Min:      durationpb.New(result.Latency.Min),
+			Avg:      durationpb.New(result.Latency.Avg),
+			Max:      durationpb.New(result.Latency.Max),
+			Jitter:   durationpb.New(result.Latency.Jitter),
+		},
+		Upload:   toProtoThroughputTestResult(result.Upload),
+		Download: toProtoThroughputTestResult(result.Download),
+	}, nil
+}
+
+func toProtoThroughputTestResult(result perftest.DirectionResult) *proto.ThroughputTestResult {
+	pbResult := &proto.ThroughputTestResult{
+		Bytes:           result.Bytes,
+		Duration:        durationpb.New(result.Duration),
+		PacketsSent:     int32(result.PacketsSent),
+		PacketsReceived: int32(result.PacketsReceived),
+		Jitter:          durationpb.New(result.Jitter),
+	}
+	if result.Err != nil {
+		pbResult.Error = result.Err.Error()
+	}
+	return pbResult
+}
@@ -51,6 +51,9 @@ type Server struct {
 	logFile string
 
 	oauthAuthFlow oauthAuthFlow
+	// tokenRefresher and refreshToken renew the SSO login before it expires
+	tokenRefresher auth.TokenRefresher
+	refreshToken   string
 
 	mutex  sync.Mutex
 	config *internal.Config
@@ -73,6 +76,8 @@ type oauthAuthFlow struct {
 	flow       auth.OAuthFlow
 	info       auth.AuthFlowInfo
 	waitCancel context.CancelFunc
+	// reauth is set for the flows that renew the login of the connected peer
+	reauth bool
 }
 
 // New server instance constructor.
@@ -140,6 +145,7 @@ func (s *Server) Start() error {
 	if s.sessionWatcher == nil {
 		s.sessionWatcher = internal.NewSessionWatcher(s.rootCtx, s.statusRecorder)
 		s.sessionWatcher.SetOnExpireListener(s.onSessionExpire)
+		s.sessionWatcher.SetOnExpiringListener(s.onLoginExpiring)
 	}
 
 	if config.DisableAutoConnect {
@@ -278,6 +284,10 @@ func (s *Server) loginAttempt(ctx context.Context, setupKey, jwtToken string) (i
 
 // Login uses setup key to prepare configuration for the daemon.
 func (s *Server) Login(callerCtx context.Context, msg *proto.LoginRequest) (*proto.LoginResponse, error) {
+	if msg.Reauthenticate {
+		return s.reauthenticate(callerCtx, msg)
+	}
+
 	s.mutex.Lock()
 	if s.actCancel != nil {
 		s.actCancel()
@@ -369,6 +379,16 @@ func (s *Server) Login(callerCtx context.Context, msg *proto.LoginRequest) (*pro
 		s.latestConfigInput.NetworkMonitor = msg.NetworkMonitor
 	}
 
+	if msg.DnsQueryLog != nil {
+		inputConfig.DNSQueryLog = msg.DnsQueryLog
+		s.latestConfigInput.DNSQueryLog = msg.DnsQueryLog
+	}
+
+	if msg.PeerTestResponder != nil {
+		inputConfig.PeerTestResponder = msg.PeerTestResponder
+		s.latestConfigInput.PeerTestResponder = msg.PeerTestResponder
+	}
+
 	if len(msg.ExtraIFaceBlacklist) > 0 {
 		inputConfig.ExtraIFaceBlackList = msg.ExtraIFaceBlacklist
 		s.latestConfigInput.ExtraIFaceBlackList = msg.ExtraIFaceBlacklist
@@ -415,7 +435,7 @@ func (s *Server) Login(callerCtx context.Context, msg *proto.LoginRequest) (*pro
 			return nil, err
 		}
 
-		if s.oauthAuthFlow.flow != nil && s.oauthAuthFlow.flow.GetClientID(ctx) == oAuthFlow.GetClientID(context.TODO()) {
+		if s.oauthAuthFlow.flow != nil && !s.oauthAuthFlow.reauth && s.oauthAuthFlow.flow.GetClientID(ctx) == oAuthFlow.GetClientID(context.TODO()) {
 			if s.oauthAuthFlow.expiresAt.After(time.Now().Add(90 * time.Second)) {
 				log.Debugf("using previous oauth flow info")
 				return &proto.LoginResponse{
@@ -442,6 +462,7 @@ func (s *Server) Login(callerCtx context.Context, msg *proto.LoginRequest) (*pro
 		s.oauthAuthFlow.flow = oAuthFlow
 		s.oauthAuthFlow.info = authInfo
 		s.oauthAuthFlow.expiresAt = time.Now().Add(time.Duration(authInfo.ExpiresIn) * time.Second)
+		s.oauthAuthFlow.reauth = false
 		s.mutex.Unlock()
 
 		state.Set(internal.StatusNeedsLogin)
@@ -466,6 +487,11 @@ func (s *Server) Login(callerCtx context.Context, msg *proto.LoginRequest) (*pro
 // waits for the user to continue with the login on a browser
 func (s *Server) WaitSSOLogin(callerCtx context.Context, msg *proto.WaitSSOLoginRequest) (*proto.WaitSSOLoginResponse, error) {
 	s.mutex.Lock()
+	if s.oauthAuthFlow.reauth {
+		s.mutex.Unlock()
+		return s.waitSSOReauthentication(callerCtx, msg)
+	}
+
 	if s.actCancel != nil {
 		s.actCancel()
 	}
@@ -541,6 +567,9 @@ func (s *Server) WaitSSOLogin(callerCtx context.Context, msg *proto.WaitSSOLogin
 		return nil, err
 	}
 
+	refresher, _ := s.oauthAuthFlow.flow.(auth.TokenRefresher)
+	s.storeRefreshToken(refresher, tokenInfo)
+
 	return &proto.WaitSSOLoginResponse{}, nil
 }
 
@@ -750,28 +779,12 @@ func toProtoFullStatus(fullStatus peer.FullStatus) *proto.FullStatus {
 	pbFullStatus.LocalPeerState.RosenpassPermissive = fullStatus.RosenpassState.Permissive
 	pbFullStatus.LocalPeerState.RosenpassEnabled = fullStatus.RosenpassState.Enabled
 	pbFullStatus.LocalPeerState.Routes = maps.Keys(fullStatus.LocalPeerState.Routes)
+	if !fullStatus.LocalPeerState.LoginExpiresAt.IsZero() {
+		pbFullStatus.LocalPeerState.LoginExpiresAt = timestamppb.New(fullStatus.LocalPeerState.LoginExpiresAt)
+	}
 
 	for _, peerState := range fullStatus.Peers {
-		pbPeerState := &proto.PeerState{
-			IP:                         peerState.IP,
-			PubKey:                     peerState.PubKey,
-			ConnStatus:                 peerState.ConnStatus.String(),
-			ConnStatusUpdate:           timestamppb.New(peerState.ConnStatusUpdate),
-			Relayed:                    peerState.Relayed,
-			LocalIceCandidateType:      peerState.LocalIceCandidateType,
-			RemoteIceCandidateType:     peerState.RemoteIceCandidateType,
-			LocalIceCandidateEndpoint:  peerState.LocalIceCandidateEndpoint,
-			RemoteIceCandidateEndpoint: peerState.RemoteIceCandidateEndpoint,
-			RelayAddress:               peerState.RelayServerAddress,
-			Fqdn:                       peerState.FQDN,
-			LastWireguardHandshake:     timestamppb.New(peerState.LastWireguardHandshake),
-			BytesRx:                    peerState.BytesRx,
-			BytesTx:                    peerState.BytesTx,
-			RosenpassEnabled:           peerState.RosenpassEnabled,
-			Routes:                     maps.Keys(peerState.GetRoutes()),
-			Latency:                    durationpb.New(peerState.Latency),
-		}
-		pbFullStatus.Peers = append(pbFullStatus.Peers, pbPeerState)
+		pbFullStatus.Peers = append(pbFullStatus.Peers, toProtoPeerState(peerState))
 	}
 
 	for _, relayState := range fullStatus.Relays {
@@ -802,6 +815,28 @@ func toProtoFullStatus(fullStatus peer.FullStatus) *proto.FullStatus {
 	return &pbFullStatus
 }
 
+func toProtoPeerState(peerState peer.State) *proto.PeerState {
+	return &proto.PeerState{
+		IP:                         peerState.IP,
+		PubKey:                     peerState.PubKey,
+		ConnStatus:                 peerState.ConnStatus.String(),
+		ConnStatusUpdate:           timestamppb.New(peerState.ConnStatusUpdate),
+		Relayed:                    peerState.Relayed,
+		LocalIceCandidateType:      peerState.LocalIceCandidateType,
+		RemoteIceCandidateType:     peerState.RemoteIceCandidateType,
+		LocalIceCandidateEndpoint:  peerState.LocalIceCandidateEndpoint,
+		RemoteIceCandidateEndpoint: peerState.RemoteIceCandidateEndpoint,
+		RelayAddress:               peerState.RelayServerAddress,
+		Fqdn:                       peerState.FQDN,
+		LastWireguardHandshake:     timestamppb.New(peerState.LastWireguardHandshake),
+		BytesRx:                    peerState.BytesRx,
+		BytesTx:                    peerState.BytesTx,
+		RosenpassEnabled:           peerState.RosenpassEnabled,
+		Routes:                     maps.Keys(peerState.GetRoutes()),
+		Latency:                    durationpb.New(peerState.Latency),
+	}
+}
+
 // sendTerminalNotification sends a terminal notification message
 // to inform the user that the NetBird connection session has expired.
 func sendTerminalNotification() error {
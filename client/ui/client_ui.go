@@ -159,9 +159,11 @@ type serviceClient struct {
 	mUpdate           *systray.MenuItem
 	mQuit             *systray.MenuItem
 	mRoutes           *systray.MenuItem
+	mExitNode         *systray.MenuItem
 	mAllowSSH         *systray.MenuItem
 	mAutoConnect      *systray.MenuItem
 	mEnableRosenpass  *systray.MenuItem
+	mNotifications    *systray.MenuItem
 	mAdvancedSettings *systray.MenuItem
 
 	// application with main windows.
@@ -197,6 +199,7 @@ type serviceClient struct {
 	isUpdateIconActive   bool
 	showRoutes           bool
 	wRoutes              fyne.Window
+	exitNodeMenu         exitNodeMenu
 }
 
 // newServiceClient instance constructor
@@ -473,6 +476,10 @@ func (s *serviceClient) updateStatus() error {
 			systrayIconState = false
 		}
 
+		if s.connected {
+			go s.updateExitNodeMenu()
+		}
+
 		// the updater struct notify by the upgrades available only, but if meanwhile the daemon has successfully
 		// updated must reset the mUpdate visibility state
 		if s.daemonVersion != status.DaemonVersion {
@@ -526,6 +533,7 @@ func (s *serviceClient) setDisconnectedStatus() {
 	s.mDown.Disable()
 	s.mUp.Enable()
 	s.mRoutes.Disable()
+	s.mExitNode.Disable()
 }
 
 func (s *serviceClient) onTrayReady() {
@@ -546,11 +554,15 @@ func (s *serviceClient) onTrayReady() {
 	s.mAllowSSH = s.mSettings.AddSubMenuItemCheckbox("Allow SSH", "Allow SSH connections", false)
 	s.mAutoConnect = s.mSettings.AddSubMenuItemCheckbox("Connect on Startup", "Connect automatically when the service starts", false)
 	s.mEnableRosenpass = s.mSettings.AddSubMenuItemCheckbox("Enable Quantum-Resistance", "Enable post-quantum security via Rosenpass", false)
+	s.mNotifications = s.mSettings.AddSubMenuItemCheckbox("Notifications", "Show notifications about the connection problems",
+		s.app.Preferences().BoolWithFallback(notificationsPreference, true))
 	s.mAdvancedSettings = s.mSettings.AddSubMenuItem("Advanced Settings", "Advanced settings of the application")
 	s.loadSettings()
 
 	s.mRoutes = systray.AddMenuItem("Network Routes", "Open the routes management window")
 	s.mRoutes.Disable()
+	s.mExitNode = systray.AddMenuItem("Exit Node", "Choose the exit node routing all traffic")
+	s.mExitNode.Disable()
 	systray.AddSeparator()
 
 	s.mAbout = systray.AddMenuItem("About", "About")
@@ -581,6 +593,8 @@ func (s *serviceClient) onTrayReady() {
 		}
 	}()
 
+	go s.listenEvents()
+
 	go func() {
 		var err error
 		for {
@@ -637,6 +651,8 @@ func (s *serviceClient) onTrayReady() {
 					log.Errorf("failed to update config: %v", err)
 					return
 				}
+			case <-s.mNotifications.ClickedCh:
+				s.toggleNotifications()
 			case <-s.mAdvancedSettings.ClickedCh:
 				s.mAdvancedSettings.Disable()
 				go func() {
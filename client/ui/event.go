@@ -0,0 +1,109 @@
+//go:build !(linux && 386) && !freebsd
+
+package main
+
+import (
+	"runtime"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"github.com/cenkalti/backoff/v4"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/netbirdio/netbird/client/proto"
+)
+
+// notificationsPreference is the key of the notifications toggle in the UI preferences
+const notificationsPreference = "notifications"
+
+// listenEvents subscribes to the events of the daemon and resubscribes when the daemon restarts
+func (s *serviceClient) listenEvents() {
+	b := backoff.NewExponentialBackOff()
+	b.InitialInterval = time.Second
+	b.MaxInterval = 30 * time.Second
+	b.MaxElapsedTime = 0
+
+	for {
+		received, err := s.receiveEvents()
+		if err != nil {
+			log.Debugf("event stream closed: %v", err)
+		}
+		if received {
+			b.Reset()
+		}
+		time.Sleep(b.NextBackOff())
+	}
+}
+
+// receiveEvents handles the events of one subscription, it reports whether any event was received
+func (s *serviceClient) receiveEvents() (bool, error) {
+	conn, err := s.getSrvClient(defaultFailTimeout)
+	if err != nil {
+		return false, err
+	}
+
+	stream, err := conn.SubscribeEvents(s.ctx, &proto.SubscribeEventsRequest{})
+	if err != nil {
+		return false, err
+	}
+
+	var received bool
+	for {
+		event, err := stream.Recv()
+		if err != nil {
+			return received, err
+		}
+		received = true
+		s.onEvent(event)
+	}
+}
+
+// onEvent notifies the user about the warnings and errors, the informational events only update the tray state
+func (s *serviceClient) onEvent(event *proto.SystemEvent) {
+	log.Debugf("received event %s: %s", event.GetType(), event.GetMessage())
+
+	if !s.mNotifications.Checked() || event.GetSeverity() == proto.SystemEvent_INFO {
+		return
+	}
+
+	if event.GetType() == proto.SystemEvent_LOGIN_EXPIRED {
+		// the status poll sends the same notification, the shared flag sends it once
+		s.updateIndicationLock.Lock()
+		s.onSessionExpire()
+		s.updateIndicationLock.Unlock()
+		return
+	}
+
+	title := eventTitle(event.GetType())
+	if runtime.GOOS == "darwin" {
+		title = "NetBird: " + title
+	}
+	s.app.SendNotification(fyne.NewNotification(title, event.GetMessage()))
+}
+
+func eventTitle(eventType proto.SystemEvent_Type) string {
+	switch eventType {
+	case proto.SystemEvent_ROUTE_FAILED_OVER:
+		return "Route failed over"
+	case proto.SystemEvent_ROUTE_DEACTIVATED:
+		return "Route unavailable"
+	case proto.SystemEvent_DNS_UPSTREAM_DISABLED:
+		return "DNS servers unavailable"
+	case proto.SystemEvent_MANAGEMENT_DISCONNECTED:
+		return "Management service unreachable"
+	case proto.SystemEvent_LOGIN_EXPIRING:
+		return "Login expiring"
+	default:
+		return "NetBird"
+	}
+}
+
+// toggleNotifications switches the desktop notifications on or off and stores the choice
+func (s *serviceClient) toggleNotifications() {
+	if s.mNotifications.Checked() {
+		s.mNotifications.Uncheck()
+	} else {
+		s.mNotifications.Check()
+	}
+	s.app.Preferences().SetBool(notificationsPreference, s.mNotifications.Checked())
+}
@@ -0,0 +1,145 @@
+//go:build !(linux && 386) && !freebsd
+
+package main
+
+import (
+	"fmt"
+	"sync"
+
+	"fyne.io/systray"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/netbirdio/netbird/client/proto"
+)
+
+const exitNodeOffText = "Off"
+
+// exitNodeChoice is the exit node and the optional routing peer a menu item selects, an empty ID turns exit nodes off
+type exitNodeChoice struct {
+	id   string
+	peer string
+}
+
+type exitNodeMenuItem struct {
+	item   *systray.MenuItem
+	choice exitNodeChoice
+}
+
+// exitNodeMenu keeps the tray sub menu items of the exit nodes. The tray library can't remove items,
+// so the items are reused between updates and the unused ones are hidden.
+type exitNodeMenu struct {
+	mu    sync.Mutex
+	items []*exitNodeMenuItem
+}
+
+// updateExitNodeMenu refreshes the exit node sub menu from the daemon
+func (s *serviceClient) updateExitNodeMenu() {
+	conn, err := s.getSrvClient(defaultFailTimeout)
+	if err != nil {
+		log.Errorf(getClientFMT, err)
+		return
+	}
+
+	resp, err := conn.ListExitNodes(s.ctx, &proto.ListExitNodesRequest{})
+	if err != nil {
+		log.Debugf("failed to list exit nodes: %v", err)
+		return
+	}
+
+	if len(resp.GetExitNodes()) == 0 {
+		s.mExitNode.Disable()
+		return
+	}
+	s.mExitNode.Enable()
+
+	type entry struct {
+		title   string
+		choice  exitNodeChoice
+		checked bool
+	}
+	entries := []entry{{title: exitNodeOffText, checked: resp.GetDisabled()}}
+	for _, exitNode := range resp.GetExitNodes() {
+		selectedPeers := 0
+		for _, p := range exitNode.GetPeers() {
+			if p.GetSelected() {
+				selectedPeers++
+			}
+		}
+		pinned := selectedPeers == 1 && len(exitNode.GetPeers()) > 1
+
+		entries = append(entries, entry{
+			title:   exitNode.GetID(),
+			choice:  exitNodeChoice{id: exitNode.GetID()},
+			checked: exitNode.GetSelected() && !pinned,
+		})
+
+		if len(exitNode.GetPeers()) < 2 {
+			continue
+		}
+		for _, p := range exitNode.GetPeers() {
+			name := p.GetFqdn()
+			if name == "" {
+				name = p.GetIP()
+			}
+			entries = append(entries, entry{
+				title:   fmt.Sprintf("%s via %s", exitNode.GetID(), name),
+				choice:  exitNodeChoice{id: exitNode.GetID(), peer: p.GetPubKey()},
+				checked: pinned && p.GetSelected(),
+			})
+		}
+	}
+
+	s.exitNodeMenu.mu.Lock()
+	defer s.exitNodeMenu.mu.Unlock()
+
+	for i, e := range entries {
+		if i == len(s.exitNodeMenu.items) {
+			menuItem := &exitNodeMenuItem{item: s.mExitNode.AddSubMenuItemCheckbox(e.title, e.title, false)}
+			s.exitNodeMenu.items = append(s.exitNodeMenu.items, menuItem)
+			go s.handleExitNodeClicks(menuItem)
+		}
+
+		menuItem := s.exitNodeMenu.items[i]
+		menuItem.choice = e.choice
+		menuItem.item.SetTitle(e.title)
+		if e.checked {
+			menuItem.item.Check()
+		} else {
+			menuItem.item.Uncheck()
+		}
+		menuItem.item.Show()
+	}
+
+	for _, menuItem := range s.exitNodeMenu.items[len(entries):] {
+		menuItem.item.Hide()
+	}
+}
+
+func (s *serviceClient) handleExitNodeClicks(menuItem *exitNodeMenuItem) {
+	for range menuItem.item.ClickedCh {
+		s.exitNodeMenu.mu.Lock()
+		choice := menuItem.choice
+		s.exitNodeMenu.mu.Unlock()
+
+		if err := s.selectExitNode(choice); err != nil {
+			log.Errorf("failed to select exit node: %v", err)
+			s.runSelfCommand("error-msg", err.Error())
+		}
+		s.updateExitNodeMenu()
+	}
+}
+
+func (s *serviceClient) selectExitNode(choice exitNodeChoice) error {
+	conn, err := s.getSrvClient(defaultFailTimeout)
+	if err != nil {
+		return fmt.Errorf(getClientFMT, err)
+	}
+
+	if choice.id == "" {
+		_, err = conn.DeselectExitNode(s.ctx, &proto.DeselectExitNodeRequest{})
+		return err
+	}
+
+	_, err = conn.SelectExitNode(s.ctx, &proto.SelectExitNodeRequest{ID: choice.id, Peer: choice.peer})
+	return err
+}
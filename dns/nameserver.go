@@ -1,6 +1,8 @@
 package dns
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"net/netip"
 	"net/url"
@@ -13,6 +15,10 @@ const (
 	InvalidNameServerType NameServerType = iota
 	// UDPNameServerType udp nameserver type
 	UDPNameServerType
+	// TLSNameServerType DNS over TLS nameserver type
+	TLSNameServerType
+	// HTTPSNameServerType DNS over HTTPS nameserver type
+	HTTPSNameServerType
 )
 
 const (
@@ -22,6 +28,10 @@ const (
 	InvalidNameServerTypeString = "invalid"
 	// UDPNameServerTypeString udp nameserver type as string
 	UDPNameServerTypeString = "udp"
+	// TLSNameServerTypeString DNS over TLS nameserver type as string
+	TLSNameServerTypeString = "tls"
+	// HTTPSNameServerTypeString DNS over HTTPS nameserver type as string
+	HTTPSNameServerTypeString = "https"
 )
 
 // NameServerType nameserver type
@@ -32,6 +42,10 @@ func (n NameServerType) String() string {
 	switch n {
 	case UDPNameServerType:
 		return UDPNameServerTypeString
+	case TLSNameServerType:
+		return TLSNameServerTypeString
+	case HTTPSNameServerType:
+		return HTTPSNameServerTypeString
 	default:
 		return InvalidNameServerTypeString
 	}
@@ -42,11 +56,20 @@ func ToNameServerType(typeString string) NameServerType {
 	switch typeString {
 	case UDPNameServerTypeString:
 		return UDPNameServerType
+	case TLSNameServerTypeString:
+		return TLSNameServerType
+	case HTTPSNameServerTypeString:
+		return HTTPSNameServerType
 	default:
 		return InvalidNameServerType
 	}
 }
 
+// IsEncrypted returns true for the nameserver types that query the upstream over TLS
+func (n NameServerType) IsEncrypted() bool {
+	return n == TLSNameServerType || n == HTTPSNameServerType
+}
+
 // NameServerGroup group of nameservers and with group ids
 type NameServerGroup struct {
 	// ID identifier of group
@@ -79,6 +102,12 @@ type NameServer struct {
 	NSType NameServerType
 	// Port nameserver listening port
 	Port int
+	// Hostname is the name the certificate of an encrypted nameserver is verified against, it is sent as SNI and as
+	// the host of the DNS over HTTPS requests. The IP is used when it is empty.
+	Hostname string
+	// CertFingerprint is the hex encoded SHA-256 fingerprint of the certificate of an encrypted nameserver. When it
+	// is set the certificate is pinned and not verified against the system roots.
+	CertFingerprint string
 }
 
 // EventMeta returns activity event meta related to the nameserver group
@@ -89,9 +118,11 @@ func (g *NameServerGroup) EventMeta() map[string]any {
 // Copy copies a nameserver object
 func (n *NameServer) Copy() *NameServer {
 	return &NameServer{
-		IP:     n.IP,
-		NSType: n.NSType,
-		Port:   n.Port,
+		IP:              n.IP,
+		NSType:          n.NSType,
+		Port:            n.Port,
+		Hostname:        n.Hostname,
+		CertFingerprint: n.CertFingerprint,
 	}
 }
 
@@ -99,10 +130,48 @@ func (n *NameServer) Copy() *NameServer {
 func (n *NameServer) IsEqual(other *NameServer) bool {
 	return other.IP == n.IP &&
 		other.NSType == n.NSType &&
-		other.Port == n.Port
+		other.Port == n.Port &&
+		other.Hostname == n.Hostname &&
+		other.CertFingerprint == n.CertFingerprint
+}
+
+// Validate checks that the TLS options are set only for the encrypted nameservers and are well formed
+func (n *NameServer) Validate() error {
+	if !n.NSType.IsEncrypted() {
+		if n.Hostname != "" || n.CertFingerprint != "" {
+			return fmt.Errorf("hostname and certificate fingerprint are supported only by the %s and %s nameservers",
+				TLSNameServerTypeString, HTTPSNameServerTypeString)
+		}
+		return nil
+	}
+
+	if n.Hostname != "" {
+		if _, err := url.Parse("https://" + n.Hostname); err != nil || strings.ContainsAny(n.Hostname, "/:@ ") {
+			return fmt.Errorf("invalid nameserver hostname %s", n.Hostname)
+		}
+	}
+
+	if n.CertFingerprint != "" {
+		if _, err := ParseCertFingerprint(n.CertFingerprint); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ParseCertFingerprint decodes a hex encoded SHA-256 certificate fingerprint, the bytes may be separated by colons
+// as printed by openssl x509 -fingerprint -sha256
+func ParseCertFingerprint(fingerprint string) ([]byte, error) {
+	decoded, err := hex.DecodeString(strings.ReplaceAll(fingerprint, ":", ""))
+	if err != nil || len(decoded) != sha256.Size {
+		return nil, fmt.Errorf("invalid certificate fingerprint %s, expected a hex encoded SHA-256 hash", fingerprint)
+	}
+	return decoded, nil
 }
 
-// ParseNameServerURL parses a nameserver url in the format <type>://<ip>:<port>, e.g., udp://1.1.1.1:53
+// ParseNameServerURL parses a nameserver url in the format <type>://<ip>:<port>, e.g., udp://1.1.1.1:53 or
+// tls://1.1.1.1:853
 func ParseNameServerURL(nsURL string) (NameServer, error) {
 	parsedURL, err := url.Parse(nsURL)
 	if err != nil {
@@ -0,0 +1,377 @@
+package encryption
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/crypto/acme"
+)
+
+// CertificateStatusEndpoint is the HTTP path the servers report the status of their certificate on
+const CertificateStatusEndpoint = "/certificate"
+
+// expiryWarningPeriod is how long before the expiry of the certificate a warning is logged
+const expiryWarningPeriod = 14 * 24 * time.Hour
+
+const (
+	sourceFile        = "file"
+	sourceLetsEncrypt = "letsencrypt"
+)
+
+// Challenge is the ACME challenge Let's Encrypt uses to verify the ownership of the domains
+type Challenge string
+
+// acmeChallengePort is the only port Let's Encrypt validates the TLS-ALPN-01 challenge on
+const acmeChallengePort = 443
+
+const (
+	// ChallengeTLSALPN01 answers the challenge on port 443, which is the TLS port of the server by default. Servers
+	// running on another port answer it with a separate listener on port 443.
+	ChallengeTLSALPN01 Challenge = "tls-alpn-01"
+	// ChallengeHTTP01 answers the challenge on a plain HTTP port, port 80 by default
+	ChallengeHTTP01 Challenge = "http-01"
+	// ChallengeDNS01 answers the challenge with a TXT record managed in AWS Route 53. It works for wildcard domains and
+	// for servers not reachable from the internet.
+	ChallengeDNS01 Challenge = "dns-01"
+)
+
+// ParseChallenge parses the name of an ACME challenge, TLS-ALPN-01 is the default
+func ParseChallenge(name string) (Challenge, error) {
+	switch challenge := Challenge(strings.ToLower(name)); challenge {
+	case "":
+		return ChallengeTLSALPN01, nil
+	case ChallengeTLSALPN01, ChallengeHTTP01, ChallengeDNS01:
+		return challenge, nil
+	default:
+		return "", fmt.Errorf("unknown ACME challenge %q, supported challenges: %s, %s, %s", name, ChallengeTLSALPN01, ChallengeHTTP01, ChallengeDNS01)
+	}
+}
+
+// CertConfig configures the certificate of a server. With domains the certificate is issued by Let's Encrypt,
+// otherwise it is loaded from the certificate files.
+type CertConfig struct {
+	// CertFile and CertKey are the PEM encoded certificate chain and private key. They are reloaded when changed.
+	CertFile string
+	CertKey  string
+
+	LetsEncryptDomains []string
+	// LetsEncryptDataDir is where the issued certificates and the ACME account are stored
+	LetsEncryptDataDir string
+	LetsEncryptEmail   string
+	Challenge          Challenge
+	// HTTPChallengeAddress is where the HTTP-01 challenge is answered, defaults to :80
+	HTTPChallengeAddress string
+	// ListenPort is the port the server serves TLS on, it answers the TLS-ALPN-01 challenge itself on port 443
+	ListenPort int
+	// TLSChallengeAddress is where the TLS-ALPN-01 challenge is answered when the server doesn't run on port 443,
+	// defaults to :443
+	TLSChallengeAddress string
+}
+
+// Enabled returns true if the server should run with TLS
+func (c CertConfig) Enabled() bool {
+	return len(c.LetsEncryptDomains) > 0 || c.CertFile != "" || c.CertKey != ""
+}
+
+// Validate checks that either Let's Encrypt or both certificate files are configured
+func (c CertConfig) Validate() error {
+	if len(c.LetsEncryptDomains) > 0 {
+		if c.LetsEncryptDataDir == "" {
+			return errors.New("a data directory is required for Let's Encrypt")
+		}
+		_, err := ParseChallenge(string(c.Challenge))
+		return err
+	}
+
+	if c.CertFile == "" || c.CertKey == "" {
+		return errors.New("both cert file and cert key must be provided when not using Let's Encrypt")
+	}
+	return nil
+}
+
+// CertificateStatus describes the certificate served by a server
+type CertificateStatus struct {
+	Source string `json:"source"`
+	// Loaded is false until the certificate is known. Certificates issued with the TLS-ALPN-01 or HTTP-01 challenges
+	// are known after the first TLS handshake.
+	Loaded    bool       `json:"loaded"`
+	Subject   string     `json:"subject,omitempty"`
+	Issuer    string     `json:"issuer,omitempty"`
+	DNSNames  []string   `json:"dnsNames,omitempty"`
+	NotBefore *time.Time `json:"notBefore,omitempty"`
+	NotAfter  *time.Time `json:"notAfter,omitempty"`
+	// ExpiresInSeconds is negative for expired certificates
+	ExpiresInSeconds int64      `json:"expiresInSeconds,omitempty"`
+	Expired          bool       `json:"expired"`
+	LoadedAt         *time.Time `json:"loadedAt,omitempty"`
+}
+
+// CertificateManager provides the certificate of a server from the certificate files or from Let's Encrypt and
+// keeps track of the served certificate to report its expiry
+type CertificateManager struct {
+	source         string
+	tlsConfig      *tls.Config
+	getCertificate func(*tls.ClientHelloInfo) (*tls.Certificate, error)
+
+	file          *fileCertificate
+	httpChallenge *http.Server
+	tlsChallenge  *http.Server
+
+	mu       sync.RWMutex
+	cert     *tls.Certificate
+	leaf     *x509.Certificate
+	loadedAt time.Time
+}
+
+// NewCertificateManager creates the certificate manager of a server. The certificate files are watched and reloaded
+// when changed, Let's Encrypt certificates are renewed automatically.
+func NewCertificateManager(cfg CertConfig) (*CertificateManager, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	if len(cfg.LetsEncryptDomains) == 0 {
+		return newFileCertificateManager(cfg)
+	}
+
+	challenge, _ := ParseChallenge(string(cfg.Challenge))
+	if challenge == ChallengeDNS01 {
+		return newDNSCertificateManager(cfg)
+	}
+	return newACMECertificateManager(cfg, challenge)
+}
+
+func newFileCertificateManager(cfg CertConfig) (*CertificateManager, error) {
+	m := &CertificateManager{
+		source: sourceFile,
+		tlsConfig: &tls.Config{
+			ClientAuth: tls.NoClientCert,
+			NextProtos: []string{
+				"h2", "http/1.1", // enable HTTP/2
+			},
+		},
+	}
+
+	file, err := newFileCertificate(cfg.CertFile, cfg.CertKey, m.observe)
+	if err != nil {
+		return nil, err
+	}
+	m.file = file
+	m.getCertificate = file.GetCertificate
+
+	log.Infof("setting up TLS with the certificate %s", cfg.CertFile)
+	return m, nil
+}
+
+func newDNSCertificateManager(cfg CertConfig) (*CertificateManager, error) {
+	log.Infof("setting up TLS with Let's Encrypt DNS challenge in Route 53")
+	r53 := Route53TLS{
+		DataDir: cfg.LetsEncryptDataDir,
+		Email:   cfg.LetsEncryptEmail,
+		Domains: cfg.LetsEncryptDomains,
+	}
+	tlsConfig, err := r53.GetCertificate()
+	if err != nil {
+		return nil, err
+	}
+
+	m := &CertificateManager{
+		source:         sourceLetsEncrypt,
+		tlsConfig:      tlsConfig,
+		getCertificate: tlsConfig.GetCertificate,
+	}
+
+	// the certificates are obtained synchronously, so the served one is known right away
+	for _, domain := range cfg.LetsEncryptDomains {
+		if strings.HasPrefix(domain, "*") {
+			continue
+		}
+		if cert, err := m.getCertificate(&tls.ClientHelloInfo{ServerName: domain}); err == nil {
+			m.observe(cert)
+			break
+		}
+	}
+	return m, nil
+}
+
+func newACMECertificateManager(cfg CertConfig, challenge Challenge) (*CertificateManager, error) {
+	certManager, err := CreateCertManager(cfg.LetsEncryptDataDir, cfg.LetsEncryptDomains...)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating LetsEncrypt cert manager: %v", err)
+	}
+	certManager.Email = cfg.LetsEncryptEmail
+
+	m := &CertificateManager{
+		source:         sourceLetsEncrypt,
+		tlsConfig:      certManager.TLSConfig(),
+		getCertificate: certManager.GetCertificate,
+	}
+
+	switch {
+	case challenge == ChallengeHTTP01:
+		addr := cfg.HTTPChallengeAddress
+		if addr == "" {
+			addr = ":80"
+		}
+		listener, err := net.Listen("tcp", addr)
+		if err != nil {
+			return nil, fmt.Errorf("failed creating HTTP challenge listener on %s: %v", addr, err)
+		}
+
+		m.httpChallenge = serveChallenge(listener, certManager.HTTPHandler(nil), "HTTP")
+	case cfg.ListenPort != acmeChallengePort:
+		// Let's Encrypt validates the TLS-ALPN-01 challenge on port 443 only
+		addr := cfg.TLSChallengeAddress
+		if addr == "" {
+			addr = fmt.Sprintf(":%d", acmeChallengePort)
+		}
+		listener, err := net.Listen("tcp", addr)
+		if err != nil {
+			return nil, fmt.Errorf("failed creating TLS challenge listener on %s, the %s challenge is validated on port %d, "+
+				"run the server on port %d or use the %s or %s challenge: %v",
+				addr, ChallengeTLSALPN01, acmeChallengePort, acmeChallengePort, ChallengeHTTP01, ChallengeDNS01, err)
+		}
+
+		m.tlsChallenge = serveChallenge(tls.NewListener(listener, certManager.TLSConfig()), certManager.HTTPHandler(nil), "TLS")
+	}
+
+	log.Infof("setting up TLS with LetsEncrypt using the %s challenge", challenge)
+	return m, nil
+}
+
+// serveChallenge serves the ACME challenge requests, other requests are redirected to HTTPS
+func serveChallenge(listener net.Listener, handler http.Handler, kind string) *http.Server {
+	server := &http.Server{Handler: handler}
+	go func() {
+		if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Errorf("failed running %s challenge server: %v", kind, err)
+		}
+	}()
+	log.Infof("running %s server (LetsEncrypt challenge handler): %s", kind, listener.Addr().String())
+	return server
+}
+
+// TLSConfig returns the TLS configuration serving the managed certificate
+func (m *CertificateManager) TLSConfig() *tls.Config {
+	cfg := m.tlsConfig.Clone()
+	cfg.GetCertificate = m.serveCertificate
+	return cfg
+}
+
+// Status returns the status of the served certificate
+func (m *CertificateManager) Status() CertificateStatus {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	status := CertificateStatus{Source: m.source}
+	if m.leaf == nil {
+		return status
+	}
+
+	notBefore, notAfter, loadedAt := m.leaf.NotBefore, m.leaf.NotAfter, m.loadedAt
+	expiresIn := time.Until(notAfter)
+	status.Loaded = true
+	status.Subject = m.leaf.Subject.String()
+	status.Issuer = m.leaf.Issuer.String()
+	status.DNSNames = m.leaf.DNSNames
+	status.NotBefore = &notBefore
+	status.NotAfter = &notAfter
+	status.ExpiresInSeconds = int64(expiresIn.Seconds())
+	status.Expired = expiresIn <= 0
+	status.LoadedAt = &loadedAt
+	return status
+}
+
+// StatusHandler serves the status of the certificate as JSON. It responds with 503 when the certificate has expired.
+func (m *CertificateManager) StatusHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		status := m.Status()
+
+		w.Header().Set("Content-Type", "application/json")
+		if status.Expired {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		if err := json.NewEncoder(w).Encode(status); err != nil {
+			log.Debugf("failed writing certificate status: %v", err)
+		}
+	})
+}
+
+// Close stops watching the certificate files and the challenge servers
+func (m *CertificateManager) Close() error {
+	if m.file != nil {
+		m.file.close()
+	}
+
+	var errs []error
+	for _, server := range []*http.Server{m.httpChallenge, m.tlsChallenge} {
+		if server != nil {
+			errs = append(errs, server.Close())
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (m *CertificateManager) serveCertificate(hello *tls.ClientHelloInfo) (*tls.Certificate, error) {
+	cert, err := m.getCertificate(hello)
+	if err != nil {
+		return nil, err
+	}
+
+	// the TLS-ALPN-01 challenge is answered with a temporary certificate
+	if !slices.Contains(hello.SupportedProtos, acme.ALPNProto) {
+		m.observe(cert)
+	}
+	return cert, nil
+}
+
+// observe records the served certificate when it changes
+func (m *CertificateManager) observe(cert *tls.Certificate) {
+	m.mu.RLock()
+	known := m.cert == cert
+	m.mu.RUnlock()
+	if known {
+		return
+	}
+
+	leaf := cert.Leaf
+	if leaf == nil {
+		if len(cert.Certificate) == 0 {
+			return
+		}
+		var err error
+		leaf, err = x509.ParseCertificate(cert.Certificate[0])
+		if err != nil {
+			log.Warnf("failed parsing the served certificate: %v", err)
+			return
+		}
+	}
+
+	m.mu.Lock()
+	changed := m.leaf == nil || !m.leaf.Equal(leaf)
+	m.cert = cert
+	if changed {
+		m.leaf = leaf
+		m.loadedAt = time.Now()
+	}
+	m.mu.Unlock()
+
+	if !changed {
+		return
+	}
+
+	log.Infof("serving certificate for %s issued by %s, valid until %s", strings.Join(leaf.DNSNames, ", "), leaf.Issuer, leaf.NotAfter)
+	if time.Until(leaf.NotAfter) < expiryWarningPeriod {
+		log.Warnf("the served certificate expires at %s", leaf.NotAfter)
+	}
+}
@@ -0,0 +1,183 @@
+package encryption
+
+import (
+	"crypto/tls"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	log "github.com/sirupsen/logrus"
+)
+
+// reloadDelay groups the file events of a certificate update, the certificate and the key are usually written one
+// after the other
+var reloadDelay = 500 * time.Millisecond
+
+// fileCertificate serves a certificate loaded from files and reloads it when the files change
+type fileCertificate struct {
+	certFile string
+	keyFile  string
+	onLoad   func(*tls.Certificate)
+
+	mu   sync.RWMutex
+	cert *tls.Certificate
+
+	watcher     *fsnotify.Watcher
+	watcherWg   sync.WaitGroup
+	reloadTimer *time.Timer
+}
+
+func newFileCertificate(certFile, keyFile string, onLoad func(*tls.Certificate)) (*fileCertificate, error) {
+	f := &fileCertificate{
+		certFile: certFile,
+		keyFile:  keyFile,
+		onLoad:   onLoad,
+	}
+
+	if err := f.load(); err != nil {
+		return nil, err
+	}
+
+	if err := f.watch(); err != nil {
+		log.Warnf("failed watching the certificate files, changes require a restart: %v", err)
+	}
+	return f, nil
+}
+
+// GetCertificate returns the last loaded certificate
+func (f *fileCertificate) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return f.cert, nil
+}
+
+func (f *fileCertificate) load() error {
+	cert, err := tls.LoadX509KeyPair(f.certFile, f.keyFile)
+	if err != nil {
+		return fmt.Errorf("cannot load TLS credentials: %v", err)
+	}
+
+	f.mu.Lock()
+	f.cert = &cert
+	f.mu.Unlock()
+
+	f.onLoad(&cert)
+	return nil
+}
+
+// watch watches the directories of the files, so the files replaced by a rename or a symlink swap, e.g. a Kubernetes
+// secret update, are also noticed
+func (f *fileCertificate) watch() error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+
+	for _, dir := range uniqueDirs(f.certFile, f.keyFile) {
+		if err := watcher.Add(dir); err != nil {
+			_ = watcher.Close()
+			return fmt.Errorf("watch %s: %v", dir, err)
+		}
+	}
+	f.watcher = watcher
+
+	f.watcherWg.Add(1)
+	go func() {
+		defer f.watcherWg.Done()
+		for {
+			select {
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if event.Has(fsnotify.Chmod) && !event.Has(fsnotify.Write) {
+					continue
+				}
+				f.scheduleReload()
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				log.Warnf("error while watching the certificate files: %v", err)
+			}
+		}
+	}()
+	return nil
+}
+
+func (f *fileCertificate) scheduleReload() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.reloadTimer != nil {
+		f.reloadTimer.Reset(reloadDelay)
+		return
+	}
+	f.reloadTimer = time.AfterFunc(reloadDelay, f.reload)
+}
+
+func (f *fileCertificate) reload() {
+	f.mu.RLock()
+	current := f.cert
+	f.mu.RUnlock()
+
+	cert, err := tls.LoadX509KeyPair(f.certFile, f.keyFile)
+	if err != nil {
+		log.Warnf("failed reloading the certificate, keep serving the previous one: %v", err)
+		return
+	}
+	if current != nil && equalCertificates(current, &cert) {
+		return
+	}
+
+	f.mu.Lock()
+	f.cert = &cert
+	f.mu.Unlock()
+
+	log.Infof("reloaded the certificate %s", f.certFile)
+	f.onLoad(&cert)
+}
+
+func (f *fileCertificate) close() {
+	if f.watcher == nil {
+		return
+	}
+	if err := f.watcher.Close(); err != nil {
+		log.Warnf("failed closing the certificate files watcher: %v", err)
+	}
+	f.watcherWg.Wait()
+
+	f.mu.Lock()
+	if f.reloadTimer != nil {
+		f.reloadTimer.Stop()
+	}
+	f.mu.Unlock()
+}
+
+func equalCertificates(a, b *tls.Certificate) bool {
+	if len(a.Certificate) != len(b.Certificate) {
+		return false
+	}
+	for i := range a.Certificate {
+		if string(a.Certificate[i]) != string(b.Certificate[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func uniqueDirs(files ...string) []string {
+	var dirs []string
+	seen := make(map[string]struct{})
+	for _, file := range files {
+		dir := filepath.Dir(file)
+		if _, ok := seen[dir]; ok {
+			continue
+		}
+		seen[dir] = struct{}{}
+		dirs = append(dirs, dir)
+	}
+	return dirs
+}
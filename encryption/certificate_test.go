@@ -0,0 +1,206 @@
+package encryption
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/acme"
+)
+
+func writeCertificate(t *testing.T, certFile, keyFile string, notAfter time.Time) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: "netbird.example.com"},
+		DNSNames:     []string{"netbird.example.com"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     notAfter,
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600))
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER}), 0600))
+}
+
+func TestCertificateManager_ReloadsFiles(t *testing.T) {
+	reloadDelay = 10 * time.Millisecond
+
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")
+	firstExpiry := time.Now().Add(24 * time.Hour).Truncate(time.Second)
+	writeCertificate(t, certFile, keyFile, firstExpiry)
+
+	m, err := NewCertificateManager(CertConfig{CertFile: certFile, CertKey: keyFile})
+	require.NoError(t, err)
+	defer func() {
+		_ = m.Close()
+	}()
+
+	status := m.Status()
+	require.True(t, status.Loaded)
+	assert.Equal(t, "file", status.Source)
+	assert.Equal(t, []string{"netbird.example.com"}, status.DNSNames)
+	assert.True(t, firstExpiry.Equal(*status.NotAfter))
+
+	secondExpiry := time.Now().Add(48 * time.Hour).Truncate(time.Second)
+	writeCertificate(t, certFile, keyFile, secondExpiry)
+
+	require.Eventually(t, func() bool {
+		return secondExpiry.Equal(*m.Status().NotAfter)
+	}, 5*time.Second, 10*time.Millisecond, "certificate was not reloaded")
+
+	cert, err := m.TLSConfig().GetCertificate(&tls.ClientHelloInfo{ServerName: "netbird.example.com"})
+	require.NoError(t, err)
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	require.NoError(t, err)
+	assert.True(t, secondExpiry.Equal(leaf.NotAfter), "the reloaded certificate should be served")
+}
+
+func TestCertificateManager_KeepsCertificateOnBrokenFiles(t *testing.T) {
+	reloadDelay = 10 * time.Millisecond
+
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")
+	expiry := time.Now().Add(24 * time.Hour).Truncate(time.Second)
+	writeCertificate(t, certFile, keyFile, expiry)
+
+	m, err := NewCertificateManager(CertConfig{CertFile: certFile, CertKey: keyFile})
+	require.NoError(t, err)
+	defer func() {
+		_ = m.Close()
+	}()
+
+	require.NoError(t, os.WriteFile(certFile, []byte("broken"), 0600))
+	time.Sleep(100 * time.Millisecond)
+
+	cert, err := m.TLSConfig().GetCertificate(&tls.ClientHelloInfo{})
+	require.NoError(t, err)
+	require.NotNil(t, cert)
+	assert.True(t, expiry.Equal(*m.Status().NotAfter))
+}
+
+func TestCertificateManager_StatusHandler(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")
+	writeCertificate(t, certFile, keyFile, time.Now().Add(-time.Minute))
+
+	m, err := NewCertificateManager(CertConfig{CertFile: certFile, CertKey: keyFile})
+	require.NoError(t, err)
+	defer func() {
+		_ = m.Close()
+	}()
+
+	rec := httptest.NewRecorder()
+	m.StatusHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, CertificateStatusEndpoint, nil))
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+
+	var status CertificateStatus
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &status))
+	assert.True(t, status.Expired)
+	assert.Negative(t, status.ExpiresInSeconds)
+}
+
+// freeAddress returns a local address nothing listens on
+func freeAddress(t *testing.T) string {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := listener.Addr().String()
+	require.NoError(t, listener.Close())
+	return addr
+}
+
+func TestCertificateManager_TLSChallengeListener(t *testing.T) {
+	newConfig := func(listenPort int, challengeAddr string) CertConfig {
+		return CertConfig{
+			LetsEncryptDomains:  []string{"netbird.example.com"},
+			LetsEncryptDataDir:  t.TempDir(),
+			Challenge:           ChallengeTLSALPN01,
+			ListenPort:          listenPort,
+			TLSChallengeAddress: challengeAddr,
+		}
+	}
+
+	t.Run("servers on port 443 answer the challenge themselves", func(t *testing.T) {
+		m, err := NewCertificateManager(newConfig(443, freeAddress(t)))
+		require.NoError(t, err)
+		defer func() {
+			_ = m.Close()
+		}()
+		assert.Nil(t, m.tlsChallenge)
+	})
+
+	t.Run("servers on other ports answer the challenge on port 443", func(t *testing.T) {
+		addr := freeAddress(t)
+		m, err := NewCertificateManager(newConfig(33073, addr))
+		require.NoError(t, err)
+		require.NotNil(t, m.tlsChallenge)
+
+		// the challenge listener answers the ACME TLS handshakes, refusing the unknown challenge token
+		_, err = tls.Dial("tcp", addr, &tls.Config{ServerName: "netbird.example.com", NextProtos: []string{acme.ALPNProto}})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "remote error")
+
+		require.NoError(t, m.Close())
+		_, err = net.Dial("tcp", addr)
+		assert.Error(t, err, "the challenge listener should be closed with the manager")
+	})
+
+	t.Run("an unusable challenge port fails the start", func(t *testing.T) {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		defer listener.Close()
+
+		_, err = NewCertificateManager(newConfig(33073, listener.Addr().String()))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), string(ChallengeHTTP01), "the error should suggest the other challenges")
+	})
+}
+
+func TestCertConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     CertConfig
+		wantErr bool
+	}{
+		{"cert files", CertConfig{CertFile: "cert.pem", CertKey: "key.pem"}, false},
+		{"missing cert key", CertConfig{CertFile: "cert.pem"}, true},
+		{"letsencrypt", CertConfig{LetsEncryptDomains: []string{"example.com"}, LetsEncryptDataDir: "/var/lib/netbird"}, false},
+		{"letsencrypt without data dir", CertConfig{LetsEncryptDomains: []string{"example.com"}}, true},
+		{"unknown challenge", CertConfig{LetsEncryptDomains: []string{"example.com"}, LetsEncryptDataDir: "/var/lib/netbird", Challenge: "smtp-01"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -9,6 +9,9 @@ import (
 type WGAddress struct {
 	IP      net.IP
 	Network *net.IPNet
+	// IPv6 is the optional overlay IPv6 address of the interface
+	IPv6      net.IP
+	NetworkV6 *net.IPNet
 }
 
 // parseWGAddress parse a string ("1.2.3.4/24") address to WG Address
@@ -23,7 +26,43 @@ func parseWGAddress(address string) (WGAddress, error) {
 	}, nil
 }
 
+// setIPv6 parses a string ("fd00::1/64") IPv6 address and sets it on the WG Address. An empty string removes the IPv6 address
+func (addr *WGAddress) setIPv6(address string) error {
+	if address == "" {
+		addr.IPv6 = nil
+		addr.NetworkV6 = nil
+		return nil
+	}
+
+	ip, network, err := net.ParseCIDR(address)
+	if err != nil {
+		return err
+	}
+
+	if ip.To4() != nil {
+		return fmt.Errorf("address %s is not an IPv6 address", address)
+	}
+
+	addr.IPv6 = ip
+	addr.NetworkV6 = network
+	return nil
+}
+
+// HasIPv6 indicates whether the WG Address has an IPv6 address
+func (addr WGAddress) HasIPv6() bool {
+	return addr.IPv6 != nil && addr.NetworkV6 != nil
+}
+
 func (addr WGAddress) String() string {
 	maskSize, _ := addr.Network.Mask.Size()
 	return fmt.Sprintf("%s/%d", addr.IP.String(), maskSize)
 }
+
+// StringV6 returns the IPv6 address in the CIDR format or an empty string if there is no IPv6 address
+func (addr WGAddress) StringV6() string {
+	if !addr.HasIPv6() {
+		return ""
+	}
+	maskSize, _ := addr.NetworkV6.Mask.Size()
+	return fmt.Sprintf("%s/%d", addr.IPv6.String(), maskSize)
+}
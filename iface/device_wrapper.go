@@ -26,6 +26,9 @@ type PacketFilter interface {
 
 	// SetNetwork of the wireguard interface to which filtering applied
 	SetNetwork(*net.IPNet)
+
+	// SetNetworkV6 of the wireguard interface to which IPv6 filtering applied, nil when the interface has no IPv6 address
+	SetNetworkV6(*net.IPNet)
 }
 
 // DeviceWrapper to override Read or Write of packets
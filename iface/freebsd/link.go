@@ -103,6 +103,16 @@ func (l *Link) AssignAddr(ip, netmask string) error {
 	return l.setAddr(ip, netmask)
 }
 
+// AssignAddrV6 adds an IPv6 address with the prefix length to the network interface.
+func (l *Link) AssignAddrV6(ip string, prefixLen int) error {
+	return l.ifconfig("set interface IPv6 addr", "inet6", ip, "prefixlen", strconv.Itoa(prefixLen), "alias")
+}
+
+// DelAddrV6 removes an IPv6 address from the network interface.
+func (l *Link) DelAddrV6(ip string) error {
+	return l.ifconfig("delete interface IPv6 addr", "inet6", ip, "-alias")
+}
+
 func (l *Link) Up() error {
 	return l.up(l.name)
 }
@@ -206,6 +216,21 @@ func (l *Link) setAddr(ip, netmask string) error {
 	return nil
 }
 
+func (l *Link) ifconfig(action string, args ...string) error {
+	var stderr bytes.Buffer
+
+	cmd := exec.Command("ifconfig", append([]string{l.name}, args...)...)
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		log.Debugf("ifconfig out: %s", stderr.String())
+
+		return fmt.Errorf("%s: %w", action, err)
+	}
+
+	return nil
+}
+
 func (l *Link) up(name string) error {
 	var stderr bytes.Buffer
 
@@ -80,9 +80,34 @@ func (w *WGIface) UpdateAddr(newAddr string) error {
 		return err
 	}
 
+	current := w.tun.WgAddress()
+	addr.IPv6 = current.IPv6
+	addr.NetworkV6 = current.NetworkV6
+
 	return w.tun.UpdateAddr(addr)
 }
 
+// UpdateAddrV6 updates the overlay IPv6 address of the interface. An empty address removes it.
+// The IPv6 address is not applied to the mobile and the netstack interfaces
+func (w *WGIface) UpdateAddrV6(newAddr string) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	addr := w.tun.WgAddress()
+	if err := addr.setIPv6(newAddr); err != nil {
+		return err
+	}
+
+	if err := w.tun.UpdateAddr(addr); err != nil {
+		return err
+	}
+
+	if w.filter != nil {
+		w.filter.SetNetworkV6(addr.NetworkV6)
+	}
+	return nil
+}
+
 // UpdatePeer updates existing Wireguard Peer or creates a new one if doesn't exist
 // Endpoint is optional
 func (w *WGIface) UpdatePeer(peerKey string, allowedIps string, keepAlive time.Duration, endpoint *net.UDPAddr, preSharedKey *wgtypes.Key) error {
@@ -154,6 +179,9 @@ func (w *WGIface) SetFilter(filter PacketFilter) error {
 
 	w.filter = filter
 	w.filter.SetNetwork(w.tun.WgAddress().Network)
+	if addr := w.tun.WgAddress(); addr.HasIPv6() {
+		w.filter.SetNetworkV6(addr.NetworkV6)
+	}
 
 	w.tun.Wrapper().SetFilter(filter)
 	return nil
@@ -101,3 +101,15 @@ func (mr *MockPacketFilterMockRecorder) SetNetwork(arg0 interface{}) *gomock.Cal
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNetwork", reflect.TypeOf((*MockPacketFilter)(nil).SetNetwork), arg0)
 }
+
+// SetNetworkV6 mocks base method.
+func (m *MockPacketFilter) SetNetworkV6(arg0 *net.IPNet) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetNetworkV6", arg0)
+}
+
+// SetNetworkV6 indicates an expected call of SetNetworkV6.
+func (mr *MockPacketFilterMockRecorder) SetNetworkV6(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNetworkV6", reflect.TypeOf((*MockPacketFilter)(nil).SetNetworkV6), arg0)
+}
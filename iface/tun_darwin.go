@@ -5,6 +5,7 @@ package iface
 import (
 	"fmt"
 	"os/exec"
+	"strconv"
 
 	"github.com/pion/transport/v3"
 	log "github.com/sirupsen/logrus"
@@ -85,6 +86,13 @@ func (t *tunDevice) Up() (*bind.UniversalUDPMuxDefault, error) {
 }
 
 func (t *tunDevice) UpdateAddr(address WGAddress) error {
+	if t.address.HasIPv6() && t.address.StringV6() != address.StringV6() {
+		cmd := exec.Command("ifconfig", t.name, "inet6", t.address.IPv6.String(), "delete")
+		if out, err := cmd.CombinedOutput(); err != nil {
+			log.Warnf("removing address command '%v' failed with output: %s", cmd.String(), out)
+		}
+	}
+
 	t.address = address
 	return t.assignAddr()
 }
@@ -131,6 +139,15 @@ func (t *tunDevice) assignAddr() error {
 		log.Debugf("adding address command '%v' failed with output: %s", cmd.String(), out)
 	}
 
+	// the IPv6 address is optional, failures are not fatal as IPv6 might be disabled on the host
+	if t.address.HasIPv6() {
+		prefixLen, _ := t.address.NetworkV6.Mask.Size()
+		cmd = exec.Command("ifconfig", t.name, "inet6", t.address.IPv6.String(), "prefixlen", strconv.Itoa(prefixLen), "alias")
+		if out, err := cmd.CombinedOutput(); err != nil {
+			log.Warnf("adding address command '%v' failed with output: %s", cmd.String(), out)
+		}
+	}
+
 	routeCmd := exec.Command("route", "add", "-net", t.address.Network.String(), "-interface", t.name)
 	if out, err := routeCmd.CombinedOutput(); err != nil {
 		log.Errorf("adding route command '%v' failed with output: %s", routeCmd.String(), out)
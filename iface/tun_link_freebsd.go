@@ -2,6 +2,7 @@ package iface
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/netbirdio/netbird/iface/freebsd"
 	log "github.com/sirupsen/logrus"
@@ -10,6 +11,8 @@ import (
 type wgLink struct {
 	name string
 	link *freebsd.Link
+	// addrV6 is the IPv6 address assigned to the interface, removed when it changes
+	addrV6 string
 }
 
 func newWGLink(name string) *wgLink {
@@ -71,6 +74,8 @@ func (l *wgLink) assignAddr(address WGAddress) error {
 		return fmt.Errorf("assign addr: %w", err)
 	}
 
+	l.assignAddrV6(link, address)
+
 	err = link.Up()
 	if err != nil {
 		return fmt.Errorf("up: %w", err)
@@ -78,3 +83,30 @@ func (l *wgLink) assignAddr(address WGAddress) error {
 
 	return nil
 }
+
+// assignAddrV6 replaces the IPv6 address of the interface. Failures are not fatal as IPv6 might be disabled on the host
+func (l *wgLink) assignAddrV6(link *freebsd.Link, address WGAddress) {
+	if l.addrV6 == address.StringV6() {
+		return
+	}
+
+	if l.addrV6 != "" {
+		ip, _, _ := strings.Cut(l.addrV6, "/")
+		if err := link.DelAddrV6(ip); err != nil {
+			log.Warnf("failed to remove IPv6 address %s from interface %s: %v", l.addrV6, l.name, err)
+		}
+		l.addrV6 = ""
+	}
+
+	if !address.HasIPv6() {
+		return
+	}
+
+	prefixLen, _ := address.NetworkV6.Mask.Size()
+	log.Infof("assign addr %s/%d to %s interface", address.IPv6, prefixLen, l.name)
+	if err := link.AssignAddrV6(address.IPv6.String(), prefixLen); err != nil {
+		log.Warnf("failed to add IPv6 address %s to interface %s: %v", address.StringV6(), l.name, err)
+		return
+	}
+	l.addrV6 = address.StringV6()
+}
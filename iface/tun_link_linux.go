@@ -124,6 +124,10 @@ func (l *wgLink) assignAddr(address WGAddress) error {
 		return fmt.Errorf("add addr: %w", err)
 	}
 
+	if address.HasIPv6() {
+		l.assignAddrV6(address)
+	}
+
 	// On linux, the link must be brought up
 	if err := netlink.LinkSetUp(l); err != nil {
 		return fmt.Errorf("link setup: %w", err)
@@ -131,3 +135,22 @@ func (l *wgLink) assignAddr(address WGAddress) error {
 
 	return nil
 }
+
+// assignAddrV6 adds the IPv6 address to the interface. Failures are not fatal as IPv6 might be disabled on the host
+func (l *wgLink) assignAddrV6(address WGAddress) {
+	addrStr := address.StringV6()
+	log.Debugf("adding address %s to interface: %s", addrStr, l.attrs.Name)
+
+	addr, err := netlink.ParseAddr(addrStr)
+	if err != nil {
+		log.Warnf("failed to parse IPv6 address %s: %v", addrStr, err)
+		return
+	}
+
+	err = netlink.AddrAdd(l, addr)
+	if os.IsExist(err) {
+		log.Infof("interface %s already has the address: %s", l.attrs.Name, addrStr)
+	} else if err != nil {
+		log.Warnf("failed to add IPv6 address %s to interface %s: %v", addrStr, l.attrs.Name, err)
+	}
+}
@@ -167,5 +167,10 @@ func (t *tunDevice) getInterfaceGUIDString() (string, error) {
 func (t *tunDevice) assignAddr() error {
 	luid := winipcfg.LUID(t.nativeTunDevice.LUID())
 	log.Debugf("adding address %s to interface: %s", t.address.IP, t.name)
-	return luid.SetIPAddresses([]netip.Prefix{netip.MustParsePrefix(t.address.String())})
+	prefixes := []netip.Prefix{netip.MustParsePrefix(t.address.String())}
+	if t.address.HasIPv6() {
+		log.Debugf("adding address %s to interface: %s", t.address.IPv6, t.name)
+		prefixes = append(prefixes, netip.MustParsePrefix(t.address.StringV6()))
+	}
+	return luid.SetIPAddresses(prefixes)
 }
@@ -381,6 +381,16 @@ func (c *GrpcClient) Login(serverKey wgtypes.Key, sysInfo *system.Info, pubSSHKe
 	return c.login(serverKey, &proto.LoginRequest{Meta: infoToMetaData(sysInfo), PeerKeys: keys})
 }
 
+// RenewLogin re-authenticates the peer with the JWT token to extend its login before it expires.
+// Takes care of encrypting and decrypting messages.
+func (c *GrpcClient) RenewLogin(serverKey wgtypes.Key, jwtToken string, sysInfo *system.Info, pubSSHKey []byte) (*proto.LoginResponse, error) {
+	keys := &proto.PeerKeys{
+		SshPubKey: pubSSHKey,
+		WgPubKey:  []byte(c.key.PublicKey().String()),
+	}
+	return c.login(serverKey, &proto.LoginRequest{Meta: infoToMetaData(sysInfo), JwtToken: jwtToken, PeerKeys: keys, RenewLogin: true})
+}
+
 // GetDeviceAuthorizationFlow returns a device authorization flow information.
 // It also takes care of encrypting and decrypting messages.
 func (c *GrpcClient) GetDeviceAuthorizationFlow(serverKey wgtypes.Key) (*proto.DeviceAuthorizationFlow, error) {
@@ -15,6 +15,7 @@ type MockClient struct {
 	GetServerPublicKeyFunc         func() (*wgtypes.Key, error)
 	RegisterFunc                   func(serverKey wgtypes.Key, setupKey string, jwtToken string, info *system.Info, sshKey []byte) (*proto.LoginResponse, error)
 	LoginFunc                      func(serverKey wgtypes.Key, info *system.Info, sshKey []byte) (*proto.LoginResponse, error)
+	RenewLoginFunc                 func(serverKey wgtypes.Key, jwtToken string, info *system.Info, sshKey []byte) (*proto.LoginResponse, error)
 	GetDeviceAuthorizationFlowFunc func(serverKey wgtypes.Key) (*proto.DeviceAuthorizationFlow, error)
 	GetPKCEAuthorizationFlowFunc   func(serverKey wgtypes.Key) (*proto.PKCEAuthorizationFlow, error)
 	SyncMetaFunc                   func(sysInfo *system.Info) error
@@ -59,6 +60,13 @@ func (m *MockClient) Login(serverKey wgtypes.Key, info *system.Info, sshKey []by
 	return m.LoginFunc(serverKey, info, sshKey)
 }
 
+func (m *MockClient) RenewLogin(serverKey wgtypes.Key, jwtToken string, info *system.Info, sshKey []byte) (*proto.LoginResponse, error) {
+	if m.RenewLoginFunc == nil {
+		return nil, nil
+	}
+	return m.RenewLoginFunc(serverKey, jwtToken, info, sshKey)
+}
+
 func (m *MockClient) GetDeviceAuthorizationFlow(serverKey wgtypes.Key) (*proto.DeviceAuthorizationFlow, error) {
 	if m.GetDeviceAuthorizationFlowFunc == nil {
 		return nil, nil
@@ -0,0 +1,227 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/netbirdio/netbird/management/server/http/api"
+)
+
+const apiTokenEnv = "NETBIRD_API_TOKEN"
+
+var (
+	accountConfigAPIURL    string
+	accountConfigToken     string
+	accountConfigAccountID string
+	accountConfigFile      string
+	accountConfigFormat    string
+	accountConfigDryRun    bool
+
+	accountConfigCmd = &cobra.Command{
+		Use:          "account-config",
+		Short:        "Contains sub-commands to export and apply the declarative account configuration",
+		Long:         "",
+		SilenceUsage: true,
+	}
+
+	accountConfigExportCmd = &cobra.Command{
+		Use:   "export [--api-url url] [--token token] [--file path] [--format json|yaml]",
+		Short: "Export groups, posture checks, policies, routes, nameserver groups and DNS settings of the account",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := newAccountConfigClient()
+			if err != nil {
+				return err
+			}
+
+			data, err := client.export(accountConfigFormat)
+			if err != nil {
+				return err
+			}
+
+			if accountConfigFile == "" || accountConfigFile == "-" {
+				_, err = cmd.OutOrStdout().Write(data)
+				return err
+			}
+			return os.WriteFile(accountConfigFile, data, 0600)
+		},
+	}
+
+	accountConfigApplyCmd = &cobra.Command{
+		Use:   "apply --file path [--api-url url] [--token token] [--dry-run]",
+		Short: "Apply a declarative configuration document to the account",
+		Long: "Apply a declarative configuration document to the account.\n\n" +
+			"Objects missing from a section of the document are removed, sections missing from the document are not changed. " +
+			"Use --dry-run to print the changes without performing them.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if accountConfigFile == "" {
+				return fmt.Errorf("--file is required")
+			}
+
+			var data []byte
+			var err error
+			if accountConfigFile == "-" {
+				data, err = io.ReadAll(cmd.InOrStdin())
+			} else {
+				data, err = os.ReadFile(accountConfigFile)
+			}
+			if err != nil {
+				return fmt.Errorf("failed reading document: %v", err)
+			}
+
+			client, err := newAccountConfigClient()
+			if err != nil {
+				return err
+			}
+
+			plan, err := client.apply(data, accountConfigDryRun)
+			if err != nil {
+				return err
+			}
+
+			printAccountConfigPlan(cmd.OutOrStdout(), plan)
+			return nil
+		},
+	}
+)
+
+func init() {
+	accountConfigCmd.PersistentFlags().StringVar(&accountConfigAPIURL, "api-url", "http://localhost", "management API URL")
+	accountConfigCmd.PersistentFlags().StringVar(&accountConfigToken, "token", "", "personal access token, defaults to the "+apiTokenEnv+" environment variable")
+	accountConfigCmd.PersistentFlags().StringVar(&accountConfigAccountID, "account-id", "", "ID of the account, defaults to the account of the token owner")
+	accountConfigCmd.PersistentFlags().StringVar(&accountConfigFile, "file", "", "document location, - for stdin/stdout")
+
+	accountConfigExportCmd.Flags().StringVar(&accountConfigFormat, "format", "yaml", "document format, json or yaml")
+	accountConfigApplyCmd.Flags().BoolVar(&accountConfigDryRun, "dry-run", false, "print the changes without performing them")
+
+	accountConfigCmd.AddCommand(accountConfigExportCmd)
+	accountConfigCmd.AddCommand(accountConfigApplyCmd)
+
+	rootCmd.AddCommand(accountConfigCmd)
+}
+
+type accountConfigClient struct {
+	httpClient *http.Client
+	baseURL    string
+	token      string
+	accountID  string
+}
+
+func newAccountConfigClient() (*accountConfigClient, error) {
+	token := accountConfigToken
+	if token == "" {
+		token = os.Getenv(apiTokenEnv)
+	}
+	if token == "" {
+		return nil, fmt.Errorf("an access token should be provided with --token or the %s environment variable", apiTokenEnv)
+	}
+
+	client := &accountConfigClient{
+		httpClient: &http.Client{Timeout: time.Minute},
+		baseURL:    strings.TrimSuffix(accountConfigAPIURL, "/"),
+		token:      token,
+		accountID:  accountConfigAccountID,
+	}
+
+	if client.accountID == "" {
+		var accounts []api.Account
+		data, err := client.do(http.MethodGet, "/api/accounts", "", nil)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(data, &accounts); err != nil {
+			return nil, fmt.Errorf("failed parsing accounts: %v", err)
+		}
+		if len(accounts) == 0 {
+			return nil, fmt.Errorf("no account found for the token")
+		}
+		client.accountID = accounts[0].Id
+	}
+
+	return client, nil
+}
+
+func (c *accountConfigClient) export(format string) ([]byte, error) {
+	path := fmt.Sprintf("/api/accounts/%s/config?format=%s", url.PathEscape(c.accountID), url.QueryEscape(format))
+	return c.do(http.MethodGet, path, "", nil)
+}
+
+func (c *accountConfigClient) apply(document []byte, dryRun bool) (*api.AccountConfigPlan, error) {
+	path := fmt.Sprintf("/api/accounts/%s/config?dry_run=%t", url.PathEscape(c.accountID), dryRun)
+	data, err := c.do(http.MethodPost, path, "application/yaml", document)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &api.AccountConfigPlan{}
+	if err := json.Unmarshal(data, plan); err != nil {
+		return nil, fmt.Errorf("failed parsing apply result: %v", err)
+	}
+	return plan, nil
+}
+
+func (c *accountConfigClient) do(method, path, contentType string, body []byte) ([]byte, error) {
+	req, err := http.NewRequest(method, c.baseURL+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Token "+c.token)
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request to %s failed: %v", c.baseURL, err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed reading response: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		var errResp struct {
+			Message string `json:"message"`
+		}
+		if json.Unmarshal(data, &errResp) == nil && errResp.Message != "" {
+			return nil, fmt.Errorf("request failed with status %d: %s", resp.StatusCode, errResp.Message)
+		}
+		return nil, fmt.Errorf("request failed with status %d", resp.StatusCode)
+	}
+
+	return data, nil
+}
+
+func printAccountConfigPlan(w io.Writer, plan *api.AccountConfigPlan) {
+	if len(plan.Changes) == 0 {
+		_, _ = fmt.Fprintln(w, "No changes, the account is up to date")
+		return
+	}
+
+	for _, change := range plan.Changes {
+		ref := ""
+		if change.Name != nil {
+			ref = *change.Name
+		}
+		if change.Id != nil {
+			ref = strings.TrimSpace(ref + " (" + *change.Id + ")")
+		}
+		_, _ = fmt.Fprintf(w, "%-7s %-16s %s\n", change.Action, change.Kind, ref)
+	}
+
+	if plan.DryRun {
+		_, _ = fmt.Fprintf(w, "%d changes planned, nothing was applied (dry run)\n", len(plan.Changes))
+		return
+	}
+	_, _ = fmt.Fprintf(w, "%d changes applied\n", len(plan.Changes))
+}
@@ -24,7 +24,6 @@ import (
 	"github.com/miekg/dns"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
-	"golang.org/x/crypto/acme/autocert"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
 	"google.golang.org/grpc"
@@ -61,6 +60,8 @@ var (
 	mgmtSingleAccModeDomain string
 	certFile                string
 	certKey                 string
+	mgmtLetsencryptEmail    string
+	mgmtACMEChallenge       string
 	config                  *server.Config
 
 	kaep = keepalive.EnforcementPolicy{
@@ -143,6 +144,16 @@ var (
 			if err != nil {
 				return err
 			}
+			certManager, err := newCertificateManager(config)
+			if err != nil {
+				return err
+			}
+			if certManager != nil {
+				defer func() {
+					_ = certManager.Close()
+				}()
+				appMetrics.Handle(encryption.CertificateStatusEndpoint, certManager.StatusHandler())
+			}
 			err = appMetrics.Expose(ctx, mgmtMetricsPort, "/metrics")
 			if err != nil {
 				return err
@@ -222,26 +233,10 @@ var (
 				grpc.ChainStreamInterceptor(realip.StreamServerInterceptorOpts(realipOpts...), streamInterceptor),
 			}
 
-			var certManager *autocert.Manager
-			var tlsConfig *tls.Config
-			tlsEnabled := false
-			if config.HttpConfig.LetsEncryptDomain != "" {
-				certManager, err = encryption.CreateCertManager(config.Datadir, config.HttpConfig.LetsEncryptDomain)
-				if err != nil {
-					return fmt.Errorf("failed creating LetsEncrypt cert manager: %v", err)
-				}
+			tlsEnabled := certManager != nil
+			if tlsEnabled {
 				transportCredentials := credentials.NewTLS(certManager.TLSConfig())
 				gRPCOpts = append(gRPCOpts, grpc.Creds(transportCredentials))
-				tlsEnabled = true
-			} else if config.HttpConfig.CertFile != "" && config.HttpConfig.CertKey != "" {
-				tlsConfig, err = loadTLSConfig(config.HttpConfig.CertFile, config.HttpConfig.CertKey)
-				if err != nil {
-					log.WithContext(ctx).Errorf("cannot load TLS credentials: %v", err)
-					return err
-				}
-				transportCredentials := credentials.NewTLS(tlsConfig)
-				gRPCOpts = append(gRPCOpts, grpc.Creds(transportCredentials))
-				tlsEnabled = true
 			}
 
 			jwtValidator, err := jwtclaims.NewJWTValidator(
@@ -305,23 +300,8 @@ var (
 
 			rootHandler := handlerFunc(gRPCAPIHandler, httpAPIHandler)
 			var listener net.Listener
-			if certManager != nil {
-				// a call to certManager.Listener() always creates a new listener so we do it once
-				cml := certManager.Listener()
-				if mgmtPort == 443 {
-					// CertManager, HTTP and gRPC API all on the same port
-					rootHandler = certManager.HTTPHandler(rootHandler)
-					listener = cml
-				} else {
-					listener, err = tls.Listen("tcp", fmt.Sprintf(":%d", mgmtPort), certManager.TLSConfig())
-					if err != nil {
-						return fmt.Errorf("failed creating TLS listener on port %d: %v", mgmtPort, err)
-					}
-					log.WithContext(ctx).Infof("running HTTP server (LetsEncrypt challenge handler): %s", cml.Addr().String())
-					serveHTTP(ctx, cml, certManager.HTTPHandler(nil))
-				}
-			} else if tlsConfig != nil {
-				listener, err = tls.Listen("tcp", fmt.Sprintf(":%d", mgmtPort), tlsConfig)
+			if tlsEnabled {
+				listener, err = tls.Listen("tcp", fmt.Sprintf(":%d", mgmtPort), certManager.TLSConfig())
 				if err != nil {
 					return fmt.Errorf("failed creating TLS listener on port %d: %v", mgmtPort, err)
 				}
@@ -346,9 +326,6 @@ var (
 			ephemeralManager.Stop()
 			_ = appMetrics.Close()
 			_ = listener.Close()
-			if certManager != nil {
-				_ = certManager.Listener().Close()
-			}
 			gRPCAPIHandler.Stop()
 			_ = store.Close(ctx)
 			_ = eventStore.Close(ctx)
@@ -425,15 +402,6 @@ func serveGRPC(ctx context.Context, grpcServer *grpc.Server, port int) (net.List
 	return listener, nil
 }
 
-func serveHTTP(ctx context.Context, httpListener net.Listener, handler http.Handler) {
-	go func() {
-		err := http.Serve(httpListener, handler)
-		if err != nil {
-			notifyStop(ctx, fmt.Sprintf("failed running HTTP server: %v", err))
-		}
-	}()
-}
-
 func serveGRPCWithHTTP(ctx context.Context, listener net.Listener, handler http.Handler, tlsEnabled bool) {
 	go func() {
 		var err error
@@ -591,23 +559,34 @@ func fetchOIDCConfig(ctx context.Context, oidcEndpoint string) (OIDCConfigRespon
 	return config, nil
 }
 
-func loadTLSConfig(certFile string, certKey string) (*tls.Config, error) {
-	// Load server's certificate and private key
-	serverCert, err := tls.LoadX509KeyPair(certFile, certKey)
+// newCertificateManager creates the certificate manager of the server, it returns nil when TLS is not configured
+func newCertificateManager(config *server.Config) (*encryption.CertificateManager, error) {
+	challenge, err := encryption.ParseChallenge(mgmtACMEChallenge)
 	if err != nil {
 		return nil, err
 	}
 
-	// NewDefaultAppMetrics the credentials and return it
-	config := &tls.Config{
-		Certificates: []tls.Certificate{serverCert},
-		ClientAuth:   tls.NoClientCert,
-		NextProtos: []string{
-			"h2", "http/1.1", // enable HTTP/2
-		},
+	certConfig := encryption.CertConfig{
+		CertFile:           config.HttpConfig.CertFile,
+		CertKey:            config.HttpConfig.CertKey,
+		LetsEncryptDataDir: config.Datadir,
+		LetsEncryptEmail:   mgmtLetsencryptEmail,
+		Challenge:          challenge,
+		ListenPort:         mgmtPort,
+	}
+	if config.HttpConfig.LetsEncryptDomain != "" {
+		certConfig.LetsEncryptDomains = []string{config.HttpConfig.LetsEncryptDomain}
 	}
 
-	return config, nil
+	if !certConfig.Enabled() {
+		return nil, nil
+	}
+
+	certManager, err := encryption.NewCertificateManager(certConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed setting up TLS: %v", err)
+	}
+	return certManager, nil
 }
 
 func handleRebrand(cmd *cobra.Command) error {
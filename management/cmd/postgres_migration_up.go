@@ -0,0 +1,43 @@
+package cmd
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/netbirdio/netbird/formatter"
+	"github.com/netbirdio/netbird/management/server"
+	"github.com/netbirdio/netbird/util"
+)
+
+var shortPostgresUp = "Migrate SQLite store to Postgres store. Please make a backup of the SQLite file before running this command."
+
+var postgresUpCmd = &cobra.Command{
+	Use:     "upgrade [--datadir directory] [--log-file console]",
+	Aliases: []string{"up"},
+	Short:   shortPostgresUp,
+	Long: shortPostgresUp +
+		"\n\n" +
+		"This command reads the content of {datadir}/store.db and migrates it to the empty Postgres database " +
+		"defined by the NETBIRD_STORE_ENGINE_POSTGRES_DSN environment variable.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		flag.Parse()
+		err := util.InitLog(logLevel, logFile)
+		if err != nil {
+			return fmt.Errorf("failed initializing log %v", err)
+		}
+
+		//nolint
+		ctx := context.WithValue(cmd.Context(), formatter.ExecutionContextKey, formatter.SystemSource)
+
+		if err := server.MigrateSqliteToPostgres(ctx, mgmtDataDir); err != nil {
+			return err
+		}
+		log.WithContext(ctx).Info("Migration finished successfully")
+
+		return nil
+	},
+}
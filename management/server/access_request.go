@@ -0,0 +1,489 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/rs/xid"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/status"
+)
+
+const (
+	// MaxAccessRequestDuration is the longest time an access request can grant access for
+	MaxAccessRequestDuration = 7 * 24 * time.Hour
+
+	accessRequestExpirationRetryInterval = time.Minute
+
+	errMsgAccessRequestAdminOnly = "only users with admin power are allowed to review access requests"
+)
+
+// AccessRequestStatus is the review state of an access request
+type AccessRequestStatus string
+
+const (
+	// AccessRequestStatusPending indicates that the request awaits review
+	AccessRequestStatusPending AccessRequestStatus = "pending"
+	// AccessRequestStatusApproved indicates that the request was approved and the access is granted
+	AccessRequestStatusApproved AccessRequestStatus = "approved"
+	// AccessRequestStatusDenied indicates that the request was denied
+	AccessRequestStatusDenied AccessRequestStatus = "denied"
+	// AccessRequestStatusExpired indicates that the access granted by the request has expired
+	AccessRequestStatusExpired AccessRequestStatus = "expired"
+)
+
+// AccessRequest is a user request for temporary access from the source groups to the destination groups.
+// Once approved, a Policy limited to the requested duration is created and removed when it expires.
+type AccessRequest struct {
+	// ID of the access request
+	ID string `gorm:"primaryKey"`
+
+	// AccountID is a reference to Account that this object belongs
+	AccountID string `json:"-" gorm:"index"`
+
+	// UserID of the user who requested the access
+	UserID string
+
+	// Reason of the request visible to the reviewer
+	Reason string
+
+	// Sources are group IDs of the peers the access is requested from
+	Sources []string `gorm:"serializer:json"`
+
+	// Destinations are group IDs of the peers the access is requested to
+	Destinations []string `gorm:"serializer:json"`
+
+	// Protocol of the requested traffic
+	Protocol PolicyRuleProtocolType
+
+	// Ports of the requested traffic
+	Ports []string `gorm:"serializer:json"`
+
+	// Duration of the access once approved
+	Duration time.Duration
+
+	// Status of the request
+	Status AccessRequestStatus
+
+	// CreatedAt is the time when the request was created
+	CreatedAt time.Time
+
+	// ReviewedBy is the ID of the user who approved or denied the request
+	ReviewedBy string
+
+	// ReviewedAt is the time when the request was approved or denied
+	ReviewedAt *time.Time
+
+	// ExpiresAt is the time when the granted access expires
+	ExpiresAt *time.Time
+
+	// PolicyID is a reference to the Policy created on approval
+	PolicyID string
+}
+
+// Copy returns a copy of the access request
+func (r *AccessRequest) Copy() *AccessRequest {
+	c := &AccessRequest{
+		ID:           r.ID,
+		AccountID:    r.AccountID,
+		UserID:       r.UserID,
+		Reason:       r.Reason,
+		Sources:      make([]string, len(r.Sources)),
+		Destinations: make([]string, len(r.Destinations)),
+		Protocol:     r.Protocol,
+		Ports:        make([]string, len(r.Ports)),
+		Duration:     r.Duration,
+		Status:       r.Status,
+		CreatedAt:    r.CreatedAt,
+		ReviewedBy:   r.ReviewedBy,
+		PolicyID:     r.PolicyID,
+	}
+	copy(c.Sources, r.Sources)
+	copy(c.Destinations, r.Destinations)
+	copy(c.Ports, r.Ports)
+	if r.ReviewedAt != nil {
+		reviewedAt := *r.ReviewedAt
+		c.ReviewedAt = &reviewedAt
+	}
+	if r.ExpiresAt != nil {
+		expiresAt := *r.ExpiresAt
+		c.ExpiresAt = &expiresAt
+	}
+	return c
+}
+
+// EventMeta returns activity event meta related to this access request
+func (r *AccessRequest) EventMeta() map[string]any {
+	meta := map[string]any{"user_id": r.UserID, "duration": r.Duration.String()}
+	if r.PolicyID != "" {
+		meta["policy_id"] = r.PolicyID
+	}
+	return meta
+}
+
+// validate checks the requested protocol, ports, duration and that the referenced groups exist in the account
+func (r *AccessRequest) validate(account *Account) error {
+	if len(r.Sources) == 0 || len(r.Destinations) == 0 {
+		return status.Errorf(status.InvalidArgument, "access request sources and destinations shouldn't be empty")
+	}
+
+	for _, groups := range [][]string{r.Sources, r.Destinations} {
+		for _, groupID := range groups {
+			if account.GetGroup(groupID) == nil {
+				return status.Errorf(status.InvalidArgument, "group with ID %s doesn't exist", groupID)
+			}
+		}
+	}
+
+	switch r.Protocol {
+	case PolicyRuleProtocolALL, PolicyRuleProtocolICMP:
+		if len(r.Ports) != 0 {
+			return status.Errorf(status.InvalidArgument, "for ALL or ICMP protocol ports is not allowed")
+		}
+	case PolicyRuleProtocolTCP, PolicyRuleProtocolUDP:
+		for _, p := range r.Ports {
+			if port, err := strconv.Atoi(p); err != nil || port < 1 || port > 65535 {
+				return status.Errorf(status.InvalidArgument, "valid port value is in 1..65535 range")
+			}
+		}
+	default:
+		return status.Errorf(status.InvalidArgument, "unknown protocol type: %v", r.Protocol)
+	}
+
+	if r.Duration <= 0 || r.Duration > MaxAccessRequestDuration {
+		return status.Errorf(status.InvalidArgument, "access request duration should be between 1s and %s", MaxAccessRequestDuration)
+	}
+
+	return nil
+}
+
+// toPolicy builds the time-limited policy granting the requested access
+func (r *AccessRequest) toPolicy(expiresAt time.Time) *Policy {
+	policyID := xid.New().String()
+	name := fmt.Sprintf("Access request %s", r.ID)
+	return &Policy{
+		ID:          policyID,
+		Name:        name,
+		Description: r.Reason,
+		Enabled:     true,
+		Rules: []*PolicyRule{
+			{
+				ID:           policyID,
+				Name:         name,
+				Enabled:      true,
+				Action:       PolicyTrafficActionAccept,
+				Protocol:     r.Protocol,
+				Ports:        r.Ports,
+				Sources:      r.Sources,
+				Destinations: r.Destinations,
+				// without ports the flow can only be bi-directional
+				Bidirectional: len(r.Ports) == 0,
+			},
+		},
+		Schedule: &PolicySchedule{ExpiresAt: &expiresAt},
+	}
+}
+
+func (a *Account) getAccessRequest(requestID string) (*AccessRequest, error) {
+	for _, request := range a.AccessRequests {
+		if request.ID == requestID {
+			return request, nil
+		}
+	}
+	return nil, status.Errorf(status.NotFound, "access request with ID %s not found", requestID)
+}
+
+// getExpiredAccessRequests returns approved access requests whose access has expired
+func (a *Account) getExpiredAccessRequests() []*AccessRequest {
+	now := time.Now().UTC()
+	var expired []*AccessRequest
+	for _, request := range a.AccessRequests {
+		if request.Status == AccessRequestStatusApproved && request.ExpiresAt != nil && !now.Before(*request.ExpiresAt) {
+			expired = append(expired, request)
+		}
+	}
+	return expired
+}
+
+// GetNextAccessRequestExpiration returns the duration until the next approved access request expires.
+// Returns false if there are no approved access requests.
+func (a *Account) GetNextAccessRequestExpiration() (time.Duration, bool) {
+	now := time.Now().UTC()
+	var nextExpiry *time.Duration
+	for _, request := range a.AccessRequests {
+		if request.Status != AccessRequestStatusApproved || request.ExpiresAt == nil {
+			continue
+		}
+		left := request.ExpiresAt.Sub(now)
+		if left < time.Second {
+			left = time.Second
+		}
+		if nextExpiry == nil || *nextExpiry > left {
+			nextExpiry = &left
+		}
+	}
+	if nextExpiry == nil {
+		return 0, false
+	}
+	return *nextExpiry, true
+}
+
+// CreateAccessRequest stores a new pending access request on behalf of the user
+func (am *DefaultAccountManager) CreateAccessRequest(ctx context.Context, accountID, userID string, request *AccessRequest) (*AccessRequest, error) {
+	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+	defer unlock()
+
+	account, err := am.Store.GetAccount(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = account.FindUser(userID); err != nil {
+		return nil, err
+	}
+
+	if request.Protocol == "" {
+		request.Protocol = PolicyRuleProtocolALL
+	}
+
+	if err = request.validate(account); err != nil {
+		return nil, err
+	}
+
+	request.ID = xid.New().String()
+	request.AccountID = accountID
+	request.UserID = userID
+	request.Status = AccessRequestStatusPending
+	request.CreatedAt = time.Now().UTC()
+	request.ReviewedBy = ""
+	request.ReviewedAt = nil
+	request.ExpiresAt = nil
+	request.PolicyID = ""
+
+	account.AccessRequests = append(account.AccessRequests, request)
+
+	if err = am.Store.SaveAccount(ctx, account); err != nil {
+		return nil, err
+	}
+
+	am.StoreEvent(ctx, userID, request.ID, accountID, activity.AccessRequestCreated, request.EventMeta())
+
+	return request, nil
+}
+
+// GetAccessRequest returns the access request if the user is its requester or has admin power
+func (am *DefaultAccountManager) GetAccessRequest(ctx context.Context, accountID, requestID, userID string) (*AccessRequest, error) {
+	unlock := am.Store.AcquireReadLockByUID(ctx, accountID)
+	defer unlock()
+
+	account, err := am.Store.GetAccount(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := account.FindUser(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := account.getAccessRequest(requestID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !user.HasAdminPower() && request.UserID != userID {
+		return nil, status.Errorf(status.NotFound, "access request with ID %s not found", requestID)
+	}
+
+	return request, nil
+}
+
+// ListAccessRequests returns all access requests of the account for users with admin power
+// and only the own requests for regular users
+func (am *DefaultAccountManager) ListAccessRequests(ctx context.Context, accountID, userID string) ([]*AccessRequest, error) {
+	unlock := am.Store.AcquireReadLockByUID(ctx, accountID)
+	defer unlock()
+
+	account, err := am.Store.GetAccount(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := account.FindUser(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.HasAdminPower() {
+		return account.AccessRequests, nil
+	}
+
+	requests := make([]*AccessRequest, 0)
+	for _, request := range account.AccessRequests {
+		if request.UserID == userID {
+			requests = append(requests, request)
+		}
+	}
+
+	return requests, nil
+}
+
+// ApproveAccessRequest grants the requested access by creating a policy that is removed once the access expires
+func (am *DefaultAccountManager) ApproveAccessRequest(ctx context.Context, accountID, requestID, userID string) (*AccessRequest, error) {
+	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+	defer unlock()
+
+	account, err := am.Store.GetAccount(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := am.getPendingAccessRequestForReview(account, requestID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// groups could have been removed since the request was created
+	if err = request.validate(account); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	expiresAt := now.Add(request.Duration)
+	policy := request.toPolicy(expiresAt)
+	account.Policies = append(account.Policies, policy)
+
+	request.Status = AccessRequestStatusApproved
+	request.ReviewedBy = userID
+	request.ReviewedAt = &now
+	request.ExpiresAt = &expiresAt
+	request.PolicyID = policy.ID
+
+	account.Network.IncSerial()
+	if err = am.Store.SaveAccount(ctx, account); err != nil {
+		return nil, err
+	}
+
+	am.checkAndSchedulePolicyTransitions(ctx, account)
+	am.checkAndScheduleAccessRequestExpiration(ctx, account)
+
+	am.StoreEvent(ctx, userID, request.ID, accountID, activity.AccessRequestApproved, request.EventMeta())
+	am.StoreEvent(ctx, userID, policy.ID, accountID, activity.PolicyAdded, policy.EventMeta())
+
+	am.updateAccountPeers(ctx, account)
+
+	return request, nil
+}
+
+// DenyAccessRequest rejects a pending access request
+func (am *DefaultAccountManager) DenyAccessRequest(ctx context.Context, accountID, requestID, userID string) (*AccessRequest, error) {
+	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+	defer unlock()
+
+	account, err := am.Store.GetAccount(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := am.getPendingAccessRequestForReview(account, requestID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	request.Status = AccessRequestStatusDenied
+	request.ReviewedBy = userID
+	request.ReviewedAt = &now
+
+	if err = am.Store.SaveAccount(ctx, account); err != nil {
+		return nil, err
+	}
+
+	am.StoreEvent(ctx, userID, request.ID, accountID, activity.AccessRequestDenied, request.EventMeta())
+
+	return request, nil
+}
+
+func (am *DefaultAccountManager) getPendingAccessRequestForReview(account *Account, requestID, userID string) (*AccessRequest, error) {
+	user, err := account.FindUser(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !user.HasAdminPower() {
+		return nil, status.Errorf(status.PermissionDenied, errMsgAccessRequestAdminOnly)
+	}
+
+	request, err := account.getAccessRequest(requestID)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.Status != AccessRequestStatusPending {
+		return nil, status.Errorf(status.PreconditionFailed, "access request is already %s", request.Status)
+	}
+
+	return request, nil
+}
+
+// expireAccessRequests removes the policies of the expired access requests and marks them as expired
+func (am *DefaultAccountManager) expireAccessRequests(ctx context.Context, account *Account, expired []*AccessRequest) error {
+	for _, request := range expired {
+		if _, err := am.deletePolicy(account, request.PolicyID); err != nil {
+			log.WithContext(ctx).Debugf("policy %s of the expired access request %s was already removed", request.PolicyID, request.ID)
+		}
+		request.Status = AccessRequestStatusExpired
+	}
+
+	account.Network.IncSerial()
+	if err := am.Store.SaveAccount(ctx, account); err != nil {
+		return err
+	}
+
+	for _, request := range expired {
+		am.StoreEvent(ctx, activity.SystemInitiator, request.ID, account.Id, activity.AccessRequestExpired, request.EventMeta())
+	}
+
+	am.checkAndSchedulePolicyTransitions(ctx, account)
+	am.updateAccountPeers(ctx, account)
+
+	return nil
+}
+
+func (am *DefaultAccountManager) accessRequestExpirationJob(ctx context.Context, accountID string) func() (time.Duration, bool) {
+	return func() (time.Duration, bool) {
+		unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+		defer unlock()
+
+		account, err := am.Store.GetAccount(ctx, accountID)
+		if err != nil {
+			log.WithContext(ctx).Errorf("failed getting account %s expiring access requests: %v", accountID, err)
+			return 0, false
+		}
+
+		expired := account.getExpiredAccessRequests()
+		if len(expired) == 0 {
+			return account.GetNextAccessRequestExpiration()
+		}
+
+		log.WithContext(ctx).Debugf("discovered %d access requests to expire for account %s", len(expired), account.Id)
+
+		if err := am.expireAccessRequests(ctx, account, expired); err != nil {
+			log.WithContext(ctx).Errorf("failed expiring access requests for account %s: %v", account.Id, err)
+			return accessRequestExpirationRetryInterval, true
+		}
+
+		return account.GetNextAccessRequestExpiration()
+	}
+}
+
+func (am *DefaultAccountManager) checkAndScheduleAccessRequestExpiration(ctx context.Context, account *Account) {
+	am.accessRequestExpiry.Cancel(ctx, []string{account.Id})
+	if nextRun, ok := account.GetNextAccessRequestExpiration(); ok {
+		go am.accessRequestExpiry.Schedule(ctx, nextRun, account.Id, am.accessRequestExpirationJob(ctx, account.Id))
+	}
+}
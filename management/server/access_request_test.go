@@ -0,0 +1,130 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	nbgroup "github.com/netbirdio/netbird/management/server/group"
+)
+
+func TestDefaultAccountManager_AccessRequest(t *testing.T) {
+	am, err := createManager(t)
+	require.NoError(t, err, "failed to create account manager")
+
+	account, err := initTestAccessRequestAccount(am)
+	require.NoError(t, err, "failed to init testing account")
+
+	t.Run("Approve access request flow", func(t *testing.T) {
+		// unknown groups are rejected
+		_, err := am.CreateAccessRequest(context.Background(), account.Id, regularUserID, &AccessRequest{
+			Sources:      []string{"laptops"},
+			Destinations: []string{"missing"},
+			Duration:     time.Hour,
+		})
+		assert.Error(t, err)
+
+		request, err := am.CreateAccessRequest(context.Background(), account.Id, regularUserID, &AccessRequest{
+			Reason:       "debug replication",
+			Sources:      []string{"laptops"},
+			Destinations: []string{"db-prod"},
+			Protocol:     PolicyRuleProtocolTCP,
+			Ports:        []string{"22"},
+			Duration:     2 * time.Hour,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, AccessRequestStatusPending, request.Status)
+		assert.Equal(t, regularUserID, request.UserID)
+
+		// regular users see only their own requests
+		requests, err := am.ListAccessRequests(context.Background(), account.Id, regularUserID)
+		require.NoError(t, err)
+		assert.Len(t, requests, 1)
+
+		// regular users can not approve requests
+		_, err = am.ApproveAccessRequest(context.Background(), account.Id, request.ID, regularUserID)
+		assert.Error(t, err)
+
+		approved, err := am.ApproveAccessRequest(context.Background(), account.Id, request.ID, adminUserID)
+		require.NoError(t, err)
+		assert.Equal(t, AccessRequestStatusApproved, approved.Status)
+		assert.Equal(t, adminUserID, approved.ReviewedBy)
+		require.NotNil(t, approved.ExpiresAt)
+		assert.WithinDuration(t, time.Now().Add(2*time.Hour), *approved.ExpiresAt, time.Minute)
+
+		policy, err := am.GetPolicy(context.Background(), account.Id, approved.PolicyID, adminUserID)
+		require.NoError(t, err, "approved request should create a policy")
+		require.NotNil(t, policy.Schedule)
+		assert.Equal(t, approved.ExpiresAt.Unix(), policy.Schedule.ExpiresAt.Unix())
+		assert.Equal(t, []string{"22"}, policy.Rules[0].Ports)
+
+		// a reviewed request can not be reviewed again
+		_, err = am.DenyAccessRequest(context.Background(), account.Id, request.ID, adminUserID)
+		assert.Error(t, err)
+	})
+
+	t.Run("Deny access request", func(t *testing.T) {
+		request, err := am.CreateAccessRequest(context.Background(), account.Id, regularUserID, &AccessRequest{
+			Sources:      []string{"laptops"},
+			Destinations: []string{"db-prod"},
+			Duration:     time.Hour,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, PolicyRuleProtocolALL, request.Protocol, "protocol should default to all")
+
+		denied, err := am.DenyAccessRequest(context.Background(), account.Id, request.ID, adminUserID)
+		require.NoError(t, err)
+		assert.Equal(t, AccessRequestStatusDenied, denied.Status)
+		assert.Empty(t, denied.PolicyID)
+	})
+
+	t.Run("Expired access is removed", func(t *testing.T) {
+		request, err := am.CreateAccessRequest(context.Background(), account.Id, regularUserID, &AccessRequest{
+			Sources:      []string{"laptops"},
+			Destinations: []string{"db-prod"},
+			Duration:     time.Hour,
+		})
+		require.NoError(t, err)
+
+		approved, err := am.ApproveAccessRequest(context.Background(), account.Id, request.ID, adminUserID)
+		require.NoError(t, err)
+
+		// move the expiry to the past
+		stored, err := am.Store.GetAccount(context.Background(), account.Id)
+		require.NoError(t, err)
+		for _, r := range stored.AccessRequests {
+			if r.ID == approved.ID {
+				expired := time.Now().Add(-time.Minute)
+				r.ExpiresAt = &expired
+			}
+		}
+		require.NoError(t, am.Store.SaveAccount(context.Background(), stored))
+
+		am.accessRequestExpirationJob(context.Background(), account.Id)()
+
+		_, err = am.GetPolicy(context.Background(), account.Id, approved.PolicyID, adminUserID)
+		assert.Error(t, err, "policy of the expired request should be removed")
+
+		expiredRequest, err := am.GetAccessRequest(context.Background(), account.Id, approved.ID, regularUserID)
+		require.NoError(t, err)
+		assert.Equal(t, AccessRequestStatusExpired, expiredRequest.Status)
+	})
+}
+
+func initTestAccessRequestAccount(am *DefaultAccountManager) (*Account, error) {
+	account := newAccountWithId(context.Background(), "testingAccount", groupAdminUserID, "example.com")
+	account.Users[adminUserID] = &User{Id: adminUserID, Role: UserRoleAdmin}
+	account.Users[regularUserID] = &User{Id: regularUserID, Role: UserRoleUser}
+	account.Groups["laptops"] = &nbgroup.Group{ID: "laptops", Name: "Laptops"}
+	account.Groups["db-prod"] = &nbgroup.Group{ID: "db-prod", Name: "DB Prod"}
+
+	err := am.Store.SaveAccount(context.Background(), account)
+	if err != nil {
+		return nil, err
+	}
+
+	return am.Store.GetAccount(context.Background(), account.Id)
+}
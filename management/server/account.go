@@ -111,7 +111,7 @@ type AccountManager interface {
 	DeletePolicy(ctx context.Context, accountID, policyID, userID string) error
 	ListPolicies(ctx context.Context, accountID, userID string) ([]*Policy, error)
 	GetRoute(ctx context.Context, accountID string, routeID route.ID, userID string) (*route.Route, error)
-	CreateRoute(ctx context.Context, accountID string, prefix netip.Prefix, networkType route.NetworkType, domains domain.List, peerID string, peerGroupIDs []string, description string, netID route.NetID, masquerade bool, metric int, groups []string, enabled bool, userID string, keepRoute bool) (*route.Route, error)
+	CreateRoute(ctx context.Context, accountID string, prefix netip.Prefix, networkType route.NetworkType, domains domain.List, peerID string, peerGroupIDs []string, description string, netID route.NetID, masquerade bool, metric int, groups []string, enabled bool, userID string, keepRoute bool, strategy route.Strategy) (*route.Route, error)
 	SaveRoute(ctx context.Context, accountID, userID string, route *route.Route) error
 	DeleteRoute(ctx context.Context, accountID string, routeID route.ID, userID string) error
 	ListRoutes(ctx context.Context, accountID, userID string) ([]*route.Route, error)
@@ -123,10 +123,12 @@ type AccountManager interface {
 	GetDNSDomain() string
 	StoreEvent(ctx context.Context, initiatorID, targetID, accountID string, activityID activity.ActivityDescriber, meta map[string]any)
 	GetEvents(ctx context.Context, accountID, userID string) ([]*activity.Event, error)
+	SearchEvents(ctx context.Context, accountID, userID string, filter *activity.Filter) ([]*activity.Event, uint64, error)
 	GetDNSSettings(ctx context.Context, accountID string, userID string) (*DNSSettings, error)
 	SaveDNSSettings(ctx context.Context, accountID string, userID string, dnsSettingsToSave *DNSSettings) error
 	GetPeer(ctx context.Context, accountID, peerID, userID string) (*nbpeer.Peer, error)
 	UpdateAccountSettings(ctx context.Context, accountID, userID string, newSettings *Settings) (*Account, error)
+	UpdateAccount(ctx context.Context, accountID, userID string, update func(tx AccountTransaction) error) error
 	LoginPeer(ctx context.Context, login PeerLogin) (*nbpeer.Peer, *NetworkMap, []*posture.Checks, error)                // used by peer gRPC API
 	SyncPeer(ctx context.Context, sync PeerSync, account *Account) (*nbpeer.Peer, *NetworkMap, []*posture.Checks, error) // used by peer gRPC API
 	GetAllConnectedPeers() (map[string]struct{}, error)
@@ -136,6 +138,22 @@ type AccountManager interface {
 	SavePostureChecks(ctx context.Context, accountID, userID string, postureChecks *posture.Checks) error
 	DeletePostureChecks(ctx context.Context, accountID, postureChecksID, userID string) error
 	ListPostureChecks(ctx context.Context, accountID, userID string) ([]*posture.Checks, error)
+	CreateAccessRequest(ctx context.Context, accountID, userID string, request *AccessRequest) (*AccessRequest, error)
+	GetAccessRequest(ctx context.Context, accountID, requestID, userID string) (*AccessRequest, error)
+	ListAccessRequests(ctx context.Context, accountID, userID string) ([]*AccessRequest, error)
+	ApproveAccessRequest(ctx context.Context, accountID, requestID, userID string) (*AccessRequest, error)
+	DenyAccessRequest(ctx context.Context, accountID, requestID, userID string) (*AccessRequest, error)
+	CreateWebhook(ctx context.Context, accountID, userID string, webhook *Webhook) (*Webhook, error)
+	SaveWebhook(ctx context.Context, accountID, userID string, webhook *Webhook) (*Webhook, error)
+	GetWebhook(ctx context.Context, accountID, webhookID, userID string) (*Webhook, error)
+	ListWebhooks(ctx context.Context, accountID, userID string) ([]*Webhook, error)
+	DeleteWebhook(ctx context.Context, accountID, webhookID, userID string) error
+	GetWebhookDeliveries(ctx context.Context, accountID, webhookID, userID string) ([]*WebhookDelivery, error)
+	CreateDNSRecord(ctx context.Context, accountID, userID string, record *DNSRecord) (*DNSRecord, error)
+	SaveDNSRecord(ctx context.Context, accountID, userID string, record *DNSRecord) (*DNSRecord, error)
+	GetDNSRecord(ctx context.Context, accountID, recordID, userID string) (*DNSRecord, error)
+	ListDNSRecords(ctx context.Context, accountID, userID string) ([]*DNSRecord, error)
+	DeleteDNSRecord(ctx context.Context, accountID, recordID, userID string) error
 	GetIdpManager() idp.Manager
 	UpdateIntegratedValidatorGroups(ctx context.Context, accountID string, userID string, groups []string) error
 	GroupValidation(ctx context.Context, accountId string, groups []string) (bool, error)
@@ -172,6 +190,14 @@ type DefaultAccountManager struct {
 	// dnsDomain is used for peer resolution. This is appended to the peer's name
 	dnsDomain       string
 	peerLoginExpiry Scheduler
+	// policySchedules recomputes network maps when policy schedule windows open or close
+	policySchedules Scheduler
+	// accessRequestExpiry removes policies of approved access requests once they expire
+	accessRequestExpiry Scheduler
+	// webhookDispatcher delivers activity events to the webhooks of the accounts
+	webhookDispatcher *webhookDispatcher
+	// peerLoginRenewals keeps the time of the last PeerLoginRenewed event of each peer
+	peerLoginRenewals sync.Map
 
 	// userDeleteFromIDPEnabled allows to delete user from IDP when user is deleted from account
 	userDeleteFromIDPEnabled bool
@@ -206,6 +232,10 @@ type Settings struct {
 	// JWTAllowGroups list of groups to which users are allowed access
 	JWTAllowGroups []string `gorm:"serializer:json"`
 
+	// IPv6Enabled distributes the overlay IPv6 addresses of the peers in the network map and DNS records.
+	// Requires clients that support IPv6 overlay addresses, the clients only use them when their firewall filters IPv6 traffic.
+	IPv6Enabled bool
+
 	// Extra is a dictionary of Account settings
 	Extra *account.ExtraSettings `gorm:"embedded;embeddedPrefix:extra_"`
 }
@@ -220,6 +250,7 @@ func (s *Settings) Copy() *Settings {
 		GroupsPropagationEnabled:   s.GroupsPropagationEnabled,
 		JWTAllowGroups:             s.JWTAllowGroups,
 		RegularUsersViewBlocked:    s.RegularUsersViewBlocked,
+		IPv6Enabled:                s.IPv6Enabled,
 	}
 	if s.Extra != nil {
 		settings.Extra = s.Extra.Copy()
@@ -254,6 +285,9 @@ type Account struct {
 	NameServerGroupsG      []nbdns.NameServerGroup           `json:"-" gorm:"foreignKey:AccountID;references:id"`
 	DNSSettings            DNSSettings                       `gorm:"embedded;embeddedPrefix:dns_settings_"`
 	PostureChecks          []*posture.Checks                 `gorm:"foreignKey:AccountID;references:id"`
+	AccessRequests         []*AccessRequest                  `gorm:"foreignKey:AccountID;references:id"`
+	Webhooks               []*Webhook                        `gorm:"foreignKey:AccountID;references:id"`
+	DNSRecords             []*DNSRecord                      `gorm:"foreignKey:AccountID;references:id"`
 	// Settings is a dictionary of Account settings
 	Settings *Settings `gorm:"embedded;embeddedPrefix:settings_"`
 }
@@ -458,6 +492,10 @@ func (a *Account) GetPeerNetworkMap(
 
 		if peersCustomZone.Domain != "" {
 			zones = append(zones, peersCustomZone)
+
+			if records := a.getPeerDNSRecords(peerID, peersCustomZone); len(records) > 0 {
+				zones = append(zones, nbdns.CustomZone{Domain: peersCustomZone.Domain, Records: records})
+			}
 		}
 		dnsUpdate.CustomZones = zones
 		dnsUpdate.NameServerGroups = getPeerNSGroups(a, peerID)
@@ -465,13 +503,17 @@ func (a *Account) GetPeerNetworkMap(
 
 	nm := &NetworkMap{
 		Peers:         peersToConnect,
-		Network:       a.Network.Copy(),
+		Network:       a.getPeerNetwork(),
 		Routes:        routesUpdate,
 		DNSConfig:     dnsUpdate,
 		OfflinePeers:  expiredPeers,
 		FirewallRules: firewallRules,
 	}
 
+	if a.Settings.PeerLoginExpirationEnabled {
+		nm.LoginExpiresAt = peer.LoginExpiresAt(a.Settings.PeerLoginExpiration)
+	}
+
 	if metrics != nil {
 		objectCount := int64(len(peersToConnect) + len(expiredPeers) + len(routesUpdate) + len(firewallRules))
 		metrics.CountNetworkMapObjects(objectCount)
@@ -521,6 +563,16 @@ func (a *Account) GetPeersCustomZone(ctx context.Context, dnsDomain string) nbdn
 			RData: peer.IP.String(),
 		})
 
+		if a.Settings.IPv6Enabled && peer.IPv6 != nil {
+			customZone.Records = append(customZone.Records, nbdns.SimpleRecord{
+				Name:  sb.String(),
+				Type:  int(dns.TypeAAAA),
+				Class: nbdns.DefaultClass,
+				TTL:   defaultTTL,
+				RData: peer.IPv6.String(),
+			})
+		}
+
 		sb.Reset()
 	}
 
@@ -751,6 +803,54 @@ func (a *Account) getTakenIPs() []net.IP {
 	return takenIps
 }
 
+func (a *Account) getTakenIPv6s() []net.IP {
+	var takenIps []net.IP
+	for _, existingPeer := range a.Peers {
+		if existingPeer.IPv6 != nil {
+			takenIps = append(takenIps, existingPeer.IPv6)
+		}
+	}
+
+	return takenIps
+}
+
+// getPeerNetwork returns a copy of the account network as seen by the peers.
+// The IPv6 prefix is omitted when IPv6 is disabled in the account settings.
+func (a *Account) getPeerNetwork() *Network {
+	network := a.Network.Copy()
+	if a.Settings == nil || !a.Settings.IPv6Enabled {
+		network.NetV6 = net.IPNet{}
+	}
+	return network
+}
+
+// allocateMissingIPv6 makes sure that the account network has an IPv6 prefix and that every peer has an IPv6 address.
+// Returns true if the account has been modified.
+func (a *Account) allocateMissingIPv6() (bool, error) {
+	updated := false
+	if !a.Network.HasIPv6() {
+		a.Network.NetV6 = NewNetworkV6()
+		updated = true
+	}
+
+	takenIps := a.getTakenIPv6s()
+	for _, peer := range a.Peers {
+		if peer.IPv6 != nil {
+			continue
+		}
+
+		ip, err := AllocatePeerIPv6(a.Network.NetV6, takenIps)
+		if err != nil {
+			return updated, err
+		}
+		peer.IPv6 = ip
+		takenIps = append(takenIps, ip)
+		updated = true
+	}
+
+	return updated, nil
+}
+
 func (a *Account) getPeerDNSLabels() lookupMap {
 	existingLabels := make(lookupMap)
 	for _, peer := range a.Peers {
@@ -809,6 +909,21 @@ func (a *Account) Copy() *Account {
 		postureChecks = append(postureChecks, postureCheck.Copy())
 	}
 
+	accessRequests := []*AccessRequest{}
+	for _, accessRequest := range a.AccessRequests {
+		accessRequests = append(accessRequests, accessRequest.Copy())
+	}
+
+	webhooks := []*Webhook{}
+	for _, webhook := range a.Webhooks {
+		webhooks = append(webhooks, webhook.Copy())
+	}
+
+	dnsRecords := []*DNSRecord{}
+	for _, record := range a.DNSRecords {
+		dnsRecords = append(dnsRecords, record.Copy())
+	}
+
 	return &Account{
 		Id:                     a.Id,
 		CreatedBy:              a.CreatedBy,
@@ -826,6 +941,9 @@ func (a *Account) Copy() *Account {
 		NameServerGroups:       nsGroups,
 		DNSSettings:            dnsSettings,
 		PostureChecks:          postureChecks,
+		AccessRequests:         accessRequests,
+		Webhooks:               webhooks,
+		DNSRecords:             dnsRecords,
 		Settings:               settings,
 	}
 }
@@ -972,6 +1090,9 @@ func BuildManager(
 		dnsDomain:                dnsDomain,
 		eventStore:               eventStore,
 		peerLoginExpiry:          NewDefaultScheduler(),
+		policySchedules:          NewDefaultScheduler(),
+		accessRequestExpiry:      NewDefaultScheduler(),
+		webhookDispatcher:        newWebhookDispatcher(store),
 		userDeleteFromIDPEnabled: userDeleteFromIDPEnabled,
 		integratedPeerValidator:  integratedPeerValidator,
 		metrics:                  metrics,
@@ -1010,6 +1131,9 @@ func BuildManager(
 				return nil, err
 			}
 		}
+
+		am.checkAndSchedulePolicyTransitions(ctx, account)
+		am.checkAndScheduleAccessRequestExpiration(ctx, account)
 	}
 
 	goCacheClient := gocache.New(CacheExpirationMax, 30*time.Minute)
@@ -1032,6 +1156,8 @@ func BuildManager(
 		}()
 	}
 
+	go am.webhookDispatcher.run(ctx)
+
 	am.integratedPeerValidator.SetPeerInvalidationListener(func(accountID string) {
 		am.onPeersInvalidated(ctx, accountID)
 	})
@@ -1100,6 +1226,20 @@ func (am *DefaultAccountManager) UpdateAccountSettings(ctx context.Context, acco
 		am.checkAndSchedulePeerLoginExpiration(ctx, account)
 	}
 
+	ipv6Updated := oldSettings.IPv6Enabled != newSettings.IPv6Enabled
+	if ipv6Updated {
+		event := activity.AccountIPv6Enabled
+		if newSettings.IPv6Enabled {
+			if _, err = account.allocateMissingIPv6(); err != nil {
+				return nil, err
+			}
+		} else {
+			event = activity.AccountIPv6Disabled
+		}
+		am.StoreEvent(ctx, userID, accountID, accountID, event, nil)
+		account.Network.IncSerial()
+	}
+
 	updatedAccount := account.UpdateSettings(newSettings)
 
 	err = am.Store.SaveAccount(ctx, account)
@@ -1107,6 +1247,10 @@ func (am *DefaultAccountManager) UpdateAccountSettings(ctx context.Context, acco
 		return nil, err
 	}
 
+	if ipv6Updated {
+		am.updateAccountPeers(ctx, account)
+	}
+
 	return updatedAccount, nil
 }
 
@@ -1258,6 +1402,14 @@ func (am *DefaultAccountManager) DeleteAccount(ctx context.Context, accountID, u
 	}
 	// cancel peer login expiry job
 	am.peerLoginExpiry.Cancel(ctx, []string{account.Id})
+	// cancel policy schedule job
+	am.policySchedules.Cancel(ctx, []string{account.Id})
+	// cancel access request expiry job
+	am.accessRequestExpiry.Cancel(ctx, []string{account.Id})
+	// the peers added with setup keys are not deleted with the users
+	for peerID := range account.Peers {
+		am.peerLoginRenewals.Delete(peerID)
+	}
 
 	log.WithContext(ctx).Debugf("account %s deleted", accountID)
 	return nil
@@ -2081,7 +2233,7 @@ func (am *DefaultAccountManager) GetAccountIDForPeerKey(ctx context.Context, pee
 	return am.Store.GetAccountIDByPeerPubKey(ctx, peerKey)
 }
 
-func (am *DefaultAccountManager) handleUserPeer(ctx context.Context, peer *nbpeer.Peer, settings *Settings) (bool, error) {
+func (am *DefaultAccountManager) handleUserPeer(ctx context.Context, peer *nbpeer.Peer, settings *Settings, renewLogin bool) (bool, error) {
 	user, err := am.Store.GetUserByUserID(ctx, peer.UserID)
 	if err != nil {
 		return false, err
@@ -2100,6 +2252,14 @@ func (am *DefaultAccountManager) handleUserPeer(ctx context.Context, peer *nbpee
 		return true, nil
 	}
 
+	// a peer that asked to renew its login before it expired extends the login, the remote peers are not affected
+	if renewLogin && settings.PeerLoginExpirationEnabled && !peer.LoginExpiresAt(settings.PeerLoginExpiration).IsZero() {
+		err = am.renewPeerLogin(ctx, user, peer)
+		if err != nil {
+			return false, err
+		}
+	}
+
 	return false, nil
 }
 
@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/golang-jwt/jwt"
+	"github.com/miekg/dns"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
@@ -1023,6 +1024,10 @@ func TestAccountManager_AddPeer(t *testing.T) {
 		t.Errorf("expecting just added peer's IP %s to be in a network range %s", peer.IP.String(), account.Network.Net.String())
 	}
 
+	if !account.Network.NetV6.Contains(peer.IPv6) {
+		t.Errorf("expecting just added peer's IPv6 %s to be in a network range %s", peer.IPv6.String(), account.Network.NetV6.String())
+	}
+
 	if peer.SetupKey != expectedSetupKey {
 		t.Errorf("expecting just added peer to have SetupKey = %s, got %s", expectedSetupKey, peer.SetupKey)
 	}
@@ -1598,6 +1603,26 @@ func TestAccount_Copy(t *testing.T) {
 				ID: "posture Checks1",
 			},
 		},
+		AccessRequests: []*AccessRequest{
+			{
+				ID:           "accessRequest1",
+				Sources:      []string{"group1"},
+				Destinations: []string{"group1"},
+				Ports:        []string{},
+			},
+		},
+		Webhooks: []*Webhook{
+			{
+				ID:         "webhook1",
+				EventCodes: []string{},
+			},
+		},
+		DNSRecords: []*DNSRecord{
+			{
+				ID:     "dnsRecord1",
+				Groups: []string{"group1"},
+			},
+		},
 		Settings: &Settings{},
 	}
 	err := hasNilField(account)
@@ -1709,6 +1734,78 @@ func TestDefaultAccountManager_UpdatePeer_PeerLoginExpiration(t *testing.T) {
 	}
 }
 
+func TestDefaultAccountManager_HandleUserPeer_RenewLogin(t *testing.T) {
+	manager, err := createManager(t)
+	require.NoError(t, err, "unable to create account manager")
+
+	account, err := manager.GetAccountByUserOrAccountID(context.Background(), userID, "", "")
+	require.NoError(t, err, "unable to create an account")
+
+	key, err := wgtypes.GenerateKey()
+	require.NoError(t, err, "unable to generate WireGuard key")
+	_, _, _, err = manager.AddPeer(context.Background(), "", userID, &nbpeer.Peer{
+		Key:                    key.PublicKey().String(),
+		Meta:                   nbpeer.PeerSystemMeta{Hostname: "test-peer"},
+		LoginExpirationEnabled: true,
+	})
+	require.NoError(t, err, "unable to add peer")
+
+	_, err = manager.UpdateAccountSettings(context.Background(), account.Id, userID, &Settings{
+		PeerLoginExpiration:        time.Hour,
+		PeerLoginExpirationEnabled: true,
+	})
+	require.NoError(t, err, "unable to update account settings")
+
+	settings, err := manager.Store.GetAccountSettings(context.Background(), account.Id)
+	require.NoError(t, err)
+
+	countEvents := func(activityID activity.Activity) int {
+		events, err := manager.eventStore.Get(context.Background(), account.Id, 0, 100, false)
+		require.NoError(t, err)
+		count := 0
+		for _, event := range events {
+			if event.Activity == activityID {
+				count++
+			}
+		}
+		return count
+	}
+
+	peer, err := manager.Store.GetPeerByPeerPubKey(context.Background(), key.PublicKey().String())
+	require.NoError(t, err)
+	lastLogin := peer.LastLogin
+
+	// a JWT login of a peer whose login didn't expire leaves the login and the events untouched
+	changed, err := manager.handleUserPeer(context.Background(), peer, settings, false)
+	require.NoError(t, err)
+	assert.False(t, changed)
+	peer, err = manager.Store.GetPeerByPeerPubKey(context.Background(), key.PublicKey().String())
+	require.NoError(t, err)
+	assert.Equal(t, lastLogin, peer.LastLogin, "the login should not be extended without a renewal")
+
+	// the renewals extend the login and are recorded once per interval
+	for i := 0; i < 3; i++ {
+		changed, err = manager.handleUserPeer(context.Background(), peer, settings, true)
+		require.NoError(t, err)
+		assert.False(t, changed, "the remote peers are not affected by a renewal")
+	}
+	peer, err = manager.Store.GetPeerByPeerPubKey(context.Background(), key.PublicKey().String())
+	require.NoError(t, err)
+	assert.True(t, peer.LastLogin.After(lastLogin), "the renewal should extend the login")
+
+	assert.Eventually(t, func() bool {
+		return countEvents(activity.PeerLoginRenewed) == 1
+	}, time.Second, 10*time.Millisecond, "the renewals should be recorded once")
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, 1, countEvents(activity.PeerLoginRenewed))
+	assert.Equal(t, 0, countEvents(activity.UserLoggedInPeer), "the renewals should not be recorded as logins")
+
+	err = manager.DeletePeer(context.Background(), account.Id, peer.ID, userID)
+	require.NoError(t, err)
+	_, ok := manager.peerLoginRenewals.Load(peer.ID)
+	assert.False(t, ok, "the renewal of a deleted peer should be forgotten")
+}
+
 func TestDefaultAccountManager_MarkPeerConnected_PeerLoginExpiration(t *testing.T) {
 	manager, err := createManager(t)
 	require.NoError(t, err, "unable to create account manager")
@@ -1843,6 +1940,52 @@ func TestDefaultAccountManager_UpdateAccountSettings(t *testing.T) {
 	require.Error(t, err, "expecting to fail when providing PeerLoginExpiration more than 180 days")
 }
 
+func TestDefaultAccountManager_UpdateAccountSettings_IPv6(t *testing.T) {
+	manager, err := createManager(t)
+	require.NoError(t, err, "unable to create account manager")
+
+	account, err := manager.GetAccountByUserOrAccountID(context.Background(), userID, "", "")
+	require.NoError(t, err, "unable to create an account")
+
+	// simulate an account and a peer created before IPv6 support
+	account.Network.NetV6 = net.IPNet{}
+	account.Peers["peer1"] = &nbpeer.Peer{ID: "peer1", Key: "key1", IP: net.ParseIP("100.64.0.1"), DNSLabel: "peer1", Status: &nbpeer.PeerStatus{}}
+	require.NoError(t, manager.Store.SaveAccount(context.Background(), account))
+
+	networkMap := account.GetPeerNetworkMap(context.Background(), "peer1", nbdns.CustomZone{}, map[string]struct{}{"peer1": {}}, nil)
+	assert.False(t, networkMap.Network.HasIPv6(), "IPv6 prefix should not be distributed while disabled")
+
+	_, err = manager.UpdateAccountSettings(context.Background(), account.Id, userID, &Settings{
+		PeerLoginExpiration: time.Hour,
+		IPv6Enabled:         true,
+	})
+	require.NoError(t, err, "expecting to update account settings successfully but got error")
+
+	account, err = manager.Store.GetAccount(context.Background(), account.Id)
+	require.NoError(t, err, "unable to get account by ID")
+
+	require.True(t, account.Network.HasIPv6(), "account network should have an IPv6 prefix")
+	peer := account.Peers["peer1"]
+	require.NotNil(t, peer.IPv6, "existing peer should get an IPv6 address")
+	assert.True(t, account.Network.NetV6.Contains(peer.IPv6))
+
+	networkMap = account.GetPeerNetworkMap(context.Background(), "peer1", nbdns.CustomZone{}, map[string]struct{}{"peer1": {}}, nil)
+	assert.True(t, networkMap.Network.HasIPv6(), "IPv6 prefix should be distributed when enabled")
+
+	peerConfig := toPeerConfig(peer, networkMap.Network, "netbird.cloud")
+	assert.Equal(t, peer.IPv6.String()+"/64", peerConfig.GetAddressV6())
+
+	customZone := account.GetPeersCustomZone(context.Background(), "netbird.cloud")
+	var aaaa int
+	for _, record := range customZone.Records {
+		if record.Type == int(dns.TypeAAAA) {
+			aaaa++
+			assert.Equal(t, peer.IPv6.String(), record.RData)
+		}
+	}
+	assert.Equal(t, 1, aaaa, "expecting one AAAA record for the peer")
+}
+
 func TestAccount_GetExpiredPeers(t *testing.T) {
 	type test struct {
 		name          string
@@ -0,0 +1,258 @@
+package server
+
+import (
+	"context"
+
+	"github.com/rs/xid"
+
+	nbdns "github.com/netbirdio/netbird/dns"
+	"github.com/netbirdio/netbird/management/server/activity"
+	nbgroup "github.com/netbirdio/netbird/management/server/group"
+	"github.com/netbirdio/netbird/management/server/posture"
+	"github.com/netbirdio/netbird/management/server/status"
+	"github.com/netbirdio/netbird/route"
+)
+
+// AccountTransaction stages changes of an account. Every change is validated against the account as left by the
+// previous changes, the changes are saved together once the update that makes them succeeds.
+type AccountTransaction interface {
+	// Account returns the staged account, it should only be changed through the transaction
+	Account() *Account
+	SaveGroup(group *nbgroup.Group) error
+	DeleteGroup(groupID string) error
+	SavePostureChecks(postureChecks *posture.Checks) error
+	DeletePostureChecks(postureChecksID string) error
+	SavePolicy(policy *Policy) error
+	DeletePolicy(policyID string) error
+	SaveRoute(routeToSave *route.Route) error
+	DeleteRoute(routeID route.ID) error
+	CreateNameServerGroup(nsGroup *nbdns.NameServerGroup) (*nbdns.NameServerGroup, error)
+	SaveNameServerGroup(nsGroup *nbdns.NameServerGroup) error
+	DeleteNameServerGroup(nsGroupID string) error
+	SaveDNSSettings(dnsSettings *DNSSettings) error
+}
+
+type accountTransaction struct {
+	ctx     context.Context
+	am      *DefaultAccountManager
+	account *Account
+	userID  string
+
+	changed       bool
+	eventsToStore []func()
+}
+
+// UpdateAccount runs update on a transaction of the account and saves all the changes it makes at once, holding the
+// account lock for the whole update. Nothing is saved when update returns an error.
+func (am *DefaultAccountManager) UpdateAccount(ctx context.Context, accountID, userID string, update func(tx AccountTransaction) error) error {
+	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+	defer unlock()
+
+	account, err := am.Store.GetAccount(ctx, accountID)
+	if err != nil {
+		return err
+	}
+
+	user, err := account.FindUser(userID)
+	if err != nil {
+		return err
+	}
+
+	if !user.HasAdminPower() {
+		return status.Errorf(status.PermissionDenied, "only users with admin power can update the account configuration")
+	}
+
+	tx := &accountTransaction{ctx: ctx, am: am, account: account, userID: userID}
+	if err = update(tx); err != nil {
+		return err
+	}
+
+	if !tx.changed {
+		return nil
+	}
+
+	account.Network.IncSerial()
+	if err = am.Store.SaveAccount(ctx, account); err != nil {
+		return err
+	}
+
+	am.checkAndSchedulePolicyTransitions(ctx, account)
+
+	for _, storeEvent := range tx.eventsToStore {
+		storeEvent()
+	}
+
+	am.updateAccountPeers(ctx, account)
+
+	return nil
+}
+
+func (tx *accountTransaction) Account() *Account {
+	return tx.account
+}
+
+// changedWith marks the account as changed and queues the events to store once it is saved
+func (tx *accountTransaction) changedWith(events ...func()) {
+	tx.changed = true
+	tx.eventsToStore = append(tx.eventsToStore, events...)
+}
+
+func (tx *accountTransaction) storeEvent(targetID string, activityID activity.Activity, meta map[string]any) func() {
+	return func() {
+		tx.am.StoreEvent(tx.ctx, tx.userID, targetID, tx.account.Id, activityID, meta)
+	}
+}
+
+func (tx *accountTransaction) SaveGroup(group *nbgroup.Group) error {
+	events, err := tx.am.saveGroup(tx.ctx, tx.account, tx.userID, group)
+	if err != nil {
+		return err
+	}
+
+	tx.changedWith(events...)
+	return nil
+}
+
+func (tx *accountTransaction) DeleteGroup(groupID string) error {
+	group, ok := tx.account.Groups[groupID]
+	if !ok {
+		return nil
+	}
+
+	if err := validateDeleteGroup(tx.account, group, tx.userID); err != nil {
+		return err
+	}
+	delete(tx.account.Groups, groupID)
+
+	tx.changedWith(tx.storeEvent(groupID, activity.GroupDeleted, group.EventMeta()))
+	return nil
+}
+
+func (tx *accountTransaction) SavePostureChecks(postureChecks *posture.Checks) error {
+	exists, err := tx.am.validateAndSavePostureChecks(tx.account, postureChecks)
+	if err != nil {
+		return err
+	}
+
+	action := activity.PostureCheckCreated
+	if exists {
+		action = activity.PostureCheckUpdated
+	}
+	tx.changedWith(tx.storeEvent(postureChecks.ID, action, postureChecks.EventMeta()))
+	return nil
+}
+
+func (tx *accountTransaction) DeletePostureChecks(postureChecksID string) error {
+	postureChecks, err := tx.am.deletePostureChecks(tx.account, postureChecksID)
+	if err != nil {
+		return err
+	}
+
+	tx.changedWith(tx.storeEvent(postureChecks.ID, activity.PostureCheckDeleted, postureChecks.EventMeta()))
+	return nil
+}
+
+func (tx *accountTransaction) SavePolicy(policy *Policy) error {
+	if err := policy.Schedule.Validate(); err != nil {
+		return err
+	}
+
+	action := activity.PolicyAdded
+	if tx.am.savePolicy(tx.account, policy) {
+		action = activity.PolicyUpdated
+	}
+	tx.changedWith(tx.storeEvent(policy.ID, action, policy.EventMeta()))
+	return nil
+}
+
+func (tx *accountTransaction) DeletePolicy(policyID string) error {
+	policy, err := tx.am.deletePolicy(tx.account, policyID)
+	if err != nil {
+		return err
+	}
+
+	tx.changedWith(tx.storeEvent(policy.ID, activity.PolicyRemoved, policy.EventMeta()))
+	return nil
+}
+
+func (tx *accountTransaction) SaveRoute(routeToSave *route.Route) error {
+	if routeToSave == nil {
+		return status.Errorf(status.InvalidArgument, "route provided is nil")
+	}
+
+	if err := tx.am.validateRouteToSave(tx.account, routeToSave); err != nil {
+		return err
+	}
+
+	action := activity.RouteCreated
+	if _, ok := tx.account.Routes[routeToSave.ID]; ok {
+		action = activity.RouteUpdated
+	}
+	tx.account.Routes[routeToSave.ID] = routeToSave
+
+	tx.changedWith(tx.storeEvent(string(routeToSave.ID), action, routeToSave.EventMeta()))
+	return nil
+}
+
+func (tx *accountTransaction) DeleteRoute(routeID route.ID) error {
+	routeToDelete := tx.account.Routes[routeID]
+	if routeToDelete == nil {
+		return status.Errorf(status.NotFound, "route with ID %s doesn't exist", routeID)
+	}
+	delete(tx.account.Routes, routeID)
+
+	tx.changedWith(tx.storeEvent(string(routeID), activity.RouteRemoved, routeToDelete.EventMeta()))
+	return nil
+}
+
+func (tx *accountTransaction) CreateNameServerGroup(nsGroup *nbdns.NameServerGroup) (*nbdns.NameServerGroup, error) {
+	newNSGroup := nsGroup.Copy()
+	newNSGroup.ID = xid.New().String()
+
+	if err := validateNameServerGroup(false, newNSGroup, tx.account); err != nil {
+		return nil, err
+	}
+
+	if tx.account.NameServerGroups == nil {
+		tx.account.NameServerGroups = make(map[string]*nbdns.NameServerGroup)
+	}
+	tx.account.NameServerGroups[newNSGroup.ID] = newNSGroup
+
+	tx.changedWith(tx.storeEvent(newNSGroup.ID, activity.NameserverGroupCreated, newNSGroup.EventMeta()))
+	return newNSGroup.Copy(), nil
+}
+
+func (tx *accountTransaction) SaveNameServerGroup(nsGroup *nbdns.NameServerGroup) error {
+	if nsGroup == nil {
+		return status.Errorf(status.InvalidArgument, "nameserver group provided is nil")
+	}
+
+	if err := validateNameServerGroup(true, nsGroup, tx.account); err != nil {
+		return err
+	}
+	tx.account.NameServerGroups[nsGroup.ID] = nsGroup
+
+	tx.changedWith(tx.storeEvent(nsGroup.ID, activity.NameserverGroupUpdated, nsGroup.EventMeta()))
+	return nil
+}
+
+func (tx *accountTransaction) DeleteNameServerGroup(nsGroupID string) error {
+	nsGroup := tx.account.NameServerGroups[nsGroupID]
+	if nsGroup == nil {
+		return status.Errorf(status.NotFound, "nameserver group %s wasn't found", nsGroupID)
+	}
+	delete(tx.account.NameServerGroups, nsGroupID)
+
+	tx.changedWith(tx.storeEvent(nsGroupID, activity.NameserverGroupDeleted, nsGroup.EventMeta()))
+	return nil
+}
+
+func (tx *accountTransaction) SaveDNSSettings(dnsSettings *DNSSettings) error {
+	events, err := tx.am.saveDNSSettings(tx.ctx, tx.account, tx.userID, dnsSettings)
+	if err != nil {
+		return err
+	}
+
+	tx.changedWith(events...)
+	return nil
+}
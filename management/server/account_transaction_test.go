@@ -0,0 +1,115 @@
+package server
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/management/server/activity"
+	nbgroup "github.com/netbirdio/netbird/management/server/group"
+	"github.com/netbirdio/netbird/route"
+)
+
+func TestDefaultAccountManager_UpdateAccount(t *testing.T) {
+	am, err := createManager(t)
+	require.NoError(t, err, "failed to create account manager")
+
+	account, err := initTestAccessRequestAccount(am)
+	require.NoError(t, err, "failed to init testing account")
+	serial := account.Network.CurrentSerial()
+
+	newRoute := func(groups ...string) *route.Route {
+		return &route.Route{
+			ID:          "lan",
+			NetID:       "lan",
+			Network:     netip.MustParsePrefix("10.10.0.0/24"),
+			NetworkType: route.IPv4Network,
+			PeerGroups:  []string{"laptops"},
+			Metric:      route.MaxMetric,
+			Enabled:     true,
+			Groups:      groups,
+		}
+	}
+
+	t.Run("a failing change discards the previous ones", func(t *testing.T) {
+		err := am.UpdateAccount(context.Background(), account.Id, adminUserID, func(tx AccountTransaction) error {
+			if err := tx.SaveGroup(&nbgroup.Group{ID: "devs", Name: "Devs", Issued: nbgroup.GroupIssuedAPI}); err != nil {
+				return err
+			}
+			if err := tx.DeleteGroup("db-prod"); err != nil {
+				return err
+			}
+			return tx.SaveRoute(newRoute("db-prod"))
+		})
+		require.Error(t, err, "the route references the group deleted in the transaction")
+
+		stored, err := am.Store.GetAccount(context.Background(), account.Id)
+		require.NoError(t, err)
+		assert.NotContains(t, stored.Groups, "devs")
+		assert.Contains(t, stored.Groups, "db-prod")
+		assert.Empty(t, stored.Routes)
+		assert.Equal(t, serial, stored.Network.CurrentSerial())
+	})
+
+	t.Run("the changes are saved together", func(t *testing.T) {
+		err := am.UpdateAccount(context.Background(), account.Id, adminUserID, func(tx AccountTransaction) error {
+			if err := tx.SaveGroup(&nbgroup.Group{ID: "devs", Name: "Devs", Issued: nbgroup.GroupIssuedAPI}); err != nil {
+				return err
+			}
+			if err := tx.DeleteGroup("db-prod"); err != nil {
+				return err
+			}
+			return tx.SaveRoute(newRoute("devs"))
+		})
+		require.NoError(t, err)
+
+		stored, err := am.Store.GetAccount(context.Background(), account.Id)
+		require.NoError(t, err)
+		assert.Contains(t, stored.Groups, "devs")
+		assert.NotContains(t, stored.Groups, "db-prod")
+		assert.Contains(t, stored.Routes, route.ID("lan"))
+		assert.Equal(t, serial+1, stored.Network.CurrentSerial(), "the serial should be increased once")
+
+		require.Eventually(t, func() bool {
+			events, err := am.GetEvents(context.Background(), account.Id, adminUserID)
+			return err == nil && len(events) == 3
+		}, time.Second, 10*time.Millisecond)
+		events, err := am.GetEvents(context.Background(), account.Id, adminUserID)
+		require.NoError(t, err)
+		var activities []activity.ActivityDescriber
+		for _, event := range events {
+			activities = append(activities, event.Activity)
+		}
+		assert.ElementsMatch(t, []activity.ActivityDescriber{activity.GroupCreated, activity.GroupDeleted, activity.RouteCreated}, activities)
+	})
+
+	t.Run("saving an existing route updates it", func(t *testing.T) {
+		err := am.UpdateAccount(context.Background(), account.Id, adminUserID, func(tx AccountTransaction) error {
+			return tx.SaveRoute(newRoute("devs"))
+		})
+		require.NoError(t, err)
+
+		require.Eventually(t, func() bool {
+			events, err := am.GetEvents(context.Background(), account.Id, adminUserID)
+			return err == nil && len(events) == 4
+		}, time.Second, 10*time.Millisecond)
+		events, err := am.GetEvents(context.Background(), account.Id, adminUserID)
+		require.NoError(t, err)
+		var activities []activity.ActivityDescriber
+		for _, event := range events {
+			activities = append(activities, event.Activity)
+		}
+		assert.Contains(t, activities, activity.RouteUpdated)
+	})
+
+	t.Run("regular users can not update the account", func(t *testing.T) {
+		err := am.UpdateAccount(context.Background(), account.Id, regularUserID, func(tx AccountTransaction) error {
+			return nil
+		})
+		assert.Error(t, err)
+	})
+}
@@ -139,6 +139,33 @@ const (
 	PostureCheckUpdated Activity = 61
 	// PostureCheckDeleted indicates that the user deleted a posture check
 	PostureCheckDeleted Activity = 62
+	// AccountIPv6Enabled indicates that a user enabled IPv6 overlay addresses for the account
+	AccountIPv6Enabled Activity = 63
+	// AccountIPv6Disabled indicates that a user disabled IPv6 overlay addresses for the account
+	AccountIPv6Disabled Activity = 64
+	// AccessRequestCreated indicates that a user requested temporary access to a group of peers
+	AccessRequestCreated Activity = 65
+	// AccessRequestApproved indicates that a user approved an access request
+	AccessRequestApproved Activity = 66
+	// AccessRequestDenied indicates that a user denied an access request
+	AccessRequestDenied Activity = 67
+	// AccessRequestExpired indicates that the access granted by an approved request expired
+	AccessRequestExpired Activity = 68
+
+	// WebhookCreated indicates that a user created an activity webhook
+	WebhookCreated Activity = 69
+	// WebhookUpdated indicates that a user updated an activity webhook
+	WebhookUpdated Activity = 70
+	// WebhookDeleted indicates that a user deleted an activity webhook
+	WebhookDeleted Activity = 71
+	// DNSRecordCreated indicates that a user created a custom DNS record
+	DNSRecordCreated Activity = 72
+	// DNSRecordUpdated indicates that a user updated a custom DNS record
+	DNSRecordUpdated Activity = 73
+	// DNSRecordDeleted indicates that a user deleted a custom DNS record
+	DNSRecordDeleted Activity = 74
+	// PeerLoginRenewed indicates that a user renewed the login of their peer before it expired
+	PeerLoginRenewed Activity = 75
 )
 
 var activityMap = map[Activity]Code{
@@ -205,6 +232,19 @@ var activityMap = map[Activity]Code{
 	PostureCheckCreated:                       {"Posture check created", "posture.check.created"},
 	PostureCheckUpdated:                       {"Posture check updated", "posture.check.updated"},
 	PostureCheckDeleted:                       {"Posture check deleted", "posture.check.deleted"},
+	AccountIPv6Enabled:                        {"Account IPv6 enabled", "account.setting.ipv6.enable"},
+	AccountIPv6Disabled:                       {"Account IPv6 disabled", "account.setting.ipv6.disable"},
+	AccessRequestCreated:                      {"Access request created", "access.request.create"},
+	AccessRequestApproved:                     {"Access request approved", "access.request.approve"},
+	AccessRequestDenied:                       {"Access request denied", "access.request.deny"},
+	AccessRequestExpired:                      {"Access request expired", "access.request.expire"},
+	WebhookCreated:                            {"Webhook created", "webhook.create"},
+	WebhookUpdated:                            {"Webhook updated", "webhook.update"},
+	WebhookDeleted:                            {"Webhook deleted", "webhook.delete"},
+	DNSRecordCreated:                          {"DNS record created", "dns.record.create"},
+	DNSRecordUpdated:                          {"DNS record updated", "dns.record.update"},
+	DNSRecordDeleted:                          {"DNS record deleted", "dns.record.delete"},
+	PeerLoginRenewed:                          {"Peer login renewed", "user.peer.login.renew"},
 }
 
 // StringCode returns a string code of the activity
@@ -223,6 +263,22 @@ func (a Activity) Message() string {
 	return "UNKNOWN_ACTIVITY"
 }
 
+// IsKnownCode returns true if the string code belongs to a registered activity
+func IsKnownCode(code string) bool {
+	_, ok := ParseCode(code)
+	return ok
+}
+
+// ParseCode returns the activity registered with the given string code
+func ParseCode(code string) (Activity, bool) {
+	for a, c := range activityMap {
+		if c.Code == code {
+			return a, true
+		}
+	}
+	return 0, false
+}
+
 // RegisterActivityMap adds new codes to the activity map
 func RegisterActivityMap(codes map[Activity]Code) {
 	maps.Copy(activityMap, codes)
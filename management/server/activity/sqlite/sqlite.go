@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"path/filepath"
+	"strings"
 	"time"
 
 	_ "github.com/mattn/go-sqlite3"
@@ -26,9 +27,15 @@ const (
 		"meta TEXT," +
 		" target_id TEXT);"
 
+	createIndexesQuery = `CREATE INDEX IF NOT EXISTS idx_events_account_id ON events(account_id, id);
+		CREATE INDEX IF NOT EXISTS idx_events_account_activity ON events(account_id, activity, id);
+		CREATE INDEX IF NOT EXISTS idx_events_account_initiator ON events(account_id, initiator_id, id);
+		CREATE INDEX IF NOT EXISTS idx_events_account_target ON events(account_id, target_id, id);
+		CREATE INDEX IF NOT EXISTS idx_events_account_timestamp ON events(account_id, timestamp);`
+
 	creatTableDeletedUsersQuery = `CREATE TABLE IF NOT EXISTS deleted_users (id TEXT NOT NULL, email TEXT NOT NULL, name TEXT);`
 
-	selectDescQuery = `SELECT events.id, activity, timestamp, initiator_id, i.name as "initiator_name", i.email as "initiator_email", target_id, t.name as "target_name", t.email as "target_email", account_id, meta
+	selectQuery = `SELECT events.id, activity, timestamp, initiator_id, i.name as "initiator_name", i.email as "initiator_email", target_id, t.name as "target_name", t.email as "target_email", account_id, meta
 		FROM events 
 		LEFT JOIN (
 		    SELECT id, MAX(name) as name, MAX(email) as email 
@@ -40,22 +47,12 @@ const (
 		    FROM deleted_users
 		    GROUP BY id
 		) t ON events.target_id = t.id
-		WHERE account_id = ? 
+		`
+
+	selectDescQuery = selectQuery + `WHERE account_id = ? 
 		ORDER BY timestamp DESC LIMIT ? OFFSET ?;`
 
-	selectAscQuery = `SELECT events.id, activity, timestamp, initiator_id, i.name as "initiator_name", i.email as "initiator_email", target_id, t.name as "target_name", t.email as "target_email", account_id, meta
-		FROM events 
-		LEFT JOIN (
-		    SELECT id, MAX(name) as name, MAX(email) as email 
-		    FROM deleted_users
-		    GROUP BY id
-		) i ON events.initiator_id = i.id 
-		LEFT JOIN (
-		    SELECT id, MAX(name) as name, MAX(email) as email 
-		    FROM deleted_users
-		    GROUP BY id
-		) t ON events.target_id = t.id
-		WHERE account_id = ? 
+	selectAscQuery = selectQuery + `WHERE account_id = ? 
 		ORDER BY timestamp ASC LIMIT ? OFFSET ?;`
 
 	insertQuery = "INSERT INTO events(activity, timestamp, initiator_id, target_id, account_id, meta) " +
@@ -75,6 +72,9 @@ const (
 	fallbackEmail = "unknown@unknown.com"
 )
 
+// likeEscaper escapes the wildcard characters of a LIKE pattern
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
 // Store is the implementation of the activity.Store interface backed by SQLite
 type Store struct {
 	db           *sql.DB
@@ -106,6 +106,12 @@ func NewSQLiteStore(ctx context.Context, dataDir string, encryptionKey string) (
 		return nil, err
 	}
 
+	_, err = db.Exec(createIndexesQuery)
+	if err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+
 	_, err = db.Exec(creatTableDeletedUsersQuery)
 	if err != nil {
 		_ = db.Close()
@@ -258,6 +264,82 @@ func (store *Store) Get(ctx context.Context, accountID string, offset, limit int
 	return store.processResult(ctx, result)
 }
 
+// Search returns the events of an account matching the filter ordered by ID. Only the columns stored in plain text
+// are matched against the filter text, the encrypted names and emails of deleted users are not searchable.
+func (store *Store) Search(ctx context.Context, accountID string, filter *activity.Filter) ([]*activity.Event, error) {
+	conditions := []string{"account_id = ?"}
+	args := []any{accountID}
+
+	if len(filter.Activities) > 0 {
+		placeholders := make([]string, 0, len(filter.Activities))
+		for _, a := range filter.Activities {
+			placeholders = append(placeholders, "?")
+			args = append(args, a)
+		}
+		conditions = append(conditions, "activity IN ("+strings.Join(placeholders, ", ")+")")
+	}
+
+	if filter.InitiatorID != "" {
+		conditions = append(conditions, "initiator_id = ?")
+		args = append(args, filter.InitiatorID)
+	}
+
+	if filter.TargetID != "" {
+		conditions = append(conditions, "target_id = ?")
+		args = append(args, filter.TargetID)
+	}
+
+	// timestamps are stored as UTC strings, so the bound values must be in UTC as well to compare correctly
+	if !filter.From.IsZero() {
+		conditions = append(conditions, "timestamp >= ?")
+		args = append(args, filter.From.UTC())
+	}
+
+	if !filter.To.IsZero() {
+		conditions = append(conditions, "timestamp < ?")
+		args = append(args, filter.To.UTC())
+	}
+
+	if filter.Text != "" {
+		pattern := "%" + likeEscaper.Replace(filter.Text) + "%"
+		textConditions := []string{`initiator_id LIKE ? ESCAPE '\'`, `target_id LIKE ? ESCAPE '\'`, `meta LIKE ? ESCAPE '\'`}
+		args = append(args, pattern, pattern, pattern)
+		for _, id := range filter.TextUserIDs {
+			textConditions = append(textConditions, "initiator_id = ?", "target_id = ?")
+			args = append(args, id, id)
+		}
+		conditions = append(conditions, "("+strings.Join(textConditions, " OR ")+")")
+	}
+
+	order := "ASC"
+	if filter.Descending {
+		order = "DESC"
+	}
+
+	if filter.Cursor != 0 {
+		if filter.Descending {
+			conditions = append(conditions, "events.id < ?")
+		} else {
+			conditions = append(conditions, "events.id > ?")
+		}
+		args = append(args, filter.Cursor)
+	}
+
+	query := selectQuery + "WHERE " + strings.Join(conditions, " AND ") + " ORDER BY events.id " + order
+	if filter.Limit > 0 {
+		query += " LIMIT ?"
+		args = append(args, filter.Limit)
+	}
+
+	result, err := store.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer result.Close() //nolint
+	return store.processResult(ctx, result)
+}
+
 // Save an event in the SQLite events table end encrypt the "email" element in meta map
 func (store *Store) Save(_ context.Context, event *activity.Event) (*activity.Event, error) {
 	var jsonMeta string
@@ -55,3 +55,115 @@ func TestNewSQLiteStore(t *testing.T) {
 	assert.Len(t, result, 5)
 	assert.True(t, result[0].Timestamp.After(result[len(result)-1].Timestamp))
 }
+
+func TestSearch(t *testing.T) {
+	dataDir := t.TempDir()
+	key, _ := GenerateKey()
+	store, err := NewSQLiteStore(context.Background(), dataDir, key)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	defer store.Close(context.Background()) //nolint
+
+	accountID := "account_1"
+	start := time.Now().UTC().Add(-time.Hour)
+
+	for i := 0; i < 10; i++ {
+		typ := activity.PeerAddedByUser
+		if i%2 == 1 {
+			typ = activity.PolicyAdded
+		}
+		_, err = store.Save(context.Background(), &activity.Event{
+			Timestamp:   start.Add(time.Duration(i) * time.Minute),
+			Activity:    typ,
+			InitiatorID: "user_" + fmt.Sprint(i%3),
+			TargetID:    "target_" + fmt.Sprint(i),
+			AccountID:   accountID,
+			Meta:        map[string]any{"name": "object_" + fmt.Sprint(i) + "%"},
+		})
+		if err != nil {
+			t.Fatal(err)
+			return
+		}
+	}
+
+	_, err = store.Save(context.Background(), &activity.Event{
+		Timestamp:   start,
+		Activity:    activity.PolicyAdded,
+		InitiatorID: "user_0",
+		AccountID:   "account_2",
+	})
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+
+	tt := []struct {
+		name     string
+		filter   *activity.Filter
+		expected []string
+	}{
+		{
+			name:     "activity",
+			filter:   &activity.Filter{Activities: []activity.Activity{activity.PolicyAdded}},
+			expected: []string{"target_1", "target_3", "target_5", "target_7", "target_9"},
+		},
+		{
+			name:     "initiator and target",
+			filter:   &activity.Filter{InitiatorID: "user_1", TargetID: "target_4"},
+			expected: []string{"target_4"},
+		},
+		{
+			name:     "time range",
+			filter:   &activity.Filter{From: start.Add(2 * time.Minute), To: start.Add(4 * time.Minute)},
+			expected: []string{"target_2", "target_3"},
+		},
+		{
+			name:     "time range in another location",
+			filter:   &activity.Filter{From: start.Add(8 * time.Minute).In(time.FixedZone("UTC+5", 5*60*60))},
+			expected: []string{"target_8", "target_9"},
+		},
+		{
+			name:     "text in meta is matched case insensitive and literally",
+			filter:   &activity.Filter{Text: "OBJECT_1%"},
+			expected: []string{"target_1"},
+		},
+		{
+			name:     "text matching user ids",
+			filter:   &activity.Filter{Text: "target_9", TextUserIDs: []string{"user_2"}},
+			expected: []string{"target_2", "target_5", "target_8", "target_9"},
+		},
+		{
+			name:     "descending with limit",
+			filter:   &activity.Filter{Descending: true, Limit: 3},
+			expected: []string{"target_9", "target_8", "target_7"},
+		},
+		{
+			name:     "descending with cursor",
+			filter:   &activity.Filter{Descending: true, Limit: 3, Cursor: 8},
+			expected: []string{"target_6", "target_5", "target_4"},
+		},
+		{
+			name:     "ascending with cursor",
+			filter:   &activity.Filter{Limit: 2, Cursor: 8},
+			expected: []string{"target_8", "target_9"},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := store.Search(context.Background(), accountID, tc.filter)
+			if err != nil {
+				t.Fatal(err)
+				return
+			}
+
+			targets := make([]string, 0, len(result))
+			for _, event := range result {
+				targets = append(targets, event.TargetID)
+			}
+			assert.Equal(t, tc.expected, targets)
+		})
+	}
+}
@@ -2,15 +2,100 @@ package activity
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"strings"
 	"sync"
+	"time"
 )
 
+// Filter narrows down the events returned by Store.Search. Zero value fields are not applied.
+type Filter struct {
+	// Activities limits the result to the given activity types
+	Activities []Activity
+	// InitiatorID limits the result to events initiated by the given object
+	InitiatorID string
+	// TargetID limits the result to events affecting the given object
+	TargetID string
+	// From limits the result to events that happened at or after the given time
+	From time.Time
+	// To limits the result to events that happened before the given time
+	To time.Time
+	// Text is matched case-insensitively against the initiator ID, target ID and the meta of an event
+	Text string
+	// TextUserIDs are initiator or target IDs matched by Text in addition to the event data, e.g. users with a matching name
+	TextUserIDs []string
+	// Cursor is the ID of the last event of the previous page. Only events after it in the requested order are returned
+	Cursor uint64
+	// Limit is the maximum number of events to return
+	Limit int
+	// Descending orders events from the newest to the oldest
+	Descending bool
+}
+
+// Match returns true if the event satisfies the filter conditions, except for the pagination ones
+func (f *Filter) Match(event *Event) bool {
+	if len(f.Activities) > 0 {
+		found := false
+		for _, a := range f.Activities {
+			if event.Activity != nil && event.Activity.StringCode() == a.StringCode() {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if f.InitiatorID != "" && event.InitiatorID != f.InitiatorID {
+		return false
+	}
+
+	if f.TargetID != "" && event.TargetID != f.TargetID {
+		return false
+	}
+
+	if !f.From.IsZero() && event.Timestamp.Before(f.From) {
+		return false
+	}
+
+	if !f.To.IsZero() && !event.Timestamp.Before(f.To) {
+		return false
+	}
+
+	if f.Text == "" {
+		return true
+	}
+
+	text := strings.ToLower(f.Text)
+	if strings.Contains(strings.ToLower(event.InitiatorID), text) || strings.Contains(strings.ToLower(event.TargetID), text) {
+		return true
+	}
+
+	for _, id := range f.TextUserIDs {
+		if event.InitiatorID == id || event.TargetID == id {
+			return true
+		}
+	}
+
+	for key, value := range event.Meta {
+		if strings.Contains(strings.ToLower(key), text) || strings.Contains(strings.ToLower(fmt.Sprint(value)), text) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // Store provides an interface to store or stream events.
 type Store interface {
 	// Save an event in the store
 	Save(ctx context.Context, event *Event) (*Event, error)
 	// Get returns "limit" number of events from the "offset" index ordered descending or ascending by a timestamp
 	Get(ctx context.Context, accountID string, offset, limit int, descending bool) ([]*Event, error)
+	// Search returns the events of an account matching the filter ordered by ID
+	Search(ctx context.Context, accountID string, filter *Filter) ([]*Event, error)
 	// Close the sink flushing events if necessary
 	Close(ctx context.Context) error
 }
@@ -22,15 +107,15 @@ type InMemoryEventStore struct {
 	events []*Event
 }
 
-// Save sets the Event.ID to 1
+// Save sets the Event.ID to the next sequence number starting from 1
 func (store *InMemoryEventStore) Save(_ context.Context, event *Event) (*Event, error) {
 	store.mu.Lock()
 	defer store.mu.Unlock()
 	if store.events == nil {
 		store.events = make([]*Event, 0)
 	}
-	event.ID = store.nextID
 	store.nextID++
+	event.ID = store.nextID
 	store.events = append(store.events, event)
 	return event, nil
 }
@@ -48,6 +133,34 @@ func (store *InMemoryEventStore) Get(_ context.Context, accountID string, offset
 	return events, nil
 }
 
+// Search returns the events that belong to the given accountID and match the filter
+func (store *InMemoryEventStore) Search(_ context.Context, accountID string, filter *Filter) ([]*Event, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	events := make([]*Event, 0)
+	for _, event := range store.events {
+		if event.AccountID != accountID || !filter.Match(event) {
+			continue
+		}
+		if filter.Cursor != 0 && (filter.Descending && event.ID >= filter.Cursor || !filter.Descending && event.ID <= filter.Cursor) {
+			continue
+		}
+		events = append(events, event)
+	}
+
+	sort.Slice(events, func(i, j int) bool {
+		if filter.Descending {
+			return events[i].ID > events[j].ID
+		}
+		return events[i].ID < events[j].ID
+	})
+
+	if filter.Limit > 0 && len(events) > filter.Limit {
+		events = events[:filter.Limit]
+	}
+	return events, nil
+}
+
 // Close cleans up the event list
 func (store *InMemoryEventStore) Close(_ context.Context) error {
 	store.mu.Lock()
@@ -80,6 +80,25 @@ type Relay struct {
 	Addresses      []string
 	CredentialsTTL util.Duration
 	Secret         string
+	// Limits are embedded in the relay tokens of the peers and enforced by the relay servers. The relay servers must
+	// support limits, the older ones reject the tokens that carry them.
+	Limits *RelayLimits
+}
+
+// RelayLimits are the relay limits of the peers. A zero value leaves the limit to the relay server defaults.
+type RelayLimits struct {
+	// PeerBytesPerSecond is the sustained transfer rate of a peer
+	PeerBytesPerSecond int64
+	// PeerBurstBytes is the number of bytes a peer can send at once above the sustained rate
+	PeerBurstBytes int64
+	// AccountBytesPerSecond is the sustained transfer rate of all the peers of an account on a relay server
+	AccountBytesPerSecond int64
+	// AccountBurstBytes is the number of bytes the peers of an account can send at once above the sustained rate
+	AccountBurstBytes int64
+	// AccountMaxPeers is the number of peers of an account that can be connected to a relay server at the same time
+	AccountMaxPeers int
+	// PeerMaxConnections is the number of connections a peer can have to a relay server at the same time
+	PeerMaxConnections int
 }
 
 // HttpServerConfig is a config of the HTTP Management service server
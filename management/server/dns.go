@@ -119,42 +119,63 @@ func (am *DefaultAccountManager) SaveDNSSettings(ctx context.Context, accountID
 		return status.Errorf(status.PermissionDenied, "only users with admin power are allowed to update DNS settings")
 	}
 
+	eventsToStore, err := am.saveDNSSettings(ctx, account, userID, dnsSettingsToSave)
+	if err != nil {
+		return err
+	}
+
+	account.Network.IncSerial()
+	if err = am.Store.SaveAccount(ctx, account); err != nil {
+		return err
+	}
+
+	for _, storeEvent := range eventsToStore {
+		storeEvent()
+	}
+
+	am.updateAccountPeers(ctx, account)
+
+	return nil
+}
+
+// saveDNSSettings validates the DNS settings and puts them in the account, it returns the events to store once the
+// account is saved
+func (am *DefaultAccountManager) saveDNSSettings(ctx context.Context, account *Account, userID string, dnsSettingsToSave *DNSSettings) ([]func(), error) {
 	if dnsSettingsToSave == nil {
-		return status.Errorf(status.InvalidArgument, "the dns settings provided are nil")
+		return nil, status.Errorf(status.InvalidArgument, "the dns settings provided are nil")
 	}
 
 	if len(dnsSettingsToSave.DisabledManagementGroups) != 0 {
-		err = validateGroups(dnsSettingsToSave.DisabledManagementGroups, account.Groups)
+		err := validateGroups(dnsSettingsToSave.DisabledManagementGroups, account.Groups)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 
 	oldSettings := account.DNSSettings.Copy()
 	account.DNSSettings = dnsSettingsToSave.Copy()
 
-	account.Network.IncSerial()
-	if err = am.Store.SaveAccount(ctx, account); err != nil {
-		return err
-	}
+	var eventsToStore []func()
 
 	addedGroups := difference(dnsSettingsToSave.DisabledManagementGroups, oldSettings.DisabledManagementGroups)
 	for _, id := range addedGroups {
 		group := account.GetGroup(id)
 		meta := map[string]any{"group": group.Name, "group_id": group.ID}
-		am.StoreEvent(ctx, userID, accountID, accountID, activity.GroupAddedToDisabledManagementGroups, meta)
+		eventsToStore = append(eventsToStore, func() {
+			am.StoreEvent(ctx, userID, account.Id, account.Id, activity.GroupAddedToDisabledManagementGroups, meta)
+		})
 	}
 
 	removedGroups := difference(oldSettings.DisabledManagementGroups, dnsSettingsToSave.DisabledManagementGroups)
 	for _, id := range removedGroups {
 		group := account.GetGroup(id)
 		meta := map[string]any{"group": group.Name, "group_id": group.ID}
-		am.StoreEvent(ctx, userID, accountID, accountID, activity.GroupRemovedFromDisabledManagementGroups, meta)
+		eventsToStore = append(eventsToStore, func() {
+			am.StoreEvent(ctx, userID, account.Id, account.Id, activity.GroupRemovedFromDisabledManagementGroups, meta)
+		})
 	}
 
-	am.updateAccountPeers(ctx, account)
-
-	return nil
+	return eventsToStore, nil
 }
 
 // toProtocolDNSConfig converts nbdns.Config to proto.DNSConfig using the cache
@@ -165,8 +186,17 @@ func toProtocolDNSConfig(update nbdns.Config, cache *DNSConfigCache) *proto.DNSC
 		NameServerGroups: make([]*proto.NameServerGroup, 0, len(update.NameServerGroups)),
 	}
 
+	seenZones := make(map[string]struct{}, len(update.CustomZones))
 	for _, zone := range update.CustomZones {
 		cacheKey := zone.Domain
+		// only the first zone of a domain holds the records shared by all peers, the following ones
+		// carry the custom DNS records distributed to this peer and can't be cached by the domain
+		if _, seen := seenZones[cacheKey]; seen {
+			protoUpdate.CustomZones = append(protoUpdate.CustomZones, convertToProtoCustomZone(zone))
+			continue
+		}
+		seenZones[cacheKey] = struct{}{}
+
 		if cachedZone, exists := cache.GetCustomZone(cacheKey); exists {
 			protoUpdate.CustomZones = append(protoUpdate.CustomZones, cachedZone)
 		} else {
@@ -218,9 +248,11 @@ func convertToProtoNameServerGroup(nsGroup *nbdns.NameServerGroup) *proto.NameSe
 	}
 	for _, ns := range nsGroup.NameServers {
 		protoGroup.NameServers = append(protoGroup.NameServers, &proto.NameServer{
-			IP:     ns.IP.String(),
-			Port:   int64(ns.Port),
-			NSType: int64(ns.NSType),
+			IP:              ns.IP.String(),
+			Port:            int64(ns.Port),
+			NSType:          int64(ns.NSType),
+			Hostname:        ns.Hostname,
+			CertFingerprint: ns.CertFingerprint,
 		})
 	}
 	return protoGroup
@@ -0,0 +1,401 @@
+package server
+
+import (
+	"context"
+	"net/netip"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/miekg/dns"
+	"github.com/rs/xid"
+
+	nbdns "github.com/netbirdio/netbird/dns"
+	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/status"
+)
+
+const (
+	// DNSRecordTypeA is an IPv4 address record
+	DNSRecordTypeA = "A"
+	// DNSRecordTypeAAAA is an IPv6 address record
+	DNSRecordTypeAAAA = "AAAA"
+	// DNSRecordTypeCNAME is an alias record
+	DNSRecordTypeCNAME = "CNAME"
+	// DNSRecordTypeTXT is a text record
+	DNSRecordTypeTXT = "TXT"
+	// DNSRecordTypeSRV is a service locator record
+	DNSRecordTypeSRV = "SRV"
+
+	dnsRecordMaxTTL = 86400
+	// txtChunkLength is the maximum length of a single character-string of a TXT record
+	txtChunkLength = 255
+
+	dnsRecordNamePattern = `^(?i)[a-z0-9_]([a-z0-9_-]*[a-z0-9_])?(\.[a-z0-9_]([a-z0-9_-]*[a-z0-9_])?)*$`
+
+	errMsgDNSRecordAdminOnly = "only users with admin power are allowed to manage DNS records"
+)
+
+var dnsRecordNameMatcher = regexp.MustCompile(dnsRecordNamePattern)
+
+// DNSRecord is a custom record of the account DNS zone distributed to the peers of its groups
+type DNSRecord struct {
+	// ID of the record
+	ID string `gorm:"primaryKey"`
+
+	// AccountID is a reference to Account that this object belongs
+	AccountID string `json:"-" gorm:"index"`
+
+	// Name of the record relative to the account DNS domain, e.g. "db.internal"
+	Name string
+
+	// Type of the record, one of A, AAAA, CNAME, TXT or SRV
+	Type string
+
+	// Value of the record. An address for A and AAAA, a domain for CNAME, raw text for TXT
+	// and "priority weight port target" for SRV records.
+	Value string
+
+	// TTL of the record in seconds. Zero uses the default TTL.
+	TTL int
+
+	// Groups of peers that resolve the record
+	Groups []string `gorm:"serializer:json"`
+
+	// Enabled records are distributed to the peers
+	Enabled bool
+}
+
+// Copy returns a copy of the DNS record
+func (r *DNSRecord) Copy() *DNSRecord {
+	c := *r
+	c.Groups = slices.Clone(r.Groups)
+	return &c
+}
+
+// EventMeta returns activity event meta related to this DNS record
+func (r *DNSRecord) EventMeta() map[string]any {
+	return map[string]any{"name": r.Name, "type": r.Type, "value": r.Value}
+}
+
+// ttl returns the TTL of the record or the default one when it isn't set
+func (r *DNSRecord) ttl() int {
+	if r.TTL == 0 {
+		return defaultTTL
+	}
+	return r.TTL
+}
+
+// rData returns the record value in the zone file presentation format
+func (r *DNSRecord) rData() string {
+	switch r.Type {
+	case DNSRecordTypeCNAME:
+		return dns.Fqdn(r.Value)
+	case DNSRecordTypeTXT:
+		return txtRData(r.Value)
+	case DNSRecordTypeSRV:
+		fields := strings.Fields(r.Value)
+		if len(fields) == 4 {
+			fields[3] = dns.Fqdn(fields[3])
+		}
+		return strings.Join(fields, " ")
+	default:
+		return r.Value
+	}
+}
+
+// toSimpleRecord converts the record to a record of the zone with the given domain
+func (r *DNSRecord) toSimpleRecord(zoneDomain string) nbdns.SimpleRecord {
+	return nbdns.SimpleRecord{
+		Name:  dns.Fqdn(r.Name + "." + zoneDomain),
+		Type:  int(dns.StringToType[r.Type]),
+		Class: nbdns.DefaultClass,
+		TTL:   r.ttl(),
+		RData: r.rData(),
+	}
+}
+
+// txtRData quotes the text and splits it into character-strings of at most 255 bytes
+func txtRData(text string) string {
+	var chunks []string
+	for len(text) > txtChunkLength {
+		chunks = append(chunks, text[:txtChunkLength])
+		text = text[txtChunkLength:]
+	}
+	chunks = append(chunks, text)
+
+	for i, chunk := range chunks {
+		chunk = strings.ReplaceAll(chunk, `\`, `\\`)
+		chunks[i] = `"` + strings.ReplaceAll(chunk, `"`, `\"`) + `"`
+	}
+	return strings.Join(chunks, " ")
+}
+
+func (r *DNSRecord) validate() error {
+	if r.Name == "" || len(r.Name) > 200 || !dnsRecordNameMatcher.MatchString(r.Name) {
+		return status.Errorf(status.InvalidArgument, "DNS record name %q should consist of letters, numbers, underscores and hyphens", r.Name)
+	}
+
+	if r.TTL < 0 || r.TTL > dnsRecordMaxTTL {
+		return status.Errorf(status.InvalidArgument, "DNS record TTL should be between 0 and %d", dnsRecordMaxTTL)
+	}
+
+	switch r.Type {
+	case DNSRecordTypeA, DNSRecordTypeAAAA:
+		addr, err := netip.ParseAddr(r.Value)
+		if err != nil || addr.Zone() != "" || addr.Is4() != (r.Type == DNSRecordTypeA) {
+			return status.Errorf(status.InvalidArgument, "DNS record value %q is not a valid %s record address", r.Value, r.Type)
+		}
+	case DNSRecordTypeCNAME, DNSRecordTypeTXT, DNSRecordTypeSRV:
+		if strings.TrimSpace(r.Value) == "" {
+			return status.Errorf(status.InvalidArgument, "DNS record value shouldn't be empty")
+		}
+	default:
+		return status.Errorf(status.InvalidArgument, "unsupported DNS record type %q", r.Type)
+	}
+
+	rr, err := dns.NewRR(r.toSimpleRecord("netbird.invalid").String())
+	if err != nil || rr == nil {
+		return status.Errorf(status.InvalidArgument, "DNS record value %q is not valid for a %s record", r.Value, r.Type)
+	}
+
+	return nil
+}
+
+// validateDNSRecord checks the record and its conflicts with the other records and peers of the account
+func validateDNSRecord(record *DNSRecord, account *Account) error {
+	if err := record.validate(); err != nil {
+		return err
+	}
+
+	if err := validateGroups(record.Groups, account.Groups); err != nil {
+		return err
+	}
+
+	for _, peer := range account.Peers {
+		if strings.EqualFold(peer.DNSLabel, record.Name) {
+			return status.Errorf(status.InvalidArgument, "DNS record name %s is already used by peer %s", record.Name, peer.Name)
+		}
+	}
+
+	for _, existing := range account.DNSRecords {
+		if existing.ID == record.ID || existing.Name != record.Name {
+			continue
+		}
+		if existing.Type == DNSRecordTypeCNAME || record.Type == DNSRecordTypeCNAME {
+			return status.Errorf(status.InvalidArgument, "a CNAME record can't coexist with other records of name %s", record.Name)
+		}
+		if existing.Type == record.Type && existing.Value == record.Value {
+			return status.Errorf(status.AlreadyExists, "a %s record of name %s with value %s already exists", record.Type, record.Name, record.Value)
+		}
+	}
+
+	return nil
+}
+
+func (a *Account) getDNSRecord(recordID string) (*DNSRecord, error) {
+	for _, record := range a.DNSRecords {
+		if record.ID == recordID {
+			return record, nil
+		}
+	}
+	return nil, status.Errorf(status.NotFound, "DNS record with ID %s not found", recordID)
+}
+
+// getPeerDNSRecords returns the enabled custom records of the zone distributed to the peer.
+// Records named like a peer of the zone are skipped, peer records take precedence.
+func (a *Account) getPeerDNSRecords(peerID string, peersCustomZone nbdns.CustomZone) []nbdns.SimpleRecord {
+	if len(a.DNSRecords) == 0 {
+		return nil
+	}
+
+	peerNames := make(map[string]struct{}, len(peersCustomZone.Records))
+	for _, record := range peersCustomZone.Records {
+		peerNames[strings.ToLower(dns.Fqdn(record.Name))] = struct{}{}
+	}
+
+	groupList := a.getPeerGroups(peerID)
+
+	var records []nbdns.SimpleRecord
+	for _, record := range a.DNSRecords {
+		if !record.Enabled {
+			continue
+		}
+
+		distributed := slices.ContainsFunc(record.Groups, func(groupID string) bool {
+			_, found := groupList[groupID]
+			return found
+		})
+		if !distributed {
+			continue
+		}
+
+		simpleRecord := record.toSimpleRecord(peersCustomZone.Domain)
+		if _, found := peerNames[strings.ToLower(simpleRecord.Name)]; found {
+			continue
+		}
+		records = append(records, simpleRecord)
+	}
+
+	return records
+}
+
+// CreateDNSRecord adds a custom DNS record to the account
+func (am *DefaultAccountManager) CreateDNSRecord(ctx context.Context, accountID, userID string, record *DNSRecord) (*DNSRecord, error) {
+	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+	defer unlock()
+
+	account, err := am.getAccountForDNSRecordAdmin(ctx, accountID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	record.ID = xid.New().String()
+	record.AccountID = accountID
+	record.Name = strings.ToLower(record.Name)
+
+	if err = validateDNSRecord(record, account); err != nil {
+		return nil, err
+	}
+
+	account.DNSRecords = append(account.DNSRecords, record)
+
+	account.Network.IncSerial()
+	if err = am.Store.SaveAccount(ctx, account); err != nil {
+		return nil, err
+	}
+
+	am.updateAccountPeers(ctx, account)
+
+	am.StoreEvent(ctx, userID, record.ID, accountID, activity.DNSRecordCreated, record.EventMeta())
+
+	return record.Copy(), nil
+}
+
+// SaveDNSRecord updates a custom DNS record of the account
+func (am *DefaultAccountManager) SaveDNSRecord(ctx context.Context, accountID, userID string, recordToSave *DNSRecord) (*DNSRecord, error) {
+	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+	defer unlock()
+
+	if recordToSave == nil {
+		return nil, status.Errorf(status.InvalidArgument, "DNS record provided is nil")
+	}
+
+	account, err := am.getAccountForDNSRecordAdmin(ctx, accountID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := account.getDNSRecord(recordToSave.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	recordToSave.AccountID = accountID
+	recordToSave.Name = strings.ToLower(recordToSave.Name)
+
+	if err = validateDNSRecord(recordToSave, account); err != nil {
+		return nil, err
+	}
+
+	record.Name = recordToSave.Name
+	record.Type = recordToSave.Type
+	record.Value = recordToSave.Value
+	record.TTL = recordToSave.TTL
+	record.Groups = recordToSave.Groups
+	record.Enabled = recordToSave.Enabled
+
+	account.Network.IncSerial()
+	if err = am.Store.SaveAccount(ctx, account); err != nil {
+		return nil, err
+	}
+
+	am.updateAccountPeers(ctx, account)
+
+	am.StoreEvent(ctx, userID, record.ID, accountID, activity.DNSRecordUpdated, record.EventMeta())
+
+	return record.Copy(), nil
+}
+
+// GetDNSRecord returns a custom DNS record of the account
+func (am *DefaultAccountManager) GetDNSRecord(ctx context.Context, accountID, recordID, userID string) (*DNSRecord, error) {
+	unlock := am.Store.AcquireReadLockByUID(ctx, accountID)
+	defer unlock()
+
+	account, err := am.getAccountForDNSRecordAdmin(ctx, accountID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := account.getDNSRecord(recordID)
+	if err != nil {
+		return nil, err
+	}
+
+	return record.Copy(), nil
+}
+
+// ListDNSRecords returns the custom DNS records of the account
+func (am *DefaultAccountManager) ListDNSRecords(ctx context.Context, accountID, userID string) ([]*DNSRecord, error) {
+	unlock := am.Store.AcquireReadLockByUID(ctx, accountID)
+	defer unlock()
+
+	account, err := am.getAccountForDNSRecordAdmin(ctx, accountID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]*DNSRecord, 0, len(account.DNSRecords))
+	for _, record := range account.DNSRecords {
+		records = append(records, record.Copy())
+	}
+
+	return records, nil
+}
+
+// DeleteDNSRecord removes a custom DNS record from the account
+func (am *DefaultAccountManager) DeleteDNSRecord(ctx context.Context, accountID, recordID, userID string) error {
+	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+	defer unlock()
+
+	account, err := am.getAccountForDNSRecordAdmin(ctx, accountID, userID)
+	if err != nil {
+		return err
+	}
+
+	record, err := account.getDNSRecord(recordID)
+	if err != nil {
+		return err
+	}
+
+	account.DNSRecords = slices.DeleteFunc(account.DNSRecords, func(r *DNSRecord) bool { return r.ID == recordID })
+
+	account.Network.IncSerial()
+	if err = am.Store.SaveAccount(ctx, account); err != nil {
+		return err
+	}
+
+	am.updateAccountPeers(ctx, account)
+
+	am.StoreEvent(ctx, userID, record.ID, accountID, activity.DNSRecordDeleted, record.EventMeta())
+
+	return nil
+}
+
+func (am *DefaultAccountManager) getAccountForDNSRecordAdmin(ctx context.Context, accountID, userID string) (*Account, error) {
+	account, err := am.Store.GetAccount(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := account.FindUser(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !user.HasAdminPower() {
+		return nil, status.Errorf(status.PermissionDenied, errMsgDNSRecordAdminOnly)
+	}
+
+	return account, nil
+}
@@ -0,0 +1,169 @@
+package server
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	nbdns "github.com/netbirdio/netbird/dns"
+	nbgroup "github.com/netbirdio/netbird/management/server/group"
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+)
+
+func TestDefaultAccountManager_DNSRecords(t *testing.T) {
+	am, err := createManager(t)
+	require.NoError(t, err, "failed to create account manager")
+
+	account, err := initTestAccessRequestAccount(am)
+	require.NoError(t, err, "failed to init testing account")
+
+	record := &DNSRecord{Name: "DB.internal", Type: DNSRecordTypeA, Value: "100.64.0.10", Groups: []string{"laptops"}, Enabled: true}
+
+	_, err = am.CreateDNSRecord(context.Background(), account.Id, regularUserID, record)
+	assert.Error(t, err, "regular users should not be able to create DNS records")
+
+	_, err = am.CreateDNSRecord(context.Background(), account.Id, adminUserID, &DNSRecord{Name: "db", Type: DNSRecordTypeA, Value: "100.64.0.10", Groups: []string{"unknown"}})
+	assert.Error(t, err, "unknown groups should be rejected")
+
+	created, err := am.CreateDNSRecord(context.Background(), account.Id, adminUserID, record)
+	require.NoError(t, err)
+	assert.NotEmpty(t, created.ID)
+	assert.Equal(t, "db.internal", created.Name, "names should be stored in lowercase")
+
+	_, err = am.CreateDNSRecord(context.Background(), account.Id, adminUserID, &DNSRecord{Name: "db.internal", Type: DNSRecordTypeA, Value: "100.64.0.10", Groups: []string{"laptops"}})
+	assert.Error(t, err, "duplicated records should be rejected")
+
+	_, err = am.CreateDNSRecord(context.Background(), account.Id, adminUserID, &DNSRecord{Name: "db.internal", Type: DNSRecordTypeCNAME, Value: "db.example.com", Groups: []string{"laptops"}})
+	assert.Error(t, err, "a CNAME record should not coexist with other records of the same name")
+
+	_, err = am.CreateDNSRecord(context.Background(), account.Id, adminUserID, &DNSRecord{Name: "db.internal", Type: DNSRecordTypeA, Value: "100.64.0.11", Groups: []string{"laptops"}})
+	require.NoError(t, err, "multiple A records of the same name should be accepted")
+
+	created.Value = "100.64.0.12"
+	created.Groups = []string{"db-prod"}
+	saved, err := am.SaveDNSRecord(context.Background(), account.Id, adminUserID, created)
+	require.NoError(t, err)
+	assert.Equal(t, "100.64.0.12", saved.Value)
+	assert.Equal(t, []string{"db-prod"}, saved.Groups)
+
+	records, err := am.ListDNSRecords(context.Background(), account.Id, adminUserID)
+	require.NoError(t, err)
+	assert.Len(t, records, 2)
+
+	require.NoError(t, am.DeleteDNSRecord(context.Background(), account.Id, created.ID, adminUserID))
+	_, err = am.GetDNSRecord(context.Background(), account.Id, created.ID, adminUserID)
+	assert.Error(t, err)
+
+	updatedAccount, err := am.Store.GetAccount(context.Background(), account.Id)
+	require.NoError(t, err)
+	assert.Greater(t, updatedAccount.Network.CurrentSerial(), account.Network.CurrentSerial(), "record changes should update the network serial")
+}
+
+func TestDNSRecord_Validate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		record  DNSRecord
+		wantErr bool
+	}{
+		{name: "A", record: DNSRecord{Name: "db", Type: DNSRecordTypeA, Value: "10.0.0.1"}},
+		{name: "AAAA", record: DNSRecord{Name: "db", Type: DNSRecordTypeAAAA, Value: "fd00::1"}},
+		{name: "CNAME", record: DNSRecord{Name: "www", Type: DNSRecordTypeCNAME, Value: "web.example.com"}},
+		{name: "TXT", record: DNSRecord{Name: "db", Type: DNSRecordTypeTXT, Value: `owner="ops team"`}},
+		{name: "Long TXT", record: DNSRecord{Name: "db", Type: DNSRecordTypeTXT, Value: strings.Repeat("a", 600)}},
+		{name: "SRV", record: DNSRecord{Name: "_postgres._tcp", Type: DNSRecordTypeSRV, Value: "10 5 5432 db.example.com"}},
+		{name: "Empty Name", record: DNSRecord{Type: DNSRecordTypeA, Value: "10.0.0.1"}, wantErr: true},
+		{name: "Invalid Name", record: DNSRecord{Name: "db..internal", Type: DNSRecordTypeA, Value: "10.0.0.1"}, wantErr: true},
+		{name: "Leading Hyphen", record: DNSRecord{Name: "-db", Type: DNSRecordTypeA, Value: "10.0.0.1"}, wantErr: true},
+		{name: "Unsupported Type", record: DNSRecord{Name: "db", Type: "MX", Value: "10 mail.example.com"}, wantErr: true},
+		{name: "IPv6 In A Record", record: DNSRecord{Name: "db", Type: DNSRecordTypeA, Value: "fd00::1"}, wantErr: true},
+		{name: "IPv4 In AAAA Record", record: DNSRecord{Name: "db", Type: DNSRecordTypeAAAA, Value: "10.0.0.1"}, wantErr: true},
+		{name: "Invalid CNAME Target", record: DNSRecord{Name: "www", Type: DNSRecordTypeCNAME, Value: "web example"}, wantErr: true},
+		{name: "Incomplete SRV", record: DNSRecord{Name: "_postgres._tcp", Type: DNSRecordTypeSRV, Value: "10 5 5432"}, wantErr: true},
+		{name: "Negative TTL", record: DNSRecord{Name: "db", Type: DNSRecordTypeA, Value: "10.0.0.1", TTL: -1}, wantErr: true},
+		{name: "TTL Too Large", record: DNSRecord{Name: "db", Type: DNSRecordTypeA, Value: "10.0.0.1", TTL: dnsRecordMaxTTL + 1}, wantErr: true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := testCase.record.validate()
+			if testCase.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			simpleRecord := testCase.record.toSimpleRecord("netbird.cloud.")
+			rr, err := dns.NewRR(simpleRecord.String())
+			require.NoError(t, err)
+			assert.Equal(t, strings.ToLower(testCase.record.Name)+".netbird.cloud.", rr.Header().Name)
+			if txt, ok := rr.(*dns.TXT); ok {
+				// the parsed character-strings keep the escaping of the presentation format
+				assert.Equal(t, testCase.record.Value, strings.ReplaceAll(strings.Join(txt.Txt, ""), `\"`, `"`))
+			}
+		})
+	}
+}
+
+func TestAccount_getPeerDNSRecords(t *testing.T) {
+	account := &Account{
+		Peers: map[string]*nbpeer.Peer{
+			"peer1": {ID: "peer1", DNSLabel: "peer1", IP: net.ParseIP("100.64.0.1")},
+			"peer2": {ID: "peer2", DNSLabel: "peer2", IP: net.ParseIP("100.64.0.2")},
+		},
+		Groups: map[string]*nbgroup.Group{
+			"servers": {ID: "servers", Peers: []string{"peer1"}},
+			"laptops": {ID: "laptops", Peers: []string{"peer2"}},
+		},
+		DNSRecords: []*DNSRecord{
+			{ID: "r1", Name: "db", Type: DNSRecordTypeA, Value: "10.0.0.1", Groups: []string{"servers", "laptops"}, Enabled: true},
+			{ID: "r2", Name: "_postgres._tcp", Type: DNSRecordTypeSRV, Value: "10 5 5432 db.netbird.cloud", Groups: []string{"servers"}, Enabled: true},
+			{ID: "r3", Name: "disabled", Type: DNSRecordTypeA, Value: "10.0.0.2", Groups: []string{"servers"}},
+			{ID: "r4", Name: "peer2", Type: DNSRecordTypeA, Value: "10.0.0.3", Groups: []string{"servers"}, Enabled: true},
+		},
+	}
+
+	peersZone := nbdns.CustomZone{
+		Domain: "netbird.cloud.",
+		Records: []nbdns.SimpleRecord{
+			{Name: "peer1.netbird.cloud", Type: int(dns.TypeA), Class: nbdns.DefaultClass, TTL: defaultTTL, RData: "100.64.0.1"},
+			{Name: "peer2.netbird.cloud", Type: int(dns.TypeA), Class: nbdns.DefaultClass, TTL: defaultTTL, RData: "100.64.0.2"},
+		},
+	}
+
+	records := account.getPeerDNSRecords("peer1", peersZone)
+	require.Len(t, records, 2, "disabled records and records named like a peer should be skipped")
+	assert.Equal(t, nbdns.SimpleRecord{Name: "db.netbird.cloud.", Type: int(dns.TypeA), Class: nbdns.DefaultClass, TTL: defaultTTL, RData: "10.0.0.1"}, records[0])
+	assert.Equal(t, nbdns.SimpleRecord{Name: "_postgres._tcp.netbird.cloud.", Type: int(dns.TypeSRV), Class: nbdns.DefaultClass, TTL: defaultTTL, RData: "10 5 5432 db.netbird.cloud."}, records[1])
+
+	records = account.getPeerDNSRecords("peer2", peersZone)
+	require.Len(t, records, 1, "records should only be distributed to peers of their groups")
+	assert.Equal(t, "db.netbird.cloud.", records[0].Name)
+}
+
+func TestToProtocolDNSConfig_PeerSpecificZones(t *testing.T) {
+	cache := &DNSConfigCache{}
+	peersZone := nbdns.CustomZone{
+		Domain:  "netbird.cloud.",
+		Records: []nbdns.SimpleRecord{{Name: "peer1.netbird.cloud.", Type: int(dns.TypeA), Class: nbdns.DefaultClass, TTL: defaultTTL, RData: "100.64.0.1"}},
+	}
+
+	first := toProtocolDNSConfig(nbdns.Config{CustomZones: []nbdns.CustomZone{
+		peersZone,
+		{Domain: "netbird.cloud.", Records: []nbdns.SimpleRecord{{Name: "db.netbird.cloud.", Type: int(dns.TypeA), Class: nbdns.DefaultClass, TTL: defaultTTL, RData: "10.0.0.1"}}},
+	}}, cache)
+	second := toProtocolDNSConfig(nbdns.Config{CustomZones: []nbdns.CustomZone{
+		peersZone,
+		{Domain: "netbird.cloud.", Records: []nbdns.SimpleRecord{{Name: "web.netbird.cloud.", Type: int(dns.TypeA), Class: nbdns.DefaultClass, TTL: defaultTTL, RData: "10.0.0.2"}}},
+	}}, cache)
+
+	require.Len(t, first.CustomZones, 2)
+	require.Len(t, second.CustomZones, 2)
+	assert.Same(t, first.CustomZones[0], second.CustomZones[0], "the shared zone should be cached")
+	assert.Equal(t, "db.netbird.cloud.", first.CustomZones[1].Records[0].Name)
+	assert.Equal(t, "web.netbird.cloud.", second.CustomZones[1].Records[0].Name, "peer specific zones should not be cached")
+}
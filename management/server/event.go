@@ -3,6 +3,7 @@ package server
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	log "github.com/sirupsen/logrus"
@@ -11,28 +12,54 @@ import (
 	"github.com/netbirdio/netbird/management/server/status"
 )
 
+// maxEventsLimit is the maximum number of events returned in a single page
+const maxEventsLimit = 10000
+
 // GetEvents returns a list of activity events of an account
 func (am *DefaultAccountManager) GetEvents(ctx context.Context, accountID, userID string) ([]*activity.Event, error) {
-	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
-	defer unlock()
+	events, _, err := am.SearchEvents(ctx, accountID, userID, &activity.Filter{Limit: maxEventsLimit, Descending: true})
+	return events, err
+}
 
-	account, err := am.Store.GetAccount(ctx, accountID)
-	if err != nil {
-		return nil, err
+// SearchEvents returns a page of activity events of an account matching the filter and the cursor of the next page.
+// The cursor is zero when there are no more events. The filter text is also matched against the names and emails
+// of the account users.
+func (am *DefaultAccountManager) SearchEvents(ctx context.Context, accountID, userID string, filter *activity.Filter) ([]*activity.Event, uint64, error) {
+	if err := am.checkEventsPermission(ctx, accountID, userID); err != nil {
+		return nil, 0, err
 	}
 
-	user, err := account.FindUser(userID)
-	if err != nil {
-		return nil, err
+	if filter.Limit <= 0 || filter.Limit > maxEventsLimit {
+		filter.Limit = maxEventsLimit
 	}
 
-	if !(user.HasAdminPower() || user.IsServiceUser) {
-		return nil, status.Errorf(status.PermissionDenied, "only users with admin power can view events")
+	if filter.Text != "" {
+		users, err := am.GetUsersFromAccount(ctx, accountID, userID)
+		if err != nil {
+			return nil, 0, err
+		}
+		text := strings.ToLower(filter.Text)
+		filter.TextUserIDs = nil
+		for _, user := range users {
+			if strings.Contains(strings.ToLower(user.Name), text) || strings.Contains(strings.ToLower(user.Email), text) {
+				filter.TextUserIDs = append(filter.TextUserIDs, user.ID)
+			}
+		}
 	}
 
-	events, err := am.eventStore.Get(ctx, accountID, 0, 10000, true)
+	// fetch one more event to know whether there is a next page
+	limit := filter.Limit
+	filter.Limit++
+	events, err := am.eventStore.Search(ctx, accountID, filter)
+	filter.Limit = limit
 	if err != nil {
-		return nil, err
+		return nil, 0, err
+	}
+
+	var nextCursor uint64
+	if len(events) > limit {
+		events = events[:limit]
+		nextCursor = events[limit-1].ID
 	}
 
 	// this is a workaround for duplicate activity.UserJoined events that might occur when a user redeems invite.
@@ -52,13 +79,34 @@ func (am *DefaultAccountManager) GetEvents(ctx context.Context, accountID, userI
 		filtered = append(filtered, event)
 	}
 
-	return filtered, nil
+	return filtered, nextCursor, nil
+}
+
+func (am *DefaultAccountManager) checkEventsPermission(ctx context.Context, accountID, userID string) error {
+	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+	defer unlock()
+
+	account, err := am.Store.GetAccount(ctx, accountID)
+	if err != nil {
+		return err
+	}
+
+	user, err := account.FindUser(userID)
+	if err != nil {
+		return err
+	}
+
+	if !(user.HasAdminPower() || user.IsServiceUser) {
+		return status.Errorf(status.PermissionDenied, "only users with admin power can view events")
+	}
+
+	return nil
 }
 
 func (am *DefaultAccountManager) StoreEvent(ctx context.Context, initiatorID, targetID, accountID string, activityID activity.ActivityDescriber, meta map[string]any) {
 
 	go func() {
-		_, err := am.eventStore.Save(ctx, &activity.Event{
+		event, err := am.eventStore.Save(ctx, &activity.Event{
 			Timestamp:   time.Now().UTC(),
 			Activity:    activityID,
 			InitiatorID: initiatorID,
@@ -69,7 +117,10 @@ func (am *DefaultAccountManager) StoreEvent(ctx context.Context, initiatorID, ta
 		if err != nil {
 			// todo add metric
 			log.WithContext(ctx).Errorf("received an error while storing an activity event, error: %s", err)
+			return
 		}
+
+		am.enqueueWebhookDeliveries(ctx, event)
 	}()
 
 }
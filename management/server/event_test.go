@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/netbirdio/netbird/management/server/activity"
 )
@@ -65,3 +66,41 @@ func TestDefaultAccountManager_GetEvents(t *testing.T) {
 		_ = manager.eventStore.Close(context.Background()) //nolint
 	})
 }
+
+func TestDefaultAccountManager_SearchEvents(t *testing.T) {
+	manager, err := createManager(t)
+	require.NoError(t, err, "failed to create account manager")
+
+	account := newAccountWithId(context.Background(), "searchAccount", adminUserID, "example.com")
+	account.Users[adminUserID].ServiceUserName = "Alice"
+	account.Users[regularUserID] = &User{Id: regularUserID, Role: UserRoleUser}
+	require.NoError(t, manager.Store.SaveAccount(context.Background(), account))
+
+	generateAndStoreEvents(t, manager, activity.PolicyAdded, adminUserID, "policy", account.Id, 5)
+	generateAndStoreEvents(t, manager, activity.PeerAddedByUser, regularUserID, "peer", account.Id, 3)
+
+	_, _, err = manager.SearchEvents(context.Background(), account.Id, regularUserID, &activity.Filter{})
+	assert.Error(t, err, "regular users should not be able to search events")
+
+	filter := &activity.Filter{Activities: []activity.Activity{activity.PolicyAdded}, Limit: 2, Descending: true}
+	var ids []uint64
+	for page := 0; ; page++ {
+		require.Less(t, page, 5, "pagination should end")
+		events, nextCursor, err := manager.SearchEvents(context.Background(), account.Id, adminUserID, filter)
+		require.NoError(t, err)
+		for _, event := range events {
+			assert.Equal(t, activity.PolicyAdded, event.Activity)
+			ids = append(ids, event.ID)
+		}
+		if nextCursor == 0 {
+			break
+		}
+		filter.Cursor = nextCursor
+	}
+	assert.Equal(t, []uint64{5, 4, 3, 2, 1}, ids)
+
+	events, nextCursor, err := manager.SearchEvents(context.Background(), account.Id, adminUserID, &activity.Filter{Text: "alice"})
+	require.NoError(t, err)
+	assert.Len(t, events, 5, "the text should match the events of users with a matching name")
+	assert.Zero(t, nextCursor)
+}
@@ -781,6 +781,48 @@ func (s *FileStore) GetPostureCheckByChecksDefinition(accountID string, checks *
 	return nil, status.Errorf(status.Internal, "GetPostureCheckByChecksDefinition is not implemented")
 }
 
+// GetAccountWebhooks returns the webhooks of the account
+func (s *FileStore) GetAccountWebhooks(_ context.Context, accountID string) ([]*Webhook, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	account, err := s.getAccount(accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	webhooks := make([]*Webhook, 0, len(account.Webhooks))
+	for _, webhook := range account.Webhooks {
+		webhooks = append(webhooks, webhook.Copy())
+	}
+	return webhooks, nil
+}
+
+// SaveWebhookDeliveries is not implemented, the webhook delivery queue requires an SQL store
+func (s *FileStore) SaveWebhookDeliveries(_ context.Context, _ []*WebhookDelivery) error {
+	return status.Errorf(status.Internal, "SaveWebhookDeliveries is not implemented")
+}
+
+// GetWebhookDeliveries is not implemented, the webhook delivery queue requires an SQL store
+func (s *FileStore) GetWebhookDeliveries(_ context.Context, _, _ string, _ int) ([]*WebhookDelivery, error) {
+	return nil, status.Errorf(status.Internal, "GetWebhookDeliveries is not implemented")
+}
+
+// GetDueWebhookDeliveries is not implemented, the webhook delivery queue requires an SQL store
+func (s *FileStore) GetDueWebhookDeliveries(_ context.Context, _ time.Time, _ int) ([]*WebhookDelivery, error) {
+	return nil, status.Errorf(status.Internal, "GetDueWebhookDeliveries is not implemented")
+}
+
+// DeleteWebhookDeliveries is not implemented, the webhook delivery queue requires an SQL store
+func (s *FileStore) DeleteWebhookDeliveries(_ context.Context, _, _ string) error {
+	return status.Errorf(status.Internal, "DeleteWebhookDeliveries is not implemented")
+}
+
+// DeleteOldWebhookDeliveries is not implemented, the webhook delivery queue requires an SQL store
+func (s *FileStore) DeleteOldWebhookDeliveries(_ context.Context, _ time.Time) error {
+	return status.Errorf(status.Internal, "DeleteOldWebhookDeliveries is not implemented")
+}
+
 // Close the FileStore persisting data to disk
 func (s *FileStore) Close(ctx context.Context) error {
 	s.mux.Lock()
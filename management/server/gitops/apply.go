@@ -0,0 +1,730 @@
+package gitops
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/netip"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/rs/xid"
+
+	nbdns "github.com/netbirdio/netbird/dns"
+	"github.com/netbirdio/netbird/management/domain"
+	"github.com/netbirdio/netbird/management/server"
+	nbgroup "github.com/netbirdio/netbird/management/server/group"
+	"github.com/netbirdio/netbird/management/server/posture"
+	"github.com/netbirdio/netbird/management/server/status"
+	"github.com/netbirdio/netbird/route"
+)
+
+// ChangeAction is the type of change performed on an object
+type ChangeAction string
+
+const (
+	ChangeCreate ChangeAction = "create"
+	ChangeUpdate ChangeAction = "update"
+	ChangeDelete ChangeAction = "delete"
+)
+
+const (
+	KindGroup           = "group"
+	KindPostureCheck    = "posture_check"
+	KindPolicy          = "policy"
+	KindRoute           = "route"
+	KindNameServerGroup = "nameserver_group"
+	KindDNSSettings     = "dns_settings"
+)
+
+// Change is a single change required to bring the account to the state of the document
+type Change struct {
+	Action ChangeAction `json:"action"`
+	Kind   string       `json:"kind"`
+	ID     string       `json:"id,omitempty"`
+	Name   string       `json:"name,omitempty"`
+}
+
+// Plan is the list of changes in the order they are, or would be with dry run, performed
+type Plan struct {
+	DryRun  bool     `json:"dry_run"`
+	Changes []Change `json:"changes"`
+}
+
+// errDryRun discards the changes of a dry run once they are validated
+var errDryRun = errors.New("dry run")
+
+type operation struct {
+	change Change
+	apply  func() error
+}
+
+// applier computes the operations needed to reconcile an account with a document
+type applier struct {
+	tx      server.AccountTransaction
+	account *server.Account
+	doc     *Document
+
+	// groupIDs and postureCheckIDs map references used in the document (IDs or names) to account object IDs
+	groupIDs        map[string]string
+	postureCheckIDs map[string]string
+
+	upserts []operation
+	deletes []operation
+}
+
+// Apply reconciles the account with the document performing the minimal set of changes in one account transaction,
+// so either all the changes are saved or none is. Objects are matched by ID and, when the ID is not known, by name.
+// Sections missing from the document are left untouched. With dryRun the changes are validated and returned, but not saved.
+func Apply(ctx context.Context, am server.AccountManager, account *server.Account, userID string, doc *Document, dryRun bool) (*Plan, error) {
+	if doc == nil {
+		return nil, status.Errorf(status.InvalidArgument, "document is empty")
+	}
+	if doc.Version != Version {
+		return nil, status.Errorf(status.InvalidArgument, "unsupported document version %q, expected %q", doc.Version, Version)
+	}
+
+	user, err := account.FindUser(userID)
+	if err != nil {
+		return nil, err
+	}
+	if !user.HasAdminPower() {
+		return nil, status.Errorf(status.PermissionDenied, "only users with admin power can apply account configuration")
+	}
+
+	var plan *Plan
+	err = am.UpdateAccount(ctx, account.Id, userID, func(tx server.AccountTransaction) error {
+		a := &applier{
+			tx:              tx,
+			account:         tx.Account(),
+			doc:             doc,
+			groupIDs:        make(map[string]string),
+			postureCheckIDs: make(map[string]string),
+		}
+
+		// objects are created in dependency order and deleted in the reverse one,
+		// so references are always valid when the transaction checks them
+		planners := []func() error{
+			a.planGroups,
+			a.planPostureChecks,
+			a.planPolicies,
+			a.planRoutes,
+			a.planNameServerGroups,
+			a.planDNSSettings,
+		}
+		for _, planner := range planners {
+			if err := planner(); err != nil {
+				return err
+			}
+		}
+
+		// the operations are applied to the account of the transaction even with dry run, which validates them
+		plan = &Plan{DryRun: dryRun, Changes: []Change{}}
+		for _, op := range append(a.upserts, a.deletes...) {
+			if err := op.apply(); err != nil {
+				return fmt.Errorf("failed to %s %s %s: %w", op.change.Action, op.change.Kind, changeRef(op.change), err)
+			}
+			plan.Changes = append(plan.Changes, op.change)
+		}
+
+		if dryRun {
+			return errDryRun
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errDryRun) {
+		return nil, err
+	}
+
+	return plan, nil
+}
+
+func changeRef(change Change) string {
+	if change.Name != "" {
+		return change.Name
+	}
+	return change.ID
+}
+
+func (a *applier) upsert(action ChangeAction, kind, id, name string, fn func() error) {
+	a.upserts = append(a.upserts, operation{change: Change{Action: action, Kind: kind, ID: id, Name: name}, apply: fn})
+}
+
+// addDeletes puts deletes of a kind in front of the ones planned before, reversing the order of kinds
+func (a *applier) addDeletes(ops []operation) {
+	sort.Slice(ops, func(i, j int) bool { return ops[i].change.ID < ops[j].change.ID })
+	a.deletes = append(ops, a.deletes...)
+}
+
+func deleteOperation(kind, id, name string, fn func() error) operation {
+	return operation{change: Change{Action: ChangeDelete, Kind: kind, ID: id, Name: name}, apply: fn}
+}
+
+func (a *applier) planGroups() error {
+	if a.doc.Groups == nil {
+		return nil
+	}
+
+	matched := make(map[string]struct{})
+	for _, g := range a.doc.Groups {
+		if g.Name == "" {
+			return status.Errorf(status.InvalidArgument, "group name shouldn't be empty")
+		}
+
+		existing := a.findGroup(g.ID, g.Name)
+		id := g.ID
+		if existing != nil {
+			id = existing.ID
+			if _, ok := matched[id]; ok {
+				return status.Errorf(status.InvalidArgument, "group %s is defined more than once", g.Name)
+			}
+			matched[id] = struct{}{}
+		} else if id == "" {
+			id = xid.New().String()
+		}
+		a.mapReference(a.groupIDs, g.ID, g.Name, id)
+
+		// the All group and groups managed by JWT or integrations are kept in sync by the system
+		if existing != nil && isReadOnlyGroup(existing) {
+			continue
+		}
+
+		group := &nbgroup.Group{ID: id, Issued: nbgroup.GroupIssuedAPI}
+		action := ChangeCreate
+		if existing != nil {
+			group = existing.Copy()
+			action = ChangeUpdate
+		}
+		group.Name = g.Name
+		group.Peers = g.Peers
+
+		if existing != nil && equal(toGroupDocument(existing), toGroupDocument(group)) {
+			continue
+		}
+
+		a.upsert(action, KindGroup, id, group.Name, func() error {
+			return a.tx.SaveGroup(group)
+		})
+	}
+
+	var deletes []operation
+	for _, group := range a.account.Groups {
+		if _, ok := matched[group.ID]; ok || isReadOnlyGroup(group) {
+			continue
+		}
+		groupID := group.ID
+		deletes = append(deletes, deleteOperation(KindGroup, groupID, group.Name, func() error {
+			return a.tx.DeleteGroup(groupID)
+		}))
+	}
+	a.addDeletes(deletes)
+
+	return nil
+}
+
+func (a *applier) planPostureChecks() error {
+	if a.doc.PostureChecks == nil {
+		return nil
+	}
+
+	matched := make(map[string]struct{})
+	for _, pc := range a.doc.PostureChecks {
+		existing := a.findPostureChecks(pc.ID, pc.Name)
+		id := pc.ID
+		if existing != nil {
+			id = existing.ID
+			if _, ok := matched[id]; ok {
+				return status.Errorf(status.InvalidArgument, "posture checks %s are defined more than once", pc.Name)
+			}
+			matched[id] = struct{}{}
+		} else if id == "" {
+			id = xid.New().String()
+		}
+		a.mapReference(a.postureCheckIDs, pc.ID, pc.Name, id)
+
+		checks := &posture.Checks{
+			ID:          id,
+			Name:        pc.Name,
+			Description: pc.Description,
+			Checks:      pc.Checks.Copy(),
+		}
+		if err := checks.Validate(); err != nil {
+			return status.Errorf(status.InvalidArgument, "invalid posture checks %s: %s", pc.Name, err)
+		}
+
+		action := ChangeCreate
+		if existing != nil {
+			if equal(toPostureCheckDocument(existing), toPostureCheckDocument(checks)) {
+				continue
+			}
+			action = ChangeUpdate
+		}
+
+		a.upsert(action, KindPostureCheck, id, checks.Name, func() error {
+			return a.tx.SavePostureChecks(checks)
+		})
+	}
+
+	var deletes []operation
+	for _, checks := range a.account.PostureChecks {
+		if _, ok := matched[checks.ID]; ok {
+			continue
+		}
+		checksID := checks.ID
+		deletes = append(deletes, deleteOperation(KindPostureCheck, checksID, checks.Name, func() error {
+			return a.tx.DeletePostureChecks(checksID)
+		}))
+	}
+	a.addDeletes(deletes)
+
+	return nil
+}
+
+func (a *applier) planPolicies() error {
+	if a.doc.Policies == nil {
+		return nil
+	}
+
+	// policies of access requests are temporary, they are neither matched nor removed
+	temporary := accessRequestPolicies(a.account)
+
+	matched := make(map[string]struct{})
+	for _, p := range a.doc.Policies {
+		if p.Name == "" {
+			return status.Errorf(status.InvalidArgument, "policy name shouldn't be empty")
+		}
+
+		existing := a.findPolicy(p.ID, p.Name, temporary)
+		id := p.ID
+		if existing != nil {
+			id = existing.ID
+			if _, ok := matched[id]; ok {
+				return status.Errorf(status.InvalidArgument, "policy %s is defined more than once", p.Name)
+			}
+			matched[id] = struct{}{}
+		} else if id == "" {
+			id = xid.New().String()
+		}
+
+		policy, err := a.toPolicy(id, p, existing)
+		if err != nil {
+			return err
+		}
+
+		action := ChangeCreate
+		if existing != nil {
+			if equal(toPolicyDocument(existing), toPolicyDocument(policy)) {
+				continue
+			}
+			action = ChangeUpdate
+		}
+
+		a.upsert(action, KindPolicy, id, policy.Name, func() error {
+			return a.tx.SavePolicy(policy)
+		})
+	}
+
+	var deletes []operation
+	for _, policy := range a.account.Policies {
+		if _, ok := matched[policy.ID]; ok {
+			continue
+		}
+		if _, ok := temporary[policy.ID]; ok {
+			continue
+		}
+		policyID := policy.ID
+		deletes = append(deletes, deleteOperation(KindPolicy, policyID, policy.Name, func() error {
+			return a.tx.DeletePolicy(policyID)
+		}))
+	}
+	a.addDeletes(deletes)
+
+	return nil
+}
+
+func (a *applier) toPolicy(id string, p Policy, existing *server.Policy) (*server.Policy, error) {
+	policy := &server.Policy{
+		ID:                  id,
+		Name:                p.Name,
+		Description:         p.Description,
+		Enabled:             p.Enabled,
+		SourcePostureChecks: a.resolve(a.postureCheckIDs, p.SourcePostureChecks, a.postureChecksByName()),
+	}
+
+	if p.Schedule != nil {
+		schedule, err := toPolicySchedule(p.Schedule)
+		if err != nil {
+			return nil, err
+		}
+		if err := schedule.Validate(); err != nil {
+			return nil, err
+		}
+		policy.Schedule = schedule
+	}
+
+	groups := a.groupsByName()
+	for i, r := range p.Rules {
+		// keep the IDs of existing rules so unchanged rules are not recreated
+		ruleID := xid.New().String()
+		switch {
+		case existing != nil && i < len(existing.Rules):
+			ruleID = existing.Rules[i].ID
+		case i == 0:
+			ruleID = id
+		}
+
+		rule := &server.PolicyRule{
+			ID:            ruleID,
+			PolicyID:      id,
+			Name:          r.Name,
+			Description:   r.Description,
+			Enabled:       r.Enabled,
+			Action:        server.PolicyTrafficActionType(r.Action),
+			Protocol:      server.PolicyRuleProtocolType(r.Protocol),
+			Bidirectional: r.Bidirectional,
+			Sources:       a.resolve(a.groupIDs, r.Sources, groups),
+			Destinations:  a.resolve(a.groupIDs, r.Destinations, groups),
+			Ports:         r.Ports,
+		}
+		if rule.Action == "" {
+			rule.Action = server.PolicyTrafficActionAccept
+		}
+		if rule.Protocol == "" {
+			rule.Protocol = server.PolicyRuleProtocolALL
+		}
+		for _, portRange := range r.PortRanges {
+			rule.PortRanges = append(rule.PortRanges, server.RulePortRange{Start: portRange.Start, End: portRange.End})
+		}
+		policy.Rules = append(policy.Rules, rule)
+	}
+
+	return policy, nil
+}
+
+func toPolicySchedule(s *PolicySchedule) (*server.PolicySchedule, error) {
+	schedule := &server.PolicySchedule{
+		Timezone:  s.Timezone,
+		ExpiresAt: s.ExpiresAt,
+	}
+
+	for _, day := range s.Days {
+		weekday, ok := parseWeekday(day)
+		if !ok {
+			return nil, status.Errorf(status.InvalidArgument, "invalid schedule day %s", day)
+		}
+		schedule.Days = append(schedule.Days, weekday)
+	}
+
+	for _, window := range s.Windows {
+		schedule.Windows = append(schedule.Windows, server.PolicyTimeWindow{Start: window.Start, End: window.End})
+	}
+
+	return schedule, nil
+}
+
+func parseWeekday(day string) (time.Weekday, bool) {
+	for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+		if strings.EqualFold(weekday.String(), day) {
+			return weekday, true
+		}
+	}
+	return 0, false
+}
+
+func (a *applier) planRoutes() error {
+	if a.doc.Routes == nil {
+		return nil
+	}
+
+	groups := a.groupsByName()
+	matched := make(map[route.ID]struct{})
+	for _, r := range a.doc.Routes {
+		if r.ID == "" {
+			return status.Errorf(status.InvalidArgument, "route %s should have an ID", r.NetworkID)
+		}
+
+		id := route.ID(r.ID)
+		if _, ok := matched[id]; ok {
+			return status.Errorf(status.InvalidArgument, "route %s is defined more than once", r.ID)
+		}
+		matched[id] = struct{}{}
+
+		newRoute := &route.Route{
+			ID:          id,
+			NetID:       route.NetID(r.NetworkID),
+			Description: r.Description,
+			KeepRoute:   r.KeepRoute,
+			Peer:        r.Peer,
+			PeerGroups:  a.resolve(a.groupIDs, r.PeerGroups, groups),
+			Masquerade:  r.Masquerade,
+			Metric:      r.Metric,
+			Enabled:     r.Enabled,
+			Groups:      a.resolve(a.groupIDs, r.Groups, groups),
+			Strategy:    route.Strategy(r.Strategy),
+		}
+
+		if len(r.Domains) > 0 {
+			domains, err := domain.FromStringList(r.Domains)
+			if err != nil {
+				return status.Errorf(status.InvalidArgument, "invalid domains of route %s: %s", r.ID, err)
+			}
+			newRoute.Domains = domains
+			newRoute.NetworkType = route.DomainNetwork
+		} else {
+			prefix, err := netip.ParsePrefix(r.Network)
+			if err != nil {
+				return status.Errorf(status.InvalidArgument, "invalid network of route %s: %s", r.ID, err)
+			}
+			newRoute.Network = prefix
+			newRoute.NetworkType = route.IPv4Network
+			if prefix.Addr().Is6() {
+				newRoute.NetworkType = route.IPv6Network
+			}
+		}
+
+		action := ChangeCreate
+		if existing, ok := a.account.Routes[id]; ok {
+			if equal(toRouteDocument(existing), toRouteDocument(newRoute)) {
+				continue
+			}
+			action = ChangeUpdate
+		}
+
+		a.upsert(action, KindRoute, r.ID, r.NetworkID, func() error {
+			return a.tx.SaveRoute(newRoute)
+		})
+	}
+
+	var deletes []operation
+	for _, r := range a.account.Routes {
+		if _, ok := matched[r.ID]; ok {
+			continue
+		}
+		routeID := r.ID
+		deletes = append(deletes, deleteOperation(KindRoute, string(routeID), string(r.NetID), func() error {
+			return a.tx.DeleteRoute(routeID)
+		}))
+	}
+	a.addDeletes(deletes)
+
+	return nil
+}
+
+func (a *applier) planNameServerGroups() error {
+	if a.doc.NameServerGroups == nil {
+		return nil
+	}
+
+	groups := a.groupsByName()
+	matched := make(map[string]struct{})
+	for _, ns := range a.doc.NameServerGroups {
+		if ns.Name == "" {
+			return status.Errorf(status.InvalidArgument, "nameserver group name shouldn't be empty")
+		}
+
+		existing := a.findNameServerGroup(ns.ID, ns.Name)
+		if existing != nil {
+			if _, ok := matched[existing.ID]; ok {
+				return status.Errorf(status.InvalidArgument, "nameserver group %s is defined more than once", ns.Name)
+			}
+			matched[existing.ID] = struct{}{}
+		}
+
+		nsGroup := &nbdns.NameServerGroup{
+			Name:                 ns.Name,
+			Description:          ns.Description,
+			Groups:               a.resolve(a.groupIDs, ns.Groups, groups),
+			Primary:              ns.Primary,
+			Domains:              ns.Domains,
+			Enabled:              ns.Enabled,
+			SearchDomainsEnabled: ns.SearchDomainsEnabled,
+		}
+		for _, nameServer := range ns.NameServers {
+			ip, err := netip.ParseAddr(nameServer.IP)
+			if err != nil {
+				return status.Errorf(status.InvalidArgument, "invalid nameserver IP %s of nameserver group %s", nameServer.IP, ns.Name)
+			}
+			nsGroup.NameServers = append(nsGroup.NameServers, nbdns.NameServer{
+				IP:              ip,
+				NSType:          nbdns.ToNameServerType(nameServer.NSType),
+				Port:            nameServer.Port,
+				Hostname:        nameServer.Hostname,
+				CertFingerprint: nameServer.CertFingerprint,
+			})
+		}
+
+		// the transaction assigns IDs to new nameserver groups, later applies match them by name
+		if existing == nil {
+			a.upsert(ChangeCreate, KindNameServerGroup, "", nsGroup.Name, func() error {
+				_, err := a.tx.CreateNameServerGroup(nsGroup)
+				return err
+			})
+			continue
+		}
+
+		nsGroup.ID = existing.ID
+		if equal(toNameServerGroupDocument(existing), toNameServerGroupDocument(nsGroup)) {
+			continue
+		}
+
+		a.upsert(ChangeUpdate, KindNameServerGroup, nsGroup.ID, nsGroup.Name, func() error {
+			return a.tx.SaveNameServerGroup(nsGroup)
+		})
+	}
+
+	var deletes []operation
+	for _, nsGroup := range a.account.NameServerGroups {
+		if _, ok := matched[nsGroup.ID]; ok {
+			continue
+		}
+		nsGroupID := nsGroup.ID
+		deletes = append(deletes, deleteOperation(KindNameServerGroup, nsGroupID, nsGroup.Name, func() error {
+			return a.tx.DeleteNameServerGroup(nsGroupID)
+		}))
+	}
+	a.addDeletes(deletes)
+
+	return nil
+}
+
+func (a *applier) planDNSSettings() error {
+	if a.doc.DNSSettings == nil {
+		return nil
+	}
+
+	settings := &server.DNSSettings{
+		DisabledManagementGroups: a.resolve(a.groupIDs, a.doc.DNSSettings.DisabledManagementGroups, a.groupsByName()),
+	}
+	if equal(toDNSSettingsDocument(a.account.DNSSettings), toDNSSettingsDocument(*settings)) {
+		return nil
+	}
+
+	a.upsert(ChangeUpdate, KindDNSSettings, "", "", func() error {
+		return a.tx.SaveDNSSettings(settings)
+	})
+
+	return nil
+}
+
+func (a *applier) findGroup(id, name string) *nbgroup.Group {
+	if group, ok := a.account.Groups[id]; ok {
+		return group
+	}
+	for _, group := range a.account.Groups {
+		if group.Name == name {
+			return group
+		}
+	}
+	return nil
+}
+
+func (a *applier) findPostureChecks(id, name string) *posture.Checks {
+	var byName *posture.Checks
+	for _, checks := range a.account.PostureChecks {
+		if id != "" && checks.ID == id {
+			return checks
+		}
+		if checks.Name == name && byName == nil {
+			byName = checks
+		}
+	}
+	return byName
+}
+
+func (a *applier) findPolicy(id, name string, excluded map[string]struct{}) *server.Policy {
+	var byName *server.Policy
+	for _, policy := range a.account.Policies {
+		if _, ok := excluded[policy.ID]; ok {
+			continue
+		}
+		if id != "" && policy.ID == id {
+			return policy
+		}
+		if policy.Name == name && byName == nil {
+			byName = policy
+		}
+	}
+	return byName
+}
+
+func (a *applier) findNameServerGroup(id, name string) *nbdns.NameServerGroup {
+	if nsGroup, ok := a.account.NameServerGroups[id]; ok {
+		return nsGroup
+	}
+	for _, nsGroup := range a.account.NameServerGroups {
+		if nsGroup.Name == name {
+			return nsGroup
+		}
+	}
+	return nil
+}
+
+func (a *applier) groupsByName() map[string]string {
+	names := make(map[string]string, len(a.account.Groups))
+	for _, group := range a.account.Groups {
+		names[group.Name] = group.ID
+	}
+	return names
+}
+
+func (a *applier) postureChecksByName() map[string]string {
+	names := make(map[string]string, len(a.account.PostureChecks))
+	for _, checks := range a.account.PostureChecks {
+		names[checks.Name] = checks.ID
+	}
+	return names
+}
+
+// mapReference records the account ID of a document object, so it can be referenced by its document ID or name
+func (a *applier) mapReference(refs map[string]string, docID, name, id string) {
+	if docID != "" {
+		refs[docID] = id
+	}
+	refs[name] = id
+}
+
+// resolve translates document references to account IDs. References are looked up in the document objects first,
+// then between account IDs and names. Unknown references are kept, so the transaction reports them.
+func (a *applier) resolve(refs map[string]string, list []string, names map[string]string) []string {
+	if len(list) == 0 {
+		return nil
+	}
+
+	resolved := make([]string, 0, len(list))
+	for _, ref := range list {
+		if id, ok := refs[ref]; ok {
+			resolved = append(resolved, id)
+			continue
+		}
+		if id, ok := names[ref]; ok && !a.isAccountID(ref) {
+			resolved = append(resolved, id)
+			continue
+		}
+		resolved = append(resolved, ref)
+	}
+	return resolved
+}
+
+func (a *applier) isAccountID(id string) bool {
+	if _, ok := a.account.Groups[id]; ok {
+		return true
+	}
+	for _, checks := range a.account.PostureChecks {
+		if checks.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+func isReadOnlyGroup(group *nbgroup.Group) bool {
+	return group.Name == "All" || (group.Issued != "" && group.Issued != nbgroup.GroupIssuedAPI)
+}
+
+func equal(a, b any) bool {
+	aData, aErr := json.Marshal(a)
+	bData, bErr := json.Marshal(b)
+	return aErr == nil && bErr == nil && string(aData) == string(bData)
+}
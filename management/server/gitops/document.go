@@ -0,0 +1,363 @@
+// Package gitops exports the configuration of an account to a declarative document
+// and applies such documents back to the account.
+package gitops
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	nbdns "github.com/netbirdio/netbird/dns"
+	"github.com/netbirdio/netbird/management/server"
+	nbgroup "github.com/netbirdio/netbird/management/server/group"
+	"github.com/netbirdio/netbird/management/server/posture"
+	"github.com/netbirdio/netbird/route"
+)
+
+// Version of the document format produced by Export
+const Version = "v1"
+
+// Document is the declarative configuration of an account.
+// A nil section is not managed by Apply, an empty section removes all objects of its kind.
+type Document struct {
+	Version          string            `json:"version"`
+	Groups           []Group           `json:"groups"`
+	PostureChecks    []PostureCheck    `json:"posture_checks"`
+	Policies         []Policy          `json:"policies"`
+	Routes           []Route           `json:"routes"`
+	NameServerGroups []NameServerGroup `json:"nameserver_groups"`
+	DNSSettings      *DNSSettings      `json:"dns_settings,omitempty"`
+}
+
+// Group is a group of peers
+type Group struct {
+	ID    string   `json:"id,omitempty"`
+	Name  string   `json:"name"`
+	Peers []string `json:"peers,omitempty"`
+}
+
+// PostureCheck is a set of posture checks
+type PostureCheck struct {
+	ID          string                   `json:"id,omitempty"`
+	Name        string                   `json:"name"`
+	Description string                   `json:"description,omitempty"`
+	Checks      posture.ChecksDefinition `json:"checks"`
+}
+
+// Policy is an access-control policy
+type Policy struct {
+	ID                  string          `json:"id,omitempty"`
+	Name                string          `json:"name"`
+	Description         string          `json:"description,omitempty"`
+	Enabled             bool            `json:"enabled"`
+	SourcePostureChecks []string        `json:"source_posture_checks,omitempty"`
+	Schedule            *PolicySchedule `json:"schedule,omitempty"`
+	Rules               []PolicyRule    `json:"rules"`
+}
+
+// PolicyRule is a rule of a policy
+type PolicyRule struct {
+	Name          string          `json:"name"`
+	Description   string          `json:"description,omitempty"`
+	Enabled       bool            `json:"enabled"`
+	Action        string          `json:"action"`
+	Protocol      string          `json:"protocol"`
+	Bidirectional bool            `json:"bidirectional"`
+	Sources       []string        `json:"sources"`
+	Destinations  []string        `json:"destinations"`
+	Ports         []string        `json:"ports,omitempty"`
+	PortRanges    []RulePortRange `json:"port_ranges,omitempty"`
+}
+
+// RulePortRange is an inclusive range of ports
+type RulePortRange struct {
+	Start uint16 `json:"start"`
+	End   uint16 `json:"end"`
+}
+
+// PolicySchedule limits the time when a policy is in effect
+type PolicySchedule struct {
+	Days      []string           `json:"days,omitempty"`
+	Windows   []PolicyTimeWindow `json:"windows,omitempty"`
+	Timezone  string             `json:"timezone,omitempty"`
+	ExpiresAt *time.Time         `json:"expires_at,omitempty"`
+}
+
+// PolicyTimeWindow is a time-of-day window in the HH:MM format
+type PolicyTimeWindow struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+// Route is a network route
+type Route struct {
+	ID          string   `json:"id"`
+	NetworkID   string   `json:"network_id"`
+	Description string   `json:"description,omitempty"`
+	Network     string   `json:"network,omitempty"`
+	Domains     []string `json:"domains,omitempty"`
+	KeepRoute   bool     `json:"keep_route,omitempty"`
+	Peer        string   `json:"peer,omitempty"`
+	PeerGroups  []string `json:"peer_groups,omitempty"`
+	Masquerade  bool     `json:"masquerade"`
+	Metric      int      `json:"metric"`
+	Enabled     bool     `json:"enabled"`
+	Groups      []string `json:"groups"`
+	Strategy    string   `json:"strategy,omitempty"`
+}
+
+// NameServerGroup is a group of nameservers distributed to peer groups
+type NameServerGroup struct {
+	ID                   string       `json:"id,omitempty"`
+	Name                 string       `json:"name"`
+	Description          string       `json:"description,omitempty"`
+	NameServers          []NameServer `json:"nameservers"`
+	Groups               []string     `json:"groups"`
+	Primary              bool         `json:"primary"`
+	Domains              []string     `json:"domains,omitempty"`
+	Enabled              bool         `json:"enabled"`
+	SearchDomainsEnabled bool         `json:"search_domains_enabled"`
+}
+
+// NameServer is a DNS nameserver
+type NameServer struct {
+	IP              string `json:"ip"`
+	NSType          string `json:"ns_type"`
+	Port            int    `json:"port"`
+	Hostname        string `json:"hostname,omitempty"`
+	CertFingerprint string `json:"cert_fingerprint,omitempty"`
+}
+
+// DNSSettings are the account DNS settings
+type DNSSettings struct {
+	DisabledManagementGroups []string `json:"disabled_management_groups"`
+}
+
+// Export returns the configuration of the account as a document.
+// Policies created by access requests are temporary and not exported.
+func Export(account *server.Account) *Document {
+	doc := &Document{
+		Version:          Version,
+		Groups:           []Group{},
+		PostureChecks:    []PostureCheck{},
+		Policies:         []Policy{},
+		Routes:           []Route{},
+		NameServerGroups: []NameServerGroup{},
+		DNSSettings:      toDNSSettingsDocument(account.DNSSettings),
+	}
+
+	for _, group := range account.Groups {
+		doc.Groups = append(doc.Groups, toGroupDocument(group))
+	}
+	sort.Slice(doc.Groups, func(i, j int) bool { return doc.Groups[i].ID < doc.Groups[j].ID })
+
+	for _, checks := range account.PostureChecks {
+		doc.PostureChecks = append(doc.PostureChecks, toPostureCheckDocument(checks))
+	}
+	sort.Slice(doc.PostureChecks, func(i, j int) bool { return doc.PostureChecks[i].ID < doc.PostureChecks[j].ID })
+
+	temporary := accessRequestPolicies(account)
+	for _, policy := range account.Policies {
+		if _, ok := temporary[policy.ID]; ok {
+			continue
+		}
+		doc.Policies = append(doc.Policies, toPolicyDocument(policy))
+	}
+	sort.Slice(doc.Policies, func(i, j int) bool { return doc.Policies[i].ID < doc.Policies[j].ID })
+
+	for _, r := range account.Routes {
+		doc.Routes = append(doc.Routes, toRouteDocument(r))
+	}
+	sort.Slice(doc.Routes, func(i, j int) bool { return doc.Routes[i].ID < doc.Routes[j].ID })
+
+	for _, nsGroup := range account.NameServerGroups {
+		doc.NameServerGroups = append(doc.NameServerGroups, toNameServerGroupDocument(nsGroup))
+	}
+	sort.Slice(doc.NameServerGroups, func(i, j int) bool { return doc.NameServerGroups[i].ID < doc.NameServerGroups[j].ID })
+
+	return doc
+}
+
+// Marshal encodes the document in the given format ("json" or "yaml")
+func Marshal(doc *Document, format string) ([]byte, error) {
+	data, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	switch strings.ToLower(format) {
+	case "", "json":
+		return data, nil
+	case "yaml", "yml":
+		// the document is converted through JSON to keep the same field names in both formats
+		var generic any
+		if err := json.Unmarshal(data, &generic); err != nil {
+			return nil, err
+		}
+		return yaml.Marshal(generic)
+	default:
+		return nil, fmt.Errorf("unsupported document format: %s", format)
+	}
+}
+
+// Unmarshal decodes a JSON or YAML document
+func Unmarshal(data []byte) (*Document, error) {
+	var generic any
+	if err := yaml.Unmarshal(data, &generic); err != nil {
+		return nil, fmt.Errorf("failed parsing document: %w", err)
+	}
+
+	// YAML is a superset of JSON, so both formats end up here and are decoded with the JSON field names
+	jsonData, err := json.Marshal(generic)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing document: %w", err)
+	}
+
+	doc := &Document{}
+	if err := json.Unmarshal(jsonData, doc); err != nil {
+		return nil, fmt.Errorf("failed parsing document: %w", err)
+	}
+
+	if doc.Version != Version {
+		return nil, fmt.Errorf("unsupported document version %q, expected %q", doc.Version, Version)
+	}
+
+	return doc, nil
+}
+
+func accessRequestPolicies(account *server.Account) map[string]struct{} {
+	policies := make(map[string]struct{})
+	for _, request := range account.AccessRequests {
+		if request.PolicyID != "" {
+			policies[request.PolicyID] = struct{}{}
+		}
+	}
+	return policies
+}
+
+func toGroupDocument(group *nbgroup.Group) Group {
+	return Group{
+		ID:    group.ID,
+		Name:  group.Name,
+		Peers: sortedCopy(group.Peers),
+	}
+}
+
+func toPostureCheckDocument(checks *posture.Checks) PostureCheck {
+	return PostureCheck{
+		ID:          checks.ID,
+		Name:        checks.Name,
+		Description: checks.Description,
+		Checks:      checks.Checks.Copy(),
+	}
+}
+
+func toPolicyDocument(policy *server.Policy) Policy {
+	p := Policy{
+		ID:                  policy.ID,
+		Name:                policy.Name,
+		Description:         policy.Description,
+		Enabled:             policy.Enabled,
+		SourcePostureChecks: sortedCopy(policy.SourcePostureChecks),
+		Rules:               make([]PolicyRule, 0, len(policy.Rules)),
+	}
+
+	if policy.Schedule != nil {
+		p.Schedule = &PolicySchedule{
+			Timezone:  policy.Schedule.Timezone,
+			ExpiresAt: policy.Schedule.ExpiresAt,
+		}
+		for _, day := range policy.Schedule.Days {
+			p.Schedule.Days = append(p.Schedule.Days, strings.ToLower(day.String()))
+		}
+		for _, window := range policy.Schedule.Windows {
+			p.Schedule.Windows = append(p.Schedule.Windows, PolicyTimeWindow{Start: window.Start, End: window.End})
+		}
+	}
+
+	for _, rule := range policy.Rules {
+		r := PolicyRule{
+			Name:          rule.Name,
+			Description:   rule.Description,
+			Enabled:       rule.Enabled,
+			Action:        string(rule.Action),
+			Protocol:      string(rule.Protocol),
+			Bidirectional: rule.Bidirectional,
+			Sources:       sortedCopy(rule.Sources),
+			Destinations:  sortedCopy(rule.Destinations),
+			Ports:         append([]string(nil), rule.Ports...),
+		}
+		for _, portRange := range rule.PortRanges {
+			r.PortRanges = append(r.PortRanges, RulePortRange{Start: portRange.Start, End: portRange.End})
+		}
+		p.Rules = append(p.Rules, r)
+	}
+
+	return p
+}
+
+func toRouteDocument(r *route.Route) Route {
+	doc := Route{
+		ID:          string(r.ID),
+		NetworkID:   string(r.NetID),
+		Description: r.Description,
+		KeepRoute:   r.KeepRoute,
+		Peer:        r.Peer,
+		PeerGroups:  sortedCopy(r.PeerGroups),
+		Masquerade:  r.Masquerade,
+		Metric:      r.Metric,
+		Enabled:     r.Enabled,
+		Groups:      sortedCopy(r.Groups),
+		Strategy:    string(r.Strategy),
+	}
+	if r.IsDynamic() {
+		doc.Domains = r.Domains.ToPunycodeList()
+	} else {
+		doc.Network = r.Network.String()
+	}
+	return doc
+}
+
+func toNameServerGroupDocument(nsGroup *nbdns.NameServerGroup) NameServerGroup {
+	doc := NameServerGroup{
+		ID:                   nsGroup.ID,
+		Name:                 nsGroup.Name,
+		Description:          nsGroup.Description,
+		NameServers:          make([]NameServer, 0, len(nsGroup.NameServers)),
+		Groups:               sortedCopy(nsGroup.Groups),
+		Primary:              nsGroup.Primary,
+		Domains:              append([]string(nil), nsGroup.Domains...),
+		Enabled:              nsGroup.Enabled,
+		SearchDomainsEnabled: nsGroup.SearchDomainsEnabled,
+	}
+	for _, ns := range nsGroup.NameServers {
+		doc.NameServers = append(doc.NameServers, NameServer{
+			IP:              ns.IP.String(),
+			NSType:          ns.NSType.String(),
+			Port:            ns.Port,
+			Hostname:        ns.Hostname,
+			CertFingerprint: ns.CertFingerprint,
+		})
+	}
+	return doc
+}
+
+func toDNSSettingsDocument(settings server.DNSSettings) *DNSSettings {
+	return &DNSSettings{
+		DisabledManagementGroups: sortedCopy(settings.DisabledManagementGroups),
+	}
+}
+
+// sortedCopy returns a sorted copy of a list which order has no meaning, so it doesn't produce changes when compared
+func sortedCopy(list []string) []string {
+	if len(list) == 0 {
+		return nil
+	}
+	c := append([]string(nil), list...)
+	sort.Strings(c)
+	return c
+}
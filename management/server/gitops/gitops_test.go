@@ -0,0 +1,373 @@
+package gitops
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	nbdns "github.com/netbirdio/netbird/dns"
+	"github.com/netbirdio/netbird/management/domain"
+	"github.com/netbirdio/netbird/management/server"
+	nbgroup "github.com/netbirdio/netbird/management/server/group"
+	"github.com/netbirdio/netbird/management/server/mock_server"
+	"github.com/netbirdio/netbird/management/server/posture"
+	"github.com/netbirdio/netbird/management/server/status"
+	"github.com/netbirdio/netbird/route"
+)
+
+const (
+	testAccountID = "account"
+	testAdminID   = "admin"
+)
+
+func newTestAccount() *server.Account {
+	return &server.Account{
+		Id: testAccountID,
+		Users: map[string]*server.User{
+			testAdminID: server.NewAdminUser(testAdminID),
+			"user":      server.NewRegularUser("user"),
+		},
+		Groups: map[string]*nbgroup.Group{
+			"all":     {ID: "all", Name: "All", Issued: nbgroup.GroupIssuedAPI, Peers: []string{"peer1", "peer2"}},
+			"devs":    {ID: "devs", Name: "Devs", Issued: nbgroup.GroupIssuedAPI, Peers: []string{"peer1"}},
+			"servers": {ID: "servers", Name: "Servers", Issued: nbgroup.GroupIssuedAPI, Peers: []string{"peer2"}},
+			"jwt":     {ID: "jwt", Name: "JWT", Issued: nbgroup.GroupIssuedJWT},
+		},
+		PostureChecks: []*posture.Checks{
+			{
+				ID:   "version",
+				Name: "Version",
+				Checks: posture.ChecksDefinition{
+					NBVersionCheck: &posture.NBVersionCheck{MinVersion: "0.26.0"},
+				},
+			},
+		},
+		Policies: []*server.Policy{
+			{
+				ID:                  "ssh",
+				Name:                "SSH",
+				Enabled:             true,
+				SourcePostureChecks: []string{"version"},
+				Rules: []*server.PolicyRule{
+					{
+						ID:           "ssh",
+						Name:         "SSH",
+						Enabled:      true,
+						Action:       server.PolicyTrafficActionAccept,
+						Protocol:     server.PolicyRuleProtocolTCP,
+						Sources:      []string{"devs"},
+						Destinations: []string{"servers"},
+						Ports:        []string{"22"},
+					},
+				},
+			},
+			{
+				ID:      "jit",
+				Name:    "Access request",
+				Enabled: true,
+				Rules: []*server.PolicyRule{
+					{ID: "jit", Enabled: true, Action: server.PolicyTrafficActionAccept, Protocol: server.PolicyRuleProtocolALL, Sources: []string{"devs"}, Destinations: []string{"servers"}},
+				},
+			},
+		},
+		AccessRequests: []*server.AccessRequest{
+			{ID: "request", Status: server.AccessRequestStatusApproved, PolicyID: "jit"},
+		},
+		Routes: map[route.ID]*route.Route{
+			"lan": {
+				ID:          "lan",
+				NetID:       "lan",
+				Network:     netip.MustParsePrefix("10.0.0.0/24"),
+				NetworkType: route.IPv4Network,
+				PeerGroups:  []string{"servers"},
+				Metric:      9999,
+				Enabled:     true,
+				Groups:      []string{"devs"},
+			},
+			"docs": {
+				ID:          "docs",
+				NetID:       "docs",
+				Domains:     domain.List{"docs.example.com"},
+				NetworkType: route.DomainNetwork,
+				Peer:        "peer2",
+				Metric:      9999,
+				Enabled:     true,
+				Groups:      []string{"devs"},
+			},
+		},
+		NameServerGroups: map[string]*nbdns.NameServerGroup{
+			"google": {
+				ID:          "google",
+				Name:        "Google",
+				NameServers: []nbdns.NameServer{{IP: netip.MustParseAddr("8.8.8.8"), NSType: nbdns.UDPNameServerType, Port: 53}},
+				Groups:      []string{"all"},
+				Primary:     true,
+				Enabled:     true,
+			},
+		},
+		DNSSettings: server.DNSSettings{DisabledManagementGroups: []string{"servers"}},
+	}
+}
+
+// recordingManager returns an account manager mock running the updates on a transaction mock that records the
+// changes, the changes are recorded only when the update succeeds
+func recordingManager(account *server.Account, calls *[]string) (*mock_server.MockAccountManager, *mock_server.MockAccountTransaction) {
+	var staged []string
+	record := func(call string) error {
+		staged = append(staged, call)
+		return nil
+	}
+	tx := &mock_server.MockAccountTransaction{
+		AccountFunc: func() *server.Account {
+			return account
+		},
+		SaveGroupFunc: func(group *nbgroup.Group) error {
+			return record("save group " + group.Name)
+		},
+		DeleteGroupFunc: func(groupID string) error {
+			return record("delete group " + groupID)
+		},
+		SavePostureChecksFunc: func(checks *posture.Checks) error {
+			return record("save posture checks " + checks.Name)
+		},
+		DeletePostureChecksFunc: func(checksID string) error {
+			return record("delete posture checks " + checksID)
+		},
+		SavePolicyFunc: func(policy *server.Policy) error {
+			return record("save policy " + policy.Name)
+		},
+		DeletePolicyFunc: func(policyID string) error {
+			return record("delete policy " + policyID)
+		},
+		SaveRouteFunc: func(r *route.Route) error {
+			return record("save route " + string(r.ID))
+		},
+		DeleteRouteFunc: func(routeID route.ID) error {
+			return record("delete route " + string(routeID))
+		},
+		CreateNameServerGroupFunc: func(nsGroup *nbdns.NameServerGroup) (*nbdns.NameServerGroup, error) {
+			return nil, record("create nameserver group " + nsGroup.Name)
+		},
+		SaveNameServerGroupFunc: func(nsGroup *nbdns.NameServerGroup) error {
+			return record("save nameserver group " + nsGroup.Name)
+		},
+		DeleteNameServerGroupFunc: func(nsGroupID string) error {
+			return record("delete nameserver group " + nsGroupID)
+		},
+		SaveDNSSettingsFunc: func(_ *server.DNSSettings) error {
+			return record("save dns settings")
+		},
+	}
+	am := &mock_server.MockAccountManager{
+		UpdateAccountFunc: func(_ context.Context, _, _ string, update func(tx server.AccountTransaction) error) error {
+			staged = nil
+			if err := update(tx); err != nil {
+				return err
+			}
+			*calls = append(*calls, staged...)
+			return nil
+		},
+	}
+	return am, tx
+}
+
+func TestExport(t *testing.T) {
+	doc := Export(newTestAccount())
+
+	assert.Equal(t, Version, doc.Version)
+	assert.Len(t, doc.Groups, 4)
+	require.Len(t, doc.Policies, 1, "policies of access requests should not be exported")
+	assert.Equal(t, "ssh", doc.Policies[0].ID)
+	require.Len(t, doc.Routes, 2)
+	assert.Equal(t, []string{"docs.example.com"}, doc.Routes[0].Domains)
+	assert.Empty(t, doc.Routes[0].Network)
+	assert.Equal(t, "10.0.0.0/24", doc.Routes[1].Network)
+	require.Len(t, doc.NameServerGroups, 1)
+	assert.Equal(t, "udp", doc.NameServerGroups[0].NameServers[0].NSType)
+	assert.Equal(t, []string{"servers"}, doc.DNSSettings.DisabledManagementGroups)
+
+	for _, format := range []string{"json", "yaml"} {
+		data, err := Marshal(doc, format)
+		require.NoError(t, err)
+
+		decoded, err := Unmarshal(data)
+		require.NoError(t, err, format)
+		assert.Equal(t, doc, decoded, format)
+	}
+
+	_, err := Unmarshal([]byte("version: v0\n"))
+	assert.Error(t, err, "unknown versions should be rejected")
+}
+
+func TestApply_ExportedDocumentIsNoop(t *testing.T) {
+	account := newTestAccount()
+	var calls []string
+	am, _ := recordingManager(account, &calls)
+
+	plan, err := Apply(context.Background(), am, account, testAdminID, Export(account), false)
+	require.NoError(t, err)
+	assert.Empty(t, plan.Changes)
+	assert.Empty(t, calls)
+}
+
+func TestApply(t *testing.T) {
+	yamlDoc := `
+version: v1
+groups:
+  - id: devs
+    name: Devs
+    peers: [peer1, peer3]
+  - name: Databases
+    peers: [peer4]
+posture_checks: []
+policies:
+  - name: SSH
+    enabled: true
+    rules:
+      - name: SSH
+        enabled: true
+        action: accept
+        protocol: tcp
+        bidirectional: false
+        sources: [Devs]
+        destinations: [Databases]
+        ports: ["22"]
+    schedule:
+      days: [monday, friday]
+      windows:
+        - start: "09:00"
+          end: "17:00"
+routes:
+  - id: lan
+    network_id: lan
+    network: 10.0.0.0/24
+    peer_groups: [servers]
+    masquerade: false
+    metric: 9999
+    enabled: true
+    groups: [devs]
+nameserver_groups:
+  - name: Quad9
+    nameservers:
+      - ip: 9.9.9.9
+        ns_type: udp
+        port: 53
+    groups: [Devs]
+    primary: true
+    enabled: true
+    search_domains_enabled: false
+`
+	doc, err := Unmarshal([]byte(yamlDoc))
+	require.NoError(t, err)
+
+	t.Run("dry run only plans changes", func(t *testing.T) {
+		var calls []string
+		account := newTestAccount()
+		am, _ := recordingManager(account, &calls)
+
+		plan, err := Apply(context.Background(), am, account, testAdminID, doc, true)
+		require.NoError(t, err)
+		assert.True(t, plan.DryRun)
+		assert.Empty(t, calls)
+
+		expected := []Change{
+			{Action: ChangeUpdate, Kind: KindGroup, ID: "devs", Name: "Devs"},
+			{Action: ChangeCreate, Kind: KindGroup, ID: plan.Changes[1].ID, Name: "Databases"},
+			{Action: ChangeUpdate, Kind: KindPolicy, ID: "ssh", Name: "SSH"},
+			{Action: ChangeCreate, Kind: KindNameServerGroup, Name: "Quad9"},
+			{Action: ChangeDelete, Kind: KindNameServerGroup, ID: "google", Name: "Google"},
+			{Action: ChangeDelete, Kind: KindRoute, ID: "docs", Name: "docs"},
+			{Action: ChangeDelete, Kind: KindPostureCheck, ID: "version", Name: "Version"},
+			{Action: ChangeDelete, Kind: KindGroup, ID: "servers", Name: "Servers"},
+		}
+		assert.Equal(t, expected, plan.Changes)
+	})
+
+	t.Run("apply performs changes in order", func(t *testing.T) {
+		var calls []string
+		account := newTestAccount()
+		am, _ := recordingManager(account, &calls)
+
+		plan, err := Apply(context.Background(), am, account, testAdminID, doc, false)
+		require.NoError(t, err)
+		assert.False(t, plan.DryRun)
+
+		expected := []string{
+			"save group Devs",
+			"save group Databases",
+			"save policy SSH",
+			"create nameserver group Quad9",
+			"delete nameserver group google",
+			"delete route docs",
+			"delete posture checks version",
+			"delete group servers",
+		}
+		assert.Equal(t, expected, calls)
+	})
+
+	t.Run("references are resolved by name", func(t *testing.T) {
+		var saved *server.Policy
+		account := newTestAccount()
+		am, tx := recordingManager(account, new([]string))
+		tx.SavePolicyFunc = func(policy *server.Policy) error {
+			saved = policy
+			return nil
+		}
+
+		plan, err := Apply(context.Background(), am, account, testAdminID, doc, false)
+		require.NoError(t, err)
+		require.NotNil(t, saved)
+
+		assert.Equal(t, []string{"devs"}, saved.Rules[0].Sources)
+		assert.Equal(t, []string{plan.Changes[1].ID}, saved.Rules[0].Destinations)
+		assert.Equal(t, "ssh", saved.Rules[0].ID, "existing rule IDs should be kept")
+		require.NotNil(t, saved.Schedule)
+		assert.Equal(t, []time.Weekday{time.Monday, time.Friday}, saved.Schedule.Days)
+	})
+
+	t.Run("regular users can not apply", func(t *testing.T) {
+		account := newTestAccount()
+		am, _ := recordingManager(account, new([]string))
+
+		_, err := Apply(context.Background(), am, account, "user", doc, true)
+		assert.Error(t, err)
+	})
+
+	t.Run("a failing change discards the previous ones", func(t *testing.T) {
+		var calls []string
+		account := newTestAccount()
+		am, tx := recordingManager(account, &calls)
+		tx.SavePolicyFunc = func(policy *server.Policy) error {
+			return status.Errorf(status.InvalidArgument, "invalid policy %s", policy.Name)
+		}
+
+		plan, err := Apply(context.Background(), am, account, testAdminID, doc, false)
+		require.Error(t, err, "the third change fails")
+		assert.Nil(t, plan)
+		s, ok := status.FromError(err)
+		require.True(t, ok, "the error of the change should be kept")
+		assert.Equal(t, status.InvalidArgument, s.Type())
+		assert.Empty(t, calls, "the changes before the failing one should not be saved")
+	})
+}
+
+func TestApply_PartialDocument(t *testing.T) {
+	doc := &Document{
+		Version:     Version,
+		DNSSettings: &DNSSettings{DisabledManagementGroups: []string{}},
+	}
+
+	var calls []string
+	account := newTestAccount()
+	am, _ := recordingManager(account, &calls)
+
+	plan, err := Apply(context.Background(), am, account, testAdminID, doc, false)
+	require.NoError(t, err)
+
+	assert.Equal(t, []Change{{Action: ChangeUpdate, Kind: KindDNSSettings}}, plan.Changes)
+	assert.Equal(t, []string{"save dns settings"}, calls, "sections missing from the document should not be changed")
+}
@@ -131,38 +131,10 @@ func (am *DefaultAccountManager) SaveGroups(ctx context.Context, accountID, user
 	var eventsToStore []func()
 
 	for _, newGroup := range newGroups {
-		if newGroup.ID == "" && newGroup.Issued != nbgroup.GroupIssuedAPI {
-			return status.Errorf(status.InvalidArgument, "%s group without ID set", newGroup.Issued)
+		events, err := am.saveGroup(ctx, account, userID, newGroup)
+		if err != nil {
+			return err
 		}
-
-		if newGroup.ID == "" && newGroup.Issued == nbgroup.GroupIssuedAPI {
-			existingGroup, err := account.FindGroupByName(newGroup.Name)
-			if err != nil {
-				s, ok := status.FromError(err)
-				if !ok || s.ErrorType != status.NotFound {
-					return err
-				}
-			}
-
-			// Avoid duplicate groups only for the API issued groups.
-			// Integration or JWT groups can be duplicated as they are coming from the IdP that we don't have control of.
-			if existingGroup != nil {
-				return status.Errorf(status.AlreadyExists, "group with name %s already exists", newGroup.Name)
-			}
-
-			newGroup.ID = xid.New().String()
-		}
-
-		for _, peerID := range newGroup.Peers {
-			if account.Peers[peerID] == nil {
-				return status.Errorf(status.InvalidArgument, "peer with ID \"%s\" not found", peerID)
-			}
-		}
-
-		oldGroup := account.Groups[newGroup.ID]
-		account.Groups[newGroup.ID] = newGroup
-
-		events := am.prepareGroupEvents(ctx, userID, accountID, newGroup, oldGroup, account)
 		eventsToStore = append(eventsToStore, events...)
 	}
 
@@ -180,6 +152,42 @@ func (am *DefaultAccountManager) SaveGroups(ctx context.Context, accountID, user
 	return nil
 }
 
+// saveGroup validates the group and puts it in the account, it returns the events to store once the account is saved
+func (am *DefaultAccountManager) saveGroup(ctx context.Context, account *Account, userID string, newGroup *nbgroup.Group) ([]func(), error) {
+	if newGroup.ID == "" && newGroup.Issued != nbgroup.GroupIssuedAPI {
+		return nil, status.Errorf(status.InvalidArgument, "%s group without ID set", newGroup.Issued)
+	}
+
+	if newGroup.ID == "" && newGroup.Issued == nbgroup.GroupIssuedAPI {
+		existingGroup, err := account.FindGroupByName(newGroup.Name)
+		if err != nil {
+			s, ok := status.FromError(err)
+			if !ok || s.ErrorType != status.NotFound {
+				return nil, err
+			}
+		}
+
+		// Avoid duplicate groups only for the API issued groups.
+		// Integration or JWT groups can be duplicated as they are coming from the IdP that we don't have control of.
+		if existingGroup != nil {
+			return nil, status.Errorf(status.AlreadyExists, "group with name %s already exists", newGroup.Name)
+		}
+
+		newGroup.ID = xid.New().String()
+	}
+
+	for _, peerID := range newGroup.Peers {
+		if account.Peers[peerID] == nil {
+			return nil, status.Errorf(status.InvalidArgument, "peer with ID \"%s\" not found", peerID)
+		}
+	}
+
+	oldGroup := account.Groups[newGroup.ID]
+	account.Groups[newGroup.ID] = newGroup
+
+	return am.prepareGroupEvents(ctx, userID, account.Id, newGroup, oldGroup, account), nil
+}
+
 // prepareGroupEvents prepares a list of event functions to be stored.
 func (am *DefaultAccountManager) prepareGroupEvents(ctx context.Context, userID string, accountID string, newGroup, oldGroup *nbgroup.Group, account *Account) []func() {
 	var eventsToStore []func()
@@ -427,6 +435,10 @@ func validateDeleteGroup(account *Account, group *nbgroup.Group, userID string)
 		return &GroupLinkError{"name server groups", linkedDns.Name}
 	}
 
+	if isLinked, linkedRecord := isGroupLinkedToDNSRecord(account.DNSRecords, group.ID); isLinked {
+		return &GroupLinkError{"DNS record", linkedRecord.Name}
+	}
+
 	if isLinked, linkedPolicy := isGroupLinkedToPolicy(account.Policies, group.ID); isLinked {
 		return &GroupLinkError{"policy", linkedPolicy.Name}
 	}
@@ -486,6 +498,16 @@ func isGroupLinkedToDns(nameServerGroups map[string]*nbdns.NameServerGroup, grou
 	return false, nil
 }
 
+// isGroupLinkedToDNSRecord checks if a group is linked to any custom DNS record in the account.
+func isGroupLinkedToDNSRecord(records []*DNSRecord, groupID string) (bool, *DNSRecord) {
+	for _, record := range records {
+		if slices.Contains(record.Groups, groupID) {
+			return true, record
+		}
+	}
+	return false, nil
+}
+
 // isGroupLinkedToSetupKey checks if a group is linked to any setup key in the account.
 func isGroupLinkedToSetupKey(setupKeys map[string]*SetupKey, groupID string) (bool, *SetupKey) {
 	for _, setupKey := range setupKeys {
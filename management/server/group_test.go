@@ -83,6 +83,11 @@ func TestDefaultAccountManager_DeleteGroup(t *testing.T) {
 			"grp-for-name-server-grp",
 			"name server groups",
 		},
+		{
+			"DNS records",
+			"grp-for-dns-record",
+			"DNS record",
+		},
 		{
 			"policy",
 			"grp-for-policies",
@@ -291,6 +296,14 @@ func initTestGroupAccount(am *DefaultAccountManager) (*DefaultAccountManager, *A
 		Peers:     make([]string, 0),
 	}
 
+	groupForDNSRecords := &nbgroup.Group{
+		ID:        "grp-for-dns-record",
+		AccountID: "account-id",
+		Name:      "Group for DNS records",
+		Issued:    nbgroup.GroupIssuedAPI,
+		Peers:     make([]string, 0),
+	}
+
 	groupForPolicies := &nbgroup.Group{
 		ID:        "grp-for-policies",
 		AccountID: "account-id",
@@ -338,6 +351,12 @@ func initTestGroupAccount(am *DefaultAccountManager) (*DefaultAccountManager, *A
 		Groups: []string{groupForNameServerGroups.ID},
 	}
 
+	dnsRecord := &DNSRecord{
+		ID:     "example DNS record",
+		Name:   "db",
+		Groups: []string{groupForDNSRecords.ID},
+	}
+
 	policy := &Policy{
 		ID: "example policy",
 		Rules: []*PolicyRule{
@@ -361,6 +380,7 @@ func initTestGroupAccount(am *DefaultAccountManager) (*DefaultAccountManager, *A
 	account.Routes[routeResource.ID] = routeResource
 	account.Routes[routePeerGroupResource.ID] = routePeerGroupResource
 	account.NameServerGroups[nameServerGroup.ID] = nameServerGroup
+	account.DNSRecords = append(account.DNSRecords, dnsRecord)
 	account.Policies = append(account.Policies, policy)
 	account.SetupKeys[setupKey.Id] = setupKey
 	account.Users[user.Id] = user
@@ -373,6 +393,7 @@ func initTestGroupAccount(am *DefaultAccountManager) (*DefaultAccountManager, *A
 	_ = am.SaveGroup(context.Background(), accountID, groupAdminUserID, groupForRoute)
 	_ = am.SaveGroup(context.Background(), accountID, groupAdminUserID, groupForRoute2)
 	_ = am.SaveGroup(context.Background(), accountID, groupAdminUserID, groupForNameServerGroups)
+	_ = am.SaveGroup(context.Background(), accountID, groupAdminUserID, groupForDNSRecords)
 	_ = am.SaveGroup(context.Background(), accountID, groupAdminUserID, groupForPolicies)
 	_ = am.SaveGroup(context.Background(), accountID, groupAdminUserID, groupForSetupKeys)
 	_ = am.SaveGroup(context.Background(), accountID, groupAdminUserID, groupForUsers)
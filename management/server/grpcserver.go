@@ -15,6 +15,7 @@ import (
 	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/netbirdio/netbird/encryption"
 	"github.com/netbirdio/netbird/management/proto"
@@ -184,7 +185,7 @@ func (s *GRPCServer) Sync(req *proto.EncryptedMessage, srv proto.ManagementServi
 
 	s.ephemeralManager.OnPeerConnected(ctx, peer)
 
-	s.secretsManager.SetupRefresh(ctx, peer.ID)
+	s.secretsManager.SetupRefresh(ctx, accountID, peer.ID)
 
 	if s.appMetrics != nil {
 		s.appMetrics.GRPCMetrics().CountSyncRequestDuration(time.Since(reqStart))
@@ -421,6 +422,7 @@ func (s *GRPCServer) Login(ctx context.Context, req *proto.EncryptedMessage) (*p
 		UserID:          userID,
 		SetupKey:        loginReq.GetSetupKey(),
 		ConnectionIP:    realIP,
+		RenewLogin:      loginReq.GetRenewLogin(),
 	})
 	if err != nil {
 		log.WithContext(ctx).Warnf("failed logging in peer %s: %s", peerKey, err)
@@ -434,7 +436,7 @@ func (s *GRPCServer) Login(ctx context.Context, req *proto.EncryptedMessage) (*p
 
 	var relayToken *Token
 	if s.config.Relay != nil && len(s.config.Relay.Addresses) > 0 {
-		relayToken, err = s.secretsManager.GenerateRelayToken()
+		relayToken, err = s.secretsManager.GenerateRelayToken(peer.AccountID)
 		if err != nil {
 			log.Errorf("failed generating Relay token: %v", err)
 		}
@@ -446,6 +448,7 @@ func (s *GRPCServer) Login(ctx context.Context, req *proto.EncryptedMessage) (*p
 		PeerConfig:        toPeerConfig(peer, netMap.Network, s.accountManager.GetDNSDomain()),
 		Checks:            toProtocolChecks(ctx, postureChecks),
 	}
+	loginResp.PeerConfig.LoginExpiresAt = toLoginExpiresAt(netMap.LoginExpiresAt)
 	encryptedResp, err := encryption.EncryptMessage(peerKey, s.wgKey, loginResp)
 	if err != nil {
 		log.WithContext(ctx).Warnf("failed encrypting peer %s message", peer.ID)
@@ -562,11 +565,25 @@ func toWiretrusteeConfig(config *Config, turnCredentials *Token, relayToken *Tok
 func toPeerConfig(peer *nbpeer.Peer, network *Network, dnsName string) *proto.PeerConfig {
 	netmask, _ := network.Net.Mask.Size()
 	fqdn := peer.FQDN(dnsName)
-	return &proto.PeerConfig{
+	peerConfig := &proto.PeerConfig{
 		Address:   fmt.Sprintf("%s/%d", peer.IP.String(), netmask), // take it from the network
 		SshConfig: &proto.SSHConfig{SshEnabled: peer.SSHEnabled},
 		Fqdn:      fqdn,
 	}
+
+	if network.HasIPv6() && peer.IPv6 != nil {
+		netmaskV6, _ := network.NetV6.Mask.Size()
+		peerConfig.AddressV6 = fmt.Sprintf("%s/%d", peer.IPv6.String(), netmaskV6)
+	}
+
+	return peerConfig
+}
+
+func toLoginExpiresAt(expiresAt time.Time) *timestamppb.Timestamp {
+	if expiresAt.IsZero() {
+		return nil
+	}
+	return timestamppb.New(expiresAt)
 }
 
 func toSyncResponse(ctx context.Context, config *Config, peer *nbpeer.Peer, turnCredentials *Token, relayCredentials *Token, networkMap *NetworkMap, dnsName string, checks []*posture.Checks, dnsCache *DNSConfigCache) *proto.SyncResponse {
@@ -581,16 +598,17 @@ func toSyncResponse(ctx context.Context, config *Config, peer *nbpeer.Peer, turn
 		Checks: toProtocolChecks(ctx, checks),
 	}
 
+	response.PeerConfig.LoginExpiresAt = toLoginExpiresAt(networkMap.LoginExpiresAt)
 	response.NetworkMap.PeerConfig = response.PeerConfig
 
 	allPeers := make([]*proto.RemotePeerConfig, 0, len(networkMap.Peers)+len(networkMap.OfflinePeers))
-	allPeers = appendRemotePeerConfig(allPeers, networkMap.Peers, dnsName)
+	allPeers = appendRemotePeerConfig(allPeers, networkMap.Peers, dnsName, networkMap.Network.HasIPv6())
 	response.RemotePeers = allPeers
 	response.NetworkMap.RemotePeers = allPeers
 	response.RemotePeersIsEmpty = len(allPeers) == 0
 	response.NetworkMap.RemotePeersIsEmpty = response.RemotePeersIsEmpty
 
-	response.NetworkMap.OfflinePeers = appendRemotePeerConfig(nil, networkMap.OfflinePeers, dnsName)
+	response.NetworkMap.OfflinePeers = appendRemotePeerConfig(nil, networkMap.OfflinePeers, dnsName, networkMap.Network.HasIPv6())
 
 	firewallRules := toProtocolFirewallRules(networkMap.FirewallRules)
 	response.NetworkMap.FirewallRules = firewallRules
@@ -599,11 +617,16 @@ func toSyncResponse(ctx context.Context, config *Config, peer *nbpeer.Peer, turn
 	return response
 }
 
-func appendRemotePeerConfig(dst []*proto.RemotePeerConfig, peers []*nbpeer.Peer, dnsName string) []*proto.RemotePeerConfig {
+func appendRemotePeerConfig(dst []*proto.RemotePeerConfig, peers []*nbpeer.Peer, dnsName string, ipv6Enabled bool) []*proto.RemotePeerConfig {
 	for _, rPeer := range peers {
+		allowedIps := []string{fmt.Sprintf(AllowedIPsFormat, rPeer.IP.String())}
+		if ipv6Enabled && rPeer.IPv6 != nil {
+			allowedIps = append(allowedIps, fmt.Sprintf(AllowedIPsV6Format, rPeer.IPv6.String()))
+		}
+
 		dst = append(dst, &proto.RemotePeerConfig{
 			WgPubKey:   rPeer.Key,
-			AllowedIps: []string{rPeer.IP.String() + "/32"},
+			AllowedIps: allowedIps,
 			SshConfig:  &proto.SSHConfig{SshPubKey: []byte(rPeer.SSHKey)},
 			Fqdn:       rPeer.FQDN(dnsName),
 		})
@@ -630,7 +653,7 @@ func (s *GRPCServer) sendInitialSync(ctx context.Context, peerKey wgtypes.Key, p
 
 	var relayToken *Token
 	if s.config.Relay != nil && len(s.config.Relay.Addresses) > 0 {
-		relayToken, err = s.secretsManager.GenerateRelayToken()
+		relayToken, err = s.secretsManager.GenerateRelayToken(peer.AccountID)
 		if err != nil {
 			log.Errorf("failed generating Relay token: %v", err)
 		}
@@ -0,0 +1,173 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/netbirdio/netbird/management/server"
+	"github.com/netbirdio/netbird/management/server/http/api"
+	"github.com/netbirdio/netbird/management/server/http/util"
+	"github.com/netbirdio/netbird/management/server/jwtclaims"
+	"github.com/netbirdio/netbird/management/server/status"
+)
+
+// AccessRequestsHandler is a handler that manages access requests of the account
+type AccessRequestsHandler struct {
+	accountManager  server.AccountManager
+	claimsExtractor *jwtclaims.ClaimsExtractor
+}
+
+// NewAccessRequestsHandler creates a new AccessRequests handler
+func NewAccessRequestsHandler(accountManager server.AccountManager, authCfg AuthCfg) *AccessRequestsHandler {
+	return &AccessRequestsHandler{
+		accountManager: accountManager,
+		claimsExtractor: jwtclaims.NewClaimsExtractor(
+			jwtclaims.WithAudience(authCfg.Audience),
+			jwtclaims.WithUserIDClaim(authCfg.UserIDClaim),
+		),
+	}
+}
+
+// GetAllAccessRequests list for the account
+func (h *AccessRequestsHandler) GetAllAccessRequests(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	account, user, err := h.accountManager.GetAccountFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	requests, err := h.accountManager.ListAccessRequests(r.Context(), account.Id, user.Id)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	resp := make([]*api.AccessRequest, 0, len(requests))
+	for _, request := range requests {
+		resp = append(resp, toAccessRequestResponse(request))
+	}
+
+	util.WriteJSONObject(r.Context(), w, resp)
+}
+
+// CreateAccessRequest handles access request creation
+func (h *AccessRequestsHandler) CreateAccessRequest(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	account, user, err := h.accountManager.GetAccountFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	var req api.PostApiAccessRequestsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteErrorResponse("couldn't parse JSON request", http.StatusBadRequest, w)
+		return
+	}
+
+	if req.Duration < 1 || req.Duration > int(server.MaxAccessRequestDuration.Seconds()) {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "access request duration should be between 1 and %d seconds", int(server.MaxAccessRequestDuration.Seconds())), w)
+		return
+	}
+
+	request := &server.AccessRequest{
+		Sources:      req.Sources,
+		Destinations: req.Destinations,
+		Duration:     time.Duration(req.Duration) * time.Second,
+	}
+	if req.Reason != nil {
+		request.Reason = *req.Reason
+	}
+	if req.Protocol != nil {
+		request.Protocol = server.PolicyRuleProtocolType(*req.Protocol)
+	}
+	if req.Ports != nil {
+		request.Ports = *req.Ports
+	}
+
+	request, err = h.accountManager.CreateAccessRequest(r.Context(), account.Id, user.Id, request)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, toAccessRequestResponse(request))
+}
+
+// GetAccessRequest handles an access request Get request identified by ID
+func (h *AccessRequestsHandler) GetAccessRequest(w http.ResponseWriter, r *http.Request) {
+	h.handleAccessRequest(w, r, h.accountManager.GetAccessRequest)
+}
+
+// ApproveAccessRequest handles an access request approval identified by ID
+func (h *AccessRequestsHandler) ApproveAccessRequest(w http.ResponseWriter, r *http.Request) {
+	h.handleAccessRequest(w, r, h.accountManager.ApproveAccessRequest)
+}
+
+// DenyAccessRequest handles an access request denial identified by ID
+func (h *AccessRequestsHandler) DenyAccessRequest(w http.ResponseWriter, r *http.Request) {
+	h.handleAccessRequest(w, r, h.accountManager.DenyAccessRequest)
+}
+
+type accessRequestFunc func(ctx context.Context, accountID, requestID, userID string) (*server.AccessRequest, error)
+
+func (h *AccessRequestsHandler) handleAccessRequest(w http.ResponseWriter, r *http.Request, fn accessRequestFunc) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	account, user, err := h.accountManager.GetAccountFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	vars := mux.Vars(r)
+	requestID := vars["requestId"]
+	if len(requestID) == 0 {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid access request ID"), w)
+		return
+	}
+
+	request, err := fn(r.Context(), account.Id, requestID, user.Id)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, toAccessRequestResponse(request))
+}
+
+func toAccessRequestResponse(request *server.AccessRequest) *api.AccessRequest {
+	resp := &api.AccessRequest{
+		Id:           request.ID,
+		UserId:       request.UserID,
+		Sources:      request.Sources,
+		Destinations: request.Destinations,
+		Protocol:     api.AccessRequestProtocol(request.Protocol),
+		Duration:     int(request.Duration.Seconds()),
+		Status:       api.AccessRequestStatus(request.Status),
+		CreatedAt:    request.CreatedAt,
+		ReviewedAt:   request.ReviewedAt,
+		ExpiresAt:    request.ExpiresAt,
+	}
+	if request.Reason != "" {
+		reason := request.Reason
+		resp.Reason = &reason
+	}
+	if len(request.Ports) != 0 {
+		ports := request.Ports
+		resp.Ports = &ports
+	}
+	if request.ReviewedBy != "" {
+		reviewedBy := request.ReviewedBy
+		resp.ReviewedBy = &reviewedBy
+	}
+	if request.PolicyID != "" {
+		policyID := request.PolicyID
+		resp.PolicyId = &policyID
+	}
+	return resp
+}
@@ -0,0 +1,251 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/netbirdio/netbird/management/server"
+	"github.com/netbirdio/netbird/management/server/http/api"
+	"github.com/netbirdio/netbird/management/server/jwtclaims"
+	"github.com/netbirdio/netbird/management/server/mock_server"
+	"github.com/netbirdio/netbird/management/server/status"
+)
+
+func initAccessRequestsTestData(requests ...*server.AccessRequest) *AccessRequestsHandler {
+	testRequests := make(map[string]*server.AccessRequest, len(requests))
+	for _, request := range requests {
+		testRequests[request.ID] = request
+	}
+
+	return &AccessRequestsHandler{
+		accountManager: &mock_server.MockAccountManager{
+			CreateAccessRequestFunc: func(_ context.Context, accountID, userID string, request *server.AccessRequest) (*server.AccessRequest, error) {
+				if len(request.Sources) == 0 || len(request.Destinations) == 0 {
+					return nil, status.Errorf(status.InvalidArgument, "access request sources and destinations shouldn't be empty")
+				}
+				request.ID = "newRequest"
+				request.UserID = userID
+				request.Status = server.AccessRequestStatusPending
+				testRequests[request.ID] = request
+				return request, nil
+			},
+			GetAccessRequestFunc: func(_ context.Context, accountID, requestID, userID string) (*server.AccessRequest, error) {
+				request, ok := testRequests[requestID]
+				if !ok {
+					return nil, status.Errorf(status.NotFound, "access request not found")
+				}
+				return request, nil
+			},
+			ListAccessRequestsFunc: func(_ context.Context, accountID, userID string) ([]*server.AccessRequest, error) {
+				list := make([]*server.AccessRequest, 0, len(testRequests))
+				for _, request := range testRequests {
+					list = append(list, request)
+				}
+				return list, nil
+			},
+			ApproveAccessRequestFunc: func(_ context.Context, accountID, requestID, userID string) (*server.AccessRequest, error) {
+				request, ok := testRequests[requestID]
+				if !ok {
+					return nil, status.Errorf(status.NotFound, "access request not found")
+				}
+				expiresAt := time.Now().Add(request.Duration)
+				request.Status = server.AccessRequestStatusApproved
+				request.ReviewedBy = userID
+				request.ExpiresAt = &expiresAt
+				request.PolicyID = "policy"
+				return request, nil
+			},
+			DenyAccessRequestFunc: func(_ context.Context, accountID, requestID, userID string) (*server.AccessRequest, error) {
+				request, ok := testRequests[requestID]
+				if !ok {
+					return nil, status.Errorf(status.NotFound, "access request not found")
+				}
+				request.Status = server.AccessRequestStatusDenied
+				request.ReviewedBy = userID
+				return request, nil
+			},
+			GetAccountFromTokenFunc: func(_ context.Context, claims jwtclaims.AuthorizationClaims) (*server.Account, *server.User, error) {
+				user := server.NewAdminUser("test_user")
+				return &server.Account{
+					Id: claims.AccountId,
+					Users: map[string]*server.User{
+						"test_user": user,
+					},
+				}, user, nil
+			},
+		},
+		claimsExtractor: jwtclaims.NewClaimsExtractor(
+			jwtclaims.WithFromRequestContext(func(r *http.Request) jwtclaims.AuthorizationClaims {
+				return jwtclaims.AuthorizationClaims{
+					UserId:    "test_user",
+					Domain:    "hotmail.com",
+					AccountId: "test_id",
+				}
+			}),
+		),
+	}
+}
+
+func TestAccessRequestsHandlers(t *testing.T) {
+	str := func(s string) *string { return &s }
+	pending := &server.AccessRequest{
+		ID:           "pendingRequest",
+		UserID:       "regular_user",
+		Sources:      []string{"laptops"},
+		Destinations: []string{"db-prod"},
+		Protocol:     server.PolicyRuleProtocolTCP,
+		Ports:        []string{"22"},
+		Duration:     2 * time.Hour,
+		Status:       server.AccessRequestStatusPending,
+	}
+
+	tt := []struct {
+		name           string
+		requestType    string
+		requestPath    string
+		requestBody    io.Reader
+		expectedStatus int
+		expectedBody   bool
+		expectedReq    *api.AccessRequest
+	}{
+		{
+			name:           "Get access request",
+			requestType:    http.MethodGet,
+			requestPath:    "/api/access-requests/pendingRequest",
+			expectedStatus: http.StatusOK,
+			expectedBody:   true,
+			expectedReq: &api.AccessRequest{
+				Id:           "pendingRequest",
+				UserId:       "regular_user",
+				Sources:      []string{"laptops"},
+				Destinations: []string{"db-prod"},
+				Protocol:     api.AccessRequestProtocolTcp,
+				Ports:        &[]string{"22"},
+				Duration:     7200,
+				Status:       api.AccessRequestStatusPending,
+			},
+		},
+		{
+			name:           "Get unknown access request",
+			requestType:    http.MethodGet,
+			requestPath:    "/api/access-requests/unknown",
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:        "Create access request",
+			requestType: http.MethodPost,
+			requestPath: "/api/access-requests",
+			requestBody: bytes.NewBufferString(
+				`{"sources": ["laptops"], "destinations": ["db-prod"], "duration": 3600, "reason": "debug"}`),
+			expectedStatus: http.StatusOK,
+			expectedBody:   true,
+			expectedReq: &api.AccessRequest{
+				Id:           "newRequest",
+				UserId:       "test_user",
+				Reason:       str("debug"),
+				Sources:      []string{"laptops"},
+				Destinations: []string{"db-prod"},
+				Duration:     3600,
+				Status:       api.AccessRequestStatusPending,
+			},
+		},
+		{
+			name:        "Create access request with invalid duration",
+			requestType: http.MethodPost,
+			requestPath: "/api/access-requests",
+			requestBody: bytes.NewBufferString(
+				`{"sources": ["laptops"], "destinations": ["db-prod"], "duration": 0}`),
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:        "Create access request without destinations",
+			requestType: http.MethodPost,
+			requestPath: "/api/access-requests",
+			requestBody: bytes.NewBufferString(
+				`{"sources": ["laptops"], "destinations": [], "duration": 3600}`),
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:           "Deny unknown access request",
+			requestType:    http.MethodPost,
+			requestPath:    "/api/access-requests/unknown/deny",
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "Approve access request",
+			requestType:    http.MethodPost,
+			requestPath:    "/api/access-requests/pendingRequest/approve",
+			expectedStatus: http.StatusOK,
+			expectedBody:   true,
+			expectedReq: &api.AccessRequest{
+				Id:           "pendingRequest",
+				UserId:       "regular_user",
+				Sources:      []string{"laptops"},
+				Destinations: []string{"db-prod"},
+				Protocol:     api.AccessRequestProtocolTcp,
+				Ports:        &[]string{"22"},
+				Duration:     7200,
+				Status:       api.AccessRequestStatusApproved,
+				ReviewedBy:   str("test_user"),
+				PolicyId:     str("policy"),
+			},
+		},
+	}
+
+	p := initAccessRequestsTestData(pending)
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			req := httptest.NewRequest(tc.requestType, tc.requestPath, tc.requestBody)
+
+			router := mux.NewRouter()
+			router.HandleFunc("/api/access-requests", p.CreateAccessRequest).Methods("POST")
+			router.HandleFunc("/api/access-requests/{requestId}", p.GetAccessRequest).Methods("GET")
+			router.HandleFunc("/api/access-requests/{requestId}/approve", p.ApproveAccessRequest).Methods("POST")
+			router.HandleFunc("/api/access-requests/{requestId}/deny", p.DenyAccessRequest).Methods("POST")
+			router.ServeHTTP(recorder, req)
+
+			res := recorder.Result()
+			defer res.Body.Close()
+
+			content, err := io.ReadAll(res.Body)
+			if err != nil {
+				t.Fatalf("I don't know what I expected; %v", err)
+			}
+
+			if status := recorder.Code; status != tc.expectedStatus {
+				t.Errorf("handler returned wrong status code: got %v want %v, content: %s",
+					status, tc.expectedStatus, string(content))
+				return
+			}
+
+			if !tc.expectedBody {
+				return
+			}
+
+			got := &api.AccessRequest{}
+			if err = json.Unmarshal(content, got); err != nil {
+				t.Fatalf("Sent content is not in correct json format; %v", err)
+			}
+
+			// time dependent fields are checked for presence only
+			if tc.expectedReq.Status == api.AccessRequestStatusApproved {
+				assert.NotNil(t, got.ExpiresAt)
+			}
+			got.ExpiresAt = nil
+			got.CreatedAt = time.Time{}
+
+			assert.Equal(t, tc.expectedReq, got)
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package http
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+
+	"github.com/netbirdio/netbird/management/server"
+	"github.com/netbirdio/netbird/management/server/gitops"
+	"github.com/netbirdio/netbird/management/server/http/api"
+	"github.com/netbirdio/netbird/management/server/http/util"
+	"github.com/netbirdio/netbird/management/server/jwtclaims"
+	"github.com/netbirdio/netbird/management/server/status"
+)
+
+// AccountConfigHandler is a handler that exports and applies the declarative account configuration
+type AccountConfigHandler struct {
+	accountManager  server.AccountManager
+	claimsExtractor *jwtclaims.ClaimsExtractor
+}
+
+// NewAccountConfigHandler creates a new AccountConfigHandler HTTP handler
+func NewAccountConfigHandler(accountManager server.AccountManager, authCfg AuthCfg) *AccountConfigHandler {
+	return &AccountConfigHandler{
+		accountManager: accountManager,
+		claimsExtractor: jwtclaims.NewClaimsExtractor(
+			jwtclaims.WithAudience(authCfg.Audience),
+			jwtclaims.WithUserIDClaim(authCfg.UserIDClaim),
+		),
+	}
+}
+
+// ExportConfig is HTTP GET handler that returns the account configuration as a JSON or YAML document
+func (h *AccountConfigHandler) ExportConfig(w http.ResponseWriter, r *http.Request) {
+	account, user, ok := h.getAccount(w, r)
+	if !ok {
+		return
+	}
+
+	if !user.HasAdminPower() {
+		util.WriteError(r.Context(), status.Errorf(status.PermissionDenied, "only users with admin power can export account configuration"), w)
+		return
+	}
+
+	format := r.URL.Query().Get("format")
+	if format == "" && strings.Contains(r.Header.Get("Accept"), "yaml") {
+		format = "yaml"
+	}
+
+	data, err := gitops.Marshal(gitops.Export(account), format)
+	if err != nil {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "%s", err), w)
+		return
+	}
+
+	contentType := "application/json; charset=UTF-8"
+	if format == "yaml" || format == "yml" {
+		contentType = "application/yaml; charset=UTF-8"
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(data)
+}
+
+// ApplyConfig is HTTP POST handler that reconciles the account with a JSON or YAML document
+func (h *AccountConfigHandler) ApplyConfig(w http.ResponseWriter, r *http.Request) {
+	account, user, ok := h.getAccount(w, r)
+	if !ok {
+		return
+	}
+
+	dryRun := false
+	if value := r.URL.Query().Get("dry_run"); value != "" {
+		var err error
+		dryRun, err = strconv.ParseBool(value)
+		if err != nil {
+			util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid dry_run value %s", value), w)
+			return
+		}
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		util.WriteErrorResponse("couldn't read request", http.StatusBadRequest, w)
+		return
+	}
+
+	doc, err := gitops.Unmarshal(body)
+	if err != nil {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "%s", err), w)
+		return
+	}
+
+	plan, err := gitops.Apply(r.Context(), h.accountManager, account, user.Id, doc, dryRun)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, toAccountConfigPlanResponse(plan))
+}
+
+func (h *AccountConfigHandler) getAccount(w http.ResponseWriter, r *http.Request) (*server.Account, *server.User, bool) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	account, user, err := h.accountManager.GetAccountFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return nil, nil, false
+	}
+
+	accountID := mux.Vars(r)["accountId"]
+	if accountID != account.Id {
+		util.WriteError(r.Context(), status.Errorf(status.NotFound, "account %s not found", accountID), w)
+		return nil, nil, false
+	}
+
+	return account, user, true
+}
+
+func toAccountConfigPlanResponse(plan *gitops.Plan) *api.AccountConfigPlan {
+	resp := &api.AccountConfigPlan{
+		DryRun:  plan.DryRun,
+		Changes: make([]api.AccountConfigChange, 0, len(plan.Changes)),
+	}
+	for _, change := range plan.Changes {
+		c := api.AccountConfigChange{
+			Action: api.AccountConfigChangeAction(change.Action),
+			Kind:   api.AccountConfigChangeKind(change.Kind),
+		}
+		if change.ID != "" {
+			id := change.ID
+			c.Id = &id
+		}
+		if change.Name != "" {
+			name := change.Name
+			c.Name = &name
+		}
+		resp.Changes = append(resp.Changes, c)
+	}
+	return resp
+}
@@ -0,0 +1,198 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/management/server"
+	"github.com/netbirdio/netbird/management/server/gitops"
+	nbgroup "github.com/netbirdio/netbird/management/server/group"
+	"github.com/netbirdio/netbird/management/server/http/api"
+	"github.com/netbirdio/netbird/management/server/jwtclaims"
+	"github.com/netbirdio/netbird/management/server/mock_server"
+)
+
+func initAccountConfigTestData(savedGroups *[]*nbgroup.Group) *AccountConfigHandler {
+	user := server.NewAdminUser("test_user")
+	account := &server.Account{
+		Id: "test_id",
+		Users: map[string]*server.User{
+			"test_user": user,
+		},
+		Groups: map[string]*nbgroup.Group{
+			"devs": {ID: "devs", Name: "Devs", Issued: nbgroup.GroupIssuedAPI, Peers: []string{"peer1"}},
+		},
+	}
+
+	return &AccountConfigHandler{
+		accountManager: &mock_server.MockAccountManager{
+			UpdateAccountFunc: func(_ context.Context, accountID, userID string, update func(tx server.AccountTransaction) error) error {
+				var staged []*nbgroup.Group
+				err := update(&mock_server.MockAccountTransaction{
+					AccountFunc: func() *server.Account {
+						return account
+					},
+					SaveGroupFunc: func(group *nbgroup.Group) error {
+						staged = append(staged, group)
+						return nil
+					},
+				})
+				if err != nil {
+					return err
+				}
+				*savedGroups = append(*savedGroups, staged...)
+				return nil
+			},
+			GetAccountFromTokenFunc: func(_ context.Context, _ jwtclaims.AuthorizationClaims) (*server.Account, *server.User, error) {
+				return account, user, nil
+			},
+		},
+		claimsExtractor: jwtclaims.NewClaimsExtractor(
+			jwtclaims.WithFromRequestContext(func(r *http.Request) jwtclaims.AuthorizationClaims {
+				return jwtclaims.AuthorizationClaims{
+					UserId:    "test_user",
+					Domain:    "hotmail.com",
+					AccountId: "test_id",
+				}
+			}),
+		),
+	}
+}
+
+func TestAccountConfigHandlers(t *testing.T) {
+	str := func(s string) *string { return &s }
+	updateDoc := `
+version: v1
+groups:
+  - id: devs
+    name: Devs
+    peers: [peer1, peer2]
+`
+
+	tt := []struct {
+		name           string
+		requestType    string
+		requestPath    string
+		requestBody    io.Reader
+		expectedStatus int
+		expectedType   string
+		expectedPlan   *api.AccountConfigPlan
+		expectedSaves  int
+	}{
+		{
+			name:           "Export JSON",
+			requestType:    http.MethodGet,
+			requestPath:    "/api/accounts/test_id/config",
+			expectedStatus: http.StatusOK,
+			expectedType:   "application/json; charset=UTF-8",
+		},
+		{
+			name:           "Export YAML",
+			requestType:    http.MethodGet,
+			requestPath:    "/api/accounts/test_id/config?format=yaml",
+			expectedStatus: http.StatusOK,
+			expectedType:   "application/yaml; charset=UTF-8",
+		},
+		{
+			name:           "Export unknown format",
+			requestType:    http.MethodGet,
+			requestPath:    "/api/accounts/test_id/config?format=xml",
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:           "Export other account",
+			requestType:    http.MethodGet,
+			requestPath:    "/api/accounts/other/config",
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "Apply dry run",
+			requestType:    http.MethodPost,
+			requestPath:    "/api/accounts/test_id/config?dry_run=true",
+			requestBody:    bytes.NewBufferString(updateDoc),
+			expectedStatus: http.StatusOK,
+			expectedPlan: &api.AccountConfigPlan{
+				DryRun: true,
+				Changes: []api.AccountConfigChange{
+					{Action: api.AccountConfigChangeActionUpdate, Kind: api.AccountConfigChangeKindGroup, Id: str("devs"), Name: str("Devs")},
+				},
+			},
+		},
+		{
+			name:           "Apply",
+			requestType:    http.MethodPost,
+			requestPath:    "/api/accounts/test_id/config",
+			requestBody:    bytes.NewBufferString(updateDoc),
+			expectedStatus: http.StatusOK,
+			expectedPlan: &api.AccountConfigPlan{
+				Changes: []api.AccountConfigChange{
+					{Action: api.AccountConfigChangeActionUpdate, Kind: api.AccountConfigChangeKindGroup, Id: str("devs"), Name: str("Devs")},
+				},
+			},
+			expectedSaves: 1,
+		},
+		{
+			name:           "Apply unsupported version",
+			requestType:    http.MethodPost,
+			requestPath:    "/api/accounts/test_id/config",
+			requestBody:    bytes.NewBufferString(`{"version": "v0"}`),
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			var savedGroups []*nbgroup.Group
+			p := initAccountConfigTestData(&savedGroups)
+
+			recorder := httptest.NewRecorder()
+			req := httptest.NewRequest(tc.requestType, tc.requestPath, tc.requestBody)
+
+			router := mux.NewRouter()
+			router.HandleFunc("/api/accounts/{accountId}/config", p.ExportConfig).Methods("GET")
+			router.HandleFunc("/api/accounts/{accountId}/config", p.ApplyConfig).Methods("POST")
+			router.ServeHTTP(recorder, req)
+
+			res := recorder.Result()
+			defer res.Body.Close()
+
+			content, err := io.ReadAll(res.Body)
+			if err != nil {
+				t.Fatalf("I don't know what I expected; %v", err)
+			}
+
+			if status := recorder.Code; status != tc.expectedStatus {
+				t.Errorf("handler returned wrong status code: got %v want %v, content: %s",
+					status, tc.expectedStatus, string(content))
+				return
+			}
+
+			if tc.expectedType != "" {
+				assert.Equal(t, tc.expectedType, res.Header.Get("Content-Type"))
+				doc, err := gitops.Unmarshal(content)
+				require.NoError(t, err)
+				require.Len(t, doc.Groups, 1)
+				assert.Equal(t, "Devs", doc.Groups[0].Name)
+			}
+
+			if tc.expectedPlan != nil {
+				got := &api.AccountConfigPlan{}
+				if err = json.Unmarshal(content, got); err != nil {
+					t.Fatalf("Sent content is not in correct json format; %v", err)
+				}
+				assert.Equal(t, tc.expectedPlan, got)
+			}
+
+			assert.Len(t, savedGroups, tc.expectedSaves)
+		})
+	}
+}
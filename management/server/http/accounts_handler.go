@@ -95,6 +95,9 @@ func (h *AccountsHandler) UpdateAccount(w http.ResponseWriter, r *http.Request)
 	if req.Settings.JwtAllowGroups != nil {
 		settings.JWTAllowGroups = *req.Settings.JwtAllowGroups
 	}
+	if req.Settings.Ipv6Enabled != nil {
+		settings.IPv6Enabled = *req.Settings.Ipv6Enabled
+	}
 
 	updatedAccount, err := h.accountManager.UpdateAccountSettings(r.Context(), accountID, user.Id, settings)
 	if err != nil {
@@ -145,6 +148,7 @@ func toAccountResponse(account *server.Account) *api.Account {
 		JwtGroupsClaimName:         &account.Settings.JWTGroupsClaimName,
 		JwtAllowGroups:             &jwtAllowGroups,
 		RegularUsersViewBlocked:    account.Settings.RegularUsersViewBlocked,
+		Ipv6Enabled:                &account.Settings.IPv6Enabled,
 	}
 
 	if account.Settings.Extra != nil {
@@ -99,6 +99,7 @@ func TestAccounts_AccountsHandler(t *testing.T) {
 				JwtGroupsEnabled:           br(false),
 				JwtAllowGroups:             &[]string{},
 				RegularUsersViewBlocked:    true,
+				Ipv6Enabled:                br(false),
 			},
 			expectedArray: true,
 			expectedID:    accountID,
@@ -118,6 +119,7 @@ func TestAccounts_AccountsHandler(t *testing.T) {
 				JwtGroupsEnabled:           br(false),
 				JwtAllowGroups:             &[]string{},
 				RegularUsersViewBlocked:    false,
+				Ipv6Enabled:                br(false),
 			},
 			expectedArray: false,
 			expectedID:    accountID,
@@ -137,6 +139,7 @@ func TestAccounts_AccountsHandler(t *testing.T) {
 				JwtGroupsEnabled:           br(true),
 				JwtAllowGroups:             &[]string{"test"},
 				RegularUsersViewBlocked:    true,
+				Ipv6Enabled:                br(false),
 			},
 			expectedArray: false,
 			expectedID:    accountID,
@@ -156,6 +159,27 @@ func TestAccounts_AccountsHandler(t *testing.T) {
 				JwtGroupsEnabled:           br(true),
 				JwtAllowGroups:             &[]string{},
 				RegularUsersViewBlocked:    true,
+				Ipv6Enabled:                br(false),
+			},
+			expectedArray: false,
+			expectedID:    accountID,
+		},
+		{
+			name:           "PutAccount OK with IPv6",
+			expectedBody:   true,
+			requestType:    http.MethodPut,
+			requestPath:    "/api/accounts/" + accountID,
+			requestBody:    bytes.NewBufferString("{\"settings\": {\"peer_login_expiration\": 554400,\"peer_login_expiration_enabled\": true,\"ipv6_enabled\":true}}"),
+			expectedStatus: http.StatusOK,
+			expectedSettings: api.AccountSettings{
+				PeerLoginExpiration:        554400,
+				PeerLoginExpirationEnabled: true,
+				GroupsPropagationEnabled:   br(false),
+				JwtGroupsClaimName:         sr(""),
+				JwtGroupsEnabled:           br(false),
+				JwtAllowGroups:             &[]string{},
+				RegularUsersViewBlocked:    false,
+				Ipv6Enabled:                br(true),
 			},
 			expectedArray: false,
 			expectedID:    accountID,
@@ -12,6 +12,65 @@ const (
 	TokenAuthScopes  = "TokenAuth.Scopes"
 )
 
+// Defines values for AccessRequestProtocol.
+const (
+	AccessRequestProtocolAll  AccessRequestProtocol = "all"
+	AccessRequestProtocolIcmp AccessRequestProtocol = "icmp"
+	AccessRequestProtocolTcp  AccessRequestProtocol = "tcp"
+	AccessRequestProtocolUdp  AccessRequestProtocol = "udp"
+)
+
+// Defines values for AccessRequestStatus.
+const (
+	AccessRequestStatusApproved AccessRequestStatus = "approved"
+	AccessRequestStatusDenied   AccessRequestStatus = "denied"
+	AccessRequestStatusExpired  AccessRequestStatus = "expired"
+	AccessRequestStatusPending  AccessRequestStatus = "pending"
+)
+
+// Defines values for AccessRequestCreateProtocol.
+const (
+	AccessRequestCreateProtocolAll  AccessRequestCreateProtocol = "all"
+	AccessRequestCreateProtocolIcmp AccessRequestCreateProtocol = "icmp"
+	AccessRequestCreateProtocolTcp  AccessRequestCreateProtocol = "tcp"
+	AccessRequestCreateProtocolUdp  AccessRequestCreateProtocol = "udp"
+)
+
+// Defines values for AccountConfigChangeAction.
+const (
+	AccountConfigChangeActionCreate AccountConfigChangeAction = "create"
+	AccountConfigChangeActionDelete AccountConfigChangeAction = "delete"
+	AccountConfigChangeActionUpdate AccountConfigChangeAction = "update"
+)
+
+// Defines values for AccountConfigChangeKind.
+const (
+	AccountConfigChangeKindDnsSettings     AccountConfigChangeKind = "dns_settings"
+	AccountConfigChangeKindGroup           AccountConfigChangeKind = "group"
+	AccountConfigChangeKindNameserverGroup AccountConfigChangeKind = "nameserver_group"
+	AccountConfigChangeKindPolicy          AccountConfigChangeKind = "policy"
+	AccountConfigChangeKindPostureCheck    AccountConfigChangeKind = "posture_check"
+	AccountConfigChangeKindRoute           AccountConfigChangeKind = "route"
+)
+
+// Defines values for DNSRecordType.
+const (
+	DNSRecordTypeA     DNSRecordType = "A"
+	DNSRecordTypeAAAA  DNSRecordType = "AAAA"
+	DNSRecordTypeCNAME DNSRecordType = "CNAME"
+	DNSRecordTypeSRV   DNSRecordType = "SRV"
+	DNSRecordTypeTXT   DNSRecordType = "TXT"
+)
+
+// Defines values for DNSRecordRequestType.
+const (
+	DNSRecordRequestTypeA     DNSRecordRequestType = "A"
+	DNSRecordRequestTypeAAAA  DNSRecordRequestType = "AAAA"
+	DNSRecordRequestTypeCNAME DNSRecordRequestType = "CNAME"
+	DNSRecordRequestTypeSRV   DNSRecordRequestType = "SRV"
+	DNSRecordRequestTypeTXT   DNSRecordRequestType = "TXT"
+)
+
 // Defines values for EventActivityCode.
 const (
 	EventActivityCodeAccountCreate                            EventActivityCode = "account.create"
@@ -85,7 +144,9 @@ const (
 
 // Defines values for NameserverNsType.
 const (
-	NameserverNsTypeUdp NameserverNsType = "udp"
+	NameserverNsTypeHttps NameserverNsType = "https"
+	NameserverNsTypeTls   NameserverNsType = "tls"
+	NameserverNsTypeUdp   NameserverNsType = "udp"
 )
 
 // Defines values for PeerNetworkRangeCheckAction.
@@ -136,6 +197,35 @@ const (
 	PolicyRuleUpdateProtocolUdp  PolicyRuleUpdateProtocol = "udp"
 )
 
+// Defines values for PolicyScheduleDays.
+const (
+	PolicyScheduleDaysFriday    PolicyScheduleDays = "friday"
+	PolicyScheduleDaysMonday    PolicyScheduleDays = "monday"
+	PolicyScheduleDaysSaturday  PolicyScheduleDays = "saturday"
+	PolicyScheduleDaysSunday    PolicyScheduleDays = "sunday"
+	PolicyScheduleDaysThursday  PolicyScheduleDays = "thursday"
+	PolicyScheduleDaysTuesday   PolicyScheduleDays = "tuesday"
+	PolicyScheduleDaysWednesday PolicyScheduleDays = "wednesday"
+)
+
+// Defines values for RouteStrategy.
+const (
+	RouteStrategyDefault     RouteStrategy = "default"
+	RouteStrategyLatency     RouteStrategy = "latency"
+	RouteStrategyLoadBalance RouteStrategy = "load-balance"
+	RouteStrategyMetric      RouteStrategy = "metric"
+	RouteStrategySticky      RouteStrategy = "sticky"
+)
+
+// Defines values for RouteRequestStrategy.
+const (
+	RouteRequestStrategyDefault     RouteRequestStrategy = "default"
+	RouteRequestStrategyLatency     RouteRequestStrategy = "latency"
+	RouteRequestStrategyLoadBalance RouteRequestStrategy = "load-balance"
+	RouteRequestStrategyMetric      RouteRequestStrategy = "metric"
+	RouteRequestStrategySticky      RouteRequestStrategy = "sticky"
+)
+
 // Defines values for UserStatus.
 const (
 	UserStatusActive  UserStatus = "active"
@@ -150,6 +240,107 @@ const (
 	UserPermissionsDashboardViewLimited UserPermissionsDashboardView = "limited"
 )
 
+// Defines values for WebhookDeliveryStatus.
+const (
+	WebhookDeliveryStatusDelivered WebhookDeliveryStatus = "delivered"
+	WebhookDeliveryStatusFailed    WebhookDeliveryStatus = "failed"
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "pending"
+)
+
+// Defines values for GetApiAccountsAccountIdConfigParamsFormat.
+const (
+	GetApiAccountsAccountIdConfigParamsFormatJson GetApiAccountsAccountIdConfigParamsFormat = "json"
+	GetApiAccountsAccountIdConfigParamsFormatYaml GetApiAccountsAccountIdConfigParamsFormat = "yaml"
+)
+
+// Defines values for GetApiEventsParamsOrder.
+const (
+	GetApiEventsParamsOrderAsc  GetApiEventsParamsOrder = "asc"
+	GetApiEventsParamsOrderDesc GetApiEventsParamsOrder = "desc"
+)
+
+// Defines values for GetApiEventsParamsFormat.
+const (
+	GetApiEventsParamsFormatCsv    GetApiEventsParamsFormat = "csv"
+	GetApiEventsParamsFormatJson   GetApiEventsParamsFormat = "json"
+	GetApiEventsParamsFormatNdjson GetApiEventsParamsFormat = "ndjson"
+)
+
+// AccessRequest defines model for AccessRequest.
+type AccessRequest struct {
+	// CreatedAt Access request creation date
+	CreatedAt time.Time `json:"created_at"`
+
+	// Destinations Group IDs of the peers the access is requested to
+	Destinations []string `json:"destinations"`
+
+	// Duration Duration of the access in seconds once approved
+	Duration int `json:"duration"`
+
+	// ExpiresAt Date when the granted access expires
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+
+	// Id Access request ID
+	Id string `json:"id"`
+
+	// PolicyId ID of the policy created on approval
+	PolicyId *string `json:"policy_id,omitempty"`
+
+	// Ports Ports of the requested traffic
+	Ports *[]string `json:"ports,omitempty"`
+
+	// Protocol Protocol of the requested traffic. Defaults to all.
+	Protocol AccessRequestProtocol `json:"protocol"`
+
+	// Reason Reason of the request visible to the reviewer
+	Reason *string `json:"reason,omitempty"`
+
+	// ReviewedAt Access request review date
+	ReviewedAt *time.Time `json:"reviewed_at,omitempty"`
+
+	// ReviewedBy ID of the user who approved or denied the request
+	ReviewedBy *string `json:"reviewed_by,omitempty"`
+
+	// Sources Group IDs of the peers the access is requested from
+	Sources []string `json:"sources"`
+
+	// Status Review state of the access request
+	Status AccessRequestStatus `json:"status"`
+
+	// UserId ID of the user who requested the access
+	UserId string `json:"user_id"`
+}
+
+// AccessRequestProtocol Protocol of the requested traffic. Defaults to all.
+type AccessRequestProtocol string
+
+// AccessRequestStatus Review state of the access request
+type AccessRequestStatus string
+
+// AccessRequestCreate defines model for AccessRequestCreate.
+type AccessRequestCreate struct {
+	// Destinations Group IDs of the peers the access is requested to
+	Destinations []string `json:"destinations"`
+
+	// Duration Duration of the access in seconds once approved
+	Duration int `json:"duration"`
+
+	// Ports Ports of the requested traffic
+	Ports *[]string `json:"ports,omitempty"`
+
+	// Protocol Protocol of the requested traffic. Defaults to all.
+	Protocol *AccessRequestCreateProtocol `json:"protocol,omitempty"`
+
+	// Reason Reason of the request visible to the reviewer
+	Reason *string `json:"reason,omitempty"`
+
+	// Sources Group IDs of the peers the access is requested from
+	Sources []string `json:"sources"`
+}
+
+// AccessRequestCreateProtocol Protocol of the requested traffic. Defaults to all.
+type AccessRequestCreateProtocol string
+
 // AccessiblePeer defines model for AccessiblePeer.
 type AccessiblePeer struct {
 	// DnsLabel Peer's DNS label is the parsed peer name for domain resolution. It is used to form an FQDN by appending the account's domain to the peer label. e.g. peer-dns-label.netbird.cloud
@@ -161,6 +352,9 @@ type AccessiblePeer struct {
 	// Ip Peer's IP address
 	Ip string `json:"ip"`
 
+	// Ipv6 Peer's overlay IPv6 address
+	Ipv6 *string `json:"ipv6,omitempty"`
+
 	// Name Peer's hostname
 	Name string `json:"name"`
 
@@ -175,6 +369,60 @@ type Account struct {
 	Settings AccountSettings `json:"settings"`
 }
 
+// AccountConfig Declarative configuration of the account. Sections missing from the document are not changed on apply, empty sections remove all objects of their kind.
+type AccountConfig struct {
+	// DnsSettings Account DNS settings
+	DnsSettings *map[string]interface{} `json:"dns_settings,omitempty"`
+
+	// Groups Groups of peers
+	Groups *[]map[string]interface{} `json:"groups,omitempty"`
+
+	// NameserverGroups Nameserver groups
+	NameserverGroups *[]map[string]interface{} `json:"nameserver_groups,omitempty"`
+
+	// Policies Access-control policies
+	Policies *[]map[string]interface{} `json:"policies,omitempty"`
+
+	// PostureChecks Posture checks
+	PostureChecks *[]map[string]interface{} `json:"posture_checks,omitempty"`
+
+	// Routes Network routes
+	Routes *[]map[string]interface{} `json:"routes,omitempty"`
+
+	// Version Version of the document format
+	Version string `json:"version"`
+}
+
+// AccountConfigChange defines model for AccountConfigChange.
+type AccountConfigChange struct {
+	// Action Change performed on the object
+	Action AccountConfigChangeAction `json:"action"`
+
+	// Id ID of the changed object, empty for objects which IDs are assigned on creation
+	Id *string `json:"id,omitempty"`
+
+	// Kind Kind of the changed object
+	Kind AccountConfigChangeKind `json:"kind"`
+
+	// Name Name of the changed object
+	Name *string `json:"name,omitempty"`
+}
+
+// AccountConfigChangeAction Change performed on the object
+type AccountConfigChangeAction string
+
+// AccountConfigChangeKind Kind of the changed object
+type AccountConfigChangeKind string
+
+// AccountConfigPlan defines model for AccountConfigPlan.
+type AccountConfigPlan struct {
+	// Changes Changes in the order they are performed
+	Changes []AccountConfigChange `json:"changes"`
+
+	// DryRun Indicates that the changes were only computed and validated, not saved
+	DryRun bool `json:"dry_run"`
+}
+
 // AccountExtraSettings defines model for AccountExtraSettings.
 type AccountExtraSettings struct {
 	// PeerApprovalEnabled (Cloud only) Enables or disables peer approval globally. If enabled, all peers added will be in pending state until approved by an admin.
@@ -193,6 +441,9 @@ type AccountSettings struct {
 	// GroupsPropagationEnabled Allows propagate the new user auto groups to peers that belongs to the user
 	GroupsPropagationEnabled *bool `json:"groups_propagation_enabled,omitempty"`
 
+	// Ipv6Enabled Enables distribution of the peers' overlay IPv6 addresses. Requires clients that support IPv6 overlay addresses, the clients only use them when their firewall filters IPv6 traffic.
+	Ipv6Enabled *bool `json:"ipv6_enabled,omitempty"`
+
 	// JwtAllowGroups List of groups to which users are allowed access
 	JwtAllowGroups *[]string `json:"jwt_allow_groups,omitempty"`
 
@@ -275,6 +526,57 @@ type CreateSetupKeyRequest struct {
 	UsageLimit int `json:"usage_limit"`
 }
 
+// DNSRecord defines model for DNSRecord.
+type DNSRecord struct {
+	// Enabled Record status
+	Enabled bool `json:"enabled"`
+
+	// Groups Distribution group IDs, peers of these groups resolve the record
+	Groups []string `json:"groups"`
+
+	// Id Record ID
+	Id string `json:"id"`
+
+	// Name Record name relative to the account DNS domain
+	Name string `json:"name"`
+
+	// Ttl Record TTL in seconds. 0 uses the default TTL.
+	Ttl *int `json:"ttl,omitempty"`
+
+	// Type Record type
+	Type DNSRecordType `json:"type"`
+
+	// Value Record value. An IP address for A and AAAA, a domain for CNAME, text for TXT and "priority weight port target" for SRV records.
+	Value string `json:"value"`
+}
+
+// DNSRecordType Record type
+type DNSRecordType string
+
+// DNSRecordRequest defines model for DNSRecordRequest.
+type DNSRecordRequest struct {
+	// Enabled Record status
+	Enabled bool `json:"enabled"`
+
+	// Groups Distribution group IDs, peers of these groups resolve the record
+	Groups []string `json:"groups"`
+
+	// Name Record name relative to the account DNS domain
+	Name string `json:"name"`
+
+	// Ttl Record TTL in seconds. 0 uses the default TTL.
+	Ttl *int `json:"ttl,omitempty"`
+
+	// Type Record type
+	Type DNSRecordRequestType `json:"type"`
+
+	// Value Record value. An IP address for A and AAAA, a domain for CNAME, text for TXT and "priority weight port target" for SRV records.
+	Value string `json:"value"`
+}
+
+// DNSRecordRequestType Record type
+type DNSRecordRequestType string
+
 // DNSSettings defines model for DNSSettings.
 type DNSSettings struct {
 	// DisabledManagementGroups Groups whose DNS management is disabled
@@ -400,17 +702,23 @@ type NBVersionCheck = MinVersionCheck
 
 // Nameserver defines model for Nameserver.
 type Nameserver struct {
+	// CertFingerprint Hex encoded SHA-256 fingerprint of the certificate of a tls or https nameserver. When set the certificate is pinned instead of verified against the system roots.
+	CertFingerprint *string `json:"cert_fingerprint,omitempty"`
+
+	// Hostname Hostname the certificate of a tls or https nameserver is verified against, it is sent as SNI. The IP is used when it is not set.
+	Hostname *string `json:"hostname,omitempty"`
+
 	// Ip Nameserver IP
 	Ip string `json:"ip"`
 
-	// NsType Nameserver Type
+	// NsType Nameserver Type, tls and https are DNS over TLS and DNS over HTTPS
 	NsType NameserverNsType `json:"ns_type"`
 
 	// Port Nameserver Port
 	Port int `json:"port"`
 }
 
-// NameserverNsType Nameserver Type
+// NameserverNsType Nameserver Type, tls and https are DNS over TLS and DNS over HTTPS
 type NameserverNsType string
 
 // NameserverGroup defines model for NameserverGroup.
@@ -526,6 +834,9 @@ type Peer struct {
 	// Ip Peer's IP address
 	Ip string `json:"ip"`
 
+	// Ipv6 Peer's overlay IPv6 address
+	Ipv6 *string `json:"ipv6,omitempty"`
+
 	// KernelVersion Peer's operating system kernel version
 	KernelVersion string `json:"kernel_version"`
 
@@ -598,6 +909,9 @@ type PeerBase struct {
 	// Ip Peer's IP address
 	Ip string `json:"ip"`
 
+	// Ipv6 Peer's overlay IPv6 address
+	Ipv6 *string `json:"ipv6,omitempty"`
+
 	// KernelVersion Peer's operating system kernel version
 	KernelVersion string `json:"kernel_version"`
 
@@ -673,6 +987,9 @@ type PeerBatch struct {
 	// Ip Peer's IP address
 	Ip string `json:"ip"`
 
+	// Ipv6 Peer's overlay IPv6 address
+	Ipv6 *string `json:"ipv6,omitempty"`
+
 	// KernelVersion Peer's operating system kernel version
 	KernelVersion string `json:"kernel_version"`
 
@@ -795,6 +1112,9 @@ type Policy struct {
 	// Rules Policy rule object for policy UI editor
 	Rules []PolicyRule `json:"rules"`
 
+	// Schedule Limits the time when the policy is in effect. If not set, the policy is always in effect.
+	Schedule *PolicySchedule `json:"schedule,omitempty"`
+
 	// SourcePostureChecks Posture checks ID's applied to policy source groups
 	SourcePostureChecks []string `json:"source_posture_checks"`
 }
@@ -812,6 +1132,9 @@ type PolicyMinimum struct {
 
 	// Name Policy name identifier
 	Name string `json:"name"`
+
+	// Schedule Limits the time when the policy is in effect. If not set, the policy is always in effect.
+	Schedule *PolicySchedule `json:"schedule,omitempty"`
 }
 
 // PolicyRule defines model for PolicyRule.
@@ -837,7 +1160,10 @@ type PolicyRule struct {
 	// Name Policy rule name identifier
 	Name string `json:"name"`
 
-	// Ports Policy rule affected ports or it ranges list
+	// PortRanges Policy rule affected ports ranges list
+	PortRanges *[]RulePortRange `json:"port_ranges,omitempty"`
+
+	// Ports Policy rule affected ports
 	Ports *[]string `json:"ports,omitempty"`
 
 	// Protocol Policy rule type of the traffic
@@ -873,7 +1199,10 @@ type PolicyRuleMinimum struct {
 	// Name Policy rule name identifier
 	Name string `json:"name"`
 
-	// Ports Policy rule affected ports or it ranges list
+	// PortRanges Policy rule affected ports ranges list
+	PortRanges *[]RulePortRange `json:"port_ranges,omitempty"`
+
+	// Ports Policy rule affected ports
 	Ports *[]string `json:"ports,omitempty"`
 
 	// Protocol Policy rule type of the traffic
@@ -909,7 +1238,10 @@ type PolicyRuleUpdate struct {
 	// Name Policy rule name identifier
 	Name string `json:"name"`
 
-	// Ports Policy rule affected ports or it ranges list
+	// PortRanges Policy rule affected ports ranges list
+	PortRanges *[]RulePortRange `json:"port_ranges,omitempty"`
+
+	// Ports Policy rule affected ports
 	Ports *[]string `json:"ports,omitempty"`
 
 	// Protocol Policy rule type of the traffic
@@ -925,6 +1257,33 @@ type PolicyRuleUpdateAction string
 // PolicyRuleUpdateProtocol Policy rule type of the traffic
 type PolicyRuleUpdateProtocol string
 
+// PolicySchedule Limits the time when the policy is in effect. If not set, the policy is always in effect.
+type PolicySchedule struct {
+	// Days Days of the week when the policy is active. If empty, the policy is active every day.
+	Days *[]PolicyScheduleDays `json:"days,omitempty"`
+
+	// ExpiresAt Time after which the policy is no longer in effect
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+
+	// Timezone IANA time zone name used to evaluate days and windows. Defaults to UTC.
+	Timezone *string `json:"timezone,omitempty"`
+
+	// Windows Time-of-day windows when the policy is active. If empty, the policy is active the whole day.
+	Windows *[]PolicyTimeWindow `json:"windows,omitempty"`
+}
+
+// PolicyScheduleDays defines model for PolicySchedule.Days.
+type PolicyScheduleDays string
+
+// PolicyTimeWindow Time-of-day window. If end is not after start, the window spans midnight.
+type PolicyTimeWindow struct {
+	// End Window end time in HH:MM format
+	End string `json:"end"`
+
+	// Start Window start time in HH:MM format
+	Start string `json:"start"`
+}
+
 // PolicyUpdate defines model for PolicyUpdate.
 type PolicyUpdate struct {
 	// Description Policy friendly description
@@ -942,6 +1301,9 @@ type PolicyUpdate struct {
 	// Rules Policy rule object for policy UI editor
 	Rules []PolicyRuleUpdate `json:"rules"`
 
+	// Schedule Limits the time when the policy is in effect. If not set, the policy is always in effect.
+	Schedule *PolicySchedule `json:"schedule,omitempty"`
+
 	// SourcePostureChecks Posture checks ID's applied to policy source groups
 	SourcePostureChecks *[]string `json:"source_posture_checks,omitempty"`
 }
@@ -1030,8 +1392,22 @@ type Route struct {
 
 	// PeerGroups Peers Group Identifier associated with route. This property can not be set together with `peer`
 	PeerGroups *[]string `json:"peer_groups,omitempty"`
+
+	// Strategy How the clients pick the routing peer among the routes sharing the network identifier and the network or domains.
+	// `default` scores the peers by metric, latency and connection type, `metric` uses the lowest metric only,
+	// `latency` uses the lowest latency, `sticky` keeps the routing peer while it is connected and `load-balance`
+	// spreads the destinations of the network across all the connected routing peers. Load balancing is not
+	// supported with domains. The routes of a network should use the same strategy.
+	Strategy *RouteStrategy `json:"strategy,omitempty"`
 }
 
+// RouteStrategy How the clients pick the routing peer among the routes sharing the network identifier and the network or domains.
+// `default` scores the peers by metric, latency and connection type, `metric` uses the lowest metric only,
+// `latency` uses the lowest latency, `sticky` keeps the routing peer while it is connected and `load-balance`
+// spreads the destinations of the network across all the connected routing peers. Load balancing is not
+// supported with domains. The routes of a network should use the same strategy.
+type RouteStrategy string
+
 // RouteRequest defines model for RouteRequest.
 type RouteRequest struct {
 	// Description Route description
@@ -1066,6 +1442,29 @@ type RouteRequest struct {
 
 	// PeerGroups Peers Group Identifier associated with route. This property can not be set together with `peer`
 	PeerGroups *[]string `json:"peer_groups,omitempty"`
+
+	// Strategy How the clients pick the routing peer among the routes sharing the network identifier and the network or domains.
+	// `default` scores the peers by metric, latency and connection type, `metric` uses the lowest metric only,
+	// `latency` uses the lowest latency, `sticky` keeps the routing peer while it is connected and `load-balance`
+	// spreads the destinations of the network across all the connected routing peers. Load balancing is not
+	// supported with domains. The routes of a network should use the same strategy.
+	Strategy *RouteRequestStrategy `json:"strategy,omitempty"`
+}
+
+// RouteRequestStrategy How the clients pick the routing peer among the routes sharing the network identifier and the network or domains.
+// `default` scores the peers by metric, latency and connection type, `metric` uses the lowest metric only,
+// `latency` uses the lowest latency, `sticky` keeps the routing peer while it is connected and `load-balance`
+// spreads the destinations of the network across all the connected routing peers. Load balancing is not
+// supported with domains. The routes of a network should use the same strategy.
+type RouteRequestStrategy string
+
+// RulePortRange Policy rule affected ports range
+type RulePortRange struct {
+	// End The ending port of the range
+	End int `json:"end"`
+
+	// Start The starting port of the range
+	Start int `json:"start"`
 }
 
 // SetupKey defines model for SetupKey.
@@ -1216,21 +1615,168 @@ type UserRequest struct {
 	Role string `json:"role"`
 }
 
+// Webhook defines model for Webhook.
+type Webhook struct {
+	// CreatedAt Webhook creation date
+	CreatedAt time.Time `json:"created_at"`
+
+	// Enabled Webhook status
+	Enabled bool `json:"enabled"`
+
+	// EventCodes Activity codes delivered to the webhook. Empty means every event.
+	EventCodes []string `json:"event_codes"`
+
+	// Id Webhook ID
+	Id string `json:"id"`
+
+	// Name Webhook name
+	Name string `json:"name"`
+
+	// Secret Secret used to sign the payloads, only returned on creation
+	Secret *string `json:"secret,omitempty"`
+
+	// Url URL the events are posted to
+	Url string `json:"url"`
+}
+
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery struct {
+	// Attempts Number of delivery attempts
+	Attempts int `json:"attempts"`
+
+	// CreatedAt Time when the event was queued
+	CreatedAt time.Time `json:"created_at"`
+
+	// EventCode Activity code of the delivered event
+	EventCode string `json:"event_code"`
+
+	// EventId ID of the delivered event
+	EventId int64 `json:"event_id"`
+
+	// Id Delivery ID, sent in the X-NetBird-Delivery header
+	Id string `json:"id"`
+
+	// LastAttemptAt Time of the last delivery attempt
+	LastAttemptAt *time.Time `json:"last_attempt_at,omitempty"`
+
+	// LastError Reason of the last attempt failure
+	LastError *string `json:"last_error,omitempty"`
+
+	// LastResponseCode HTTP status code returned by the webhook on the last attempt
+	LastResponseCode *int `json:"last_response_code,omitempty"`
+
+	// NextAttemptAt Time of the next attempt of a pending delivery
+	NextAttemptAt *time.Time `json:"next_attempt_at,omitempty"`
+
+	// Status Delivery status
+	Status WebhookDeliveryStatus `json:"status"`
+
+	// WebhookId Webhook ID
+	WebhookId string `json:"webhook_id"`
+}
+
+// WebhookDeliveryStatus Delivery status
+type WebhookDeliveryStatus string
+
+// WebhookRequest defines model for WebhookRequest.
+type WebhookRequest struct {
+	// Enabled Webhook status
+	Enabled bool `json:"enabled"`
+
+	// EventCodes Activity codes delivered to the webhook. Empty means every event.
+	EventCodes *[]string `json:"event_codes,omitempty"`
+
+	// Name Webhook name
+	Name string `json:"name"`
+
+	// Secret Secret used to sign the payloads with HMAC-SHA256. Generated when empty on creation, kept when empty on update.
+	Secret *string `json:"secret,omitempty"`
+
+	// Url URL the events are posted to
+	Url string `json:"url"`
+}
+
+// GetApiAccountsAccountIdConfigParams defines parameters for GetApiAccountsAccountIdConfig.
+type GetApiAccountsAccountIdConfigParams struct {
+	// Format Format of the exported document, JSON by default
+	Format *GetApiAccountsAccountIdConfigParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetApiAccountsAccountIdConfigParamsFormat defines parameters for GetApiAccountsAccountIdConfig.
+type GetApiAccountsAccountIdConfigParamsFormat string
+
+// PostApiAccountsAccountIdConfigParams defines parameters for PostApiAccountsAccountIdConfig.
+type PostApiAccountsAccountIdConfigParams struct {
+	// DryRun Only compute and validate the changes without saving them
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+}
+
+// GetApiEventsParams defines parameters for GetApiEvents.
+type GetApiEventsParams struct {
+	// ActivityCode Filters events by activity code, can be repeated
+	ActivityCode *[]string `form:"activity_code,omitempty" json:"activity_code,omitempty"`
+
+	// InitiatorId Filters events by the ID of the initiator
+	InitiatorId *string `form:"initiator_id,omitempty" json:"initiator_id,omitempty"`
+
+	// TargetId Filters events by the ID of the affected object
+	TargetId *string `form:"target_id,omitempty" json:"target_id,omitempty"`
+
+	// StartDate Returns events that happened at or after the given time
+	StartDate *time.Time `form:"start_date,omitempty" json:"start_date,omitempty"`
+
+	// EndDate Returns events that happened before the given time
+	EndDate *time.Time `form:"end_date,omitempty" json:"end_date,omitempty"`
+
+	// Search Free text matched against the initiator, target, event meta and the names and emails of the account users
+	Search *string `form:"search,omitempty" json:"search,omitempty"`
+
+	// Limit Maximum number of events in a page
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Cursor returned in the X-Next-Cursor header of the previous page
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Order Order of the events
+	Order *GetApiEventsParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// Format Format of the response
+	Format *GetApiEventsParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetApiEventsParamsOrder defines parameters for GetApiEvents.
+type GetApiEventsParamsOrder string
+
+// GetApiEventsParamsFormat defines parameters for GetApiEvents.
+type GetApiEventsParamsFormat string
+
 // GetApiUsersParams defines parameters for GetApiUsers.
 type GetApiUsersParams struct {
 	// ServiceUser Filters users and returns either regular users or service users
 	ServiceUser *bool `form:"service_user,omitempty" json:"service_user,omitempty"`
 }
 
+// PostApiAccessRequestsJSONRequestBody defines body for PostApiAccessRequests for application/json ContentType.
+type PostApiAccessRequestsJSONRequestBody = AccessRequestCreate
+
 // PutApiAccountsAccountIdJSONRequestBody defines body for PutApiAccountsAccountId for application/json ContentType.
 type PutApiAccountsAccountIdJSONRequestBody = AccountRequest
 
+// PostApiAccountsAccountIdConfigJSONRequestBody defines body for PostApiAccountsAccountIdConfig for application/json ContentType.
+type PostApiAccountsAccountIdConfigJSONRequestBody = AccountConfig
+
 // PostApiDnsNameserversJSONRequestBody defines body for PostApiDnsNameservers for application/json ContentType.
 type PostApiDnsNameserversJSONRequestBody = NameserverGroupRequest
 
 // PutApiDnsNameserversNsgroupIdJSONRequestBody defines body for PutApiDnsNameserversNsgroupId for application/json ContentType.
 type PutApiDnsNameserversNsgroupIdJSONRequestBody = NameserverGroupRequest
 
+// PostApiDnsRecordsJSONRequestBody defines body for PostApiDnsRecords for application/json ContentType.
+type PostApiDnsRecordsJSONRequestBody = DNSRecordRequest
+
+// PutApiDnsRecordsRecordIdJSONRequestBody defines body for PutApiDnsRecordsRecordId for application/json ContentType.
+type PutApiDnsRecordsRecordIdJSONRequestBody = DNSRecordRequest
+
 // PutApiDnsSettingsJSONRequestBody defines body for PutApiDnsSettings for application/json ContentType.
 type PutApiDnsSettingsJSONRequestBody = DNSSettings
 
@@ -1275,3 +1821,9 @@ type PutApiUsersUserIdJSONRequestBody = UserRequest
 
 // PostApiUsersUserIdTokensJSONRequestBody defines body for PostApiUsersUserIdTokens for application/json ContentType.
 type PostApiUsersUserIdTokensJSONRequestBody = PersonalAccessTokenRequest
+
+// PostApiWebhooksJSONRequestBody defines body for PostApiWebhooks for application/json ContentType.
+type PostApiWebhooksJSONRequestBody = WebhookRequest
+
+// PutApiWebhooksWebhookIdJSONRequestBody defines body for PutApiWebhooksWebhookId for application/json ContentType.
+type PutApiWebhooksWebhookIdJSONRequestBody = WebhookRequest
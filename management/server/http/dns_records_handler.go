@@ -0,0 +1,199 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/netbirdio/netbird/management/server"
+	"github.com/netbirdio/netbird/management/server/http/api"
+	"github.com/netbirdio/netbird/management/server/http/util"
+	"github.com/netbirdio/netbird/management/server/jwtclaims"
+	"github.com/netbirdio/netbird/management/server/status"
+)
+
+// DNSRecordsHandler is a handler that manages the custom DNS records of the account
+type DNSRecordsHandler struct {
+	accountManager  server.AccountManager
+	claimsExtractor *jwtclaims.ClaimsExtractor
+}
+
+// NewDNSRecordsHandler creates a new DNS records handler
+func NewDNSRecordsHandler(accountManager server.AccountManager, authCfg AuthCfg) *DNSRecordsHandler {
+	return &DNSRecordsHandler{
+		accountManager: accountManager,
+		claimsExtractor: jwtclaims.NewClaimsExtractor(
+			jwtclaims.WithAudience(authCfg.Audience),
+			jwtclaims.WithUserIDClaim(authCfg.UserIDClaim),
+		),
+	}
+}
+
+// GetAllDNSRecords returns the list of custom DNS records for the account
+func (h *DNSRecordsHandler) GetAllDNSRecords(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	account, user, err := h.accountManager.GetAccountFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	records, err := h.accountManager.ListDNSRecords(r.Context(), account.Id, user.Id)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	resp := make([]*api.DNSRecord, 0, len(records))
+	for _, record := range records {
+		resp = append(resp, toDNSRecordResponse(record))
+	}
+
+	util.WriteJSONObject(r.Context(), w, resp)
+}
+
+// CreateDNSRecord handles DNS record creation request
+func (h *DNSRecordsHandler) CreateDNSRecord(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	account, user, err := h.accountManager.GetAccountFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	record, ok := parseDNSRecordRequest(w, r)
+	if !ok {
+		return
+	}
+
+	record, err = h.accountManager.CreateDNSRecord(r.Context(), account.Id, user.Id, record)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, toDNSRecordResponse(record))
+}
+
+// UpdateDNSRecord handles update to a DNS record identified by a given ID
+func (h *DNSRecordsHandler) UpdateDNSRecord(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	account, user, err := h.accountManager.GetAccountFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	recordID, ok := getDNSRecordID(w, r)
+	if !ok {
+		return
+	}
+
+	record, ok := parseDNSRecordRequest(w, r)
+	if !ok {
+		return
+	}
+	record.ID = recordID
+
+	record, err = h.accountManager.SaveDNSRecord(r.Context(), account.Id, user.Id, record)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, toDNSRecordResponse(record))
+}
+
+// GetDNSRecord handles a DNS record Get request identified by ID
+func (h *DNSRecordsHandler) GetDNSRecord(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	account, user, err := h.accountManager.GetAccountFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	recordID, ok := getDNSRecordID(w, r)
+	if !ok {
+		return
+	}
+
+	record, err := h.accountManager.GetDNSRecord(r.Context(), account.Id, recordID, user.Id)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, toDNSRecordResponse(record))
+}
+
+// DeleteDNSRecord handles DNS record deletion request
+func (h *DNSRecordsHandler) DeleteDNSRecord(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	account, user, err := h.accountManager.GetAccountFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	recordID, ok := getDNSRecordID(w, r)
+	if !ok {
+		return
+	}
+
+	if err = h.accountManager.DeleteDNSRecord(r.Context(), account.Id, recordID, user.Id); err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, emptyObject{})
+}
+
+func parseDNSRecordRequest(w http.ResponseWriter, r *http.Request) (*server.DNSRecord, bool) {
+	var req api.PostApiDnsRecordsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteErrorResponse("couldn't parse JSON request", http.StatusBadRequest, w)
+		return nil, false
+	}
+
+	record := &server.DNSRecord{
+		Name:    req.Name,
+		Type:    string(req.Type),
+		Value:   req.Value,
+		Groups:  req.Groups,
+		Enabled: req.Enabled,
+	}
+	if req.Ttl != nil {
+		record.TTL = *req.Ttl
+	}
+
+	return record, true
+}
+
+func getDNSRecordID(w http.ResponseWriter, r *http.Request) (string, bool) {
+	recordID := mux.Vars(r)["recordId"]
+	if len(recordID) == 0 {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid DNS record ID"), w)
+		return "", false
+	}
+	return recordID, true
+}
+
+func toDNSRecordResponse(record *server.DNSRecord) *api.DNSRecord {
+	groups := record.Groups
+	if groups == nil {
+		groups = []string{}
+	}
+
+	ttl := record.TTL
+	return &api.DNSRecord{
+		Id:      record.ID,
+		Name:    record.Name,
+		Type:    api.DNSRecordType(record.Type),
+		Value:   record.Value,
+		Ttl:     &ttl,
+		Groups:  groups,
+		Enabled: record.Enabled,
+	}
+}
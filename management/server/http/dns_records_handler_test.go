@@ -0,0 +1,228 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/netbirdio/netbird/management/server"
+	"github.com/netbirdio/netbird/management/server/http/api"
+	"github.com/netbirdio/netbird/management/server/jwtclaims"
+	"github.com/netbirdio/netbird/management/server/mock_server"
+	"github.com/netbirdio/netbird/management/server/status"
+)
+
+func initDNSRecordsTestData(records ...*server.DNSRecord) *DNSRecordsHandler {
+	testRecords := make(map[string]*server.DNSRecord, len(records))
+	for _, record := range records {
+		testRecords[record.ID] = record
+	}
+
+	return &DNSRecordsHandler{
+		accountManager: &mock_server.MockAccountManager{
+			CreateDNSRecordFunc: func(_ context.Context, accountID, userID string, record *server.DNSRecord) (*server.DNSRecord, error) {
+				if record.Type != server.DNSRecordTypeA {
+					return nil, status.Errorf(status.InvalidArgument, "unsupported DNS record type")
+				}
+				record.ID = "newRecord"
+				testRecords[record.ID] = record
+				return record, nil
+			},
+			SaveDNSRecordFunc: func(_ context.Context, accountID, userID string, record *server.DNSRecord) (*server.DNSRecord, error) {
+				if _, ok := testRecords[record.ID]; !ok {
+					return nil, status.Errorf(status.NotFound, "DNS record not found")
+				}
+				testRecords[record.ID] = record
+				return record, nil
+			},
+			GetDNSRecordFunc: func(_ context.Context, accountID, recordID, userID string) (*server.DNSRecord, error) {
+				record, ok := testRecords[recordID]
+				if !ok {
+					return nil, status.Errorf(status.NotFound, "DNS record not found")
+				}
+				return record, nil
+			},
+			ListDNSRecordsFunc: func(_ context.Context, accountID, userID string) ([]*server.DNSRecord, error) {
+				list := make([]*server.DNSRecord, 0, len(testRecords))
+				for _, record := range testRecords {
+					list = append(list, record)
+				}
+				return list, nil
+			},
+			DeleteDNSRecordFunc: func(_ context.Context, accountID, recordID, userID string) error {
+				if _, ok := testRecords[recordID]; !ok {
+					return status.Errorf(status.NotFound, "DNS record not found")
+				}
+				delete(testRecords, recordID)
+				return nil
+			},
+			GetAccountFromTokenFunc: func(_ context.Context, claims jwtclaims.AuthorizationClaims) (*server.Account, *server.User, error) {
+				user := server.NewAdminUser("test_user")
+				return &server.Account{
+					Id: claims.AccountId,
+					Users: map[string]*server.User{
+						"test_user": user,
+					},
+				}, user, nil
+			},
+		},
+		claimsExtractor: jwtclaims.NewClaimsExtractor(
+			jwtclaims.WithFromRequestContext(func(r *http.Request) jwtclaims.AuthorizationClaims {
+				return jwtclaims.AuthorizationClaims{
+					UserId:    "test_user",
+					Domain:    "hotmail.com",
+					AccountId: "test_id",
+				}
+			}),
+		),
+	}
+}
+
+func TestDNSRecordsHandlers(t *testing.T) {
+	ttl := func(i int) *int { return &i }
+	existing := &server.DNSRecord{
+		ID:      "record",
+		Name:    "_postgres._tcp",
+		Type:    server.DNSRecordTypeSRV,
+		Value:   "10 5 5432 db.netbird.cloud",
+		Groups:  []string{"servers"},
+		Enabled: true,
+	}
+
+	tt := []struct {
+		name           string
+		requestType    string
+		requestPath    string
+		requestBody    io.Reader
+		expectedStatus int
+		expectedBody   any
+	}{
+		{
+			name:           "Get DNS record",
+			requestType:    http.MethodGet,
+			requestPath:    "/api/dns/records/record",
+			expectedStatus: http.StatusOK,
+			expectedBody: &api.DNSRecord{
+				Id:      "record",
+				Name:    "_postgres._tcp",
+				Type:    api.DNSRecordType(server.DNSRecordTypeSRV),
+				Value:   "10 5 5432 db.netbird.cloud",
+				Ttl:     ttl(0),
+				Groups:  []string{"servers"},
+				Enabled: true,
+			},
+		},
+		{
+			name:           "Get unknown DNS record",
+			requestType:    http.MethodGet,
+			requestPath:    "/api/dns/records/unknown",
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "Create DNS record",
+			requestType:    http.MethodPost,
+			requestPath:    "/api/dns/records",
+			requestBody:    bytes.NewBufferString(`{"name": "db", "type": "A", "value": "10.0.0.1", "ttl": 60, "groups": ["servers"], "enabled": true}`),
+			expectedStatus: http.StatusOK,
+			expectedBody: &api.DNSRecord{
+				Id:      "newRecord",
+				Name:    "db",
+				Type:    api.DNSRecordType(server.DNSRecordTypeA),
+				Value:   "10.0.0.1",
+				Ttl:     ttl(60),
+				Groups:  []string{"servers"},
+				Enabled: true,
+			},
+		},
+		{
+			name:           "Create DNS record with unsupported type",
+			requestType:    http.MethodPost,
+			requestPath:    "/api/dns/records",
+			requestBody:    bytes.NewBufferString(`{"name": "db", "type": "MX", "value": "10 mail", "groups": ["servers"], "enabled": true}`),
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:           "Create DNS record with invalid JSON",
+			requestType:    http.MethodPost,
+			requestPath:    "/api/dns/records",
+			requestBody:    bytes.NewBufferString(`{"name": `),
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Update DNS record",
+			requestType:    http.MethodPut,
+			requestPath:    "/api/dns/records/record",
+			requestBody:    bytes.NewBufferString(`{"name": "_postgres._tcp", "type": "SRV", "value": "10 5 5433 db.netbird.cloud", "groups": ["servers"], "enabled": false}`),
+			expectedStatus: http.StatusOK,
+			expectedBody: &api.DNSRecord{
+				Id:      "record",
+				Name:    "_postgres._tcp",
+				Type:    api.DNSRecordType(server.DNSRecordTypeSRV),
+				Value:   "10 5 5433 db.netbird.cloud",
+				Ttl:     ttl(0),
+				Groups:  []string{"servers"},
+				Enabled: false,
+			},
+		},
+		{
+			name:           "Delete DNS record",
+			requestType:    http.MethodDelete,
+			requestPath:    "/api/dns/records/record",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Delete unknown DNS record",
+			requestType:    http.MethodDelete,
+			requestPath:    "/api/dns/records/record",
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	p := initDNSRecordsTestData(existing)
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			req := httptest.NewRequest(tc.requestType, tc.requestPath, tc.requestBody)
+
+			router := mux.NewRouter()
+			router.HandleFunc("/api/dns/records", p.GetAllDNSRecords).Methods("GET")
+			router.HandleFunc("/api/dns/records", p.CreateDNSRecord).Methods("POST")
+			router.HandleFunc("/api/dns/records/{recordId}", p.GetDNSRecord).Methods("GET")
+			router.HandleFunc("/api/dns/records/{recordId}", p.UpdateDNSRecord).Methods("PUT")
+			router.HandleFunc("/api/dns/records/{recordId}", p.DeleteDNSRecord).Methods("DELETE")
+			router.ServeHTTP(recorder, req)
+
+			res := recorder.Result()
+			defer res.Body.Close()
+
+			content, err := io.ReadAll(res.Body)
+			if err != nil {
+				t.Fatalf("I don't know what I expected; %v", err)
+			}
+
+			if status := recorder.Code; status != tc.expectedStatus {
+				t.Errorf("handler returned wrong status code: got %v want %v, content: %s",
+					status, tc.expectedStatus, string(content))
+				return
+			}
+
+			if tc.expectedBody == nil {
+				return
+			}
+
+			got := &api.DNSRecord{}
+			if err = json.Unmarshal(content, got); err != nil {
+				t.Fatalf("Sent content is not in correct json format; %v", err)
+			}
+			assert.Equal(t, tc.expectedBody, got)
+		})
+	}
+}
@@ -2,8 +2,13 @@ package http
 
 import (
 	"context"
+	"encoding/csv"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 
@@ -12,8 +17,18 @@ import (
 	"github.com/netbirdio/netbird/management/server/http/api"
 	"github.com/netbirdio/netbird/management/server/http/util"
 	"github.com/netbirdio/netbird/management/server/jwtclaims"
+	"github.com/netbirdio/netbird/management/server/status"
 )
 
+const (
+	// nextCursorHeader holds the cursor of the next page of events
+	nextCursorHeader = "X-Next-Cursor"
+	// exportPageSize is the number of events read at once while exporting
+	exportPageSize = 1000
+)
+
+var eventsCSVHeader = []string{"id", "timestamp", "activity_code", "activity", "initiator_id", "initiator_name", "initiator_email", "target_id", "meta"}
+
 // EventsHandler HTTP handler
 type EventsHandler struct {
 	accountManager  server.AccountManager
@@ -31,7 +46,7 @@ func NewEventsHandler(accountManager server.AccountManager, authCfg AuthCfg) *Ev
 	}
 }
 
-// GetAllEvents list of the given account
+// GetAllEvents list of the given account matching the query parameters
 func (h *EventsHandler) GetAllEvents(w http.ResponseWriter, r *http.Request) {
 	claims := h.claimsExtractor.FromRequestContext(r)
 	account, user, err := h.accountManager.GetAccountFromToken(r.Context(), claims)
@@ -41,7 +56,24 @@ func (h *EventsHandler) GetAllEvents(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	accountEvents, err := h.accountManager.GetEvents(r.Context(), account.Id, user.Id)
+	filter, err := parseEventsFilter(r)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	format := api.GetApiEventsParamsFormat(r.URL.Query().Get("format"))
+	switch format {
+	case "", api.GetApiEventsParamsFormatJson:
+	case api.GetApiEventsParamsFormatCsv, api.GetApiEventsParamsFormatNdjson:
+		h.exportEvents(w, r, account.Id, user.Id, filter, format)
+		return
+	default:
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "unsupported format %s", format), w)
+		return
+	}
+
+	accountEvents, nextCursor, err := h.accountManager.SearchEvents(r.Context(), account.Id, user.Id, filter)
 	if err != nil {
 		util.WriteError(r.Context(), err, w)
 		return
@@ -51,23 +83,163 @@ func (h *EventsHandler) GetAllEvents(w http.ResponseWriter, r *http.Request) {
 		events[i] = toEventResponse(e)
 	}
 
-	err = h.fillEventsWithUserInfo(r.Context(), events, account.Id, user.Id)
+	userInfos, err := h.accountManager.GetUsersFromAccount(r.Context(), account.Id, user.Id)
 	if err != nil {
+		log.WithContext(r.Context()).Errorf("failed to get users from account: %s", err)
 		util.WriteError(r.Context(), err, w)
 		return
 	}
+	fillEventsWithUserInfo(r.Context(), events, userInfos)
+
+	if nextCursor != 0 {
+		w.Header().Set(nextCursorHeader, strconv.FormatUint(nextCursor, 10))
+	}
 
 	util.WriteJSONObject(r.Context(), w, events)
 }
 
-func (h *EventsHandler) fillEventsWithUserInfo(ctx context.Context, events []*api.Event, accountId, userId string) error {
-	// build email, name maps based on users
-	userInfos, err := h.accountManager.GetUsersFromAccount(ctx, accountId, userId)
+// exportEvents streams all the events matching the filter page by page in the CSV or NDJSON format
+func (h *EventsHandler) exportEvents(w http.ResponseWriter, r *http.Request, accountID, userID string, filter *activity.Filter, format api.GetApiEventsParamsFormat) {
+	filter.Limit = exportPageSize
+	accountEvents, nextCursor, err := h.accountManager.SearchEvents(r.Context(), accountID, userID, filter)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	userInfos, err := h.accountManager.GetUsersFromAccount(r.Context(), accountID, userID)
 	if err != nil {
-		log.WithContext(ctx).Errorf("failed to get users from account: %s", err)
-		return err
+		log.WithContext(r.Context()).Errorf("failed to get users from account: %s", err)
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	var writeEvent func(event *api.Event) error
+	var flush func() error
+	switch format {
+	case api.GetApiEventsParamsFormatCsv:
+		w.Header().Set("Content-Type", "text/csv")
+		w.Header().Set("Content-Disposition", `attachment; filename="events.csv"`)
+		csvWriter := csv.NewWriter(w)
+		if err = csvWriter.Write(eventsCSVHeader); err != nil {
+			log.WithContext(r.Context()).Errorf("failed to write events export: %s", err)
+			return
+		}
+		writeEvent = func(event *api.Event) error {
+			return csvWriter.Write(toEventCSVRecord(event))
+		}
+		flush = func() error {
+			csvWriter.Flush()
+			return csvWriter.Error()
+		}
+	default:
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		w.Header().Set("Content-Disposition", `attachment; filename="events.ndjson"`)
+		encoder := json.NewEncoder(w)
+		writeEvent = func(event *api.Event) error {
+			return encoder.Encode(event)
+		}
+		flush = func() error { return nil }
+	}
+	w.WriteHeader(http.StatusOK)
+
+	for {
+		events := make([]*api.Event, len(accountEvents))
+		for i, e := range accountEvents {
+			events[i] = toEventResponse(e)
+		}
+		fillEventsWithUserInfo(r.Context(), events, userInfos)
+
+		for _, event := range events {
+			if err = writeEvent(event); err != nil {
+				log.WithContext(r.Context()).Errorf("failed to write events export: %s", err)
+				return
+			}
+		}
+		if err = flush(); err != nil {
+			log.WithContext(r.Context()).Errorf("failed to write events export: %s", err)
+			return
+		}
+
+		if nextCursor == 0 {
+			return
+		}
+
+		filter.Cursor = nextCursor
+		accountEvents, nextCursor, err = h.accountManager.SearchEvents(r.Context(), accountID, userID, filter)
+		if err != nil {
+			// the response status was already sent, so the export can only be cut short
+			log.WithContext(r.Context()).Errorf("failed to get events for export: %s", err)
+			return
+		}
+	}
+}
+
+func parseEventsFilter(r *http.Request) (*activity.Filter, error) {
+	query := r.URL.Query()
+	filter := &activity.Filter{
+		InitiatorID: query.Get("initiator_id"),
+		TargetID:    query.Get("target_id"),
+		Text:        query.Get("search"),
+		Descending:  true,
+	}
+
+	for _, value := range query["activity_code"] {
+		for _, code := range strings.Split(value, ",") {
+			code = strings.TrimSpace(code)
+			if code == "" {
+				continue
+			}
+			a, ok := activity.ParseCode(code)
+			if !ok {
+				return nil, status.Errorf(status.InvalidArgument, "unknown activity code %s", code)
+			}
+			filter.Activities = append(filter.Activities, a)
+		}
+	}
+
+	var err error
+	if value := query.Get("start_date"); value != "" {
+		filter.From, err = time.Parse(time.RFC3339, value)
+		if err != nil {
+			return nil, status.Errorf(status.InvalidArgument, "invalid start_date, expected RFC3339 format")
+		}
 	}
 
+	if value := query.Get("end_date"); value != "" {
+		filter.To, err = time.Parse(time.RFC3339, value)
+		if err != nil {
+			return nil, status.Errorf(status.InvalidArgument, "invalid end_date, expected RFC3339 format")
+		}
+	}
+
+	if value := query.Get("limit"); value != "" {
+		filter.Limit, err = strconv.Atoi(value)
+		if err != nil || filter.Limit < 1 {
+			return nil, status.Errorf(status.InvalidArgument, "invalid limit, expected a positive number")
+		}
+	}
+
+	if value := query.Get("cursor"); value != "" {
+		filter.Cursor, err = strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return nil, status.Errorf(status.InvalidArgument, "invalid cursor")
+		}
+	}
+
+	switch api.GetApiEventsParamsOrder(query.Get("order")) {
+	case "", api.GetApiEventsParamsOrderDesc:
+	case api.GetApiEventsParamsOrderAsc:
+		filter.Descending = false
+	default:
+		return nil, status.Errorf(status.InvalidArgument, "invalid order, expected asc or desc")
+	}
+
+	return filter, nil
+}
+
+func fillEventsWithUserInfo(ctx context.Context, events []*api.Event, userInfos []*server.UserInfo) {
+	// build email, name maps based on users
 	emails := make(map[string]string)
 	names := make(map[string]string)
 	for _, ui := range userInfos {
@@ -103,7 +275,21 @@ func (h *EventsHandler) fillEventsWithUserInfo(ctx context.Context, events []*ap
 		}
 		event.Meta["username"] = username
 	}
-	return nil
+}
+
+func toEventCSVRecord(event *api.Event) []string {
+	meta, _ := json.Marshal(event.Meta)
+	return []string{
+		event.Id,
+		event.Timestamp.Format(time.RFC3339Nano),
+		string(event.ActivityCode),
+		event.Activity,
+		event.InitiatorId,
+		event.InitiatorName,
+		event.InitiatorEmail,
+		event.TargetId,
+		string(meta),
+	}
 }
 
 func toEventResponse(event *activity.Event) *api.Event {
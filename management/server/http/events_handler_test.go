@@ -23,11 +23,29 @@ import (
 func initEventsTestData(account string, user *server.User, events ...*activity.Event) *EventsHandler {
 	return &EventsHandler{
 		accountManager: &mock_server.MockAccountManager{
-			GetEventsFunc: func(_ context.Context, accountID, userID string) ([]*activity.Event, error) {
-				if accountID == account {
-					return events, nil
+			SearchEventsFunc: func(_ context.Context, accountID, userID string, filter *activity.Filter) ([]*activity.Event, uint64, error) {
+				if accountID != account {
+					return []*activity.Event{}, 0, nil
 				}
-				return []*activity.Event{}, nil
+
+				result := make([]*activity.Event, 0)
+				for i := range events {
+					event := events[i]
+					if filter.Descending {
+						event = events[len(events)-1-i]
+					}
+					if !filter.Match(event) {
+						continue
+					}
+					if filter.Cursor != 0 && (filter.Descending && event.ID >= filter.Cursor || !filter.Descending && event.ID <= filter.Cursor) {
+						continue
+					}
+					if filter.Limit > 0 && len(result) == filter.Limit {
+						return result, result[len(result)-1].ID, nil
+					}
+					result = append(result, event)
+				}
+				return result, 0, nil
 			},
 			GetAccountFromTokenFunc: func(_ context.Context, claims jwtclaims.AuthorizationClaims) (*server.Account, *server.User, error) {
 				return &server.Account{
@@ -252,3 +270,127 @@ func TestEvents_GetEvents(t *testing.T) {
 		})
 	}
 }
+
+func TestEvents_SearchEvents(t *testing.T) {
+	tt := []struct {
+		name               string
+		requestPath        string
+		expectedStatus     int
+		expectedNextCursor string
+		expectedIDs        []string
+		expectedBody       string
+	}{
+		{
+			name:           "Filter by activity codes",
+			requestPath:    "/api/events?activity_code=setupkey.update,setupkey.revoke&activity_code=rule.add",
+			expectedStatus: http.StatusOK,
+			expectedIDs:    []string{"9", "6", "5", "4"},
+		},
+		{
+			name:           "Unknown activity code",
+			requestPath:    "/api/events?activity_code=unknown",
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:           "Filter by target and search text",
+			requestPath:    "/api/events?target_id=setup-key-id&search=setup&order=asc",
+			expectedStatus: http.StatusOK,
+			expectedIDs:    []string{"4", "5", "6", "7", "8"},
+		},
+		{
+			name:               "First page",
+			requestPath:        "/api/events?limit=5",
+			expectedStatus:     http.StatusOK,
+			expectedNextCursor: "8",
+			expectedIDs:        []string{"12", "11", "10", "9", "8"},
+		},
+		{
+			name:           "Last page",
+			requestPath:    "/api/events?limit=5&cursor=3",
+			expectedStatus: http.StatusOK,
+			expectedIDs:    []string{"2", "1"},
+		},
+		{
+			name:           "Invalid limit",
+			requestPath:    "/api/events?limit=0",
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:           "Invalid start date",
+			requestPath:    "/api/events?start_date=yesterday",
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:           "Export as CSV",
+			requestPath:    "/api/events?format=csv&activity_code=setupkey.revoke",
+			expectedStatus: http.StatusOK,
+			expectedBody: "id,timestamp,activity_code,activity,initiator_id,initiator_name,initiator_email,target_id,meta\n" +
+				"6,2024-01-02T03:04:05Z,setupkey.revoke,Setup key revoked,test_user,,,setup-key-id,\"{\"\"some\"\":\"\"meta\"\"}\"\n",
+		},
+		{
+			name:           "Export as NDJSON",
+			requestPath:    "/api/events?format=ndjson&activity_code=setupkey.revoke",
+			expectedStatus: http.StatusOK,
+			expectedBody: `{"activity":"Setup key revoked","activity_code":"setupkey.revoke","id":"6","initiator_email":"","initiator_id":"test_user",` +
+				`"initiator_name":"","meta":{"some":"meta"},"target_id":"setup-key-id","timestamp":"2024-01-02T03:04:05Z"}` + "\n",
+		},
+		{
+			name:           "Unsupported format",
+			requestPath:    "/api/events?format=xml",
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+	}
+	accountID := "test_account"
+	adminUser := server.NewAdminUser("test_user")
+	events := generateEvents(accountID, adminUser.Id)
+	for _, event := range events {
+		event.Timestamp = time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	}
+	handler := initEventsTestData(accountID, adminUser, events...)
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, tc.requestPath, nil)
+
+			router := mux.NewRouter()
+			router.HandleFunc("/api/events", handler.GetAllEvents).Methods("GET")
+			router.ServeHTTP(recorder, req)
+
+			res := recorder.Result()
+			defer res.Body.Close()
+
+			content, err := io.ReadAll(res.Body)
+			if err != nil {
+				t.Fatalf("I don't know what I expected; %v", err)
+			}
+
+			if status := recorder.Code; status != tc.expectedStatus {
+				t.Errorf("handler returned wrong status code: got %v want %v, content: %s",
+					status, tc.expectedStatus, string(content))
+				return
+			}
+
+			if tc.expectedStatus != http.StatusOK {
+				return
+			}
+
+			if tc.expectedBody != "" {
+				assert.Equal(t, tc.expectedBody, string(content))
+				return
+			}
+
+			var got []*api.Event
+			if err = json.Unmarshal(content, &got); err != nil {
+				t.Fatalf("Sent content is not in correct json format; %v", err)
+			}
+
+			ids := make([]string, 0, len(got))
+			for _, event := range got {
+				ids = append(ids, event.Id)
+			}
+			assert.Equal(t, tc.expectedIDs, ids)
+			assert.Equal(t, tc.expectedNextCursor, res.Header.Get(nextCursorHeader))
+		})
+	}
+}
@@ -95,9 +95,12 @@ func APIHandler(ctx context.Context, accountManager s.AccountManager, LocationMa
 	api.addGroupsEndpoint()
 	api.addRoutesEndpoint()
 	api.addDNSNameserversEndpoint()
+	api.addDNSRecordsEndpoint()
 	api.addDNSSettingEndpoint()
 	api.addEventsEndpoint()
 	api.addPostureCheckEndpoint()
+	api.addAccessRequestsEndpoint()
+	api.addWebhooksEndpoint()
 	api.addLocationsEndpoint()
 
 	return rootRouter, nil
@@ -108,6 +111,10 @@ func (apiHandler *apiHandler) addAccountsEndpoint() {
 	apiHandler.Router.HandleFunc("/accounts/{accountId}", accountsHandler.UpdateAccount).Methods("PUT", "OPTIONS")
 	apiHandler.Router.HandleFunc("/accounts/{accountId}", accountsHandler.DeleteAccount).Methods("DELETE", "OPTIONS")
 	apiHandler.Router.HandleFunc("/accounts", accountsHandler.GetAllAccounts).Methods("GET", "OPTIONS")
+
+	accountConfigHandler := NewAccountConfigHandler(apiHandler.AccountManager, apiHandler.AuthCfg)
+	apiHandler.Router.HandleFunc("/accounts/{accountId}/config", accountConfigHandler.ExportConfig).Methods("GET", "OPTIONS")
+	apiHandler.Router.HandleFunc("/accounts/{accountId}/config", accountConfigHandler.ApplyConfig).Methods("POST", "OPTIONS")
 }
 
 func (apiHandler *apiHandler) addPeersEndpoint() {
@@ -178,6 +185,15 @@ func (apiHandler *apiHandler) addDNSNameserversEndpoint() {
 	apiHandler.Router.HandleFunc("/dns/nameservers/{nsgroupId}", nameserversHandler.DeleteNameserverGroup).Methods("DELETE", "OPTIONS")
 }
 
+func (apiHandler *apiHandler) addDNSRecordsEndpoint() {
+	dnsRecordsHandler := NewDNSRecordsHandler(apiHandler.AccountManager, apiHandler.AuthCfg)
+	apiHandler.Router.HandleFunc("/dns/records", dnsRecordsHandler.GetAllDNSRecords).Methods("GET", "OPTIONS")
+	apiHandler.Router.HandleFunc("/dns/records", dnsRecordsHandler.CreateDNSRecord).Methods("POST", "OPTIONS")
+	apiHandler.Router.HandleFunc("/dns/records/{recordId}", dnsRecordsHandler.UpdateDNSRecord).Methods("PUT", "OPTIONS")
+	apiHandler.Router.HandleFunc("/dns/records/{recordId}", dnsRecordsHandler.GetDNSRecord).Methods("GET", "OPTIONS")
+	apiHandler.Router.HandleFunc("/dns/records/{recordId}", dnsRecordsHandler.DeleteDNSRecord).Methods("DELETE", "OPTIONS")
+}
+
 func (apiHandler *apiHandler) addDNSSettingEndpoint() {
 	dnsSettingsHandler := NewDNSSettingsHandler(apiHandler.AccountManager, apiHandler.AuthCfg)
 	apiHandler.Router.HandleFunc("/dns/settings", dnsSettingsHandler.GetDNSSettings).Methods("GET", "OPTIONS")
@@ -198,6 +214,25 @@ func (apiHandler *apiHandler) addPostureCheckEndpoint() {
 	apiHandler.Router.HandleFunc("/posture-checks/{postureCheckId}", postureCheckHandler.DeletePostureCheck).Methods("DELETE", "OPTIONS")
 }
 
+func (apiHandler *apiHandler) addAccessRequestsEndpoint() {
+	accessRequestsHandler := NewAccessRequestsHandler(apiHandler.AccountManager, apiHandler.AuthCfg)
+	apiHandler.Router.HandleFunc("/access-requests", accessRequestsHandler.GetAllAccessRequests).Methods("GET", "OPTIONS")
+	apiHandler.Router.HandleFunc("/access-requests", accessRequestsHandler.CreateAccessRequest).Methods("POST", "OPTIONS")
+	apiHandler.Router.HandleFunc("/access-requests/{requestId}", accessRequestsHandler.GetAccessRequest).Methods("GET", "OPTIONS")
+	apiHandler.Router.HandleFunc("/access-requests/{requestId}/approve", accessRequestsHandler.ApproveAccessRequest).Methods("POST", "OPTIONS")
+	apiHandler.Router.HandleFunc("/access-requests/{requestId}/deny", accessRequestsHandler.DenyAccessRequest).Methods("POST", "OPTIONS")
+}
+
+func (apiHandler *apiHandler) addWebhooksEndpoint() {
+	webhooksHandler := NewWebhooksHandler(apiHandler.AccountManager, apiHandler.AuthCfg)
+	apiHandler.Router.HandleFunc("/webhooks", webhooksHandler.GetAllWebhooks).Methods("GET", "OPTIONS")
+	apiHandler.Router.HandleFunc("/webhooks", webhooksHandler.CreateWebhook).Methods("POST", "OPTIONS")
+	apiHandler.Router.HandleFunc("/webhooks/{webhookId}", webhooksHandler.GetWebhook).Methods("GET", "OPTIONS")
+	apiHandler.Router.HandleFunc("/webhooks/{webhookId}", webhooksHandler.UpdateWebhook).Methods("PUT", "OPTIONS")
+	apiHandler.Router.HandleFunc("/webhooks/{webhookId}", webhooksHandler.DeleteWebhook).Methods("DELETE", "OPTIONS")
+	apiHandler.Router.HandleFunc("/webhooks/{webhookId}/deliveries", webhooksHandler.GetWebhookDeliveries).Methods("GET", "OPTIONS")
+}
+
 func (apiHandler *apiHandler) addLocationsEndpoint() {
 	locationHandler := NewGeolocationsHandlerHandler(apiHandler.AccountManager, apiHandler.geolocationManager, apiHandler.AuthCfg)
 	apiHandler.Router.HandleFunc("/locations/countries", locationHandler.GetAllCountries).Methods("GET", "OPTIONS")
@@ -37,6 +37,9 @@ func NewAccessControl(audience, userIDClaim string, getUser GetUser) *AccessCont
 
 var tokenPathRegexp = regexp.MustCompile(`^.*/api/users/.*/tokens.*$`)
 
+// accessRequestPathRegexp matches the path where regular users create access requests
+var accessRequestPathRegexp = regexp.MustCompile(`^.*/api/access-requests/?$`)
+
 // Handler method of the middleware which forbids all modify requests for non admin users
 func (a *AccessControl) Handler(h http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -69,6 +72,11 @@ func (a *AccessControl) Handler(h http.Handler) http.Handler {
 					return
 				}
 
+				if r.Method == http.MethodPost && accessRequestPathRegexp.MatchString(r.URL.Path) {
+					h.ServeHTTP(w, r)
+					return
+				}
+
 				util.WriteError(r.Context(), status.Errorf(status.PermissionDenied, "only users with admin power can perform this operation"), w)
 				return
 			}
@@ -199,6 +199,12 @@ func toServerNSList(apiNSList []api.Nameserver) ([]nbdns.NameServer, error) {
 		if err != nil {
 			return nil, err
 		}
+		if apiNS.Hostname != nil {
+			parsed.Hostname = *apiNS.Hostname
+		}
+		if apiNS.CertFingerprint != nil {
+			parsed.CertFingerprint = *apiNS.CertFingerprint
+		}
 		nsList = append(nsList, parsed)
 	}
 
@@ -213,6 +219,12 @@ func toNameserverGroupResponse(serverNSGroup *nbdns.NameServerGroup) *api.Namese
 			NsType: api.NameserverNsType(ns.NSType.String()),
 			Port:   ns.Port,
 		}
+		if hostname := ns.Hostname; hostname != "" {
+			apiNS.Hostname = &hostname
+		}
+		if fingerprint := ns.CertFingerprint; fingerprint != "" {
+			apiNS.CertFingerprint = &fingerprint
+		}
 		nsList = append(nsList, apiNS)
 	}
 
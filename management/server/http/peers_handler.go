@@ -227,6 +227,7 @@ func toAccessiblePeers(netMap *server.NetworkMap, dnsDomain string) []api.Access
 			Id:       p.ID,
 			Name:     p.Name,
 			Ip:       p.IP.String(),
+			Ipv6:     peerIPv6(p),
 			DnsLabel: fqdn(p, dnsDomain),
 			UserId:   p.UserID,
 		}
@@ -238,6 +239,7 @@ func toAccessiblePeers(netMap *server.NetworkMap, dnsDomain string) []api.Access
 			Id:       p.ID,
 			Name:     p.Name,
 			Ip:       p.IP.String(),
+			Ipv6:     peerIPv6(p),
 			DnsLabel: fqdn(p, dnsDomain),
 			UserId:   p.UserID,
 		}
@@ -280,6 +282,7 @@ func toSinglePeerResponse(peer *nbpeer.Peer, groupsInfo []api.GroupMinimum, dnsD
 		Id:                     peer.ID,
 		Name:                   peer.Name,
 		Ip:                     peer.IP.String(),
+		Ipv6:                   peerIPv6(peer),
 		ConnectionIp:           peer.Location.ConnectionIP.String(),
 		Connected:              peer.Status.Connected,
 		LastSeen:               peer.Status.LastSeen,
@@ -314,6 +317,7 @@ func toPeerListItemResponse(peer *nbpeer.Peer, groupsInfo []api.GroupMinimum, dn
 		Id:                     peer.ID,
 		Name:                   peer.Name,
 		Ip:                     peer.IP.String(),
+		Ipv6:                   peerIPv6(peer),
 		ConnectionIp:           peer.Location.ConnectionIP.String(),
 		Connected:              peer.Status.Connected,
 		LastSeen:               peer.Status.LastSeen,
@@ -337,6 +341,14 @@ func toPeerListItemResponse(peer *nbpeer.Peer, groupsInfo []api.GroupMinimum, dn
 	}
 }
 
+func peerIPv6(peer *nbpeer.Peer) *string {
+	if peer.IPv6 == nil {
+		return nil
+	}
+	ip := peer.IPv6.String()
+	return &ip
+}
+
 func fqdn(peer *nbpeer.Peer, dnsDomain string) string {
 	fqdn := peer.FQDN(dnsDomain)
 	if fqdn == "" {
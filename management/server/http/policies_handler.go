@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/rs/xid"
@@ -185,10 +187,23 @@ func (h *Policies) savePolicy(
 			}
 		}
 
+		if rule.PortRanges != nil && len(*rule.PortRanges) != 0 {
+			for _, portRange := range *rule.PortRanges {
+				if portRange.Start < 1 || portRange.End > 65535 || portRange.Start > portRange.End {
+					util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "valid port range is within 1..65535 and start is not greater than end"), w)
+					return
+				}
+				pr.PortRanges = append(pr.PortRanges, server.RulePortRange{
+					Start: uint16(portRange.Start),
+					End:   uint16(portRange.End),
+				})
+			}
+		}
+
 		// validate policy object
 		switch pr.Protocol {
 		case server.PolicyRuleProtocolALL, server.PolicyRuleProtocolICMP:
-			if len(pr.Ports) != 0 {
+			if len(pr.Ports) != 0 || len(pr.PortRanges) != 0 {
 				util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "for ALL or ICMP protocol ports is not allowed"), w)
 				return
 			}
@@ -197,7 +212,7 @@ func (h *Policies) savePolicy(
 				return
 			}
 		case server.PolicyRuleProtocolTCP, server.PolicyRuleProtocolUDP:
-			if !pr.Bidirectional && len(pr.Ports) == 0 {
+			if !pr.Bidirectional && len(pr.Ports) == 0 && len(pr.PortRanges) == 0 {
 				util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "for ALL or ICMP protocol type flow can be only bi-directional"), w)
 				return
 			}
@@ -210,6 +225,15 @@ func (h *Policies) savePolicy(
 		policy.SourcePostureChecks = sourcePostureChecksToStrings(account, *req.SourcePostureChecks)
 	}
 
+	if req.Schedule != nil {
+		schedule, err := toPolicySchedule(req.Schedule)
+		if err != nil {
+			util.WriteError(r.Context(), err, w)
+			return
+		}
+		policy.Schedule = schedule
+	}
+
 	if err := h.accountManager.SavePolicy(r.Context(), account.Id, user.Id, &policy); err != nil {
 		util.WriteError(r.Context(), err, w)
 		return
@@ -293,6 +317,7 @@ func toPolicyResponse(account *server.Account, policy *server.Policy) *api.Polic
 		Description:         policy.Description,
 		Enabled:             policy.Enabled,
 		SourcePostureChecks: policy.SourcePostureChecks,
+		Schedule:            toPolicyScheduleResponse(policy.Schedule),
 	}
 	for _, r := range policy.Rules {
 		rID := r.ID
@@ -310,6 +335,16 @@ func toPolicyResponse(account *server.Account, policy *server.Policy) *api.Polic
 			portsCopy := r.Ports
 			rule.Ports = &portsCopy
 		}
+		if len(r.PortRanges) != 0 {
+			portRanges := make([]api.RulePortRange, 0, len(r.PortRanges))
+			for _, portRange := range r.PortRanges {
+				portRanges = append(portRanges, api.RulePortRange{
+					Start: int(portRange.Start),
+					End:   int(portRange.End),
+				})
+			}
+			rule.PortRanges = &portRanges
+		}
 		for _, gid := range r.Sources {
 			_, ok := cache[gid]
 			if ok {
@@ -370,3 +405,84 @@ func sourcePostureChecksToStrings(account *server.Account, postureChecksIds []st
 	}
 	return result
 }
+
+var policyScheduleDays = map[api.PolicyScheduleDays]time.Weekday{
+	api.PolicyScheduleDaysSunday:    time.Sunday,
+	api.PolicyScheduleDaysMonday:    time.Monday,
+	api.PolicyScheduleDaysTuesday:   time.Tuesday,
+	api.PolicyScheduleDaysWednesday: time.Wednesday,
+	api.PolicyScheduleDaysThursday:  time.Thursday,
+	api.PolicyScheduleDaysFriday:    time.Friday,
+	api.PolicyScheduleDaysSaturday:  time.Saturday,
+}
+
+func toPolicySchedule(schedule *api.PolicySchedule) (*server.PolicySchedule, error) {
+	s := &server.PolicySchedule{
+		ExpiresAt: schedule.ExpiresAt,
+	}
+
+	if schedule.Timezone != nil {
+		s.Timezone = *schedule.Timezone
+	}
+
+	if schedule.Days != nil {
+		for _, day := range *schedule.Days {
+			weekday, ok := policyScheduleDays[day]
+			if !ok {
+				return nil, status.Errorf(status.InvalidArgument, "unknown policy schedule day: %s", day)
+			}
+			s.Days = append(s.Days, weekday)
+		}
+	}
+
+	if schedule.Windows != nil {
+		for _, window := range *schedule.Windows {
+			s.Windows = append(s.Windows, server.PolicyTimeWindow{
+				Start: window.Start,
+				End:   window.End,
+			})
+		}
+	}
+
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func toPolicyScheduleResponse(schedule *server.PolicySchedule) *api.PolicySchedule {
+	if schedule == nil {
+		return nil
+	}
+
+	s := &api.PolicySchedule{
+		ExpiresAt: schedule.ExpiresAt,
+	}
+
+	if schedule.Timezone != "" {
+		timezone := schedule.Timezone
+		s.Timezone = &timezone
+	}
+
+	if len(schedule.Days) != 0 {
+		days := make([]api.PolicyScheduleDays, 0, len(schedule.Days))
+		for _, day := range schedule.Days {
+			days = append(days, api.PolicyScheduleDays(strings.ToLower(day.String())))
+		}
+		s.Days = &days
+	}
+
+	if len(schedule.Windows) != 0 {
+		windows := make([]api.PolicyTimeWindow, 0, len(schedule.Windows))
+		for _, window := range schedule.Windows {
+			windows = append(windows, api.PolicyTimeWindow{
+				Start: window.Start,
+				End:   window.End,
+			})
+		}
+		s.Windows = &windows
+	}
+
+	return s
+}
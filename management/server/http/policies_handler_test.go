@@ -235,6 +235,158 @@ func TestPoliciesWritePolicy(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:        "WritePolicy POST OK with port ranges",
+			requestType: http.MethodPost,
+			requestPath: "/api/policies",
+			requestBody: bytes.NewBuffer(
+				[]byte(`{
+                    "Name":"Default POSTed Policy",
+                    "Rules":[
+                        {
+                            "Name":"Default POSTed Policy",
+                            "Description": "Description",
+                            "Protocol": "tcp",
+                            "Action": "accept",
+                            "Bidirectional":true,
+                            "Ports": ["80"],
+                            "port_ranges": [{"start": 30000, "end": 32767}]
+                        }
+                ]}`)),
+			expectedStatus: http.StatusOK,
+			expectedBody:   true,
+			expectedPolicy: &api.Policy{
+				Id:   str("id-was-set"),
+				Name: "Default POSTed Policy",
+				Rules: []api.PolicyRule{
+					{
+						Id:            str("id-was-set"),
+						Name:          "Default POSTed Policy",
+						Description:   str("Description"),
+						Protocol:      "tcp",
+						Action:        "accept",
+						Bidirectional: true,
+						Ports:         &[]string{"80"},
+						PortRanges:    &[]api.RulePortRange{{Start: 30000, End: 32767}},
+					},
+				},
+			},
+		},
+		{
+			name:        "WritePolicy POST Invalid port range",
+			requestType: http.MethodPost,
+			requestPath: "/api/policies",
+			requestBody: bytes.NewBuffer(
+				[]byte(`{
+                    "Name":"Default POSTed Policy",
+                    "Rules":[
+                        {
+                            "Name":"Default POSTed Policy",
+                            "Protocol": "tcp",
+                            "Action": "accept",
+                            "Bidirectional":true,
+                            "port_ranges": [{"start": 32767, "end": 30000}]
+                        }
+                ]}`)),
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:        "WritePolicy POST port range with ALL protocol",
+			requestType: http.MethodPost,
+			requestPath: "/api/policies",
+			requestBody: bytes.NewBuffer(
+				[]byte(`{
+                    "Name":"Default POSTed Policy",
+                    "Rules":[
+                        {
+                            "Name":"Default POSTed Policy",
+                            "Protocol": "all",
+                            "Action": "accept",
+                            "Bidirectional":true,
+                            "port_ranges": [{"start": 30000, "end": 32767}]
+                        }
+                ]}`)),
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:        "WritePolicy POST OK with schedule",
+			requestType: http.MethodPost,
+			requestPath: "/api/policies",
+			requestBody: bytes.NewBuffer(
+				[]byte(`{
+                    "Name":"Default POSTed Policy",
+                    "schedule": {
+                        "days": ["monday", "friday"],
+                        "windows": [{"start": "09:00", "end": "17:00"}],
+                        "timezone": "Europe/Berlin"
+                    },
+                    "Rules":[
+                        {
+                            "Name":"Default POSTed Policy",
+                            "Description": "Description",
+                            "Protocol": "tcp",
+                            "Action": "accept",
+                            "Bidirectional":true
+                        }
+                ]}`)),
+			expectedStatus: http.StatusOK,
+			expectedBody:   true,
+			expectedPolicy: &api.Policy{
+				Id:   str("id-was-set"),
+				Name: "Default POSTed Policy",
+				Schedule: &api.PolicySchedule{
+					Days:     &[]api.PolicyScheduleDays{api.PolicyScheduleDaysMonday, api.PolicyScheduleDaysFriday},
+					Windows:  &[]api.PolicyTimeWindow{{Start: "09:00", End: "17:00"}},
+					Timezone: str("Europe/Berlin"),
+				},
+				Rules: []api.PolicyRule{
+					{
+						Id:            str("id-was-set"),
+						Name:          "Default POSTed Policy",
+						Description:   str("Description"),
+						Protocol:      "tcp",
+						Action:        "accept",
+						Bidirectional: true,
+					},
+				},
+			},
+		},
+		{
+			name:        "WritePolicy POST Invalid schedule window",
+			requestType: http.MethodPost,
+			requestPath: "/api/policies",
+			requestBody: bytes.NewBuffer(
+				[]byte(`{
+                    "Name":"Default POSTed Policy",
+                    "schedule": {"windows": [{"start": "9am", "end": "17:00"}]},
+                    "Rules":[
+                        {
+                            "Name":"Default POSTed Policy",
+                            "Protocol": "tcp",
+                            "Action": "accept",
+                            "Bidirectional":true
+                        }
+                ]}`)),
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:        "WritePolicy POST Invalid schedule timezone",
+			requestType: http.MethodPost,
+			requestPath: "/api/policies",
+			requestBody: bytes.NewBuffer(
+				[]byte(`{
+                    "Name":"Default POSTed Policy",
+                    "schedule": {"timezone": "Mars/Olympus_Mons"},
+                    "Rules":[
+                        {
+                            "Name":"Default POSTed Policy",
+                            "Protocol": "tcp",
+                            "Action": "accept",
+                            "Bidirectional":true
+                        }
+                ]}`)),
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
 		{
 			name:        "WritePolicy PUT Invalid Name",
 			requestType: http.MethodPut,
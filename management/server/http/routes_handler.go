@@ -125,7 +125,7 @@ func (h *RoutesHandler) CreateRoute(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	newRoute, err := h.accountManager.CreateRoute(r.Context(), account.Id, newPrefix, networkType, domains, peerId, peerGroupIds, req.Description, route.NetID(req.NetworkId), req.Masquerade, req.Metric, req.Groups, req.Enabled, user.Id, req.KeepRoute)
+	newRoute, err := h.accountManager.CreateRoute(r.Context(), account.Id, newPrefix, networkType, domains, peerId, peerGroupIds, req.Description, route.NetID(req.NetworkId), req.Masquerade, req.Metric, req.Groups, req.Enabled, user.Id, req.KeepRoute, toRouteStrategy(req.Strategy))
 	if err != nil {
 		util.WriteError(r.Context(), err, w)
 		return
@@ -162,6 +162,10 @@ func (h *RoutesHandler) validateRoute(req api.PostApiRoutesJSONRequestBody) erro
 			route.MaxNetIDChar)
 	}
 
+	if req.Strategy != nil && !toRouteStrategy(req.Strategy).IsValid() {
+		return status.Errorf(status.InvalidArgument, "invalid strategy %q", *req.Strategy)
+	}
+
 	return nil
 }
 
@@ -221,6 +225,7 @@ func (h *RoutesHandler) UpdateRoute(w http.ResponseWriter, r *http.Request) {
 		Enabled:     req.Enabled,
 		Groups:      req.Groups,
 		KeepRoute:   req.KeepRoute,
+		Strategy:    toRouteStrategy(req.Strategy),
 	}
 
 	if req.Domains != nil {
@@ -335,6 +340,7 @@ func toRouteResponse(serverRoute *route.Route) (*api.Route, error) {
 		Metric:      serverRoute.Metric,
 		Groups:      serverRoute.Groups,
 		KeepRoute:   serverRoute.KeepRoute,
+		Strategy:    toRouteStrategyResponse(serverRoute.Strategy),
 	}
 
 	if len(serverRoute.PeerGroups) > 0 {
@@ -373,3 +379,19 @@ func validateDomains(domains []string) (domain.List, error) {
 	}
 	return domainList, nil
 }
+
+// toRouteStrategy converts the strategy of the request, the default strategy is stored empty
+func toRouteStrategy(strategy *api.RouteRequestStrategy) route.Strategy {
+	if strategy == nil || *strategy == api.RouteRequestStrategyDefault {
+		return route.StrategyDefault
+	}
+	return route.Strategy(*strategy)
+}
+
+func toRouteStrategyResponse(strategy route.Strategy) *api.RouteStrategy {
+	responseStrategy := api.RouteStrategy(strategy)
+	if strategy == route.StrategyDefault {
+		responseStrategy = api.RouteStrategyDefault
+	}
+	return &responseStrategy
+}
@@ -0,0 +1,257 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/netbirdio/netbird/management/server"
+	"github.com/netbirdio/netbird/management/server/http/api"
+	"github.com/netbirdio/netbird/management/server/http/util"
+	"github.com/netbirdio/netbird/management/server/jwtclaims"
+	"github.com/netbirdio/netbird/management/server/status"
+)
+
+// WebhooksHandler is a handler that manages the activity webhooks of the account
+type WebhooksHandler struct {
+	accountManager  server.AccountManager
+	claimsExtractor *jwtclaims.ClaimsExtractor
+}
+
+// NewWebhooksHandler creates a new Webhooks handler
+func NewWebhooksHandler(accountManager server.AccountManager, authCfg AuthCfg) *WebhooksHandler {
+	return &WebhooksHandler{
+		accountManager: accountManager,
+		claimsExtractor: jwtclaims.NewClaimsExtractor(
+			jwtclaims.WithAudience(authCfg.Audience),
+			jwtclaims.WithUserIDClaim(authCfg.UserIDClaim),
+		),
+	}
+}
+
+// GetAllWebhooks list for the account
+func (h *WebhooksHandler) GetAllWebhooks(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	account, user, err := h.accountManager.GetAccountFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	webhooks, err := h.accountManager.ListWebhooks(r.Context(), account.Id, user.Id)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	resp := make([]*api.Webhook, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		resp = append(resp, toWebhookResponse(webhook, false))
+	}
+
+	util.WriteJSONObject(r.Context(), w, resp)
+}
+
+// CreateWebhook handles webhook creation request. The response contains the webhook secret.
+func (h *WebhooksHandler) CreateWebhook(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	account, user, err := h.accountManager.GetAccountFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	webhook, ok := h.parseWebhookRequest(w, r)
+	if !ok {
+		return
+	}
+
+	webhook, err = h.accountManager.CreateWebhook(r.Context(), account.Id, user.Id, webhook)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, toWebhookResponse(webhook, true))
+}
+
+// UpdateWebhook handles update to a webhook identified by a given ID
+func (h *WebhooksHandler) UpdateWebhook(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	account, user, err := h.accountManager.GetAccountFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	webhookID, ok := getWebhookID(w, r)
+	if !ok {
+		return
+	}
+
+	webhook, ok := h.parseWebhookRequest(w, r)
+	if !ok {
+		return
+	}
+	webhook.ID = webhookID
+
+	webhook, err = h.accountManager.SaveWebhook(r.Context(), account.Id, user.Id, webhook)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, toWebhookResponse(webhook, false))
+}
+
+// GetWebhook handles a webhook Get request identified by ID
+func (h *WebhooksHandler) GetWebhook(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	account, user, err := h.accountManager.GetAccountFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	webhookID, ok := getWebhookID(w, r)
+	if !ok {
+		return
+	}
+
+	webhook, err := h.accountManager.GetWebhook(r.Context(), account.Id, webhookID, user.Id)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, toWebhookResponse(webhook, false))
+}
+
+// DeleteWebhook handles webhook deletion request
+func (h *WebhooksHandler) DeleteWebhook(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	account, user, err := h.accountManager.GetAccountFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	webhookID, ok := getWebhookID(w, r)
+	if !ok {
+		return
+	}
+
+	if err = h.accountManager.DeleteWebhook(r.Context(), account.Id, webhookID, user.Id); err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, emptyObject{})
+}
+
+// GetWebhookDeliveries returns the most recent deliveries of a webhook
+func (h *WebhooksHandler) GetWebhookDeliveries(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	account, user, err := h.accountManager.GetAccountFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	webhookID, ok := getWebhookID(w, r)
+	if !ok {
+		return
+	}
+
+	deliveries, err := h.accountManager.GetWebhookDeliveries(r.Context(), account.Id, webhookID, user.Id)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	resp := make([]*api.WebhookDelivery, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		resp = append(resp, toWebhookDeliveryResponse(delivery))
+	}
+
+	util.WriteJSONObject(r.Context(), w, resp)
+}
+
+func (h *WebhooksHandler) parseWebhookRequest(w http.ResponseWriter, r *http.Request) (*server.Webhook, bool) {
+	var req api.PostApiWebhooksJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteErrorResponse("couldn't parse JSON request", http.StatusBadRequest, w)
+		return nil, false
+	}
+
+	webhook := &server.Webhook{
+		Name:    req.Name,
+		URL:     req.Url,
+		Enabled: req.Enabled,
+	}
+	if req.Secret != nil {
+		webhook.Secret = *req.Secret
+	}
+	if req.EventCodes != nil {
+		webhook.EventCodes = *req.EventCodes
+	}
+
+	return webhook, true
+}
+
+func getWebhookID(w http.ResponseWriter, r *http.Request) (string, bool) {
+	webhookID := mux.Vars(r)["webhookId"]
+	if len(webhookID) == 0 {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid webhook ID"), w)
+		return "", false
+	}
+	return webhookID, true
+}
+
+func toWebhookResponse(webhook *server.Webhook, withSecret bool) *api.Webhook {
+	eventCodes := webhook.EventCodes
+	if eventCodes == nil {
+		eventCodes = []string{}
+	}
+
+	resp := &api.Webhook{
+		Id:         webhook.ID,
+		Name:       webhook.Name,
+		Url:        webhook.URL,
+		EventCodes: eventCodes,
+		Enabled:    webhook.Enabled,
+		CreatedAt:  webhook.CreatedAt,
+	}
+	if withSecret {
+		secret := webhook.Secret
+		resp.Secret = &secret
+	}
+	return resp
+}
+
+func toWebhookDeliveryResponse(delivery *server.WebhookDelivery) *api.WebhookDelivery {
+	resp := &api.WebhookDelivery{
+		Id:            delivery.ID,
+		WebhookId:     delivery.WebhookID,
+		EventId:       int64(delivery.EventID),
+		EventCode:     delivery.EventCode,
+		Status:        api.WebhookDeliveryStatus(delivery.Status),
+		Attempts:      delivery.Attempts,
+		LastAttemptAt: delivery.LastAttemptAt,
+		CreatedAt:     delivery.CreatedAt,
+	}
+	if delivery.Status == server.WebhookDeliveryStatusPending {
+		nextAttemptAt := delivery.NextAttemptAt
+		resp.NextAttemptAt = &nextAttemptAt
+	}
+	if delivery.LastResponseCode != 0 {
+		code := delivery.LastResponseCode
+		resp.LastResponseCode = &code
+	}
+	if delivery.LastError != "" {
+		lastError := delivery.LastError
+		resp.LastError = &lastError
+	}
+	return resp
+}
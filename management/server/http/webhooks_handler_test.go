@@ -0,0 +1,259 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/netbirdio/netbird/management/server"
+	"github.com/netbirdio/netbird/management/server/http/api"
+	"github.com/netbirdio/netbird/management/server/jwtclaims"
+	"github.com/netbirdio/netbird/management/server/mock_server"
+	"github.com/netbirdio/netbird/management/server/status"
+)
+
+func initWebhooksTestData(webhooks ...*server.Webhook) *WebhooksHandler {
+	testWebhooks := make(map[string]*server.Webhook, len(webhooks))
+	for _, webhook := range webhooks {
+		testWebhooks[webhook.ID] = webhook
+	}
+
+	return &WebhooksHandler{
+		accountManager: &mock_server.MockAccountManager{
+			CreateWebhookFunc: func(_ context.Context, accountID, userID string, webhook *server.Webhook) (*server.Webhook, error) {
+				if webhook.Name == "" {
+					return nil, status.Errorf(status.InvalidArgument, "webhook name shouldn't be empty")
+				}
+				webhook.ID = "newWebhook"
+				if webhook.Secret == "" {
+					webhook.Secret = "generated"
+				}
+				testWebhooks[webhook.ID] = webhook
+				return webhook, nil
+			},
+			SaveWebhookFunc: func(_ context.Context, accountID, userID string, webhook *server.Webhook) (*server.Webhook, error) {
+				existing, ok := testWebhooks[webhook.ID]
+				if !ok {
+					return nil, status.Errorf(status.NotFound, "webhook not found")
+				}
+				existing.Name = webhook.Name
+				existing.URL = webhook.URL
+				existing.EventCodes = webhook.EventCodes
+				existing.Enabled = webhook.Enabled
+				return existing, nil
+			},
+			GetWebhookFunc: func(_ context.Context, accountID, webhookID, userID string) (*server.Webhook, error) {
+				webhook, ok := testWebhooks[webhookID]
+				if !ok {
+					return nil, status.Errorf(status.NotFound, "webhook not found")
+				}
+				return webhook, nil
+			},
+			DeleteWebhookFunc: func(_ context.Context, accountID, webhookID, userID string) error {
+				if _, ok := testWebhooks[webhookID]; !ok {
+					return status.Errorf(status.NotFound, "webhook not found")
+				}
+				delete(testWebhooks, webhookID)
+				return nil
+			},
+			GetWebhookDeliveriesFunc: func(_ context.Context, accountID, webhookID, userID string) ([]*server.WebhookDelivery, error) {
+				if _, ok := testWebhooks[webhookID]; !ok {
+					return nil, status.Errorf(status.NotFound, "webhook not found")
+				}
+				return []*server.WebhookDelivery{
+					{
+						ID:               "delivery",
+						WebhookID:        webhookID,
+						EventID:          10,
+						EventCode:        "policy.add",
+						Status:           server.WebhookDeliveryStatusDelivered,
+						Attempts:         2,
+						LastResponseCode: 200,
+					},
+				}, nil
+			},
+			GetAccountFromTokenFunc: func(_ context.Context, claims jwtclaims.AuthorizationClaims) (*server.Account, *server.User, error) {
+				user := server.NewAdminUser("test_user")
+				return &server.Account{
+					Id: claims.AccountId,
+					Users: map[string]*server.User{
+						"test_user": user,
+					},
+				}, user, nil
+			},
+		},
+		claimsExtractor: jwtclaims.NewClaimsExtractor(
+			jwtclaims.WithFromRequestContext(func(r *http.Request) jwtclaims.AuthorizationClaims {
+				return jwtclaims.AuthorizationClaims{
+					UserId:    "test_user",
+					Domain:    "hotmail.com",
+					AccountId: "test_id",
+				}
+			}),
+		),
+	}
+}
+
+func TestWebhooksHandlers(t *testing.T) {
+	str := func(s string) *string { return &s }
+	existing := &server.Webhook{
+		ID:         "webhook",
+		Name:       "SIEM",
+		URL:        "https://siem.example.com",
+		Secret:     "secret",
+		EventCodes: []string{"policy.add"},
+		Enabled:    true,
+	}
+
+	tt := []struct {
+		name           string
+		requestType    string
+		requestPath    string
+		requestBody    io.Reader
+		expectedStatus int
+		expectedBody   any
+	}{
+		{
+			name:           "Get webhook hides the secret",
+			requestType:    http.MethodGet,
+			requestPath:    "/api/webhooks/webhook",
+			expectedStatus: http.StatusOK,
+			expectedBody: &api.Webhook{
+				Id:         "webhook",
+				Name:       "SIEM",
+				Url:        "https://siem.example.com",
+				EventCodes: []string{"policy.add"},
+				Enabled:    true,
+			},
+		},
+		{
+			name:           "Get unknown webhook",
+			requestType:    http.MethodGet,
+			requestPath:    "/api/webhooks/unknown",
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "Create webhook returns the secret",
+			requestType:    http.MethodPost,
+			requestPath:    "/api/webhooks",
+			requestBody:    bytes.NewBufferString(`{"name": "SOC", "url": "https://soc.example.com", "enabled": true}`),
+			expectedStatus: http.StatusOK,
+			expectedBody: &api.Webhook{
+				Id:         "newWebhook",
+				Name:       "SOC",
+				Url:        "https://soc.example.com",
+				Secret:     str("generated"),
+				EventCodes: []string{},
+				Enabled:    true,
+			},
+		},
+		{
+			name:           "Create webhook without name",
+			requestType:    http.MethodPost,
+			requestPath:    "/api/webhooks",
+			requestBody:    bytes.NewBufferString(`{"url": "https://soc.example.com", "enabled": true}`),
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:           "Update webhook",
+			requestType:    http.MethodPut,
+			requestPath:    "/api/webhooks/webhook",
+			requestBody:    bytes.NewBufferString(`{"name": "SIEM", "url": "https://siem.example.com", "event_codes": ["user.join"], "enabled": false}`),
+			expectedStatus: http.StatusOK,
+			expectedBody: &api.Webhook{
+				Id:         "webhook",
+				Name:       "SIEM",
+				Url:        "https://siem.example.com",
+				EventCodes: []string{"user.join"},
+				Enabled:    false,
+			},
+		},
+		{
+			name:           "Get webhook deliveries",
+			requestType:    http.MethodGet,
+			requestPath:    "/api/webhooks/webhook/deliveries",
+			expectedStatus: http.StatusOK,
+			expectedBody: &[]api.WebhookDelivery{
+				{
+					Id:               "delivery",
+					WebhookId:        "webhook",
+					EventId:          10,
+					EventCode:        "policy.add",
+					Status:           api.WebhookDeliveryStatusDelivered,
+					Attempts:         2,
+					LastResponseCode: func() *int { i := 200; return &i }(),
+				},
+			},
+		},
+		{
+			name:           "Delete webhook",
+			requestType:    http.MethodDelete,
+			requestPath:    "/api/webhooks/webhook",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Delete unknown webhook",
+			requestType:    http.MethodDelete,
+			requestPath:    "/api/webhooks/webhook",
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	p := initWebhooksTestData(existing)
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			req := httptest.NewRequest(tc.requestType, tc.requestPath, tc.requestBody)
+
+			router := mux.NewRouter()
+			router.HandleFunc("/api/webhooks", p.CreateWebhook).Methods("POST")
+			router.HandleFunc("/api/webhooks/{webhookId}", p.GetWebhook).Methods("GET")
+			router.HandleFunc("/api/webhooks/{webhookId}", p.UpdateWebhook).Methods("PUT")
+			router.HandleFunc("/api/webhooks/{webhookId}", p.DeleteWebhook).Methods("DELETE")
+			router.HandleFunc("/api/webhooks/{webhookId}/deliveries", p.GetWebhookDeliveries).Methods("GET")
+			router.ServeHTTP(recorder, req)
+
+			res := recorder.Result()
+			defer res.Body.Close()
+
+			content, err := io.ReadAll(res.Body)
+			if err != nil {
+				t.Fatalf("I don't know what I expected; %v", err)
+			}
+
+			if status := recorder.Code; status != tc.expectedStatus {
+				t.Errorf("handler returned wrong status code: got %v want %v, content: %s",
+					status, tc.expectedStatus, string(content))
+				return
+			}
+
+			switch expected := tc.expectedBody.(type) {
+			case *api.Webhook:
+				got := &api.Webhook{}
+				if err = json.Unmarshal(content, got); err != nil {
+					t.Fatalf("Sent content is not in correct json format; %v", err)
+				}
+				got.CreatedAt = time.Time{}
+				assert.Equal(t, expected, got)
+			case *[]api.WebhookDelivery:
+				got := &[]api.WebhookDelivery{}
+				if err = json.Unmarshal(content, got); err != nil {
+					t.Fatalf("Sent content is not in correct json format; %v", err)
+				}
+				for i := range *got {
+					(*got)[i].CreatedAt = time.Time{}
+				}
+				assert.Equal(t, expected, got)
+			}
+		})
+	}
+}
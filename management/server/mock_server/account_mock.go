@@ -57,7 +57,7 @@ type MockAccountManager struct {
 	UpdatePeerMetaFunc                  func(ctx context.Context, peerID string, meta nbpeer.PeerSystemMeta) error
 	UpdatePeerSSHKeyFunc                func(ctx context.Context, peerID string, sshKey string) error
 	UpdatePeerFunc                      func(ctx context.Context, accountID, userID string, peer *nbpeer.Peer) (*nbpeer.Peer, error)
-	CreateRouteFunc                     func(ctx context.Context, accountID string, prefix netip.Prefix, networkType route.NetworkType, domains domain.List, peer string, peerGroups []string, description string, netID route.NetID, masquerade bool, metric int, groups []string, enabled bool, userID string, keepRoute bool) (*route.Route, error)
+	CreateRouteFunc                     func(ctx context.Context, accountID string, prefix netip.Prefix, networkType route.NetworkType, domains domain.List, peer string, peerGroups []string, description string, netID route.NetID, masquerade bool, metric int, groups []string, enabled bool, userID string, keepRoute bool, strategy route.Strategy) (*route.Route, error)
 	GetRouteFunc                        func(ctx context.Context, accountID string, routeID route.ID, userID string) (*route.Route, error)
 	SaveRouteFunc                       func(ctx context.Context, accountID string, userID string, route *route.Route) error
 	DeleteRouteFunc                     func(ctx context.Context, accountID string, routeID route.ID, userID string) error
@@ -85,10 +85,12 @@ type MockAccountManager struct {
 	GetDNSDomainFunc                    func() string
 	StoreEventFunc                      func(ctx context.Context, initiatorID, targetID, accountID string, activityID activity.ActivityDescriber, meta map[string]any)
 	GetEventsFunc                       func(ctx context.Context, accountID, userID string) ([]*activity.Event, error)
+	SearchEventsFunc                    func(ctx context.Context, accountID, userID string, filter *activity.Filter) ([]*activity.Event, uint64, error)
 	GetDNSSettingsFunc                  func(ctx context.Context, accountID, userID string) (*server.DNSSettings, error)
 	SaveDNSSettingsFunc                 func(ctx context.Context, accountID, userID string, dnsSettingsToSave *server.DNSSettings) error
 	GetPeerFunc                         func(ctx context.Context, accountID, peerID, userID string) (*nbpeer.Peer, error)
 	UpdateAccountSettingsFunc           func(ctx context.Context, accountID, userID string, newSettings *server.Settings) (*server.Account, error)
+	UpdateAccountFunc                   func(ctx context.Context, accountID, userID string, update func(tx server.AccountTransaction) error) error
 	LoginPeerFunc                       func(ctx context.Context, login server.PeerLogin) (*nbpeer.Peer, *server.NetworkMap, []*posture.Checks, error)
 	SyncPeerFunc                        func(ctx context.Context, sync server.PeerSync, account *server.Account) (*nbpeer.Peer, *server.NetworkMap, []*posture.Checks, error)
 	InviteUserFunc                      func(ctx context.Context, accountID string, initiatorUserID string, targetUserEmail string) error
@@ -99,6 +101,22 @@ type MockAccountManager struct {
 	SavePostureChecksFunc               func(ctx context.Context, accountID, userID string, postureChecks *posture.Checks) error
 	DeletePostureChecksFunc             func(ctx context.Context, accountID, postureChecksID, userID string) error
 	ListPostureChecksFunc               func(ctx context.Context, accountID, userID string) ([]*posture.Checks, error)
+	CreateAccessRequestFunc             func(ctx context.Context, accountID, userID string, request *server.AccessRequest) (*server.AccessRequest, error)
+	GetAccessRequestFunc                func(ctx context.Context, accountID, requestID, userID string) (*server.AccessRequest, error)
+	ListAccessRequestsFunc              func(ctx context.Context, accountID, userID string) ([]*server.AccessRequest, error)
+	ApproveAccessRequestFunc            func(ctx context.Context, accountID, requestID, userID string) (*server.AccessRequest, error)
+	DenyAccessRequestFunc               func(ctx context.Context, accountID, requestID, userID string) (*server.AccessRequest, error)
+	CreateWebhookFunc                   func(ctx context.Context, accountID, userID string, webhook *server.Webhook) (*server.Webhook, error)
+	SaveWebhookFunc                     func(ctx context.Context, accountID, userID string, webhook *server.Webhook) (*server.Webhook, error)
+	GetWebhookFunc                      func(ctx context.Context, accountID, webhookID, userID string) (*server.Webhook, error)
+	ListWebhooksFunc                    func(ctx context.Context, accountID, userID string) ([]*server.Webhook, error)
+	DeleteWebhookFunc                   func(ctx context.Context, accountID, webhookID, userID string) error
+	GetWebhookDeliveriesFunc            func(ctx context.Context, accountID, webhookID, userID string) ([]*server.WebhookDelivery, error)
+	CreateDNSRecordFunc                 func(ctx context.Context, accountID, userID string, record *server.DNSRecord) (*server.DNSRecord, error)
+	SaveDNSRecordFunc                   func(ctx context.Context, accountID, userID string, record *server.DNSRecord) (*server.DNSRecord, error)
+	GetDNSRecordFunc                    func(ctx context.Context, accountID, recordID, userID string) (*server.DNSRecord, error)
+	ListDNSRecordsFunc                  func(ctx context.Context, accountID, userID string) ([]*server.DNSRecord, error)
+	DeleteDNSRecordFunc                 func(ctx context.Context, accountID, recordID, userID string) error
 	GetIdpManagerFunc                   func() idp.Manager
 	UpdateIntegratedValidatorGroupsFunc func(ctx context.Context, accountID string, userID string, groups []string) error
 	GroupValidationFunc                 func(ctx context.Context, accountId string, groups []string) (bool, error)
@@ -440,9 +458,9 @@ func (am *MockAccountManager) UpdatePeer(ctx context.Context, accountID, userID
 }
 
 // CreateRoute mock implementation of CreateRoute from server.AccountManager interface
-func (am *MockAccountManager) CreateRoute(ctx context.Context, accountID string, prefix netip.Prefix, networkType route.NetworkType, domains domain.List, peerID string, peerGroupIDs []string, description string, netID route.NetID, masquerade bool, metric int, groups []string, enabled bool, userID string, keepRoute bool) (*route.Route, error) {
+func (am *MockAccountManager) CreateRoute(ctx context.Context, accountID string, prefix netip.Prefix, networkType route.NetworkType, domains domain.List, peerID string, peerGroupIDs []string, description string, netID route.NetID, masquerade bool, metric int, groups []string, enabled bool, userID string, keepRoute bool, strategy route.Strategy) (*route.Route, error) {
 	if am.CreateRouteFunc != nil {
-		return am.CreateRouteFunc(ctx, accountID, prefix, networkType, domains, peerID, peerGroupIDs, description, netID, masquerade, metric, groups, enabled, userID, keepRoute)
+		return am.CreateRouteFunc(ctx, accountID, prefix, networkType, domains, peerID, peerGroupIDs, description, netID, masquerade, metric, groups, enabled, userID, keepRoute, strategy)
 	}
 	return nil, status.Errorf(codes.Unimplemented, "method CreateRoute is not implemented")
 }
@@ -642,6 +660,14 @@ func (am *MockAccountManager) GetEvents(ctx context.Context, accountID, userID s
 	return nil, status.Errorf(codes.Unimplemented, "method GetEvents is not implemented")
 }
 
+// SearchEvents mocks SearchEvents of the AccountManager interface
+func (am *MockAccountManager) SearchEvents(ctx context.Context, accountID, userID string, filter *activity.Filter) ([]*activity.Event, uint64, error) {
+	if am.SearchEventsFunc != nil {
+		return am.SearchEventsFunc(ctx, accountID, userID, filter)
+	}
+	return nil, 0, status.Errorf(codes.Unimplemented, "method SearchEvents is not implemented")
+}
+
 // GetDNSSettings mocks GetDNSSettings of the AccountManager interface
 func (am *MockAccountManager) GetDNSSettings(ctx context.Context, accountID string, userID string) (*server.DNSSettings, error) {
 	if am.GetDNSSettingsFunc != nil {
@@ -674,6 +700,14 @@ func (am *MockAccountManager) UpdateAccountSettings(ctx context.Context, account
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateAccountSettings is not implemented")
 }
 
+// UpdateAccount mocks UpdateAccount of the AccountManager interface
+func (am *MockAccountManager) UpdateAccount(ctx context.Context, accountID, userID string, update func(tx server.AccountTransaction) error) error {
+	if am.UpdateAccountFunc != nil {
+		return am.UpdateAccountFunc(ctx, accountID, userID, update)
+	}
+	return status.Errorf(codes.Unimplemented, "method UpdateAccount is not implemented")
+}
+
 // LoginPeer mocks LoginPeer of the AccountManager interface
 func (am *MockAccountManager) LoginPeer(ctx context.Context, login server.PeerLogin) (*nbpeer.Peer, *server.NetworkMap, []*posture.Checks, error) {
 	if am.LoginPeerFunc != nil {
@@ -755,6 +789,134 @@ func (am *MockAccountManager) ListPostureChecks(ctx context.Context, accountID,
 	return nil, status.Errorf(codes.Unimplemented, "method ListPostureChecks is not implemented")
 }
 
+// CreateAccessRequest mocks CreateAccessRequest of the AccountManager interface
+func (am *MockAccountManager) CreateAccessRequest(ctx context.Context, accountID, userID string, request *server.AccessRequest) (*server.AccessRequest, error) {
+	if am.CreateAccessRequestFunc != nil {
+		return am.CreateAccessRequestFunc(ctx, accountID, userID, request)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAccessRequest is not implemented")
+}
+
+// GetAccessRequest mocks GetAccessRequest of the AccountManager interface
+func (am *MockAccountManager) GetAccessRequest(ctx context.Context, accountID, requestID, userID string) (*server.AccessRequest, error) {
+	if am.GetAccessRequestFunc != nil {
+		return am.GetAccessRequestFunc(ctx, accountID, requestID, userID)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccessRequest is not implemented")
+}
+
+// ListAccessRequests mocks ListAccessRequests of the AccountManager interface
+func (am *MockAccountManager) ListAccessRequests(ctx context.Context, accountID, userID string) ([]*server.AccessRequest, error) {
+	if am.ListAccessRequestsFunc != nil {
+		return am.ListAccessRequestsFunc(ctx, accountID, userID)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccessRequests is not implemented")
+}
+
+// ApproveAccessRequest mocks ApproveAccessRequest of the AccountManager interface
+func (am *MockAccountManager) ApproveAccessRequest(ctx context.Context, accountID, requestID, userID string) (*server.AccessRequest, error) {
+	if am.ApproveAccessRequestFunc != nil {
+		return am.ApproveAccessRequestFunc(ctx, accountID, requestID, userID)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveAccessRequest is not implemented")
+}
+
+// DenyAccessRequest mocks DenyAccessRequest of the AccountManager interface
+func (am *MockAccountManager) DenyAccessRequest(ctx context.Context, accountID, requestID, userID string) (*server.AccessRequest, error) {
+	if am.DenyAccessRequestFunc != nil {
+		return am.DenyAccessRequestFunc(ctx, accountID, requestID, userID)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method DenyAccessRequest is not implemented")
+}
+
+// CreateWebhook mocks CreateWebhook of the AccountManager interface
+func (am *MockAccountManager) CreateWebhook(ctx context.Context, accountID, userID string, webhook *server.Webhook) (*server.Webhook, error) {
+	if am.CreateWebhookFunc != nil {
+		return am.CreateWebhookFunc(ctx, accountID, userID, webhook)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhook is not implemented")
+}
+
+// SaveWebhook mocks SaveWebhook of the AccountManager interface
+func (am *MockAccountManager) SaveWebhook(ctx context.Context, accountID, userID string, webhook *server.Webhook) (*server.Webhook, error) {
+	if am.SaveWebhookFunc != nil {
+		return am.SaveWebhookFunc(ctx, accountID, userID, webhook)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method SaveWebhook is not implemented")
+}
+
+// GetWebhook mocks GetWebhook of the AccountManager interface
+func (am *MockAccountManager) GetWebhook(ctx context.Context, accountID, webhookID, userID string) (*server.Webhook, error) {
+	if am.GetWebhookFunc != nil {
+		return am.GetWebhookFunc(ctx, accountID, webhookID, userID)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method GetWebhook is not implemented")
+}
+
+// ListWebhooks mocks ListWebhooks of the AccountManager interface
+func (am *MockAccountManager) ListWebhooks(ctx context.Context, accountID, userID string) ([]*server.Webhook, error) {
+	if am.ListWebhooksFunc != nil {
+		return am.ListWebhooksFunc(ctx, accountID, userID)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhooks is not implemented")
+}
+
+// DeleteWebhook mocks DeleteWebhook of the AccountManager interface
+func (am *MockAccountManager) DeleteWebhook(ctx context.Context, accountID, webhookID, userID string) error {
+	if am.DeleteWebhookFunc != nil {
+		return am.DeleteWebhookFunc(ctx, accountID, webhookID, userID)
+	}
+	return status.Errorf(codes.Unimplemented, "method DeleteWebhook is not implemented")
+}
+
+// GetWebhookDeliveries mocks GetWebhookDeliveries of the AccountManager interface
+func (am *MockAccountManager) GetWebhookDeliveries(ctx context.Context, accountID, webhookID, userID string) ([]*server.WebhookDelivery, error) {
+	if am.GetWebhookDeliveriesFunc != nil {
+		return am.GetWebhookDeliveriesFunc(ctx, accountID, webhookID, userID)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method GetWebhookDeliveries is not implemented")
+}
+
+// CreateDNSRecord mocks CreateDNSRecord of the AccountManager interface
+func (am *MockAccountManager) CreateDNSRecord(ctx context.Context, accountID, userID string, record *server.DNSRecord) (*server.DNSRecord, error) {
+	if am.CreateDNSRecordFunc != nil {
+		return am.CreateDNSRecordFunc(ctx, accountID, userID, record)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDNSRecord is not implemented")
+}
+
+// SaveDNSRecord mocks SaveDNSRecord of the AccountManager interface
+func (am *MockAccountManager) SaveDNSRecord(ctx context.Context, accountID, userID string, record *server.DNSRecord) (*server.DNSRecord, error) {
+	if am.SaveDNSRecordFunc != nil {
+		return am.SaveDNSRecordFunc(ctx, accountID, userID, record)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method SaveDNSRecord is not implemented")
+}
+
+// GetDNSRecord mocks GetDNSRecord of the AccountManager interface
+func (am *MockAccountManager) GetDNSRecord(ctx context.Context, accountID, recordID, userID string) (*server.DNSRecord, error) {
+	if am.GetDNSRecordFunc != nil {
+		return am.GetDNSRecordFunc(ctx, accountID, recordID, userID)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method GetDNSRecord is not implemented")
+}
+
+// ListDNSRecords mocks ListDNSRecords of the AccountManager interface
+func (am *MockAccountManager) ListDNSRecords(ctx context.Context, accountID, userID string) ([]*server.DNSRecord, error) {
+	if am.ListDNSRecordsFunc != nil {
+		return am.ListDNSRecordsFunc(ctx, accountID, userID)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method ListDNSRecords is not implemented")
+}
+
+// DeleteDNSRecord mocks DeleteDNSRecord of the AccountManager interface
+func (am *MockAccountManager) DeleteDNSRecord(ctx context.Context, accountID, recordID, userID string) error {
+	if am.DeleteDNSRecordFunc != nil {
+		return am.DeleteDNSRecordFunc(ctx, accountID, recordID, userID)
+	}
+	return status.Errorf(codes.Unimplemented, "method DeleteDNSRecord is not implemented")
+}
+
 // GetIdpManager mocks GetIdpManager of the AccountManager interface
 func (am *MockAccountManager) GetIdpManager() idp.Manager {
 	if am.GetIdpManagerFunc != nil {
@@ -0,0 +1,132 @@
+package mock_server
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	nbdns "github.com/netbirdio/netbird/dns"
+	"github.com/netbirdio/netbird/management/server"
+	"github.com/netbirdio/netbird/management/server/group"
+	"github.com/netbirdio/netbird/management/server/posture"
+	"github.com/netbirdio/netbird/route"
+)
+
+type MockAccountTransaction struct {
+	AccountFunc               func() *server.Account
+	SaveGroupFunc             func(group *group.Group) error
+	DeleteGroupFunc           func(groupID string) error
+	SavePostureChecksFunc     func(postureChecks *posture.Checks) error
+	DeletePostureChecksFunc   func(postureChecksID string) error
+	SavePolicyFunc            func(policy *server.Policy) error
+	DeletePolicyFunc          func(policyID string) error
+	SaveRouteFunc             func(routeToSave *route.Route) error
+	DeleteRouteFunc           func(routeID route.ID) error
+	CreateNameServerGroupFunc func(nsGroup *nbdns.NameServerGroup) (*nbdns.NameServerGroup, error)
+	SaveNameServerGroupFunc   func(nsGroup *nbdns.NameServerGroup) error
+	DeleteNameServerGroupFunc func(nsGroupID string) error
+	SaveDNSSettingsFunc       func(dnsSettings *server.DNSSettings) error
+}
+
+// Account mocks Account of the AccountTransaction interface
+func (tx *MockAccountTransaction) Account() *server.Account {
+	if tx.AccountFunc != nil {
+		return tx.AccountFunc()
+	}
+	return nil
+}
+
+// SaveGroup mocks SaveGroup of the AccountTransaction interface
+func (tx *MockAccountTransaction) SaveGroup(group *group.Group) error {
+	if tx.SaveGroupFunc != nil {
+		return tx.SaveGroupFunc(group)
+	}
+	return status.Errorf(codes.Unimplemented, "method SaveGroup is not implemented")
+}
+
+// DeleteGroup mocks DeleteGroup of the AccountTransaction interface
+func (tx *MockAccountTransaction) DeleteGroup(groupID string) error {
+	if tx.DeleteGroupFunc != nil {
+		return tx.DeleteGroupFunc(groupID)
+	}
+	return status.Errorf(codes.Unimplemented, "method DeleteGroup is not implemented")
+}
+
+// SavePostureChecks mocks SavePostureChecks of the AccountTransaction interface
+func (tx *MockAccountTransaction) SavePostureChecks(postureChecks *posture.Checks) error {
+	if tx.SavePostureChecksFunc != nil {
+		return tx.SavePostureChecksFunc(postureChecks)
+	}
+	return status.Errorf(codes.Unimplemented, "method SavePostureChecks is not implemented")
+}
+
+// DeletePostureChecks mocks DeletePostureChecks of the AccountTransaction interface
+func (tx *MockAccountTransaction) DeletePostureChecks(postureChecksID string) error {
+	if tx.DeletePostureChecksFunc != nil {
+		return tx.DeletePostureChecksFunc(postureChecksID)
+	}
+	return status.Errorf(codes.Unimplemented, "method DeletePostureChecks is not implemented")
+}
+
+// SavePolicy mocks SavePolicy of the AccountTransaction interface
+func (tx *MockAccountTransaction) SavePolicy(policy *server.Policy) error {
+	if tx.SavePolicyFunc != nil {
+		return tx.SavePolicyFunc(policy)
+	}
+	return status.Errorf(codes.Unimplemented, "method SavePolicy is not implemented")
+}
+
+// DeletePolicy mocks DeletePolicy of the AccountTransaction interface
+func (tx *MockAccountTransaction) DeletePolicy(policyID string) error {
+	if tx.DeletePolicyFunc != nil {
+		return tx.DeletePolicyFunc(policyID)
+	}
+	return status.Errorf(codes.Unimplemented, "method DeletePolicy is not implemented")
+}
+
+// SaveRoute mocks SaveRoute of the AccountTransaction interface
+func (tx *MockAccountTransaction) SaveRoute(routeToSave *route.Route) error {
+	if tx.SaveRouteFunc != nil {
+		return tx.SaveRouteFunc(routeToSave)
+	}
+	return status.Errorf(codes.Unimplemented, "method SaveRoute is not implemented")
+}
+
+// DeleteRoute mocks DeleteRoute of the AccountTransaction interface
+func (tx *MockAccountTransaction) DeleteRoute(routeID route.ID) error {
+	if tx.DeleteRouteFunc != nil {
+		return tx.DeleteRouteFunc(routeID)
+	}
+	return status.Errorf(codes.Unimplemented, "method DeleteRoute is not implemented")
+}
+
+// CreateNameServerGroup mocks CreateNameServerGroup of the AccountTransaction interface
+func (tx *MockAccountTransaction) CreateNameServerGroup(nsGroup *nbdns.NameServerGroup) (*nbdns.NameServerGroup, error) {
+	if tx.CreateNameServerGroupFunc != nil {
+		return tx.CreateNameServerGroupFunc(nsGroup)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method CreateNameServerGroup is not implemented")
+}
+
+// SaveNameServerGroup mocks SaveNameServerGroup of the AccountTransaction interface
+func (tx *MockAccountTransaction) SaveNameServerGroup(nsGroup *nbdns.NameServerGroup) error {
+	if tx.SaveNameServerGroupFunc != nil {
+		return tx.SaveNameServerGroupFunc(nsGroup)
+	}
+	return status.Errorf(codes.Unimplemented, "method SaveNameServerGroup is not implemented")
+}
+
+// DeleteNameServerGroup mocks DeleteNameServerGroup of the AccountTransaction interface
+func (tx *MockAccountTransaction) DeleteNameServerGroup(nsGroupID string) error {
+	if tx.DeleteNameServerGroupFunc != nil {
+		return tx.DeleteNameServerGroupFunc(nsGroupID)
+	}
+	return status.Errorf(codes.Unimplemented, "method DeleteNameServerGroup is not implemented")
+}
+
+// SaveDNSSettings mocks SaveDNSSettings of the AccountTransaction interface
+func (tx *MockAccountTransaction) SaveDNSSettings(dnsSettings *server.DNSSettings) error {
+	if tx.SaveDNSSettingsFunc != nil {
+		return tx.SaveDNSSettingsFunc(dnsSettings)
+	}
+	return status.Errorf(codes.Unimplemented, "method SaveDNSSettings is not implemented")
+}
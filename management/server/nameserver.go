@@ -260,6 +260,15 @@ func validateNSList(list []nbdns.NameServer) error {
 	if nsListLenght == 0 || nsListLenght > 3 {
 		return status.Errorf(status.InvalidArgument, "the list of nameservers should be 1 or 3, got %d", len(list))
 	}
+
+	for _, ns := range list {
+		if ns.NSType == nbdns.InvalidNameServerType {
+			return status.Errorf(status.InvalidArgument, "invalid type of nameserver %s", ns.IP)
+		}
+		if err := ns.Validate(); err != nil {
+			return status.Errorf(status.InvalidArgument, "invalid nameserver %s: %v", ns.IP, err)
+		}
+	}
 	return nil
 }
 
@@ -935,3 +935,56 @@ func TestValidateDomain(t *testing.T) {
 	}
 
 }
+
+func TestValidateNSList(t *testing.T) {
+	fingerprint := "3B:B1:6F:5D:7A:4C:8B:0E:96:16:8A:4F:29:2C:68:D5:17:3A:6D:8F:99:C1:A3:0B:28:E8:4D:72:E5:08:1F:5B"
+
+	testCases := []struct {
+		name       string
+		nameServer nbdns.NameServer
+		errFunc    require.ErrorAssertionFunc
+	}{
+		{
+			name:       "Valid UDP nameserver",
+			nameServer: nbdns.NameServer{IP: netip.MustParseAddr("1.1.1.1"), NSType: nbdns.UDPNameServerType, Port: 53},
+			errFunc:    require.NoError,
+		},
+		{
+			name: "Valid DNS over TLS nameserver with hostname and pinned certificate",
+			nameServer: nbdns.NameServer{IP: netip.MustParseAddr("1.1.1.1"), NSType: nbdns.TLSNameServerType, Port: 853,
+				Hostname: "one.one.one.one", CertFingerprint: fingerprint},
+			errFunc: require.NoError,
+		},
+		{
+			name:       "Valid DNS over HTTPS nameserver",
+			nameServer: nbdns.NameServer{IP: netip.MustParseAddr("8.8.8.8"), NSType: nbdns.HTTPSNameServerType, Port: 443, Hostname: "dns.google"},
+			errFunc:    require.NoError,
+		},
+		{
+			name:       "Invalid nameserver type",
+			nameServer: nbdns.NameServer{IP: netip.MustParseAddr("1.1.1.1"), NSType: nbdns.InvalidNameServerType, Port: 53},
+			errFunc:    require.Error,
+		},
+		{
+			name:       "Invalid UDP nameserver with hostname",
+			nameServer: nbdns.NameServer{IP: netip.MustParseAddr("1.1.1.1"), NSType: nbdns.UDPNameServerType, Port: 53, Hostname: "one.one.one.one"},
+			errFunc:    require.Error,
+		},
+		{
+			name:       "Invalid hostname",
+			nameServer: nbdns.NameServer{IP: netip.MustParseAddr("8.8.8.8"), NSType: nbdns.HTTPSNameServerType, Port: 443, Hostname: "dns.google/dns-query"},
+			errFunc:    require.Error,
+		},
+		{
+			name:       "Invalid certificate fingerprint",
+			nameServer: nbdns.NameServer{IP: netip.MustParseAddr("1.1.1.1"), NSType: nbdns.TLSNameServerType, Port: 853, CertFingerprint: "3B:B1"},
+			errFunc:    require.Error,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.errFunc(t, validateNSList([]nbdns.NameServer{testCase.nameServer}))
+		})
+	}
+}
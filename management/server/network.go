@@ -1,6 +1,8 @@
 package server
 
 import (
+	crand "crypto/rand"
+	"encoding/binary"
 	"math/rand"
 	"net"
 	"sync"
@@ -21,8 +23,16 @@ const (
 	// NetSize is a global network size 100.64.0.0/10
 	NetSize = 10
 
+	// SubnetV6Size is a size of the IPv6 subnet of the global network, e.g. fd12:3456:789a::/64
+	SubnetV6Size = 64
+
 	// AllowedIPsFormat generates Wireguard AllowedIPs format (e.g. 100.64.30.1/32)
 	AllowedIPsFormat = "%s/32"
+	// AllowedIPsV6Format generates Wireguard AllowedIPs format for IPv6 addresses (e.g. fd12:3456:789a::1/128)
+	AllowedIPsV6Format = "%s/128"
+
+	// maxIPv6AllocationAttempts limits the number of random IPv6 addresses tried before giving up on a collision
+	maxIPv6AllocationAttempts = 100
 )
 
 type NetworkMap struct {
@@ -32,12 +42,16 @@ type NetworkMap struct {
 	DNSConfig     nbdns.Config
 	OfflinePeers  []*nbpeer.Peer
 	FirewallRules []*FirewallRule
+	// LoginExpiresAt is the deadline of the login of the peer, zero when its login doesn't expire
+	LoginExpiresAt time.Time
 }
 
 type Network struct {
 	Identifier string    `json:"id"`
 	Net        net.IPNet `gorm:"serializer:json"`
-	Dns        string
+	// NetV6 is a unique local (ULA) IPv6 prefix of the network. Empty for networks created before IPv6 support
+	NetV6 net.IPNet `gorm:"serializer:json"`
+	Dns   string
 	// Serial is an ID that increments by 1 when any change to the network happened (e.g. new peer has been added).
 	// Used to synchronize state to the client apps.
 	Serial uint64
@@ -46,7 +60,7 @@ type Network struct {
 }
 
 // NewNetwork creates a new Network initializing it with a Serial=0
-// It takes a random /16 subnet from 100.64.0.0/10 (64 different subnets)
+// It takes a random /16 subnet from 100.64.0.0/10 (64 different subnets) and a random /64 ULA prefix
 func NewNetwork() *Network {
 
 	n := iplib.NewNet4(net.ParseIP("100.64.0.0"), NetSize)
@@ -59,10 +73,26 @@ func NewNetwork() *Network {
 	return &Network{
 		Identifier: xid.New().String(),
 		Net:        sub[intn].IPNet,
+		NetV6:      NewNetworkV6(),
 		Dns:        "",
 		Serial:     0}
 }
 
+// NewNetworkV6 generates a random unique local IPv6 /64 prefix as described in RFC 4193 (fdXX:XXXX:XXXX::/64)
+func NewNetworkV6() net.IPNet {
+	ip := make(net.IP, net.IPv6len)
+	ip[0] = 0xfd
+	// 40-bit pseudo-random global ID, the subnet ID is left as zero
+	_, _ = crand.Read(ip[1:6])
+
+	return net.IPNet{IP: ip, Mask: net.CIDRMask(SubnetV6Size, 8*net.IPv6len)}
+}
+
+// HasIPv6 indicates whether the network has an IPv6 prefix assigned
+func (n *Network) HasIPv6() bool {
+	return n.NetV6.IP != nil
+}
+
 // IncSerial increments Serial by 1 reflecting that the network state has been changed
 func (n *Network) IncSerial() {
 	n.mu.Lock()
@@ -81,6 +111,7 @@ func (n *Network) Copy() *Network {
 	return &Network{
 		Identifier: n.Identifier,
 		Net:        n.Net,
+		NetV6:      n.NetV6,
 		Dns:        n.Dns,
 		Serial:     n.Serial,
 	}
@@ -110,6 +141,46 @@ func AllocatePeerIP(ipNet net.IPNet, takenIps []net.IP) (net.IP, error) {
 	return ips[intn], nil
 }
 
+// AllocatePeerIPv6 picks a random IPv6 address from the /64 ipNet that is not present in takenIps.
+// The interface identifier is random, so the address is not derived from the IPv4 address of the peer.
+func AllocatePeerIPv6(ipNet net.IPNet, takenIps []net.IP) (net.IP, error) {
+	ones, bits := ipNet.Mask.Size()
+	if bits != 8*net.IPv6len || ones != SubnetV6Size {
+		return nil, status.Errorf(status.PreconditionFailed, "failed allocating new IPv6 for the ipNet %s - expected a /%d prefix", ipNet.String(), SubnetV6Size)
+	}
+
+	takenIPMap := make(map[string]struct{}, len(takenIps))
+	for _, ip := range takenIps {
+		if ip != nil {
+			takenIPMap[ip.String()] = struct{}{}
+		}
+	}
+
+	prefix := ipNet.IP.Mask(ipNet.Mask)
+	for i := 0; i < maxIPv6AllocationAttempts; i++ {
+		ip := copyIP(prefix)
+		if _, err := crand.Read(ip[8:]); err != nil {
+			return nil, status.Errorf(status.Internal, "failed generating IPv6 interface identifier: %v", err)
+		}
+
+		if isReservedIPv6InterfaceID(binary.BigEndian.Uint64(ip[8:])) {
+			continue
+		}
+
+		if _, ok := takenIPMap[ip.String()]; !ok {
+			return ip, nil
+		}
+	}
+
+	return nil, status.Errorf(status.PreconditionFailed, "failed allocating new IPv6 for the ipNet %s", ipNet.String())
+}
+
+// isReservedIPv6InterfaceID reports whether the interface identifier is the subnet-router anycast one (all zeros) or
+// one of the subnet anycast identifiers fdff:ffff:ffff:ff80 to fdff:ffff:ffff:ffff reserved by RFC 5453
+func isReservedIPv6InterfaceID(iid uint64) bool {
+	return iid == 0 || (iid >= 0xfdffffffffffff80 && iid <= 0xfdffffffffffffff)
+}
+
 // generateIPs generates a list of all possible IPs of the given network excluding IPs specified in the exclusion list
 func generateIPs(ipNet *net.IPNet, exclusions map[string]struct{}) ([]net.IP, int) {
 
@@ -1,6 +1,7 @@
 package server
 
 import (
+	"fmt"
 	"net"
 	"testing"
 
@@ -13,6 +14,13 @@ func TestNewNetwork(t *testing.T) {
 	// generated net should be a subnet of a larger 100.64.0.0/10 net
 	ipNet := net.IPNet{IP: net.ParseIP("100.64.0.0"), Mask: net.IPMask{255, 192, 0, 0}}
 	assert.Equal(t, ipNet.Contains(network.Net.IP), true)
+
+	// generated IPv6 net should be a /64 subnet of the fd00::/8 unique local range
+	ula := net.IPNet{IP: net.ParseIP("fd00::"), Mask: net.CIDRMask(8, 128)}
+	assert.True(t, ula.Contains(network.NetV6.IP))
+	ones, bits := network.NetV6.Mask.Size()
+	assert.Equal(t, SubnetV6Size, ones)
+	assert.Equal(t, 128, bits)
 }
 
 func TestAllocatePeerIP(t *testing.T) {
@@ -38,6 +46,51 @@ func TestAllocatePeerIP(t *testing.T) {
 	}
 }
 
+func TestAllocatePeerIPv6(t *testing.T) {
+	ipNet := NewNetworkV6()
+	var ips []net.IP
+	for i := 0; i < 1000; i++ {
+		ip, err := AllocatePeerIPv6(ipNet, ips)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.True(t, ipNet.Contains(ip), "allocated IP %s is outside of %s", ip, ipNet.String())
+		ips = append(ips, ip)
+	}
+
+	uniq := make(map[string]struct{})
+	for _, ip := range ips {
+		if _, ok := uniq[ip.String()]; ok {
+			t.Errorf("found duplicate IP %s", ip.String())
+		}
+		uniq[ip.String()] = struct{}{}
+	}
+
+	_, err := AllocatePeerIPv6(net.IPNet{IP: net.ParseIP("fd00::"), Mask: net.CIDRMask(48, 128)}, nil)
+	assert.Error(t, err, "should fail on a non /64 prefix")
+}
+
+func TestIsReservedIPv6InterfaceID(t *testing.T) {
+	tests := []struct {
+		iid      uint64
+		reserved bool
+	}{
+		{iid: 0x0000000000000000, reserved: true},
+		{iid: 0x0000000000000001, reserved: false},
+		{iid: 0xfdffffffffffff7f, reserved: false},
+		{iid: 0xfdffffffffffff80, reserved: true},
+		{iid: 0xfdffffffffffffff, reserved: true},
+		{iid: 0xfe00000000000000, reserved: false},
+		{iid: 0xffffffffffffffff, reserved: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%016x", tt.iid), func(t *testing.T) {
+			assert.Equal(t, tt.reserved, isReservedIPv6InterfaceID(tt.iid))
+		})
+	}
+}
+
 func TestGenerateIPs(t *testing.T) {
 	ipNet := net.IPNet{IP: net.ParseIP("100.64.0.0"), Mask: net.IPMask{255, 255, 255, 0}}
 	ips, ipsLen := generateIPs(&ipNet, map[string]struct{}{"100.64.0.0": {}})
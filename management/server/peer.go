@@ -44,6 +44,8 @@ type PeerLogin struct {
 	SetupKey string
 	// ConnectionIP is the real IP of the peer
 	ConnectionIP net.IP
+	// RenewLogin indicates that the peer re-authenticated with a JWT to extend its login before it expired
+	RenewLogin bool
 }
 
 // GetPeers returns a list of peers under the given account filtering out peers that do not belong to a user if
@@ -271,6 +273,7 @@ func (am *DefaultAccountManager) deletePeers(ctx context.Context, account *Accou
 				},
 			})
 		am.peersUpdateManager.CloseChannel(ctx, peer.ID)
+		am.peerLoginRenewals.Delete(peer.ID)
 		am.StoreEvent(ctx, userID, peer.ID, account.Id, activity.PeerRemovedByUser, peer.EventMeta(am.GetDNSDomain()))
 	}
 
@@ -440,6 +443,14 @@ func (am *DefaultAccountManager) AddPeer(ctx context.Context, setupKey, userID s
 		return nil, nil, nil, err
 	}
 
+	var nextIPv6 net.IP
+	if network.HasIPv6() {
+		nextIPv6, err = AllocatePeerIPv6(network.NetV6, account.getTakenIPv6s())
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
 	registrationTime := time.Now().UTC()
 
 	newPeer := &nbpeer.Peer{
@@ -447,6 +458,7 @@ func (am *DefaultAccountManager) AddPeer(ctx context.Context, setupKey, userID s
 		Key:                    peer.Key,
 		SetupKey:               upperKey,
 		IP:                     nextIp,
+		IPv6:                   nextIPv6,
 		Meta:                   peer.Meta,
 		Name:                   peer.Meta.Hostname,
 		DNSLabel:               newLabel,
@@ -664,7 +676,7 @@ func (am *DefaultAccountManager) LoginPeer(ctx context.Context, login PeerLogin)
 	updateRemotePeers := false
 
 	if login.UserID != "" {
-		changed, err := am.handleUserPeer(ctx, peer, settings)
+		changed, err := am.handleUserPeer(ctx, peer, settings, login.RenewLogin)
 		if err != nil {
 			return nil, nil, nil, err
 		}
@@ -797,6 +809,36 @@ func (am *DefaultAccountManager) handleExpiredPeer(ctx context.Context, user *Us
 	return nil
 }
 
+// peerLoginRenewedEventInterval is the minimum time between two PeerLoginRenewed events of a peer
+const peerLoginRenewedEventInterval = time.Hour
+
+// renewPeerLogin extends the login of a peer that re-authenticated before its login expired. The renewals are
+// recorded at most once per peerLoginRenewedEventInterval so the silent renewals of the clients don't flood the events.
+func (am *DefaultAccountManager) renewPeerLogin(ctx context.Context, user *User, peer *nbpeer.Peer) error {
+	err := checkAuth(ctx, user.Id, peer)
+	if err != nil {
+		return err
+	}
+
+	peer = peer.UpdateLastLogin()
+	err = am.Store.SavePeer(ctx, peer.AccountID, peer)
+	if err != nil {
+		return err
+	}
+
+	err = am.Store.SaveUserLastLogin(user.AccountID, user.Id, peer.LastLogin)
+	if err != nil {
+		return err
+	}
+
+	if last, ok := am.peerLoginRenewals.Load(peer.ID); ok && peer.LastLogin.Sub(last.(time.Time)) < peerLoginRenewedEventInterval {
+		return nil
+	}
+	am.peerLoginRenewals.Store(peer.ID, peer.LastLogin)
+	am.StoreEvent(ctx, user.Id, peer.ID, user.AccountID, activity.PeerLoginRenewed, peer.EventMeta(am.GetDNSDomain()))
+	return nil
+}
+
 func checkIfPeerOwnerIsBlocked(peer *nbpeer.Peer, user *User) error {
 	if peer.AddedWithSSOLogin() {
 		if user.IsBlocked() {
@@ -20,6 +20,8 @@ type Peer struct {
 	SetupKey string
 	// IP address of the Peer
 	IP net.IP `gorm:"serializer:json"`
+	// IPv6 is the overlay IPv6 address of the Peer allocated from the Network.NetV6 prefix
+	IPv6 net.IP `gorm:"serializer:json"`
 	// Meta is a Peer system meta data
 	Meta PeerSystemMeta `gorm:"embedded;embeddedPrefix:meta_"`
 	// Name is peer's name (machine name)
@@ -175,6 +177,7 @@ func (p *Peer) Copy() *Peer {
 		Key:                    p.Key,
 		SetupKey:               p.SetupKey,
 		IP:                     p.IP,
+		IPv6:                   p.IPv6,
 		Meta:                   p.Meta,
 		Name:                   p.Name,
 		DNSLabel:               p.DNSLabel,
@@ -235,6 +238,15 @@ func (p *Peer) LoginExpired(expiresIn time.Duration) (bool, time.Duration) {
 	return timeLeft <= 0, timeLeft
 }
 
+// LoginExpiresAt returns when the login of the peer expires, zero if its login doesn't expire.
+// The account level Settings.PeerLoginExpirationEnabled is not considered.
+func (p *Peer) LoginExpiresAt(expiresIn time.Duration) time.Time {
+	if !p.AddedWithSSOLogin() || !p.LoginExpirationEnabled {
+		return time.Time{}
+	}
+	return p.LastLogin.Add(expiresIn)
+}
+
 // FQDN returns peers FQDN combined of the peer's DNS label and the system's DNS domain
 func (p *Peer) FQDN(dnsDomain string) string {
 	if dnsDomain == "" {
@@ -78,6 +78,19 @@ func TestPeer_LoginExpired(t *testing.T) {
 	}
 }
 
+func TestPeer_LoginExpiresAt(t *testing.T) {
+	lastLogin := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	peer := &nbpeer.Peer{LoginExpirationEnabled: true, LastLogin: lastLogin, UserID: userID}
+	assert.Equal(t, lastLogin.Add(time.Hour), peer.LoginExpiresAt(time.Hour))
+
+	peer.LoginExpirationEnabled = false
+	assert.True(t, peer.LoginExpiresAt(time.Hour).IsZero(), "the login of the peer doesn't expire")
+
+	peer = &nbpeer.Peer{LoginExpirationEnabled: true, LastLogin: lastLogin}
+	assert.True(t, peer.LoginExpiresAt(time.Hour).IsZero(), "peers added with a setup key don't expire")
+}
+
 func TestAccountManager_GetNetworkMap(t *testing.T) {
 	manager, err := createManager(t)
 	if err != nil {
@@ -903,6 +916,7 @@ func TestToSyncResponse(t *testing.T) {
 		FirewallRules: []*FirewallRule{
 			{PeerIP: "192.168.1.2", Direction: firewallRuleDirectionIN, Action: string(PolicyTrafficActionAccept), Protocol: string(PolicyRuleProtocolTCP), Port: "80"},
 		},
+		LoginExpiresAt: time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC),
 	}
 	dnsName := "example.com"
 	checks := []*posture.Checks{
@@ -923,6 +937,7 @@ func TestToSyncResponse(t *testing.T) {
 	assert.Equal(t, "192.168.1.1/24", response.PeerConfig.Address)
 	assert.Equal(t, "peer1.example.com", response.PeerConfig.Fqdn)
 	assert.Equal(t, true, response.PeerConfig.SshConfig.SshEnabled)
+	assert.Equal(t, networkMap.LoginExpiresAt, response.PeerConfig.GetLoginExpiresAt().AsTime())
 	// assert wiretrustee config
 	assert.Equal(t, "signal.uri", response.WiretrusteeConfig.Signal.Uri)
 	assert.Equal(t, proto.HostConfig_HTTPS, response.WiretrusteeConfig.Signal.GetProtocol())
@@ -3,8 +3,10 @@ package server
 import (
 	"context"
 	_ "embed"
+	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 
@@ -109,6 +111,20 @@ type PolicyRule struct {
 
 	// Ports or it ranges list
 	Ports []string `gorm:"serializer:json"`
+
+	// PortRanges a list of port ranges
+	PortRanges []RulePortRange `gorm:"serializer:json"`
+}
+
+// RulePortRange represents a range of ports for a firewall rule
+type RulePortRange struct {
+	Start uint16
+	End   uint16
+}
+
+// String returns the port range in the "start-end" format
+func (r RulePortRange) String() string {
+	return fmt.Sprintf("%d-%d", r.Start, r.End)
 }
 
 // Copy returns a copy of a policy rule
@@ -124,10 +140,12 @@ func (pm *PolicyRule) Copy() *PolicyRule {
 		Bidirectional: pm.Bidirectional,
 		Protocol:      pm.Protocol,
 		Ports:         make([]string, len(pm.Ports)),
+		PortRanges:    make([]RulePortRange, len(pm.PortRanges)),
 	}
 	copy(rule.Destinations, pm.Destinations)
 	copy(rule.Sources, pm.Sources)
 	copy(rule.Ports, pm.Ports)
+	copy(rule.PortRanges, pm.PortRanges)
 	return rule
 }
 
@@ -153,6 +171,9 @@ type Policy struct {
 
 	// SourcePostureChecks are ID references to Posture checks for policy source groups
 	SourcePostureChecks []string `gorm:"serializer:json"`
+
+	// Schedule limits the time when the policy is in effect. Nil means the policy is always in effect.
+	Schedule *PolicySchedule `gorm:"serializer:json"`
 }
 
 // Copy returns a copy of the policy.
@@ -164,6 +185,7 @@ func (p *Policy) Copy() *Policy {
 		Enabled:             p.Enabled,
 		Rules:               make([]*PolicyRule, len(p.Rules)),
 		SourcePostureChecks: make([]string, len(p.SourcePostureChecks)),
+		Schedule:            p.Schedule.Copy(),
 	}
 	for i, r := range p.Rules {
 		c.Rules[i] = r.Copy()
@@ -207,6 +229,9 @@ type FirewallRule struct {
 
 	// Port of the traffic
 	Port string
+
+	// PortRange of the traffic, set instead of Port when the rule applies to a range of ports
+	PortRange RulePortRange
 }
 
 // getPeerConnectionResources for a given peer
@@ -214,8 +239,9 @@ type FirewallRule struct {
 // This function returns the list of peers and firewall rules that are applicable to a given peer.
 func (a *Account) getPeerConnectionResources(ctx context.Context, peerID string, validatedPeersMap map[string]struct{}) ([]*nbpeer.Peer, []*FirewallRule) {
 	generateResources, getAccumulatedResources := a.connResourcesGenerator(ctx)
+	now := time.Now().UTC()
 	for _, policy := range a.Policies {
-		if !policy.Enabled {
+		if !policy.Enabled || !policy.Schedule.IsActive(now) {
 			continue
 		}
 
@@ -278,33 +304,45 @@ func (a *Account) connResourcesGenerator(ctx context.Context) (func(*PolicyRule,
 					peersExists[peer.ID] = struct{}{}
 				}
 
-				fr := FirewallRule{
-					PeerIP:    peer.IP.String(),
-					Direction: direction,
-					Action:    string(rule.Action),
-					Protocol:  string(rule.Protocol),
-				}
-
+				peerIPs := []string{peer.IP.String()}
 				if isAll {
-					fr.PeerIP = "0.0.0.0"
+					peerIPs = []string{"0.0.0.0"}
+				} else if a.Settings != nil && a.Settings.IPv6Enabled && peer.IPv6 != nil {
+					// the peer is reachable on its IPv6 address too, the rule has to cover it
+					peerIPs = append(peerIPs, peer.IPv6.String())
 				}
 
-				ruleID := rule.ID + fr.PeerIP + strconv.Itoa(direction) +
-					fr.Protocol + fr.Action + strings.Join(rule.Ports, ",")
-				if _, ok := rulesExists[ruleID]; ok {
-					continue
-				}
-				rulesExists[ruleID] = struct{}{}
-
-				if len(rule.Ports) == 0 {
-					rules = append(rules, &fr)
-					continue
-				}
-
-				for _, port := range rule.Ports {
-					pr := fr // clone rule and add set new port
-					pr.Port = port
-					rules = append(rules, &pr)
+				for _, peerIP := range peerIPs {
+					fr := FirewallRule{
+						PeerIP:    peerIP,
+						Direction: direction,
+						Action:    string(rule.Action),
+						Protocol:  string(rule.Protocol),
+					}
+
+					ruleID := rule.ID + fr.PeerIP + strconv.Itoa(direction) +
+						fr.Protocol + fr.Action + strings.Join(rule.Ports, ",") + portRangesString(rule.PortRanges)
+					if _, ok := rulesExists[ruleID]; ok {
+						continue
+					}
+					rulesExists[ruleID] = struct{}{}
+
+					if len(rule.Ports) == 0 && len(rule.PortRanges) == 0 {
+						rules = append(rules, &fr)
+						continue
+					}
+
+					for _, port := range rule.Ports {
+						pr := fr // clone rule and add set new port
+						pr.Port = port
+						rules = append(rules, &pr)
+					}
+
+					for _, portRange := range rule.PortRanges {
+						pr := fr // clone rule and set new port range
+						pr.PortRange = portRange
+						rules = append(rules, &pr)
+					}
 				}
 			}
 		}, func() ([]*nbpeer.Peer, []*FirewallRule) {
@@ -350,6 +388,10 @@ func (am *DefaultAccountManager) SavePolicy(ctx context.Context, accountID, user
 		return err
 	}
 
+	if err = policy.Schedule.Validate(); err != nil {
+		return err
+	}
+
 	exists := am.savePolicy(account, policy)
 
 	account.Network.IncSerial()
@@ -357,6 +399,8 @@ func (am *DefaultAccountManager) SavePolicy(ctx context.Context, accountID, user
 		return err
 	}
 
+	am.checkAndSchedulePolicyTransitions(ctx, account)
+
 	action := activity.PolicyAdded
 	if exists {
 		action = activity.PolicyUpdated
@@ -388,6 +432,8 @@ func (am *DefaultAccountManager) DeletePolicy(ctx context.Context, accountID, po
 		return err
 	}
 
+	am.checkAndSchedulePolicyTransitions(ctx, account)
+
 	am.StoreEvent(ctx, userID, policy.ID, accountID, activity.PolicyRemoved, policy.EventMeta())
 
 	am.updateAccountPeers(ctx, account)
@@ -479,10 +525,36 @@ func toProtocolFirewallRules(update []*FirewallRule) []*proto.FirewallRule {
 			Protocol:  protocol,
 			Port:      update[i].Port,
 		}
+
+		if update[i].PortRange.Start != 0 {
+			// clients without port range support fail to parse the range in the Port field and skip the rule
+			// instead of treating it as a rule for all ports
+			result[i].Port = update[i].PortRange.String()
+			result[i].PortInfo = &proto.PortInfo{
+				PortSelection: &proto.PortInfo_Range_{
+					Range: &proto.PortInfo_Range{
+						Start: uint32(update[i].PortRange.Start),
+						End:   uint32(update[i].PortRange.End),
+					},
+				},
+			}
+		} else if port, err := strconv.ParseUint(update[i].Port, 10, 16); err == nil {
+			result[i].PortInfo = &proto.PortInfo{
+				PortSelection: &proto.PortInfo_Port{Port: uint32(port)},
+			}
+		}
 	}
 	return result
 }
 
+func portRangesString(portRanges []RulePortRange) string {
+	ranges := make([]string, 0, len(portRanges))
+	for _, portRange := range portRanges {
+		ranges = append(ranges, portRange.String())
+	}
+	return strings.Join(ranges, ",")
+}
+
 // getAllPeersFromGroups for given peer ID and list of groups
 //
 // Returns a list of peers from specified groups that pass specified posture checks
@@ -0,0 +1,279 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/netbirdio/netbird/management/server/status"
+)
+
+const (
+	// policyScheduleTimeLayout is the layout of the time-of-day boundaries of a PolicyTimeWindow
+	policyScheduleTimeLayout = "15:04"
+	// policyScheduleLookAheadDays limits how many days ahead the next schedule transition is searched for
+	policyScheduleLookAheadDays = 8
+)
+
+// PolicySchedule limits the time when a policy is in effect.
+// An empty schedule (no days and no windows) is active all the time until it expires.
+type PolicySchedule struct {
+	// Days of the week when the policy is active. Empty means every day.
+	// For windows crossing midnight the day refers to the day the window starts.
+	Days []time.Weekday
+
+	// Windows are time-of-day intervals when the policy is active. Empty means the whole day.
+	Windows []PolicyTimeWindow
+
+	// Timezone is an IANA time zone name used to evaluate Days and Windows. Empty means UTC.
+	Timezone string
+
+	// ExpiresAt is an absolute time after which the policy is no longer active
+	ExpiresAt *time.Time
+}
+
+// PolicyTimeWindow is a time-of-day interval in the "15:04" format.
+// If End is not after Start the window spans midnight.
+type PolicyTimeWindow struct {
+	Start string
+	End   string
+}
+
+// Copy returns a copy of the policy schedule
+func (s *PolicySchedule) Copy() *PolicySchedule {
+	if s == nil {
+		return nil
+	}
+	c := &PolicySchedule{
+		Days:     make([]time.Weekday, len(s.Days)),
+		Windows:  make([]PolicyTimeWindow, len(s.Windows)),
+		Timezone: s.Timezone,
+	}
+	copy(c.Days, s.Days)
+	copy(c.Windows, s.Windows)
+	if s.ExpiresAt != nil {
+		expiresAt := *s.ExpiresAt
+		c.ExpiresAt = &expiresAt
+	}
+	return c
+}
+
+// Validate checks that the schedule days, windows and timezone are well-formed
+func (s *PolicySchedule) Validate() error {
+	if s == nil {
+		return nil
+	}
+
+	if _, err := time.LoadLocation(s.Timezone); err != nil {
+		return status.Errorf(status.InvalidArgument, "invalid policy schedule timezone %q", s.Timezone)
+	}
+
+	for _, day := range s.Days {
+		if day < time.Sunday || day > time.Saturday {
+			return status.Errorf(status.InvalidArgument, "invalid policy schedule day %d", day)
+		}
+	}
+
+	for _, window := range s.Windows {
+		start, err := time.Parse(policyScheduleTimeLayout, window.Start)
+		if err != nil {
+			return status.Errorf(status.InvalidArgument, "invalid policy schedule window start %q, expected HH:MM", window.Start)
+		}
+		end, err := time.Parse(policyScheduleTimeLayout, window.End)
+		if err != nil {
+			return status.Errorf(status.InvalidArgument, "invalid policy schedule window end %q, expected HH:MM", window.End)
+		}
+		if start.Equal(end) {
+			return status.Errorf(status.InvalidArgument, "policy schedule window start and end shouldn't be equal")
+		}
+	}
+
+	return nil
+}
+
+// IsActive returns true if the schedule allows the policy to be in effect at the given time
+func (s *PolicySchedule) IsActive(now time.Time) bool {
+	if s == nil {
+		return true
+	}
+
+	if s.ExpiresAt != nil && !now.Before(*s.ExpiresAt) {
+		return false
+	}
+
+	loc, err := time.LoadLocation(s.Timezone)
+	if err != nil {
+		return false
+	}
+	now = now.In(loc)
+
+	// windows which started yesterday may still be open
+	for _, dayStart := range []time.Time{startOfDay(now), startOfDay(now).AddDate(0, 0, -1)} {
+		for _, interval := range s.intervals(dayStart) {
+			if !now.Before(interval[0]) && now.Before(interval[1]) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// NextTransition returns the duration until the schedule may change its active state.
+// Returns false if the schedule will not change anymore.
+func (s *PolicySchedule) NextTransition(now time.Time) (time.Duration, bool) {
+	if s == nil {
+		return 0, false
+	}
+
+	if s.ExpiresAt != nil && !now.Before(*s.ExpiresAt) {
+		return 0, false
+	}
+
+	loc, err := time.LoadLocation(s.Timezone)
+	if err != nil {
+		return 0, false
+	}
+	now = now.In(loc)
+
+	var next time.Time
+	consider := func(t time.Time) {
+		if t.After(now) && (next.IsZero() || t.Before(next)) {
+			next = t
+		}
+	}
+
+	if len(s.Days) != 0 || len(s.Windows) != 0 {
+		for i := -1; i < policyScheduleLookAheadDays; i++ {
+			for _, interval := range s.intervals(startOfDay(now).AddDate(0, 0, i)) {
+				consider(interval[0])
+				consider(interval[1])
+			}
+		}
+	}
+
+	if s.ExpiresAt != nil {
+		consider(*s.ExpiresAt)
+	}
+
+	if next.IsZero() {
+		return 0, false
+	}
+
+	return next.Sub(now), true
+}
+
+// intervals returns the [start, end) intervals of the schedule that begin on the given day
+func (s *PolicySchedule) intervals(dayStart time.Time) [][2]time.Time {
+	if !s.includesDay(dayStart.Weekday()) {
+		return nil
+	}
+
+	nextDayStart := dayStart.AddDate(0, 0, 1)
+	if len(s.Windows) == 0 {
+		return [][2]time.Time{{dayStart, nextDayStart}}
+	}
+
+	intervals := make([][2]time.Time, 0, len(s.Windows))
+	for _, window := range s.Windows {
+		start, err := time.Parse(policyScheduleTimeLayout, window.Start)
+		if err != nil {
+			continue
+		}
+		end, err := time.Parse(policyScheduleTimeLayout, window.End)
+		if err != nil {
+			continue
+		}
+
+		startAt := atTimeOfDay(dayStart, start)
+		endAt := atTimeOfDay(dayStart, end)
+		if !endAt.After(startAt) {
+			endAt = atTimeOfDay(nextDayStart, end)
+		}
+		intervals = append(intervals, [2]time.Time{startAt, endAt})
+	}
+
+	return intervals
+}
+
+func (s *PolicySchedule) includesDay(day time.Weekday) bool {
+	if len(s.Days) == 0 {
+		return true
+	}
+	for _, d := range s.Days {
+		if d == day {
+			return true
+		}
+	}
+	return false
+}
+
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+func atTimeOfDay(dayStart, clock time.Time) time.Time {
+	return time.Date(dayStart.Year(), dayStart.Month(), dayStart.Day(), clock.Hour(), clock.Minute(), 0, 0, dayStart.Location())
+}
+
+// GetNextPolicyTransition returns the duration until the earliest policy schedule of the account
+// may change its active state. Returns false if none of the enabled policies have a pending transition.
+func (a *Account) GetNextPolicyTransition() (time.Duration, bool) {
+	now := time.Now().UTC()
+
+	var nextRun *time.Duration
+	for _, policy := range a.Policies {
+		if !policy.Enabled || policy.Schedule == nil {
+			continue
+		}
+
+		next, ok := policy.Schedule.NextTransition(now)
+		if !ok {
+			continue
+		}
+
+		if nextRun == nil || *nextRun > next {
+			nextRun = &next
+		}
+	}
+
+	if nextRun == nil {
+		return 0, false
+	}
+
+	// add a small margin to avoid evaluating the schedule right before the transition
+	return *nextRun + time.Second, true
+}
+
+// policyScheduleJob recomputes and pushes network maps of the account when a policy schedule window opens or closes
+func (am *DefaultAccountManager) policyScheduleJob(ctx context.Context, accountID string) func() (time.Duration, bool) {
+	return func() (time.Duration, bool) {
+		unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+		defer unlock()
+
+		account, err := am.Store.GetAccount(ctx, accountID)
+		if err != nil {
+			log.WithContext(ctx).Errorf("failed getting account %s to apply policy schedules: %v", accountID, err)
+			return 0, false
+		}
+
+		account.Network.IncSerial()
+		if err = am.Store.SaveAccount(ctx, account); err != nil {
+			log.WithContext(ctx).Errorf("failed saving account %s while applying policy schedules: %v", accountID, err)
+			return account.GetNextPolicyTransition()
+		}
+
+		log.WithContext(ctx).Debugf("policy schedule transition for account %s, updating peers", accountID)
+		am.updateAccountPeers(ctx, account)
+
+		return account.GetNextPolicyTransition()
+	}
+}
+
+func (am *DefaultAccountManager) checkAndSchedulePolicyTransitions(ctx context.Context, account *Account) {
+	am.policySchedules.Cancel(ctx, []string{account.Id})
+	if nextRun, ok := account.GetNextPolicyTransition(); ok {
+		go am.policySchedules.Schedule(ctx, nextRun, account.Id, am.policyScheduleJob(ctx, account.Id))
+	}
+}
@@ -0,0 +1,184 @@
+package server
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	nbgroup "github.com/netbirdio/netbird/management/server/group"
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+)
+
+func TestPolicySchedule_IsActive(t *testing.T) {
+	// Monday
+	monday := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	expiresAt := monday.Add(12 * time.Hour)
+
+	tt := []struct {
+		name     string
+		schedule *PolicySchedule
+		at       time.Time
+		active   bool
+	}{
+		{
+			name:     "nil schedule is always active",
+			schedule: nil,
+			at:       monday,
+			active:   true,
+		},
+		{
+			name:     "empty schedule is always active",
+			schedule: &PolicySchedule{},
+			at:       monday,
+			active:   true,
+		},
+		{
+			name:     "inside a window",
+			schedule: &PolicySchedule{Windows: []PolicyTimeWindow{{Start: "09:00", End: "17:00"}}},
+			at:       monday.Add(10 * time.Hour),
+			active:   true,
+		},
+		{
+			name:     "window end is exclusive",
+			schedule: &PolicySchedule{Windows: []PolicyTimeWindow{{Start: "09:00", End: "17:00"}}},
+			at:       monday.Add(17 * time.Hour),
+			active:   false,
+		},
+		{
+			name:     "day not included",
+			schedule: &PolicySchedule{Days: []time.Weekday{time.Tuesday}},
+			at:       monday.Add(10 * time.Hour),
+			active:   false,
+		},
+		{
+			name: "overnight window started the previous day",
+			schedule: &PolicySchedule{
+				Days:    []time.Weekday{time.Sunday},
+				Windows: []PolicyTimeWindow{{Start: "22:00", End: "02:00"}},
+			},
+			at:     monday.Add(time.Hour),
+			active: true,
+		},
+		{
+			name: "window evaluated in timezone",
+			schedule: &PolicySchedule{
+				Windows:  []PolicyTimeWindow{{Start: "09:00", End: "17:00"}},
+				Timezone: "America/New_York",
+			},
+			// 10:00 UTC is 05:00 in New York
+			at:     monday.Add(10 * time.Hour),
+			active: false,
+		},
+		{
+			name:     "expired",
+			schedule: &PolicySchedule{ExpiresAt: &expiresAt},
+			at:       expiresAt,
+			active:   false,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.active, tc.schedule.IsActive(tc.at))
+		})
+	}
+}
+
+func TestPolicySchedule_NextTransition(t *testing.T) {
+	monday := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	schedule := &PolicySchedule{
+		Days:    []time.Weekday{time.Monday},
+		Windows: []PolicyTimeWindow{{Start: "09:00", End: "17:00"}},
+	}
+
+	next, ok := schedule.NextTransition(monday.Add(8 * time.Hour))
+	assert.True(t, ok)
+	assert.Equal(t, time.Hour, next, "window should open at 09:00")
+
+	next, ok = schedule.NextTransition(monday.Add(10 * time.Hour))
+	assert.True(t, ok)
+	assert.Equal(t, 7*time.Hour, next, "window should close at 17:00")
+
+	next, ok = schedule.NextTransition(monday.Add(18 * time.Hour))
+	assert.True(t, ok)
+	assert.Equal(t, 7*24*time.Hour-9*time.Hour, next, "window should open next Monday")
+
+	expiresAt := monday.Add(12 * time.Hour)
+	schedule.ExpiresAt = &expiresAt
+	next, ok = schedule.NextTransition(monday.Add(10 * time.Hour))
+	assert.True(t, ok)
+	assert.Equal(t, 2*time.Hour, next, "policy should expire before the window closes")
+
+	_, ok = schedule.NextTransition(expiresAt)
+	assert.False(t, ok, "expired schedule should not transition anymore")
+
+	_, ok = (&PolicySchedule{}).NextTransition(monday)
+	assert.False(t, ok, "empty schedule should not transition")
+}
+
+func TestPolicySchedule_Validate(t *testing.T) {
+	assert.NoError(t, (&PolicySchedule{
+		Days:     []time.Weekday{time.Monday},
+		Windows:  []PolicyTimeWindow{{Start: "22:00", End: "06:00"}},
+		Timezone: "Europe/Berlin",
+	}).Validate())
+	assert.Error(t, (&PolicySchedule{Timezone: "Invalid/Zone"}).Validate())
+	assert.Error(t, (&PolicySchedule{Days: []time.Weekday{7}}).Validate())
+	assert.Error(t, (&PolicySchedule{Windows: []PolicyTimeWindow{{Start: "25:00", End: "06:00"}}}).Validate())
+	assert.Error(t, (&PolicySchedule{Windows: []PolicyTimeWindow{{Start: "06:00", End: "06:00"}}}).Validate())
+}
+
+func TestAccount_getPeersByPolicy_Schedule(t *testing.T) {
+	account := &Account{
+		Peers: map[string]*nbpeer.Peer{
+			"peerA": {ID: "peerA", IP: net.ParseIP("100.65.0.1"), Status: &nbpeer.PeerStatus{}},
+			"peerB": {ID: "peerB", IP: net.ParseIP("100.65.0.2"), Status: &nbpeer.PeerStatus{}},
+		},
+		Groups: map[string]*nbgroup.Group{
+			"groupA": {ID: "groupA", Name: "A", Peers: []string{"peerA"}},
+			"groupB": {ID: "groupB", Name: "B", Peers: []string{"peerB"}},
+		},
+		Policies: []*Policy{
+			{
+				ID:      "scheduled",
+				Enabled: true,
+				Rules: []*PolicyRule{
+					{
+						ID:            "scheduled",
+						Enabled:       true,
+						Action:        PolicyTrafficActionAccept,
+						Protocol:      PolicyRuleProtocolALL,
+						Bidirectional: true,
+						Sources:       []string{"groupA"},
+						Destinations:  []string{"groupB"},
+					},
+				},
+			},
+		},
+	}
+	validatedPeers := map[string]struct{}{"peerA": {}, "peerB": {}}
+
+	peers, _ := account.getPeerConnectionResources(context.Background(), "peerA", validatedPeers)
+	assert.Len(t, peers, 1, "policy without schedule should be in effect")
+
+	expired := time.Now().Add(-time.Hour)
+	account.Policies[0].Schedule = &PolicySchedule{ExpiresAt: &expired}
+	peers, _ = account.getPeerConnectionResources(context.Background(), "peerA", validatedPeers)
+	assert.Len(t, peers, 0, "expired policy should not be in effect")
+
+	_, ok := account.GetNextPolicyTransition()
+	assert.False(t, ok, "expired policy should not be scheduled")
+
+	expiresAt := time.Now().Add(time.Hour)
+	account.Policies[0].Schedule = &PolicySchedule{ExpiresAt: &expiresAt}
+	peers, _ = account.getPeerConnectionResources(context.Background(), "peerA", validatedPeers)
+	assert.Len(t, peers, 1, "policy should be in effect before it expires")
+
+	next, ok := account.GetNextPolicyTransition()
+	assert.True(t, ok)
+	assert.InDelta(t, time.Hour, next, float64(5*time.Second))
+}
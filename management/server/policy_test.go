@@ -487,6 +487,112 @@ func TestAccount_getPeersByPolicyDirect(t *testing.T) {
 	})
 }
 
+func TestAccount_getPeersByPolicyIPv6(t *testing.T) {
+	account := &Account{
+		Peers: map[string]*nbpeer.Peer{
+			"peerA": {
+				ID:     "peerA",
+				IP:     net.ParseIP("100.65.14.88"),
+				IPv6:   net.ParseIP("fd12:3456:789a::1"),
+				Status: &nbpeer.PeerStatus{},
+			},
+			"peerB": {
+				ID:     "peerB",
+				IP:     net.ParseIP("100.65.80.39"),
+				IPv6:   net.ParseIP("fd12:3456:789a::2"),
+				Status: &nbpeer.PeerStatus{},
+			},
+			"peerC": {
+				ID:     "peerC",
+				IP:     net.ParseIP("100.65.254.139"),
+				Status: &nbpeer.PeerStatus{},
+			},
+		},
+		Groups: map[string]*nbgroup.Group{
+			"GroupAll": {
+				ID:    "GroupAll",
+				Name:  "All",
+				Peers: []string{"peerA", "peerB", "peerC"},
+			},
+			"GroupSSH": {
+				ID:    "GroupSSH",
+				Name:  "ssh",
+				Peers: []string{"peerA"},
+			},
+			"GroupServers": {
+				ID:    "GroupServers",
+				Name:  "servers",
+				Peers: []string{"peerB"},
+			},
+		},
+		Policies: []*Policy{
+			{
+				ID:      "RuleSSH",
+				Name:    "SSH",
+				Enabled: true,
+				Rules: []*PolicyRule{
+					{
+						ID:           "RuleSSH",
+						Name:         "SSH",
+						Enabled:      true,
+						Protocol:     PolicyRuleProtocolTCP,
+						Action:       PolicyTrafficActionAccept,
+						Ports:        []string{"22"},
+						Sources:      []string{"GroupSSH"},
+						Destinations: []string{"GroupServers"},
+					},
+				},
+			},
+		},
+		Settings: &Settings{},
+	}
+
+	approvedPeers := make(map[string]struct{})
+	for p := range account.Peers {
+		approvedPeers[p] = struct{}{}
+	}
+
+	t.Run("IPv6 disabled", func(t *testing.T) {
+		account.Settings.IPv6Enabled = false
+		_, firewallRules := account.getPeerConnectionResources(context.Background(), "peerB", approvedPeers)
+
+		expectedFirewallRules := []*FirewallRule{
+			{
+				PeerIP:    "100.65.14.88",
+				Direction: firewallRuleDirectionIN,
+				Action:    "accept",
+				Protocol:  "tcp",
+				Port:      "22",
+			},
+		}
+		assert.Equal(t, expectedFirewallRules, firewallRules)
+	})
+
+	t.Run("IPv6 enabled", func(t *testing.T) {
+		account.Settings.IPv6Enabled = true
+		_, firewallRules := account.getPeerConnectionResources(context.Background(), "peerB", approvedPeers)
+
+		expectedFirewallRules := []*FirewallRule{
+			{
+				PeerIP:    "100.65.14.88",
+				Direction: firewallRuleDirectionIN,
+				Action:    "accept",
+				Protocol:  "tcp",
+				Port:      "22",
+			},
+			{
+				PeerIP:    "fd12:3456:789a::1",
+				Direction: firewallRuleDirectionIN,
+				Action:    "accept",
+				Protocol:  "tcp",
+				Port:      "22",
+			},
+		}
+		slices.SortFunc(firewallRules, sortFunc())
+		assert.Equal(t, expectedFirewallRules, firewallRules)
+	})
+}
+
 func TestAccount_getPeersByPolicyPostureChecks(t *testing.T) {
 	account := &Account{
 		Peers: map[string]*nbpeer.Peer{
@@ -808,6 +914,27 @@ func TestAccount_getPeersByPolicyPostureChecks(t *testing.T) {
 	})
 }
 
+func TestToProtocolFirewallRules_Ports(t *testing.T) {
+	rules := toProtocolFirewallRules([]*FirewallRule{
+		{PeerIP: "100.65.1.1", Direction: firewallRuleDirectionIN, Action: "accept", Protocol: "tcp"},
+		{PeerIP: "100.65.1.1", Direction: firewallRuleDirectionIN, Action: "accept", Protocol: "tcp", Port: "80"},
+		{PeerIP: "100.65.1.1", Direction: firewallRuleDirectionIN, Action: "accept", Protocol: "tcp", PortRange: RulePortRange{Start: 30000, End: 32767}},
+	})
+	assert.Len(t, rules, 3)
+
+	assert.Empty(t, rules[0].Port)
+	assert.Nil(t, rules[0].PortInfo)
+
+	assert.Equal(t, "80", rules[1].Port)
+	assert.Equal(t, uint32(80), rules[1].PortInfo.GetPort())
+	assert.Nil(t, rules[1].PortInfo.GetRange())
+
+	// legacy Port field carries an unparsable value, so old clients skip the rule instead of allowing all ports
+	assert.Equal(t, "30000-32767", rules[2].Port)
+	assert.Equal(t, uint32(30000), rules[2].PortInfo.GetRange().GetStart())
+	assert.Equal(t, uint32(32767), rules[2].PortInfo.GetRange().GetEnd())
+}
+
 func sortFunc() func(a *FirewallRule, b *FirewallRule) int {
 	return func(a, b *FirewallRule) int {
 		// Concatenate PeerIP and Direction as string for comparison
@@ -59,15 +59,9 @@ func (am *DefaultAccountManager) SavePostureChecks(ctx context.Context, accountI
 		return status.Errorf(status.PermissionDenied, errMsgPostureAdminOnly)
 	}
 
-	if err := postureChecks.Validate(); err != nil {
-		return status.Errorf(status.InvalidArgument, err.Error()) //nolint
-	}
-
-	exists, uniqName := am.savePostureChecks(account, postureChecks)
-
-	// we do not allow create new posture checks with non uniq name
-	if !exists && !uniqName {
-		return status.Errorf(status.PreconditionFailed, "Posture check name should be unique")
+	exists, err := am.validateAndSavePostureChecks(account, postureChecks)
+	if err != nil {
+		return err
 	}
 
 	action := activity.PostureCheckCreated
@@ -141,6 +135,22 @@ func (am *DefaultAccountManager) ListPostureChecks(ctx context.Context, accountI
 	return account.PostureChecks, nil
 }
 
+// validateAndSavePostureChecks validates the posture checks and puts them in the account
+func (am *DefaultAccountManager) validateAndSavePostureChecks(account *Account, postureChecks *posture.Checks) (exists bool, err error) {
+	if err := postureChecks.Validate(); err != nil {
+		return false, status.Errorf(status.InvalidArgument, err.Error()) //nolint
+	}
+
+	exists, uniqName := am.savePostureChecks(account, postureChecks)
+
+	// we do not allow create new posture checks with non uniq name
+	if !exists && !uniqName {
+		return false, status.Errorf(status.PreconditionFailed, "Posture check name should be unique")
+	}
+
+	return exists, nil
+}
+
 func (am *DefaultAccountManager) savePostureChecks(account *Account, postureChecks *posture.Checks) (exists, uniqName bool) {
 	uniqName = true
 	for i, p := range account.PostureChecks {
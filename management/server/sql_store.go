@@ -70,6 +70,7 @@ func NewSqlStore(ctx context.Context, db *gorm.DB, storeEngine StoreEngine, metr
 		&SetupKey{}, &nbpeer.Peer{}, &User{}, &PersonalAccessToken{}, &nbgroup.Group{},
 		&Account{}, &Policy{}, &PolicyRule{}, &route.Route{}, &nbdns.NameServerGroup{},
 		&installation{}, &account.ExtraSettings{}, &posture.Checks{}, &nbpeer.NetworkAddress{},
+		&AccessRequest{}, &Webhook{}, &WebhookDelivery{}, &DNSRecord{},
 	)
 	if err != nil {
 		return nil, fmt.Errorf("auto migrate: %w", err)
@@ -252,6 +253,11 @@ func (s *SqlStore) DeleteAccount(ctx context.Context, account *Account) error {
 			return result.Error
 		}
 
+		result = tx.Delete(&WebhookDelivery{}, "account_id = ?", account.Id)
+		if result.Error != nil {
+			return result.Error
+		}
+
 		return nil
 	})
 
@@ -749,6 +755,60 @@ func (s *SqlStore) GetPostureCheckByChecksDefinition(accountID string, checks *p
 	return &postureCheck, nil
 }
 
+func (s *SqlStore) GetAccountWebhooks(ctx context.Context, accountID string) ([]*Webhook, error) {
+	var webhooks []*Webhook
+	if err := s.db.Find(&webhooks, "account_id = ?", accountID).Error; err != nil {
+		log.WithContext(ctx).Errorf("error when getting webhooks from the store: %s", err)
+		return nil, status.Errorf(status.Internal, "issue getting webhooks from store")
+	}
+	return webhooks, nil
+}
+
+func (s *SqlStore) SaveWebhookDeliveries(ctx context.Context, deliveries []*WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+	if err := s.db.Clauses(clause.OnConflict{UpdateAll: true}).Create(&deliveries).Error; err != nil {
+		log.WithContext(ctx).Errorf("error when saving webhook deliveries to the store: %s", err)
+		return status.Errorf(status.Internal, "issue saving webhook deliveries to store")
+	}
+	return nil
+}
+
+func (s *SqlStore) GetWebhookDeliveries(ctx context.Context, accountID, webhookID string, limit int) ([]*WebhookDelivery, error) {
+	var deliveries []*WebhookDelivery
+	err := s.db.Where("account_id = ? AND webhook_id = ?", accountID, webhookID).
+		Order("created_at DESC").
+		Limit(limit).
+		Find(&deliveries).Error
+	if err != nil {
+		log.WithContext(ctx).Errorf("error when getting webhook deliveries from the store: %s", err)
+		return nil, status.Errorf(status.Internal, "issue getting webhook deliveries from store")
+	}
+	return deliveries, nil
+}
+
+func (s *SqlStore) GetDueWebhookDeliveries(ctx context.Context, now time.Time, limit int) ([]*WebhookDelivery, error) {
+	var deliveries []*WebhookDelivery
+	err := s.db.Where("status = ? AND next_attempt_at <= ?", WebhookDeliveryStatusPending, now).
+		Order("next_attempt_at").
+		Limit(limit).
+		Find(&deliveries).Error
+	if err != nil {
+		log.WithContext(ctx).Errorf("error when getting due webhook deliveries from the store: %s", err)
+		return nil, status.Errorf(status.Internal, "issue getting webhook deliveries from store")
+	}
+	return deliveries, nil
+}
+
+func (s *SqlStore) DeleteWebhookDeliveries(_ context.Context, accountID, webhookID string) error {
+	return s.db.Delete(&WebhookDelivery{}, "account_id = ? AND webhook_id = ?", accountID, webhookID).Error
+}
+
+func (s *SqlStore) DeleteOldWebhookDeliveries(_ context.Context, before time.Time) error {
+	return s.db.Delete(&WebhookDelivery{}, "status <> ? AND created_at < ?", WebhookDeliveryStatusPending, before).Error
+}
+
 // Close closes the underlying DB connection
 func (s *SqlStore) Close(_ context.Context) error {
 	sql, err := s.db.DB()
@@ -829,6 +889,28 @@ func NewSqliteStoreFromFileStore(ctx context.Context, fileStore *FileStore, data
 	return store, nil
 }
 
+// NewPostgresqlStoreFromSqlStore restores a store from SqlStore and stores Postgres DB.
+func NewPostgresqlStoreFromSqlStore(ctx context.Context, sqlStore *SqlStore, dsn string, metrics telemetry.AppMetrics) (*SqlStore, error) {
+	store, err := NewPostgresqlStore(ctx, dsn, metrics)
+	if err != nil {
+		return nil, err
+	}
+
+	err = store.SaveInstallationID(ctx, sqlStore.GetInstallationID())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, account := range sqlStore.GetAllAccounts(ctx) {
+		err := store.SaveAccount(ctx, account)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return store, nil
+}
+
 // NewPostgresqlStoreFromFileStore restores a store from FileStore and stores Postgres DB.
 func NewPostgresqlStoreFromFileStore(ctx context.Context, fileStore *FileStore, dsn string, metrics telemetry.AppMetrics) (*SqlStore, error) {
 	store, err := NewPostgresqlStore(ctx, dsn, metrics)
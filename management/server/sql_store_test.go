@@ -1003,3 +1003,50 @@ func TestPostgresql_GetUserByTokenID(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, id, user.PATs[id].ID)
 }
+
+func TestPostgresql_MigrateFromSqlite(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skipf("The PostgreSQL store is not properly supported by %s yet", runtime.GOOS)
+	}
+
+	dataDir := t.TempDir()
+	err := util.CopyFileContents("testdata/store.json", filepath.Join(dataDir, "store.json"))
+	require.NoError(t, err)
+
+	err = MigrateFileStoreToSqlite(context.Background(), dataDir)
+	require.NoError(t, err)
+
+	cleanUp, err := testutil.CreatePGDB()
+	require.NoError(t, err)
+	t.Cleanup(cleanUp)
+
+	err = MigrateSqliteToPostgres(context.Background(), dataDir)
+	require.NoError(t, err)
+
+	postgresDsn, ok := os.LookupEnv(postgresDsnEnv)
+	require.True(t, ok)
+
+	store, err := NewPostgresqlStore(context.Background(), postgresDsn, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = store.Close(context.Background()) })
+
+	account, err := store.GetAccount(context.Background(), "bf1c8084-ba50-4ce7-9439-34653001fc3b")
+	require.NoError(t, err)
+	require.Len(t, account.SetupKeys, 1)
+
+	// the target database is not empty anymore
+	err = MigrateSqliteToPostgres(context.Background(), dataDir)
+	require.Error(t, err)
+}
+
+func TestMigrateSqliteToPostgres_Preconditions(t *testing.T) {
+	t.Setenv(postgresDsnEnv, "")
+	os.Unsetenv(postgresDsnEnv)
+
+	err := MigrateSqliteToPostgres(context.Background(), t.TempDir())
+	require.ErrorContains(t, err, postgresDsnEnv)
+
+	t.Setenv(postgresDsnEnv, "host=localhost")
+	err = MigrateSqliteToPostgres(context.Background(), t.TempDir())
+	require.ErrorContains(t, err, "doesn't exist")
+}
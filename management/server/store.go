@@ -68,6 +68,16 @@ type Store interface {
 	GetStoreEngine() StoreEngine
 	GetPeerByPeerPubKey(ctx context.Context, peerKey string) (*nbpeer.Peer, error)
 	GetAccountSettings(ctx context.Context, accountID string) (*Settings, error)
+	GetAccountWebhooks(ctx context.Context, accountID string) ([]*Webhook, error)
+	// SaveWebhookDeliveries creates or updates queued webhook deliveries
+	SaveWebhookDeliveries(ctx context.Context, deliveries []*WebhookDelivery) error
+	// GetWebhookDeliveries returns up to limit most recent deliveries of a webhook
+	GetWebhookDeliveries(ctx context.Context, accountID, webhookID string, limit int) ([]*WebhookDelivery, error)
+	// GetDueWebhookDeliveries returns up to limit pending deliveries whose next attempt is due at the given time
+	GetDueWebhookDeliveries(ctx context.Context, now time.Time, limit int) ([]*WebhookDelivery, error)
+	DeleteWebhookDeliveries(ctx context.Context, accountID, webhookID string) error
+	// DeleteOldWebhookDeliveries removes finished deliveries created before the given time
+	DeleteOldWebhookDeliveries(ctx context.Context, before time.Time) error
 }
 
 type StoreEngine string
@@ -266,3 +276,50 @@ func MigrateFileStoreToSqlite(ctx context.Context, dataDir string) error {
 
 	return nil
 }
+
+// MigrateSqliteToPostgres migrates the SQLite store to the Postgres store defined by the NETBIRD_STORE_ENGINE_POSTGRES_DSN environment variable.
+func MigrateSqliteToPostgres(ctx context.Context, dataDir string) error {
+	dsn, ok := os.LookupEnv(postgresDsnEnv)
+	if !ok {
+		return fmt.Errorf("%s is not set", postgresDsnEnv)
+	}
+
+	sqlStorePath := path.Join(dataDir, storeSqliteFileName)
+	if _, err := os.Stat(sqlStorePath); errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("%s doesn't exist, couldn't continue the operation", sqlStorePath)
+	}
+
+	sqliteStore, err := NewSqliteStore(ctx, dataDir, nil)
+	if err != nil {
+		return fmt.Errorf("failed creating sqlite store: %s: %v", dataDir, err)
+	}
+	defer sqliteStore.Close(ctx)
+
+	existing, err := NewPostgresqlStore(ctx, dsn, nil)
+	if err != nil {
+		return fmt.Errorf("failed creating postgres store: %v", err)
+	}
+	existingAccounts := len(existing.GetAllAccounts(ctx))
+	_ = existing.Close(ctx)
+	if existingAccounts != 0 {
+		return fmt.Errorf("postgres store already contains %d accounts, couldn't continue the operation", existingAccounts)
+	}
+
+	sqliteStoreAccounts := len(sqliteStore.GetAllAccounts(ctx))
+	log.WithContext(ctx).Infof("%d account will be migrated from sqlite store %s to postgres store",
+		sqliteStoreAccounts, sqlStorePath)
+
+	store, err := NewPostgresqlStoreFromSqlStore(ctx, sqliteStore, dsn, nil)
+	if err != nil {
+		return fmt.Errorf("failed creating postgres store: %v", err)
+	}
+	defer store.Close(ctx)
+
+	postgresStoreAccounts := len(store.GetAllAccounts(ctx))
+	if sqliteStoreAccounts != postgresStoreAccounts {
+		return fmt.Errorf("failed to migrate accounts from sqlite to postgres. Expected accounts: %d, got: %d",
+			sqliteStoreAccounts, postgresStoreAccounts)
+	}
+
+	return nil
+}
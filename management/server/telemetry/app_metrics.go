@@ -23,6 +23,7 @@ type MockAppMetrics struct {
 	GetMeterFunc                 func() metric2.Meter
 	CloseFunc                    func() error
 	ExposeFunc                   func(ctx context.Context, port int, endpoint string) error
+	HandleFunc                   func(pattern string, handler http.Handler)
 	IDPMetricsFunc               func() *IDPMetrics
 	HTTPMiddlewareFunc           func() *HTTPMiddleware
 	GRPCMetricsFunc              func() *GRPCMetrics
@@ -55,6 +56,13 @@ func (mock *MockAppMetrics) Expose(ctx context.Context, port int, endpoint strin
 	return fmt.Errorf("unimplemented")
 }
 
+// Handle mocks the Handle function of the AppMetrics interface
+func (mock *MockAppMetrics) Handle(pattern string, handler http.Handler) {
+	if mock.HandleFunc != nil {
+		mock.HandleFunc(pattern, handler)
+	}
+}
+
 // IDPMetrics mocks the IDPMetrics function of the IDPMetrics interface
 func (mock *MockAppMetrics) IDPMetrics() *IDPMetrics {
 	if mock.IDPMetricsFunc != nil {
@@ -108,6 +116,7 @@ type AppMetrics interface {
 	GetMeter() metric2.Meter
 	Close() error
 	Expose(ctx context.Context, port int, endpoint string) error
+	Handle(pattern string, handler http.Handler)
 	IDPMetrics() *IDPMetrics
 	HTTPMiddleware() *HTTPMiddleware
 	GRPCMetrics() *GRPCMetrics
@@ -121,6 +130,7 @@ type defaultAppMetrics struct {
 	// Meter can be used by different application parts to create counters and measure things
 	Meter                 metric2.Meter
 	listener              net.Listener
+	router                *mux.Router
 	ctx                   context.Context
 	idpMetrics            *IDPMetrics
 	httpMiddleware        *HTTPMiddleware
@@ -174,7 +184,7 @@ func (appMetrics *defaultAppMetrics) Expose(ctx context.Context, port int, endpo
 	if endpoint == "" {
 		endpoint = defaultEndpoint
 	}
-	rootRouter := mux.NewRouter()
+	rootRouter := appMetrics.router
 	rootRouter.Handle(endpoint, promhttp.HandlerFor(
 		prometheus2.DefaultGatherer,
 		promhttp.HandlerOpts{EnableOpenMetrics: true}))
@@ -195,6 +205,11 @@ func (appMetrics *defaultAppMetrics) Expose(ctx context.Context, port int, endpo
 	return nil
 }
 
+// Handle registers an additional handler on the metrics endpoint, it has to be called before Expose
+func (appMetrics *defaultAppMetrics) Handle(pattern string, handler http.Handler) {
+	appMetrics.router.Handle(pattern, handler)
+}
+
 // GetMeter returns metrics meter that can be used to add various counters
 func (appMetrics *defaultAppMetrics) GetMeter() metric2.Meter {
 	return appMetrics.Meter
@@ -243,6 +258,7 @@ func NewDefaultAppMetrics(ctx context.Context) (AppMetrics, error) {
 
 	return &defaultAppMetrics{
 		Meter:                 meter,
+		router:                mux.NewRouter(),
 		ctx:                   ctx,
 		idpMetrics:            idpMetrics,
 		httpMiddleware:        middleware,
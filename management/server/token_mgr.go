@@ -19,8 +19,8 @@ const defaultDuration = 12 * time.Hour
 // SecretsManager used to manage TURN and relay secrets
 type SecretsManager interface {
 	GenerateTurnToken() (*Token, error)
-	GenerateRelayToken() (*Token, error)
-	SetupRefresh(ctx context.Context, peerKey string)
+	GenerateRelayToken(accountID string) (*Token, error)
+	SetupRefresh(ctx context.Context, accountID, peerKey string)
 	CancelRefresh(peerKey string)
 }
 
@@ -81,18 +81,38 @@ func (m *TimeBasedAuthSecretsManager) GenerateTurnToken() (*Token, error) {
 	return (*Token)(turnToken), nil
 }
 
-// GenerateRelayToken generates new time-based secret credentials for relay
-func (m *TimeBasedAuthSecretsManager) GenerateRelayToken() (*Token, error) {
+// GenerateRelayToken generates new time-based secret credentials for relay. The configured relay limits of the account
+// are embedded in the token.
+func (m *TimeBasedAuthSecretsManager) GenerateRelayToken(accountID string) (*Token, error) {
 	if m.relayHmacToken == nil {
 		return nil, fmt.Errorf("relay configuration is not set")
 	}
-	relayToken, err := m.relayHmacToken.GenerateToken(sha256.New)
+	relayToken, err := m.relayHmacToken.GenerateTokenWithLimits(sha256.New, m.relayLimits(accountID))
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate relay token: %s", err)
 	}
 	return (*Token)(relayToken), nil
 }
 
+// relayLimits returns the limits to embed in the relay tokens of the account, nil if no limits are configured so the
+// tokens stay compatible with the relay servers without limits support
+func (m *TimeBasedAuthSecretsManager) relayLimits(accountID string) *auth.Limits {
+	if m.relayCfg == nil || m.relayCfg.Limits == nil {
+		return nil
+	}
+
+	limits := m.relayCfg.Limits
+	return &auth.Limits{
+		AccountID:             accountID,
+		PeerBytesPerSecond:    limits.PeerBytesPerSecond,
+		PeerBurstBytes:        limits.PeerBurstBytes,
+		AccountBytesPerSecond: limits.AccountBytesPerSecond,
+		AccountBurstBytes:     limits.AccountBurstBytes,
+		AccountMaxPeers:       limits.AccountMaxPeers,
+		PeerMaxConnections:    limits.PeerMaxConnections,
+	}
+}
+
 func (m *TimeBasedAuthSecretsManager) cancelTURN(peerID string) {
 	if channel, ok := m.turnCancelMap[peerID]; ok {
 		close(channel)
@@ -116,7 +136,7 @@ func (m *TimeBasedAuthSecretsManager) CancelRefresh(peerID string) {
 }
 
 // SetupRefresh starts peer credentials refresh
-func (m *TimeBasedAuthSecretsManager) SetupRefresh(ctx context.Context, peerID string) {
+func (m *TimeBasedAuthSecretsManager) SetupRefresh(ctx context.Context, accountID, peerID string) {
 	m.mux.Lock()
 	defer m.mux.Unlock()
 
@@ -133,7 +153,7 @@ func (m *TimeBasedAuthSecretsManager) SetupRefresh(ctx context.Context, peerID s
 	if m.relayCfg != nil {
 		relayCancel := make(chan struct{}, 1)
 		m.relayCancelMap[peerID] = relayCancel
-		go m.refreshRelayTokens(ctx, peerID, relayCancel)
+		go m.refreshRelayTokens(ctx, accountID, peerID, relayCancel)
 		log.WithContext(ctx).Debugf("starting relay refresh for %s", peerID)
 	}
 }
@@ -153,7 +173,7 @@ func (m *TimeBasedAuthSecretsManager) refreshTURNTokens(ctx context.Context, pee
 	}
 }
 
-func (m *TimeBasedAuthSecretsManager) refreshRelayTokens(ctx context.Context, peerID string, cancel chan struct{}) {
+func (m *TimeBasedAuthSecretsManager) refreshRelayTokens(ctx context.Context, accountID, peerID string, cancel chan struct{}) {
 	ticker := time.NewTicker(m.relayCfg.CredentialsTTL.Duration / 4 * 3)
 	defer ticker.Stop()
 
@@ -163,7 +183,7 @@ func (m *TimeBasedAuthSecretsManager) refreshRelayTokens(ctx context.Context, pe
 			log.WithContext(ctx).Debugf("stopping relay refresh for %s", peerID)
 			return
 		case <-ticker.C:
-			m.pushNewRelayTokens(ctx, peerID)
+			m.pushNewRelayTokens(ctx, accountID, peerID)
 		}
 	}
 }
@@ -199,8 +219,8 @@ func (m *TimeBasedAuthSecretsManager) pushNewTURNTokens(ctx context.Context, pee
 	m.updateManager.SendUpdate(ctx, peerID, &UpdateMessage{Update: update})
 }
 
-func (m *TimeBasedAuthSecretsManager) pushNewRelayTokens(ctx context.Context, peerID string) {
-	relayToken, err := m.relayHmacToken.GenerateToken(sha256.New)
+func (m *TimeBasedAuthSecretsManager) pushNewRelayTokens(ctx context.Context, accountID, peerID string) {
+	relayToken, err := m.relayHmacToken.GenerateTokenWithLimits(sha256.New, m.relayLimits(accountID))
 	if err != nil {
 		log.Errorf("failed to generate relay token for peer '%s': %s", peerID, err)
 		return
@@ -13,6 +13,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/netbirdio/netbird/management/proto"
+	relayAuth "github.com/netbirdio/netbird/relay/auth/hmac"
 	"github.com/netbirdio/netbird/util"
 )
 
@@ -53,7 +54,7 @@ func TestTimeBasedAuthSecretsManager_GenerateCredentials(t *testing.T) {
 
 	validateMAC(t, sha1.New, turnCredentials.Payload, turnCredentials.Signature, []byte(secret))
 
-	relayCredentials, err := tested.GenerateRelayToken()
+	relayCredentials, err := tested.GenerateRelayToken("account")
 	require.NoError(t, err)
 
 	if relayCredentials.Payload == "" {
@@ -88,7 +89,7 @@ func TestTimeBasedAuthSecretsManager_SetupRefresh(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	tested.SetupRefresh(ctx, peer)
+	tested.SetupRefresh(ctx, "account", peer)
 
 	if _, ok := tested.turnCancelMap[peer]; !ok {
 		t.Errorf("expecting peer to be present in the turn cancel map, got not present")
@@ -179,7 +180,7 @@ func TestTimeBasedAuthSecretsManager_CancelRefresh(t *testing.T) {
 		TimeBasedCredentials: true,
 	}, rc)
 
-	tested.SetupRefresh(context.Background(), peer)
+	tested.SetupRefresh(context.Background(), "account", peer)
 	if _, ok := tested.turnCancelMap[peer]; !ok {
 		t.Errorf("expecting peer to be present in turn cancel map, got not present")
 	}
@@ -196,6 +197,30 @@ func TestTimeBasedAuthSecretsManager_CancelRefresh(t *testing.T) {
 	}
 }
 
+func TestTimeBasedAuthSecretsManager_GenerateRelayTokenWithLimits(t *testing.T) {
+	secret := "some_secret"
+	rc := &Relay{
+		Addresses:      []string{"localhost:0"},
+		CredentialsTTL: util.Duration{Duration: time.Hour},
+		Secret:         secret,
+		Limits: &RelayLimits{
+			PeerBytesPerSecond: 1000,
+			AccountMaxPeers:    10,
+			PeerMaxConnections: 2,
+		},
+	}
+	tested := NewTimeBasedAuthSecretsManager(NewPeersUpdateManager(nil), nil, rc)
+
+	relayCredentials, err := tested.GenerateRelayToken("account")
+	require.NoError(t, err)
+
+	validateMAC(t, sha256.New, relayCredentials.Payload, relayCredentials.Signature, []byte(secret))
+
+	limits, err := relayAuth.NewTimedHMAC(secret, time.Hour).ValidateLimits(sha256.New, relayAuth.Token(*relayCredentials))
+	require.NoError(t, err)
+	require.Equal(t, &relayAuth.Limits{AccountID: "account", PeerBytesPerSecond: 1000, AccountMaxPeers: 10, PeerMaxConnections: 2}, limits)
+}
+
 func validateMAC(t *testing.T, algo func() hash.Hash, username string, actualMAC string, key []byte) {
 	t.Helper()
 	mac := hmac.New(algo, key)
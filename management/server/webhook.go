@@ -0,0 +1,736 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/rs/xid"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/status"
+)
+
+const (
+	// WebhookSignatureHeader carries the HMAC-SHA256 signature of the payload made with the webhook secret
+	WebhookSignatureHeader = "X-NetBird-Signature"
+	// WebhookEventHeader carries the activity code of the delivered event
+	WebhookEventHeader = "X-NetBird-Event"
+	// WebhookDeliveryHeader carries the ID of the delivery, which stays the same between retries
+	WebhookDeliveryHeader = "X-NetBird-Delivery"
+
+	webhookMaxAttempts          = 10
+	webhookInitialBackoff       = 10 * time.Second
+	webhookMaxBackoff           = time.Hour
+	webhookPollInterval         = 10 * time.Second
+	webhookRequestTimeout       = 10 * time.Second
+	webhookResolveTimeout       = 5 * time.Second
+	webhookDeliveryWorkers      = 10
+	webhookDeliveryBatchSize    = 100
+	webhookDeliveryListLimit    = 100
+	webhookDeliveryRetention    = 7 * 24 * time.Hour
+	webhookDeliveryCleanupEvery = time.Hour
+	webhookSecretLength         = 32
+
+	errMsgWebhookAdminOnly = "only users with admin power are allowed to manage webhooks"
+)
+
+// WebhookDeliveryStatus is the state of a webhook delivery
+type WebhookDeliveryStatus string
+
+const (
+	// WebhookDeliveryStatusPending indicates that the delivery awaits its next attempt
+	WebhookDeliveryStatusPending WebhookDeliveryStatus = "pending"
+	// WebhookDeliveryStatusDelivered indicates that the destination accepted the event
+	WebhookDeliveryStatusDelivered WebhookDeliveryStatus = "delivered"
+	// WebhookDeliveryStatusFailed indicates that all delivery attempts failed
+	WebhookDeliveryStatusFailed WebhookDeliveryStatus = "failed"
+)
+
+// Webhook is a destination receiving the activity events of the account
+type Webhook struct {
+	// ID of the webhook
+	ID string `gorm:"primaryKey"`
+
+	// AccountID is a reference to Account that this object belongs
+	AccountID string `json:"-" gorm:"index"`
+
+	// Name of the webhook visible in the UI
+	Name string
+
+	// URL the events are posted to
+	URL string
+
+	// Secret used to sign the delivered payloads
+	Secret string
+
+	// EventCodes are the activity codes delivered to the webhook, e.g. "policy.add". Empty means every event.
+	EventCodes []string `gorm:"serializer:json"`
+
+	// Enabled webhooks receive events
+	Enabled bool
+
+	// CreatedAt is the time when the webhook was created
+	CreatedAt time.Time
+}
+
+// Copy returns a copy of the webhook
+func (w *Webhook) Copy() *Webhook {
+	c := *w
+	c.EventCodes = slices.Clone(w.EventCodes)
+	return &c
+}
+
+// EventMeta returns activity event meta related to this webhook
+func (w *Webhook) EventMeta() map[string]any {
+	return map[string]any{"name": w.Name, "url": w.URL}
+}
+
+func (w *Webhook) validate() error {
+	if w.Name == "" {
+		return status.Errorf(status.InvalidArgument, "webhook name shouldn't be empty")
+	}
+
+	u, err := url.Parse(w.URL)
+	if err != nil || u.Scheme != "https" || u.Hostname() == "" {
+		return status.Errorf(status.InvalidArgument, "webhook URL should be a valid https URL")
+	}
+
+	for _, code := range w.EventCodes {
+		if !activity.IsKnownCode(code) {
+			return status.Errorf(status.InvalidArgument, "unknown event code %s", code)
+		}
+	}
+
+	return nil
+}
+
+// webhookBlockedPrefixes are the ranges besides the private, loopback and link-local ones that webhooks can't reach
+var webhookBlockedPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+}
+
+// webhookAddressAllowed returns false for the addresses the management server must not post webhooks to: loopback,
+// private, link-local (including the metadata endpoints of the clouds), shared and reserved addresses
+func webhookAddressAllowed(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsGlobalUnicast() || addr.IsPrivate() {
+		return false
+	}
+	for _, prefix := range webhookBlockedPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
+
+// matches returns true if the event should be delivered to the webhook
+func (w *Webhook) matches(event *activity.Event) bool {
+	if !w.Enabled {
+		return false
+	}
+	return len(w.EventCodes) == 0 || slices.Contains(w.EventCodes, event.Activity.StringCode())
+}
+
+// WebhookDelivery is a queued delivery of an activity event to a webhook
+type WebhookDelivery struct {
+	// ID of the delivery
+	ID string `gorm:"primaryKey"`
+
+	// AccountID is a reference to Account that this object belongs
+	AccountID string `gorm:"index"`
+
+	// WebhookID is a reference to the destination Webhook
+	WebhookID string `gorm:"index"`
+
+	// EventID is the ID of the delivered activity event
+	EventID uint64
+
+	// EventCode is the activity code of the delivered event
+	EventCode string
+
+	// Payload is the JSON body posted to the webhook
+	Payload []byte
+
+	// Status of the delivery
+	Status WebhookDeliveryStatus `gorm:"index"`
+
+	// Attempts is the number of delivery attempts made
+	Attempts int
+
+	// NextAttemptAt is the time of the next delivery attempt of a pending delivery
+	NextAttemptAt time.Time `gorm:"index"`
+
+	// LastAttemptAt is the time of the last delivery attempt
+	LastAttemptAt *time.Time
+
+	// LastResponseCode is the HTTP status code returned by the webhook on the last attempt
+	LastResponseCode int
+
+	// LastError describes why the last attempt failed
+	LastError string
+
+	// CreatedAt is the time when the event was queued
+	CreatedAt time.Time
+}
+
+// WebhookPayload is the JSON body delivered to webhooks
+type WebhookPayload struct {
+	ID           uint64         `json:"id"`
+	Timestamp    time.Time      `json:"timestamp"`
+	Activity     string         `json:"activity"`
+	ActivityCode string         `json:"activity_code"`
+	InitiatorID  string         `json:"initiator_id"`
+	TargetID     string         `json:"target_id"`
+	AccountID    string         `json:"account_id"`
+	Meta         map[string]any `json:"meta"`
+}
+
+// SignWebhookPayload returns the value of the WebhookSignatureHeader for the payload
+func SignWebhookPayload(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func generateWebhookSecret() (string, error) {
+	secret := make([]byte, webhookSecretLength)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(secret), nil
+}
+
+// webhookBackoff returns the delay before the next attempt after the given number of failed attempts
+func webhookBackoff(attempts int) time.Duration {
+	backoff := webhookInitialBackoff
+	for i := 1; i < attempts && backoff < webhookMaxBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, webhookMaxBackoff)
+}
+
+func (a *Account) getWebhook(webhookID string) (*Webhook, error) {
+	for _, webhook := range a.Webhooks {
+		if webhook.ID == webhookID {
+			return webhook, nil
+		}
+	}
+	return nil, status.Errorf(status.NotFound, "webhook with ID %s not found", webhookID)
+}
+
+// CreateWebhook adds a webhook to the account. A secret is generated when none is provided.
+func (am *DefaultAccountManager) CreateWebhook(ctx context.Context, accountID, userID string, webhook *Webhook) (*Webhook, error) {
+	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+	defer unlock()
+
+	account, err := am.getAccountForWebhookAdmin(ctx, accountID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if am.Store.GetStoreEngine() == FileStoreEngine {
+		return nil, status.Errorf(status.PreconditionFailed, "webhooks require an SQL store, migrate the JSON file store to SQLite to use them")
+	}
+
+	if err = am.validateWebhook(ctx, webhook); err != nil {
+		return nil, err
+	}
+
+	if webhook.Secret == "" {
+		webhook.Secret, err = generateWebhookSecret()
+		if err != nil {
+			return nil, status.Errorf(status.Internal, "failed generating webhook secret")
+		}
+	}
+
+	webhook.ID = xid.New().String()
+	webhook.AccountID = accountID
+	webhook.CreatedAt = time.Now().UTC()
+
+	account.Webhooks = append(account.Webhooks, webhook)
+
+	if err = am.Store.SaveAccount(ctx, account); err != nil {
+		return nil, err
+	}
+
+	am.StoreEvent(ctx, userID, webhook.ID, accountID, activity.WebhookCreated, webhook.EventMeta())
+
+	return webhook, nil
+}
+
+// SaveWebhook updates a webhook of the account. An empty secret keeps the current one.
+func (am *DefaultAccountManager) SaveWebhook(ctx context.Context, accountID, userID string, webhookToSave *Webhook) (*Webhook, error) {
+	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+	defer unlock()
+
+	account, err := am.getAccountForWebhookAdmin(ctx, accountID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	webhook, err := account.getWebhook(webhookToSave.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = am.validateWebhook(ctx, webhookToSave); err != nil {
+		return nil, err
+	}
+
+	webhook.Name = webhookToSave.Name
+	webhook.URL = webhookToSave.URL
+	webhook.EventCodes = webhookToSave.EventCodes
+	webhook.Enabled = webhookToSave.Enabled
+	if webhookToSave.Secret != "" {
+		webhook.Secret = webhookToSave.Secret
+	}
+
+	if err = am.Store.SaveAccount(ctx, account); err != nil {
+		return nil, err
+	}
+
+	am.StoreEvent(ctx, userID, webhook.ID, accountID, activity.WebhookUpdated, webhook.EventMeta())
+
+	return webhook, nil
+}
+
+// GetWebhook returns a webhook of the account
+func (am *DefaultAccountManager) GetWebhook(ctx context.Context, accountID, webhookID, userID string) (*Webhook, error) {
+	unlock := am.Store.AcquireReadLockByUID(ctx, accountID)
+	defer unlock()
+
+	account, err := am.getAccountForWebhookAdmin(ctx, accountID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return account.getWebhook(webhookID)
+}
+
+// ListWebhooks returns the webhooks of the account
+func (am *DefaultAccountManager) ListWebhooks(ctx context.Context, accountID, userID string) ([]*Webhook, error) {
+	unlock := am.Store.AcquireReadLockByUID(ctx, accountID)
+	defer unlock()
+
+	account, err := am.getAccountForWebhookAdmin(ctx, accountID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return account.Webhooks, nil
+}
+
+// DeleteWebhook removes a webhook and its queued deliveries from the account
+func (am *DefaultAccountManager) DeleteWebhook(ctx context.Context, accountID, webhookID, userID string) error {
+	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+	defer unlock()
+
+	account, err := am.getAccountForWebhookAdmin(ctx, accountID, userID)
+	if err != nil {
+		return err
+	}
+
+	webhook, err := account.getWebhook(webhookID)
+	if err != nil {
+		return err
+	}
+
+	account.Webhooks = slices.DeleteFunc(account.Webhooks, func(w *Webhook) bool { return w.ID == webhookID })
+
+	if err = am.Store.SaveAccount(ctx, account); err != nil {
+		return err
+	}
+
+	if err = am.Store.DeleteWebhookDeliveries(ctx, accountID, webhookID); err != nil {
+		log.WithContext(ctx).Errorf("failed deleting deliveries of webhook %s: %v", webhookID, err)
+	}
+
+	am.StoreEvent(ctx, userID, webhook.ID, accountID, activity.WebhookDeleted, webhook.EventMeta())
+
+	return nil
+}
+
+// GetWebhookDeliveries returns the most recent deliveries of a webhook
+func (am *DefaultAccountManager) GetWebhookDeliveries(ctx context.Context, accountID, webhookID, userID string) ([]*WebhookDelivery, error) {
+	unlock := am.Store.AcquireReadLockByUID(ctx, accountID)
+	defer unlock()
+
+	account, err := am.getAccountForWebhookAdmin(ctx, accountID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = account.getWebhook(webhookID); err != nil {
+		return nil, err
+	}
+
+	return am.Store.GetWebhookDeliveries(ctx, accountID, webhookID, webhookDeliveryListLimit)
+}
+
+// validateWebhook validates the webhook and checks that its host doesn't resolve to an address webhooks can't reach.
+// The addresses are checked again when the deliveries connect, as the DNS records may change after the validation.
+func (am *DefaultAccountManager) validateWebhook(ctx context.Context, webhook *Webhook) error {
+	if err := webhook.validate(); err != nil {
+		return err
+	}
+
+	u, _ := url.Parse(webhook.URL)
+	host := u.Hostname()
+	if strings.EqualFold(host, "localhost") || strings.HasSuffix(strings.ToLower(host), ".localhost") {
+		return status.Errorf(status.InvalidArgument, "webhook URL should not point to a local address")
+	}
+
+	addrs, err := am.webhookDispatcher.resolve(ctx, host)
+	if err != nil {
+		// the host may not be resolvable from the management server yet, the deliveries check the addresses again
+		log.WithContext(ctx).Debugf("failed resolving webhook host %s: %v", host, err)
+		return nil
+	}
+	for _, addr := range addrs {
+		if !am.webhookDispatcher.allowAddress(addr) {
+			return status.Errorf(status.InvalidArgument, "webhook URL should not point to a private, loopback or link-local address")
+		}
+	}
+	return nil
+}
+
+func (am *DefaultAccountManager) getAccountForWebhookAdmin(ctx context.Context, accountID, userID string) (*Account, error) {
+	account, err := am.Store.GetAccount(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := account.FindUser(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !user.HasAdminPower() {
+		return nil, status.Errorf(status.PermissionDenied, errMsgWebhookAdminOnly)
+	}
+
+	return account, nil
+}
+
+// enqueueWebhookDeliveries persists a delivery of the event for every matching webhook of the account
+func (am *DefaultAccountManager) enqueueWebhookDeliveries(ctx context.Context, event *activity.Event) {
+	webhooks, err := am.Store.GetAccountWebhooks(ctx, event.AccountID)
+	if err != nil {
+		log.WithContext(ctx).Errorf("failed getting webhooks of account %s: %v", event.AccountID, err)
+		return
+	}
+
+	var matching []*Webhook
+	for _, webhook := range webhooks {
+		if webhook.matches(event) {
+			matching = append(matching, webhook)
+		}
+	}
+	if len(matching) == 0 {
+		return
+	}
+
+	payload, err := json.Marshal(&WebhookPayload{
+		ID:           event.ID,
+		Timestamp:    event.Timestamp,
+		Activity:     event.Activity.Message(),
+		ActivityCode: event.Activity.StringCode(),
+		InitiatorID:  event.InitiatorID,
+		TargetID:     event.TargetID,
+		AccountID:    event.AccountID,
+		Meta:         event.Meta,
+	})
+	if err != nil {
+		log.WithContext(ctx).Errorf("failed encoding webhook payload of event %d: %v", event.ID, err)
+		return
+	}
+
+	now := time.Now().UTC()
+	deliveries := make([]*WebhookDelivery, 0, len(matching))
+	for _, webhook := range matching {
+		deliveries = append(deliveries, &WebhookDelivery{
+			ID:            xid.New().String(),
+			AccountID:     event.AccountID,
+			WebhookID:     webhook.ID,
+			EventID:       event.ID,
+			EventCode:     event.Activity.StringCode(),
+			Payload:       payload,
+			Status:        WebhookDeliveryStatusPending,
+			NextAttemptAt: now,
+			CreatedAt:     now,
+		})
+	}
+
+	if err = am.Store.SaveWebhookDeliveries(ctx, deliveries); err != nil {
+		log.WithContext(ctx).Errorf("failed queueing webhook deliveries of event %d: %v", event.ID, err)
+		return
+	}
+
+	am.webhookDispatcher.notify()
+}
+
+// webhookDispatcher delivers the queued webhook deliveries, retrying failed ones with an exponential backoff.
+// The queue is kept in the store, so deliveries survive restarts. Each webhook is delivered by its own worker, so a
+// slow destination doesn't delay the webhooks of the other accounts.
+type webhookDispatcher struct {
+	store        Store
+	client       *http.Client
+	pollInterval time.Duration
+	wakeUp       chan struct{}
+	lastCleanup  time.Time
+	// allowAddress tells if the webhooks can connect to the address
+	allowAddress func(addr netip.Addr) bool
+	// workers limits the number of webhooks delivered at the same time
+	workers chan struct{}
+
+	mu sync.Mutex
+	// inFlight are the IDs of the webhooks being delivered by a worker
+	inFlight map[string]struct{}
+}
+
+func newWebhookDispatcher(store Store) *webhookDispatcher {
+	d := &webhookDispatcher{
+		store:        store,
+		pollInterval: webhookPollInterval,
+		wakeUp:       make(chan struct{}, 1),
+		allowAddress: webhookAddressAllowed,
+		workers:      make(chan struct{}, webhookDeliveryWorkers),
+		inFlight:     make(map[string]struct{}),
+	}
+	d.client = d.newHTTPClient()
+	return d
+}
+
+// newHTTPClient returns a client that refuses to connect to the addresses not allowed for webhooks
+func (d *webhookDispatcher) newHTTPClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout: webhookRequestTimeout,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil {
+				return err
+			}
+			if !d.allowAddress(addrPort.Addr()) {
+				return fmt.Errorf("webhook address %s is not allowed", addrPort.Addr())
+			}
+			return nil
+		},
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// a proxy would connect to the webhook on our behalf and bypass the address check
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{
+		Timeout:   webhookRequestTimeout,
+		Transport: transport,
+	}
+}
+
+// resolve returns the addresses of the webhook host
+func (d *webhookDispatcher) resolve(ctx context.Context, host string) ([]netip.Addr, error) {
+	if addr, err := netip.ParseAddr(host); err == nil {
+		return []netip.Addr{addr}, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, webhookResolveTimeout)
+	defer cancel()
+	return net.DefaultResolver.LookupNetIP(ctx, "ip", host)
+}
+
+// notify wakes up the dispatcher to deliver newly queued deliveries without waiting for the next poll
+func (d *webhookDispatcher) notify() {
+	if d == nil {
+		return
+	}
+	select {
+	case d.wakeUp <- struct{}{}:
+	default:
+	}
+}
+
+// run delivers due deliveries until the context is canceled
+func (d *webhookDispatcher) run(ctx context.Context) {
+	ticker := time.NewTicker(d.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-d.wakeUp:
+		}
+		d.deliverDue(ctx)
+	}
+}
+
+func (d *webhookDispatcher) deliverDue(ctx context.Context) {
+	now := time.Now().UTC()
+	if now.Sub(d.lastCleanup) > webhookDeliveryCleanupEvery {
+		if err := d.store.DeleteOldWebhookDeliveries(ctx, now.Add(-webhookDeliveryRetention)); err != nil {
+			log.WithContext(ctx).Errorf("failed deleting old webhook deliveries: %v", err)
+		}
+		d.lastCleanup = now
+	}
+
+	deliveries, err := d.store.GetDueWebhookDeliveries(ctx, now, webhookDeliveryBatchSize)
+	if err != nil {
+		log.WithContext(ctx).Errorf("failed getting due webhook deliveries: %v", err)
+		return
+	}
+
+	var webhookIDs []string
+	byWebhook := make(map[string][]*WebhookDelivery)
+	for _, delivery := range deliveries {
+		if _, ok := byWebhook[delivery.WebhookID]; !ok {
+			webhookIDs = append(webhookIDs, delivery.WebhookID)
+		}
+		byWebhook[delivery.WebhookID] = append(byWebhook[delivery.WebhookID], delivery)
+	}
+
+	for _, webhookID := range webhookIDs {
+		if !d.startWorker(webhookID) {
+			// the previous deliveries of the webhook are still being made
+			continue
+		}
+		go d.deliverWebhook(ctx, webhookID, byWebhook[webhookID])
+	}
+}
+
+// startWorker marks the webhook as being delivered, it returns false if a worker is delivering it already
+func (d *webhookDispatcher) startWorker(webhookID string) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if _, ok := d.inFlight[webhookID]; ok {
+		return false
+	}
+	d.inFlight[webhookID] = struct{}{}
+	return true
+}
+
+func (d *webhookDispatcher) finishWorker(webhookID string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	delete(d.inFlight, webhookID)
+}
+
+// deliverWebhook makes the due deliveries of a webhook in order. Once an attempt fails, the remaining deliveries wait
+// for the retry of the failed one instead of waiting for the failing destination one by one.
+func (d *webhookDispatcher) deliverWebhook(ctx context.Context, webhookID string, deliveries []*WebhookDelivery) {
+	defer d.finishWorker(webhookID)
+
+	select {
+	case d.workers <- struct{}{}:
+		defer func() { <-d.workers }()
+	case <-ctx.Done():
+		return
+	}
+
+	accountID := deliveries[0].AccountID
+	accountWebhooks, err := d.store.GetAccountWebhooks(ctx, accountID)
+	if err != nil {
+		log.WithContext(ctx).Errorf("failed getting webhooks of account %s: %v", accountID, err)
+		return
+	}
+	idx := slices.IndexFunc(accountWebhooks, func(w *Webhook) bool { return w.ID == webhookID })
+
+	var retryAt *time.Time
+	for _, delivery := range deliveries {
+		switch {
+		case idx < 0:
+			delivery.Status = WebhookDeliveryStatusFailed
+			delivery.LastError = "webhook was removed"
+		case !accountWebhooks[idx].Enabled:
+			delivery.Status = WebhookDeliveryStatusFailed
+			delivery.LastError = "webhook is disabled"
+		case retryAt != nil:
+			delivery.NextAttemptAt = *retryAt
+		default:
+			d.deliver(ctx, accountWebhooks[idx], delivery)
+			if delivery.Status == WebhookDeliveryStatusPending {
+				nextAttemptAt := delivery.NextAttemptAt
+				retryAt = &nextAttemptAt
+			}
+		}
+
+		if err = d.store.SaveWebhookDeliveries(ctx, []*WebhookDelivery{delivery}); err != nil {
+			log.WithContext(ctx).Errorf("failed saving webhook delivery %s: %v", delivery.ID, err)
+		}
+	}
+}
+
+// deliver makes a delivery attempt and updates the delivery state with its result
+func (d *webhookDispatcher) deliver(ctx context.Context, webhook *Webhook, delivery *WebhookDelivery) {
+	now := time.Now().UTC()
+	delivery.Attempts++
+	delivery.LastAttemptAt = &now
+
+	responseCode, err := d.post(ctx, webhook, delivery)
+	delivery.LastResponseCode = responseCode
+	if err == nil {
+		delivery.Status = WebhookDeliveryStatusDelivered
+		delivery.LastError = ""
+		return
+	}
+
+	delivery.LastError = err.Error()
+	if delivery.Attempts >= webhookMaxAttempts {
+		delivery.Status = WebhookDeliveryStatusFailed
+		log.WithContext(ctx).Warnf("giving up delivery %s of event %d to webhook %s after %d attempts: %v",
+			delivery.ID, delivery.EventID, webhook.ID, delivery.Attempts, err)
+		return
+	}
+
+	delivery.NextAttemptAt = now.Add(webhookBackoff(delivery.Attempts))
+	log.WithContext(ctx).Debugf("delivery %s to webhook %s failed, retrying at %s: %v", delivery.ID, webhook.ID, delivery.NextAttemptAt, err)
+}
+
+func (d *webhookDispatcher) post(ctx context.Context, webhook *Webhook, delivery *WebhookDelivery) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "NetBird-Webhook")
+	req.Header.Set(WebhookSignatureHeader, SignWebhookPayload(webhook.Secret, delivery.Payload))
+	req.Header.Set(WebhookEventHeader, delivery.EventCode)
+	req.Header.Set(WebhookDeliveryHeader, delivery.ID)
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+
+	return resp.StatusCode, nil
+}
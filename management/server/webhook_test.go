@@ -0,0 +1,287 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/status"
+	"github.com/netbirdio/netbird/management/server/telemetry"
+)
+
+func TestDefaultAccountManager_Webhooks(t *testing.T) {
+	am, err := createManager(t)
+	require.NoError(t, err, "failed to create account manager")
+
+	account, err := initTestAccessRequestAccount(am)
+	require.NoError(t, err, "failed to init testing account")
+
+	_, err = am.CreateWebhook(context.Background(), account.Id, regularUserID, &Webhook{Name: "SIEM", URL: "https://siem.example.com"})
+	assert.Error(t, err, "regular users should not be able to create webhooks")
+
+	_, err = am.CreateWebhook(context.Background(), account.Id, adminUserID, &Webhook{Name: "SIEM", URL: "ftp://siem.example.com"})
+	assert.Error(t, err, "only https URLs should be accepted")
+
+	_, err = am.CreateWebhook(context.Background(), account.Id, adminUserID, &Webhook{Name: "SIEM", URL: "http://siem.example.com"})
+	assert.Error(t, err, "only https URLs should be accepted")
+
+	_, err = am.CreateWebhook(context.Background(), account.Id, adminUserID, &Webhook{Name: "SIEM", URL: "https://siem.example.com", EventCodes: []string{"unknown.code"}})
+	assert.Error(t, err, "unknown event codes should be rejected")
+
+	webhook, err := am.CreateWebhook(context.Background(), account.Id, adminUserID, &Webhook{
+		Name:       "SIEM",
+		URL:        "https://siem.example.com",
+		EventCodes: []string{activity.PolicyAdded.StringCode()},
+		Enabled:    true,
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, webhook.ID)
+	assert.Len(t, webhook.Secret, 2*webhookSecretLength, "a secret should be generated")
+	secret := webhook.Secret
+
+	webhook, err = am.SaveWebhook(context.Background(), account.Id, adminUserID, &Webhook{ID: webhook.ID, Name: "SOC", URL: "https://soc.example.com"})
+	require.NoError(t, err)
+	assert.Equal(t, "SOC", webhook.Name)
+	assert.False(t, webhook.Enabled)
+	assert.Equal(t, secret, webhook.Secret, "an empty secret should keep the current one")
+
+	webhooks, err := am.ListWebhooks(context.Background(), account.Id, adminUserID)
+	require.NoError(t, err)
+	assert.Len(t, webhooks, 1)
+
+	require.NoError(t, am.DeleteWebhook(context.Background(), account.Id, webhook.ID, adminUserID))
+	_, err = am.GetWebhook(context.Background(), account.Id, webhook.ID, adminUserID)
+	assert.Error(t, err)
+}
+
+func TestDefaultAccountManager_WebhookDelivery(t *testing.T) {
+	am, err := createManager(t)
+	require.NoError(t, err, "failed to create account manager")
+
+	account, err := initTestAccessRequestAccount(am)
+	require.NoError(t, err, "failed to init testing account")
+
+	var mu sync.Mutex
+	var requests []*http.Request
+	var bodies [][]byte
+	failures := 1
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		body, _ := io.ReadAll(r.Body)
+		requests = append(requests, r)
+		bodies = append(bodies, body)
+		if failures > 0 {
+			failures--
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+	allowTestServer(am, server)
+
+	webhook, err := am.CreateWebhook(context.Background(), account.Id, adminUserID, &Webhook{
+		Name:       "SIEM",
+		URL:        server.URL,
+		EventCodes: []string{activity.PolicyAdded.StringCode()},
+		Enabled:    true,
+	})
+	require.NoError(t, err)
+
+	event := &activity.Event{
+		ID:          42,
+		Timestamp:   time.Now().UTC(),
+		Activity:    activity.PolicyAdded,
+		InitiatorID: adminUserID,
+		TargetID:    "policy",
+		AccountID:   account.Id,
+		Meta:        map[string]any{"name": "policy"},
+	}
+	am.enqueueWebhookDeliveries(context.Background(), event)
+
+	// events not matching the filter are not queued
+	am.enqueueWebhookDeliveries(context.Background(), &activity.Event{ID: 43, Activity: activity.PolicyRemoved, AccountID: account.Id})
+
+	getDelivery := func() *WebhookDelivery {
+		deliveries, err := am.GetWebhookDeliveries(context.Background(), account.Id, webhook.ID, adminUserID)
+		require.NoError(t, err)
+		require.Len(t, deliveries, 1)
+		return deliveries[0]
+	}
+
+	// the dispatcher is woken up by the queued delivery, the first attempt fails and is scheduled for a retry
+	require.Eventually(t, func() bool { return getDelivery().Attempts == 1 }, 5*time.Second, 50*time.Millisecond)
+	delivery := getDelivery()
+	assert.Equal(t, WebhookDeliveryStatusPending, delivery.Status, "failed delivery should be retried")
+	assert.Equal(t, http.StatusServiceUnavailable, delivery.LastResponseCode)
+	assert.WithinDuration(t, time.Now().Add(webhookInitialBackoff), delivery.NextAttemptAt, 5*time.Second)
+
+	// move the retry to the past, the queue is read from the store on every run
+	delivery.NextAttemptAt = time.Now().UTC().Add(-time.Second)
+	require.NoError(t, am.Store.SaveWebhookDeliveries(context.Background(), []*WebhookDelivery{delivery}))
+	am.webhookDispatcher.notify()
+
+	require.Eventually(t, func() bool { return getDelivery().Status == WebhookDeliveryStatusDelivered }, 5*time.Second, 50*time.Millisecond)
+	delivery = getDelivery()
+	assert.Equal(t, 2, delivery.Attempts)
+	assert.Empty(t, delivery.LastError)
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Len(t, requests, 2)
+	req, body := requests[1], bodies[1]
+	assert.Equal(t, SignWebhookPayload(webhook.Secret, body), req.Header.Get(WebhookSignatureHeader))
+	assert.Equal(t, activity.PolicyAdded.StringCode(), req.Header.Get(WebhookEventHeader))
+	assert.Equal(t, delivery.ID, req.Header.Get(WebhookDeliveryHeader))
+
+	payload := &WebhookPayload{}
+	require.NoError(t, json.Unmarshal(body, payload))
+	assert.Equal(t, uint64(42), payload.ID)
+	assert.Equal(t, activity.PolicyAdded.StringCode(), payload.ActivityCode)
+	assert.Equal(t, "policy", payload.TargetID)
+	assert.Equal(t, "policy", payload.Meta["name"])
+}
+
+// allowTestServer lets the webhooks reach the loopback test server and trust its certificate
+func allowTestServer(am *DefaultAccountManager, server *httptest.Server) {
+	am.webhookDispatcher.allowAddress = func(netip.Addr) bool { return true }
+	am.webhookDispatcher.client.Transport.(*http.Transport).TLSClientConfig = server.Client().Transport.(*http.Transport).TLSClientConfig
+}
+
+func TestDefaultAccountManager_WebhookSlowDestination(t *testing.T) {
+	am, err := createManager(t)
+	require.NoError(t, err, "failed to create account manager")
+
+	account, err := initTestAccessRequestAccount(am)
+	require.NoError(t, err, "failed to init testing account")
+
+	release := make(chan struct{})
+	hanging := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer hanging.Close()
+	defer close(release)
+
+	delivered := make(chan struct{}, 1)
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+		select {
+		case delivered <- struct{}{}:
+		default:
+		}
+	}))
+	defer server.Close()
+	// both test servers share the same certificate
+	allowTestServer(am, server)
+
+	for _, url := range []string{hanging.URL, server.URL} {
+		_, err = am.CreateWebhook(context.Background(), account.Id, adminUserID, &Webhook{
+			Name:       "SIEM",
+			URL:        url,
+			EventCodes: []string{activity.PolicyAdded.StringCode()},
+			Enabled:    true,
+		})
+		require.NoError(t, err)
+	}
+
+	am.enqueueWebhookDeliveries(context.Background(), &activity.Event{ID: 42, Activity: activity.PolicyAdded, AccountID: account.Id})
+
+	select {
+	case <-delivered:
+	case <-time.After(webhookRequestTimeout / 2):
+		t.Fatal("a hanging webhook should not delay the delivery to the other webhooks")
+	}
+}
+
+func TestDefaultAccountManager_WebhookPrivateDestination(t *testing.T) {
+	am, err := createManager(t)
+	require.NoError(t, err, "failed to create account manager")
+
+	account, err := initTestAccessRequestAccount(am)
+	require.NoError(t, err, "failed to init testing account")
+
+	for _, url := range []string{
+		"https://127.0.0.1/hook",
+		"https://localhost:8443/hook",
+		"https://10.0.0.1/hook",
+		"https://[::1]/hook",
+		"https://169.254.169.254/latest/meta-data",
+		"https://100.64.0.1/hook",
+	} {
+		_, err = am.CreateWebhook(context.Background(), account.Id, adminUserID, &Webhook{Name: "SIEM", URL: url})
+		assert.Error(t, err, "webhooks to %s should be rejected", url)
+	}
+
+	// the dialer checks the address again, as the DNS records of a validated host may change
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	_, err = am.webhookDispatcher.client.Get(server.URL)
+	assert.ErrorContains(t, err, "is not allowed")
+}
+
+func TestDefaultAccountManager_WebhookFileStore(t *testing.T) {
+	store, err := NewFileStore(context.Background(), t.TempDir(), nil)
+	require.NoError(t, err)
+
+	metrics, err := telemetry.NewDefaultAppMetrics(context.Background())
+	require.NoError(t, err)
+
+	am, err := BuildManager(context.Background(), store, NewPeersUpdateManager(nil), nil, "", "netbird.cloud", &activity.InMemoryEventStore{}, nil, false, MocIntegratedValidator{}, metrics)
+	require.NoError(t, err)
+
+	account, err := initTestAccessRequestAccount(am)
+	require.NoError(t, err, "failed to init testing account")
+
+	_, err = am.CreateWebhook(context.Background(), account.Id, adminUserID, &Webhook{Name: "SIEM", URL: "https://siem.example.com"})
+	require.Error(t, err, "the file store can't keep the webhook deliveries")
+	assert.Equal(t, status.PreconditionFailed, err.(*status.Error).Type())
+}
+
+func TestWebhookAddressAllowed(t *testing.T) {
+	tests := []struct {
+		addr    string
+		allowed bool
+	}{
+		{"1.1.1.1", true},
+		{"2606:4700:4700::1111", true},
+		{"::ffff:1.1.1.1", true},
+		{"127.0.0.1", false},
+		{"::1", false},
+		{"::ffff:127.0.0.1", false},
+		{"10.1.2.3", false},
+		{"172.16.0.1", false},
+		{"192.168.1.1", false},
+		{"fd00::1", false},
+		{"169.254.169.254", false},
+		{"fe80::1", false},
+		{"100.64.0.1", false},
+		{"0.0.0.0", false},
+		{"::", false},
+		{"224.0.0.1", false},
+		{"255.255.255.255", false},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.allowed, webhookAddressAllowed(netip.MustParseAddr(tt.addr)), tt.addr)
+	}
+}
+
+func TestWebhookBackoff(t *testing.T) {
+	assert.Equal(t, webhookInitialBackoff, webhookBackoff(1))
+	assert.Equal(t, 2*webhookInitialBackoff, webhookBackoff(2))
+	assert.Equal(t, 8*webhookInitialBackoff, webhookBackoff(4))
+	assert.Equal(t, webhookMaxBackoff, webhookBackoff(webhookMaxAttempts))
+}
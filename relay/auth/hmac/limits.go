@@ -0,0 +1,71 @@
+package hmac
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// limitsSeparator separates the expiration timestamp and the encoded limits in the token payload
+const limitsSeparator = "."
+
+// Limits are the relay limits of a peer. The Management server embeds them in the signed token payload, so the Relay
+// server can trust them. A zero value means the limit is not set by the token.
+type Limits struct {
+	// AccountID is the account of the peer, the account limits are shared by the peers of the same account
+	AccountID string `json:"account_id,omitempty"`
+	// PeerBytesPerSecond is the sustained transfer rate of the peer
+	PeerBytesPerSecond int64 `json:"peer_bytes_per_second,omitempty"`
+	// PeerBurstBytes is the number of bytes the peer can send at once above the sustained rate
+	PeerBurstBytes int64 `json:"peer_burst_bytes,omitempty"`
+	// AccountBytesPerSecond is the sustained transfer rate of all the peers of the account
+	AccountBytesPerSecond int64 `json:"account_bytes_per_second,omitempty"`
+	// AccountBurstBytes is the number of bytes the peers of the account can send at once above the sustained rate
+	AccountBurstBytes int64 `json:"account_burst_bytes,omitempty"`
+	// AccountMaxPeers is the number of peers of the account that can be connected at the same time
+	AccountMaxPeers int `json:"account_max_peers,omitempty"`
+	// PeerMaxConnections is the number of connections the peer can have at the same time
+	PeerMaxConnections int `json:"peer_max_connections,omitempty"`
+}
+
+// marshalPayload encodes the expiration timestamp and the limits in a token payload. Without limits the payload is
+// the plain timestamp, as the Relay servers without limits support expect it.
+func marshalPayload(timeAuth int64, limits *Limits) (string, error) {
+	timeStamp := strconv.FormatInt(timeAuth, 10)
+	if limits == nil {
+		return timeStamp, nil
+	}
+
+	data, err := json.Marshal(limits)
+	if err != nil {
+		return "", fmt.Errorf("marshal limits: %w", err)
+	}
+	return timeStamp + limitsSeparator + base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// unmarshalPayload decodes the expiration timestamp and the optional limits of a token payload
+func unmarshalPayload(payload string) (int64, *Limits, error) {
+	timeStamp, encodedLimits, hasLimits := strings.Cut(payload, limitsSeparator)
+
+	timeAuth, err := strconv.ParseInt(timeStamp, 10, 64)
+	if err != nil {
+		return 0, nil, fmt.Errorf("invalid payload: %w", err)
+	}
+
+	if !hasLimits {
+		return timeAuth, nil, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(encodedLimits)
+	if err != nil {
+		return 0, nil, fmt.Errorf("invalid payload limits: %w", err)
+	}
+
+	limits := &Limits{}
+	if err := json.Unmarshal(data, limits); err != nil {
+		return 0, nil, fmt.Errorf("invalid payload limits: %w", err)
+	}
+	return timeAuth, limits, nil
+}
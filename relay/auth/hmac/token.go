@@ -7,7 +7,6 @@ import (
 	"encoding/gob"
 	"fmt"
 	"hash"
-	"strconv"
 	"time"
 
 	log "github.com/sirupsen/logrus"
@@ -54,43 +53,58 @@ func NewTimedHMAC(secret string, timeToLive time.Duration) *TimedHMAC {
 // GenerateToken generates new time-based secret token - basically Payload is a unix timestamp and Signature is a HMAC
 // hash of a timestamp with a preshared TURN secret
 func (m *TimedHMAC) GenerateToken(algo func() hash.Hash) (*Token, error) {
+	return m.GenerateTokenWithLimits(algo, nil)
+}
+
+// GenerateTokenWithLimits generates new time-based secret token that carries the relay limits of the peer in the
+// signed payload. With nil limits the token is the same as the one of GenerateToken.
+func (m *TimedHMAC) GenerateTokenWithLimits(algo func() hash.Hash, limits *Limits) (*Token, error) {
 	timeAuth := time.Now().Add(m.timeToLive).Unix()
-	timeStamp := strconv.FormatInt(timeAuth, 10)
+	payload, err := marshalPayload(timeAuth, limits)
+	if err != nil {
+		return nil, err
+	}
 
-	checksum, err := m.generate(algo, timeStamp)
+	checksum, err := m.generate(algo, payload)
 	if err != nil {
 		return nil, err
 	}
 
 	return &Token{
-		Payload:   timeStamp,
+		Payload:   payload,
 		Signature: base64.StdEncoding.EncodeToString(checksum),
 	}, nil
 }
 
 // Validate checks if the token is valid
 func (m *TimedHMAC) Validate(algo func() hash.Hash, token Token) error {
+	_, err := m.ValidateLimits(algo, token)
+	return err
+}
+
+// ValidateLimits checks if the token is valid and returns the limits it carries, nil if it has none
+func (m *TimedHMAC) ValidateLimits(algo func() hash.Hash, token Token) (*Limits, error) {
 	expectedMAC, err := m.generate(algo, token.Payload)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	expectedSignature := base64.StdEncoding.EncodeToString(expectedMAC)
 
 	if !hmac.Equal([]byte(expectedSignature), []byte(token.Signature)) {
-		return fmt.Errorf("signature mismatch")
+		return nil, fmt.Errorf("signature mismatch")
 	}
 
-	timeAuthInt, err := strconv.ParseInt(token.Payload, 10, 64)
+	timeAuthInt, limits, err := unmarshalPayload(token.Payload)
 	if err != nil {
-		return fmt.Errorf("invalid payload: %w", err)
+		return nil, err
 	}
 
 	if time.Now().Unix() > timeAuthInt {
-		return fmt.Errorf("expired token")
+		return nil, fmt.Errorf("expired token")
 	}
 
-	return nil
+	return limits, nil
 }
 
 func (m *TimedHMAC) generate(algo func() hash.Hash, payload string) ([]byte, error) {
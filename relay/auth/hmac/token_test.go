@@ -5,6 +5,7 @@ import (
 	"crypto/sha256"
 	"encoding/base64"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 )
@@ -103,3 +104,61 @@ func TestInvalidPayload(t *testing.T) {
 		t.Fatalf("expected invalid token due to invalid payload")
 	}
 }
+
+func TestLimits(t *testing.T) {
+	secret := "supersecret"
+	v := NewTimedHMAC(secret, time.Hour)
+
+	limits := &Limits{
+		AccountID:          "account",
+		PeerBytesPerSecond: 1000,
+		PeerBurstBytes:     2000,
+		AccountMaxPeers:    5,
+	}
+	creds, err := v.GenerateTokenWithLimits(sha256.New, limits)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	validated, err := v.ValidateLimits(sha256.New, *creds)
+	if err != nil {
+		t.Fatalf("expected valid token: %s", err)
+	}
+	if *validated != *limits {
+		t.Fatalf("expected limits %+v, got %+v", limits, validated)
+	}
+
+	// the limits are signed, they can't be changed by the peer
+	tampered := *limits
+	tampered.PeerBytesPerSecond = 0
+	tamperedPayload, err := marshalPayload(0, &tampered)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	timeStamp, _, _ := strings.Cut(creds.Payload, limitsSeparator)
+	_, encodedLimits, _ := strings.Cut(tamperedPayload, limitsSeparator)
+	tamperedCreds := Token{
+		Payload:   timeStamp + limitsSeparator + encodedLimits,
+		Signature: creds.Signature,
+	}
+	if _, err := v.ValidateLimits(sha256.New, tamperedCreds); err == nil {
+		t.Fatalf("expected invalid token due to tampered limits")
+	}
+}
+
+func TestWithoutLimits(t *testing.T) {
+	v := NewTimedHMAC("supersecret", time.Hour)
+
+	creds, err := v.GenerateToken(sha256.New)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	limits, err := v.ValidateLimits(sha256.New, *creds)
+	if err != nil {
+		t.Fatalf("expected valid token: %s", err)
+	}
+	if limits != nil {
+		t.Fatalf("expected no limits, got %+v", limits)
+	}
+}
@@ -20,14 +20,20 @@ func NewTimedHMACValidator(secret string, duration time.Duration) *TimedHMACVali
 }
 
 func (a *TimedHMACValidator) Validate(algo func() hash.Hash, credentials any) error {
+	_, err := a.ValidateLimits(algo, credentials)
+	return err
+}
+
+// ValidateLimits validates the credentials and returns the relay limits carried by the token, nil if it has none
+func (a *TimedHMACValidator) ValidateLimits(algo func() hash.Hash, credentials any) (*Limits, error) {
 	b, ok := credentials.([]byte)
 	if !ok {
-		return fmt.Errorf("invalid credentials type")
+		return nil, fmt.Errorf("invalid credentials type")
 	}
 	c, err := unmarshalToken(b)
 	if err != nil {
 		log.Debugf("failed to unmarshal token: %s", err)
-		return err
+		return nil, err
 	}
-	return a.TimedHMAC.Validate(algo, c)
+	return a.TimedHMAC.ValidateLimits(algo, c)
 }
@@ -1,8 +1,18 @@
 package auth
 
-import "hash"
+import (
+	"hash"
+
+	"github.com/netbirdio/netbird/relay/auth/hmac"
+)
 
 // Validator is an interface that defines the Validate method.
 type Validator interface {
 	Validate(func() hash.Hash, any) error
 }
+
+// LimitsValidator is a Validator whose credentials can carry the relay limits of the peer.
+type LimitsValidator interface {
+	Validator
+	ValidateLimits(func() hash.Hash, any) (*hmac.Limits, error)
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/netbirdio/netbird/util"
 
 	"github.com/netbirdio/netbird/relay/server"
+	"github.com/netbirdio/netbird/relay/server/cluster"
 )
 
 var (
@@ -629,3 +630,100 @@ func waitForServerToStart(errChan chan error) error {
 	}
 	return nil
 }
+
+func TestClusterEcho(t *testing.T) {
+	ctx := context.Background()
+	idAlice := "alice"
+	idBob := "bob"
+
+	clusterPeers := []string{"127.0.0.1:1240", "127.0.0.1:2240"}
+	startServer := func(addr, url, clusterAddr, instanceID string) *server.Server {
+		srvCfg := server.ListenerConfig{
+			Address: addr,
+			Cluster: &cluster.Config{
+				ListenAddress: clusterAddr,
+				Peers:         clusterPeers,
+				InstanceID:    instanceID,
+				Secret:        "cluster-secret",
+			},
+		}
+		srv, err := server.NewServer(otel.Meter(""), url, false, av)
+		if err != nil {
+			t.Fatalf("failed to create server: %s", err)
+		}
+		errChan := make(chan error, 1)
+		go func() {
+			if err := srv.Listen(srvCfg); err != nil {
+				errChan <- err
+			}
+		}()
+		t.Cleanup(func() {
+			if err := srv.Shutdown(ctx); err != nil {
+				t.Errorf("failed to close server: %s", err)
+			}
+		})
+
+		if err := waitForServerToStart(errChan); err != nil {
+			t.Fatalf("failed to start server: %s", err)
+		}
+		return srv
+	}
+
+	startServer(serverListenAddr, serverURL, clusterPeers[0], "relay1")
+	startServer("127.0.0.1:2234", "rel://127.0.0.1:2234", clusterPeers[1], "relay2")
+
+	clientAlice := NewClient(ctx, serverURL, hmacTokenStore, idAlice)
+	if err := clientAlice.Connect(); err != nil {
+		t.Fatalf("failed to connect to server: %s", err)
+	}
+	defer func() {
+		_ = clientAlice.Close()
+	}()
+
+	clientBob := NewClient(ctx, "rel://127.0.0.1:2234", hmacTokenStore, idBob)
+	if err := clientBob.Connect(); err != nil {
+		t.Fatalf("failed to connect to server: %s", err)
+	}
+	defer func() {
+		_ = clientBob.Close()
+	}()
+
+	connAliceToBob, err := clientAlice.OpenConn(idBob)
+	if err != nil {
+		t.Fatalf("failed to bind channel: %s", err)
+	}
+	connBobToAlice, err := clientBob.OpenConn(idAlice)
+	if err != nil {
+		t.Fatalf("failed to bind channel: %s", err)
+	}
+
+	received := make(chan string, 1)
+	go func() {
+		buf := make([]byte, 65535)
+		n, err := connBobToAlice.Read(buf)
+		if err != nil {
+			return
+		}
+		received <- string(buf[:n])
+	}()
+
+	// the messages are dropped until the instances learned about each other's peers
+	payload := "hello bob, I am alice"
+	timeout := time.After(10 * time.Second)
+	for {
+		if _, err := connAliceToBob.Write([]byte(payload)); err != nil {
+			t.Fatalf("failed to write to channel: %s", err)
+		}
+
+		select {
+		case msg := <-received:
+			if msg != payload {
+				t.Fatalf("expected %s, got %s", payload, msg)
+			}
+			return
+		case <-time.After(200 * time.Millisecond):
+		case <-timeout:
+			t.Fatalf("message was not forwarded between the relay instances")
+		}
+	}
+}
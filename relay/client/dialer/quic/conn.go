@@ -0,0 +1,112 @@
+package quic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/quic-go/quic-go"
+	log "github.com/sirupsen/logrus"
+
+	quicTransport "github.com/netbirdio/netbird/relay/server/listener/quic"
+)
+
+type Conn struct {
+	session    quic.Connection
+	messages   *quicTransport.Messages
+	packetConn net.PacketConn
+	remoteAddr QuicAddr
+	ctx        context.Context
+	ctxCancel  context.CancelFunc
+	closed     bool
+	closedMu   sync.Mutex
+}
+
+func NewConn(session quic.Connection, packetConn net.PacketConn, serverAddress string) net.Conn {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Conn{
+		session:    session,
+		messages:   quicTransport.NewMessages(ctx, session),
+		packetConn: packetConn,
+		remoteAddr: QuicAddr{addr: serverAddress},
+		ctx:        ctx,
+		ctxCancel:  cancel,
+	}
+}
+
+func (c *Conn) Read(b []byte) (n int, err error) {
+	n, err = c.messages.Read(b)
+	if err != nil && !errors.Is(err, io.ErrShortBuffer) {
+		return 0, c.ioErrHandling(err)
+	}
+	return n, err
+}
+
+func (c *Conn) Write(b []byte) (int, error) {
+	n, err := c.messages.Write(b)
+	if err != nil {
+		return 0, c.ioErrHandling(err)
+	}
+	return n, nil
+}
+
+func (c *Conn) RemoteAddr() net.Addr {
+	return c.remoteAddr
+}
+
+func (c *Conn) LocalAddr() net.Addr {
+	return c.session.LocalAddr()
+}
+
+func (c *Conn) SetReadDeadline(t time.Time) error {
+	return fmt.Errorf("SetReadDeadline is not implemented")
+}
+
+func (c *Conn) SetWriteDeadline(t time.Time) error {
+	return fmt.Errorf("SetWriteDeadline is not implemented")
+}
+
+func (c *Conn) SetDeadline(t time.Time) error {
+	return fmt.Errorf("SetDeadline is not implemented")
+}
+
+func (c *Conn) Close() error {
+	c.closedMu.Lock()
+	if c.closed {
+		c.closedMu.Unlock()
+		return nil
+	}
+	c.closed = true
+	c.closedMu.Unlock()
+
+	c.ctxCancel()
+	err := c.session.CloseWithError(0, "normal closure")
+
+	// the packet conn is owned by the dialer, quic-go does not close it with the session
+	if pErr := c.packetConn.Close(); pErr != nil {
+		log.Debugf("failed to close QUIC packet conn: %s", pErr)
+	}
+	return err
+}
+
+func (c *Conn) isClosed() bool {
+	c.closedMu.Lock()
+	defer c.closedMu.Unlock()
+	return c.closed
+}
+
+func (c *Conn) ioErrHandling(err error) error {
+	if c.isClosed() {
+		return io.EOF
+	}
+
+	var appErr *quic.ApplicationError
+	if errors.As(err, &appErr) && appErr.ErrorCode == 0 {
+		return io.EOF
+	}
+	return err
+}
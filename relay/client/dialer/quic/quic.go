@@ -0,0 +1,90 @@
+package quic
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/url"
+	"time"
+
+	"github.com/quic-go/quic-go"
+	log "github.com/sirupsen/logrus"
+
+	quicTransport "github.com/netbirdio/netbird/relay/server/listener/quic"
+	nbnet "github.com/netbirdio/netbird/util/net"
+)
+
+const (
+	// keepAlivePeriod keeps the NAT bindings of the UDP flow alive between the relay health checks
+	keepAlivePeriod = 15 * time.Second
+)
+
+type Dialer struct {
+}
+
+func (d Dialer) Protocol() string {
+	return "QUIC"
+}
+
+func (d Dialer) Dial(ctx context.Context, address string) (net.Conn, error) {
+	serverURL, err := prepareURL(address)
+	if err != nil {
+		return nil, err
+	}
+
+	udpAddr, err := net.ResolveUDPAddr("udp", serverURL.Host)
+	if err != nil {
+		return nil, fmt.Errorf("resolve relay address %s: %w", serverURL.Host, err)
+	}
+
+	tlsConf := &tls.Config{
+		ServerName: serverURL.Hostname(),
+		NextProtos: []string{quicTransport.ALPN},
+		// relays without TLS serve QUIC with a self-signed certificate, the same trust level as plain WebSocket
+		InsecureSkipVerify: serverURL.Scheme == "rel",
+	}
+
+	quicConfig := &quic.Config{
+		EnableDatagrams: true,
+		KeepAlivePeriod: keepAlivePeriod,
+	}
+
+	packetConn, err := nbnet.NewListener().ListenPacket(ctx, "udp", ":0")
+	if err != nil {
+		return nil, fmt.Errorf("listen udp: %w", err)
+	}
+
+	session, err := quic.Dial(ctx, packetConn, udpAddr, tlsConf, quicConfig)
+	if err != nil {
+		if cErr := packetConn.Close(); cErr != nil {
+			log.Debugf("failed to close QUIC packet conn: %s", cErr)
+		}
+		return nil, fmt.Errorf("dial QUIC relay server %s: %w", address, err)
+	}
+
+	return NewConn(session, packetConn, address), nil
+}
+
+// prepareURL parses the relay address and adds the default port if it is missing
+func prepareURL(address string) (*url.URL, error) {
+	serverURL, err := url.Parse(address)
+	if err != nil {
+		return nil, fmt.Errorf("parse relay address: %w", err)
+	}
+
+	var defaultPort string
+	switch serverURL.Scheme {
+	case "rel":
+		defaultPort = "80"
+	case "rels":
+		defaultPort = "443"
+	default:
+		return nil, fmt.Errorf("unsupported scheme: %s", address)
+	}
+
+	if serverURL.Port() == "" {
+		serverURL.Host = net.JoinHostPort(serverURL.Hostname(), defaultPort)
+	}
+	return serverURL, nil
+}
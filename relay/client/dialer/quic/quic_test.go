@@ -0,0 +1,136 @@
+package quic
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	quicListener "github.com/netbirdio/netbird/relay/server/listener/quic"
+)
+
+func TestDialer(t *testing.T) {
+	listener := &quicListener.Listener{Address: "127.0.0.1:53412"}
+	accepted := make(chan net.Conn, 1)
+	go func() {
+		_ = listener.Listen(func(conn net.Conn) {
+			accepted <- conn
+		})
+	}()
+	t.Cleanup(func() {
+		_ = listener.Shutdown(context.Background())
+	})
+	time.Sleep(100 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	conn, err := Dialer{}.Dial(ctx, "rel://127.0.0.1:53412")
+	require.NoError(t, err)
+
+	_, err = conn.Write([]byte("hello"))
+	require.NoError(t, err)
+
+	var srvConn net.Conn
+	select {
+	case srvConn = <-accepted:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("connection was not accepted")
+	}
+
+	buf := make([]byte, 1500)
+	n, err := srvConn.Read(buf)
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(buf[:n]))
+
+	_, err = srvConn.Write([]byte("world"))
+	require.NoError(t, err)
+	n, err = conn.Read(buf)
+	require.NoError(t, err)
+	assert.Equal(t, "world", string(buf[:n]))
+
+	require.NoError(t, srvConn.Close())
+	_, err = conn.Read(buf)
+	assert.ErrorIs(t, err, io.EOF, "closing by the server should be reported as EOF")
+	require.NoError(t, conn.Close())
+}
+
+func TestDialer_MTUSizedMessages(t *testing.T) {
+	listener := &quicListener.Listener{Address: "127.0.0.1:53413"}
+	accepted := make(chan net.Conn, 1)
+	go func() {
+		_ = listener.Listen(func(conn net.Conn) {
+			accepted <- conn
+		})
+	}()
+	t.Cleanup(func() {
+		_ = listener.Shutdown(context.Background())
+	})
+	time.Sleep(100 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	conn, err := Dialer{}.Dial(ctx, "rel://127.0.0.1:53413")
+	require.NoError(t, err)
+	defer conn.Close()
+
+	_, err = conn.Write([]byte("hello"))
+	require.NoError(t, err)
+
+	var srvConn net.Conn
+	select {
+	case srvConn = <-accepted:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("connection was not accepted")
+	}
+	defer srvConn.Close()
+
+	buf := make([]byte, 9000)
+	_, err = srvConn.Read(buf)
+	require.NoError(t, err)
+
+	for _, size := range []int{quicListener.MaxDatagramSize, 1280, 1500, 8192} {
+		msg := bytes.Repeat([]byte{byte(size)}, size)
+
+		_, err = conn.Write(msg)
+		require.NoError(t, err, "size %d", size)
+		n, err := srvConn.Read(buf)
+		require.NoError(t, err, "size %d", size)
+		assert.Equal(t, msg, buf[:n], "message of %d bytes sent by the client", size)
+
+		_, err = srvConn.Write(msg)
+		require.NoError(t, err, "size %d", size)
+		n, err = conn.Read(buf)
+		require.NoError(t, err, "size %d", size)
+		assert.Equal(t, msg, buf[:n], "message of %d bytes sent by the server", size)
+	}
+}
+
+func TestPrepareURL(t *testing.T) {
+	tests := []struct {
+		address string
+		want    string
+		wantErr bool
+	}{
+		{address: "rel://relay.example.com", want: "relay.example.com:80"},
+		{address: "rels://relay.example.com", want: "relay.example.com:443"},
+		{address: "rels://relay.example.com:33080", want: "relay.example.com:33080"},
+		{address: "https://relay.example.com", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.address, func(t *testing.T) {
+			u, err := prepareURL(tt.address)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, u.Host)
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package dialer
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockConn struct {
+	net.Conn
+	protocol string
+	closed   chan struct{}
+}
+
+func (c *mockConn) Close() error {
+	close(c.closed)
+	return nil
+}
+
+type mockDialer struct {
+	protocol string
+	delay    time.Duration
+	err      error
+	conn     *mockConn
+}
+
+func newMockDialer(protocol string, delay time.Duration, err error) *mockDialer {
+	return &mockDialer{
+		protocol: protocol,
+		delay:    delay,
+		err:      err,
+		conn:     &mockConn{protocol: protocol, closed: make(chan struct{})},
+	}
+}
+
+func (d *mockDialer) Dial(ctx context.Context, _ string) (net.Conn, error) {
+	select {
+	case <-time.After(d.delay):
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	if d.err != nil {
+		return nil, d.err
+	}
+	return d.conn, nil
+}
+
+func (d *mockDialer) Protocol() string {
+	return d.protocol
+}
+
+func TestRaceDial_FastestWins(t *testing.T) {
+	fast := newMockDialer("fast", 10*time.Millisecond, nil)
+	slow := newMockDialer("slow", 200*time.Millisecond, nil)
+
+	conn, err := NewRaceDial(log.NewEntry(log.StandardLogger()), "rel://example.com", slow, fast).Dial()
+	require.NoError(t, err)
+	assert.Equal(t, "fast", conn.(*mockConn).protocol)
+
+	select {
+	case <-slow.conn.closed:
+		t.Fatalf("the aborted dial should not have established a connection")
+	case <-time.After(300 * time.Millisecond):
+	}
+}
+
+func TestRaceDial_LoserConnectionClosed(t *testing.T) {
+	fast := newMockDialer("fast", 10*time.Millisecond, nil)
+	// the slow dialer ignores the abort and still establishes the connection
+	slow := &ignoreAbortDialer{newMockDialer("slow", 100*time.Millisecond, nil)}
+
+	conn, err := NewRaceDial(log.NewEntry(log.StandardLogger()), "rel://example.com", fast, slow).Dial()
+	require.NoError(t, err)
+	assert.Equal(t, "fast", conn.(*mockConn).protocol)
+
+	select {
+	case <-slow.conn.closed:
+	case <-time.After(time.Second):
+		t.Fatalf("the connection of the slower dialer should be closed")
+	}
+}
+
+func TestRaceDial_Fallback(t *testing.T) {
+	failing := newMockDialer("failing", 0, errors.New("blocked"))
+	fallback := newMockDialer("fallback", 50*time.Millisecond, nil)
+
+	conn, err := NewRaceDial(log.NewEntry(log.StandardLogger()), "rel://example.com", failing, fallback).Dial()
+	require.NoError(t, err)
+	assert.Equal(t, "fallback", conn.(*mockConn).protocol)
+}
+
+func TestRaceDial_AllFail(t *testing.T) {
+	first := newMockDialer("first", 0, errors.New("blocked"))
+	second := newMockDialer("second", 10*time.Millisecond, errors.New("refused"))
+
+	_, err := NewRaceDial(log.NewEntry(log.StandardLogger()), "rel://example.com", first, second).Dial()
+	assert.Error(t, err)
+}
+
+type ignoreAbortDialer struct {
+	*mockDialer
+}
+
+func (d *ignoreAbortDialer) Dial(_ context.Context, address string) (net.Conn, error) {
+	return d.mockDialer.Dial(context.Background(), address)
+}
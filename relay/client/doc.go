@@ -3,7 +3,8 @@ Package client contains the implementation of the Relay client.
 
 The Relay client is responsible for establishing a connection with the Relay server and sending and receiving messages,
 Keep persistent connection with the Relay server and handle the connection issues.
-It uses the WebSocket protocol for communication and optionally supports TLS (Transport Layer Security).
+It dials the server with QUIC and the WebSocket protocol in parallel and keeps the first established connection, so it
+falls back to WebSocket when UDP is blocked. WebSocket optionally supports TLS (Transport Layer Security).
 
 If a peer wants to communicate with a peer on a different relay server, the manager will establish a new connection to
 the relay server. The connection with these relay servers will be closed if there is no active connection. The peers
@@ -2,12 +2,13 @@ package cmd
 
 import (
 	"context"
-	"crypto/tls"
 	"errors"
 	"fmt"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
 	"syscall"
 	"time"
 
@@ -18,6 +19,7 @@ import (
 	"github.com/netbirdio/netbird/encryption"
 	auth "github.com/netbirdio/netbird/relay/auth/hmac"
 	"github.com/netbirdio/netbird/relay/server"
+	"github.com/netbirdio/netbird/relay/server/cluster"
 	"github.com/netbirdio/netbird/signal/metrics"
 	"github.com/netbirdio/netbird/util"
 )
@@ -37,11 +39,33 @@ type Config struct {
 	// in case of using Route 53 for DNS challenge the credentials should be provided in the environment variables or
 	// in the AWS credentials file
 	LetsencryptAWSRoute53 bool
-	TlsCertFile           string
-	TlsKeyFile            string
-	AuthSecret            string
-	LogLevel              string
-	LogFile               string
+	// LetsencryptChallenge is the ACME challenge used to verify the domains, tls-alpn-01 by default
+	LetsencryptChallenge string
+	TlsCertFile          string
+	TlsKeyFile           string
+	AuthSecret           string
+	LogLevel             string
+	LogFile              string
+	// ClusterListenAddress enables the relay-to-relay mesh, the other instances connect to this TCP address
+	ClusterListenAddress string
+	// ClusterPeers are the cluster addresses of the other instances
+	ClusterPeers []string
+	// ClusterInstanceID is the unique ID of the instance in the cluster, defaults to the hostname
+	ClusterInstanceID string
+	// ClusterSecret is shared by the instances of the cluster to authenticate each other
+	ClusterSecret string
+	// PeerRateLimit is the default transfer rate limit of a peer in bytes per second
+	PeerRateLimit int64
+	// PeerBurstLimit is the default burst of a peer in bytes
+	PeerBurstLimit int64
+	// AccountRateLimit is the default transfer rate limit of the peers of an account in bytes per second
+	AccountRateLimit int64
+	// AccountBurstLimit is the default burst of the peers of an account in bytes
+	AccountBurstLimit int64
+	// AccountMaxPeers is the default number of peers of an account that can be connected at the same time
+	AccountMaxPeers int
+	// PeerMaxConnections is the default number of connections a peer can have at the same time
+	PeerMaxConnections int
 }
 
 func (c Config) Validate() error {
@@ -51,11 +75,24 @@ func (c Config) Validate() error {
 	if c.AuthSecret == "" {
 		return fmt.Errorf("auth secret is required")
 	}
+	if len(c.ClusterPeers) > 0 && c.ClusterListenAddress == "" {
+		return fmt.Errorf("cluster listen address is required with cluster peers")
+	}
+	if c.HasCluster() && c.ClusterSecret == "" {
+		return fmt.Errorf("cluster secret is required with the cluster listen address")
+	}
+	// the peers hold tokens signed with the auth secret, they must not be able to join the cluster
+	if c.HasCluster() && c.ClusterSecret == c.AuthSecret {
+		return fmt.Errorf("cluster secret must differ from the auth secret")
+	}
+	if c.PeerRateLimit < 0 || c.PeerBurstLimit < 0 || c.AccountRateLimit < 0 || c.AccountBurstLimit < 0 || c.AccountMaxPeers < 0 || c.PeerMaxConnections < 0 {
+		return fmt.Errorf("limits can't be negative")
+	}
 	return nil
 }
 
-func (c Config) HasCertConfig() bool {
-	return c.TlsCertFile != "" && c.TlsKeyFile != ""
+func (c Config) HasCluster() bool {
+	return c.ClusterListenAddress != ""
 }
 
 func (c Config) HasLetsEncrypt() bool {
@@ -83,11 +120,22 @@ func init() {
 	rootCmd.PersistentFlags().StringSliceVarP(&cobraConfig.LetsencryptDomains, "letsencrypt-domains", "a", nil, "list of domains to issue Let's Encrypt certificate for. Enables TLS using Let's Encrypt. Will fetch and renew certificate, and run the server with TLS")
 	rootCmd.PersistentFlags().StringVar(&cobraConfig.LetsencryptEmail, "letsencrypt-email", "", "email address to use for Let's Encrypt certificate registration")
 	rootCmd.PersistentFlags().BoolVar(&cobraConfig.LetsencryptAWSRoute53, "letsencrypt-aws-route53", false, "use AWS Route 53 for Let's Encrypt DNS challenge")
-	rootCmd.PersistentFlags().StringVarP(&cobraConfig.TlsCertFile, "tls-cert-file", "c", "", "")
-	rootCmd.PersistentFlags().StringVarP(&cobraConfig.TlsKeyFile, "tls-key-file", "k", "", "")
+	rootCmd.PersistentFlags().StringVar(&cobraConfig.LetsencryptChallenge, "letsencrypt-challenge", string(encryption.ChallengeTLSALPN01), "Let's Encrypt challenge used to verify the domains: tls-alpn-01 (on port 443), http-01 (on port 80) or dns-01 (with AWS Route 53, credentials are loaded from the environment)")
+	rootCmd.PersistentFlags().StringVarP(&cobraConfig.TlsCertFile, "tls-cert-file", "c", "", "location of the TLS certificate. The certificate is reloaded when the file changes")
+	rootCmd.PersistentFlags().StringVarP(&cobraConfig.TlsKeyFile, "tls-key-file", "k", "", "location of the TLS certificate private key")
 	rootCmd.PersistentFlags().StringVarP(&cobraConfig.AuthSecret, "auth-secret", "s", "", "auth secret")
 	rootCmd.PersistentFlags().StringVar(&cobraConfig.LogLevel, "log-level", "info", "log level")
 	rootCmd.PersistentFlags().StringVar(&cobraConfig.LogFile, "log-file", "console", "log file")
+	rootCmd.PersistentFlags().StringVar(&cobraConfig.ClusterListenAddress, "cluster-listen-address", "", "TCP address to accept the connections of the other relay instances on. Enables the relay cluster, so peers connected to different instances behind the same exposed address can reach each other")
+	rootCmd.PersistentFlags().StringSliceVar(&cobraConfig.ClusterPeers, "cluster-peers", nil, "list of the cluster addresses (host:port) of the relay instances. The own address may be included")
+	rootCmd.PersistentFlags().StringVar(&cobraConfig.ClusterInstanceID, "cluster-instance-id", "", "unique ID of this instance in the relay cluster. Defaults to the hostname")
+	rootCmd.PersistentFlags().StringVar(&cobraConfig.ClusterSecret, "cluster-secret", "", "secret shared by the relay instances of the cluster to authenticate each other. Must differ from the auth secret")
+	rootCmd.PersistentFlags().Int64Var(&cobraConfig.PeerRateLimit, "peer-rate-limit", 0, "default transfer rate limit of a peer in bytes per second, 0 disables it. The limits in the auth token of the peer override the defaults")
+	rootCmd.PersistentFlags().Int64Var(&cobraConfig.PeerBurstLimit, "peer-burst-limit", 0, "default number of bytes a peer can send at once above its rate limit. Defaults to one second of traffic")
+	rootCmd.PersistentFlags().Int64Var(&cobraConfig.AccountRateLimit, "account-rate-limit", 0, "default transfer rate limit of all the peers of an account in bytes per second, 0 disables it")
+	rootCmd.PersistentFlags().Int64Var(&cobraConfig.AccountBurstLimit, "account-burst-limit", 0, "default number of bytes the peers of an account can send at once above their rate limit. Defaults to one second of traffic")
+	rootCmd.PersistentFlags().IntVar(&cobraConfig.AccountMaxPeers, "account-max-peers", 0, "default number of peers of an account that can be connected at the same time, 0 disables the limit")
+	rootCmd.PersistentFlags().IntVar(&cobraConfig.PeerMaxConnections, "peer-max-connections", 0, "default number of connections a peer can have at the same time, 0 disables the limit. A reconnecting peer may need two")
 
 	setFlagsFromEnvVars(rootCmd)
 }
@@ -132,12 +180,37 @@ func execute(cmd *cobra.Command, args []string) error {
 		Address: cobraConfig.ListenAddress,
 	}
 
-	tlsConfig, tlsSupport, err := handleTLSConfig(cobraConfig)
+	certManager, err := handleTLSConfig(cobraConfig)
 	if err != nil {
 		log.Debugf("failed to setup TLS config: %s", err)
 		return fmt.Errorf("failed to setup TLS config: %s", err)
 	}
-	srvListenerCfg.TLSConfig = tlsConfig
+	tlsSupport := certManager != nil
+	if tlsSupport {
+		defer func() {
+			_ = certManager.Close()
+		}()
+		srvListenerCfg.TLSConfig = certManager.TLSConfig()
+		metricsServer.Handle(encryption.CertificateStatusEndpoint, certManager.StatusHandler())
+	}
+
+	if cobraConfig.HasCluster() {
+		srvListenerCfg.Cluster = &cluster.Config{
+			ListenAddress: cobraConfig.ClusterListenAddress,
+			Peers:         cobraConfig.ClusterPeers,
+			InstanceID:    cobraConfig.ClusterInstanceID,
+			Secret:        cobraConfig.ClusterSecret,
+		}
+	}
+
+	srvListenerCfg.Limits = &server.Limits{
+		PeerBytesPerSecond:    cobraConfig.PeerRateLimit,
+		PeerBurstBytes:        cobraConfig.PeerBurstLimit,
+		AccountBytesPerSecond: cobraConfig.AccountRateLimit,
+		AccountBurstBytes:     cobraConfig.AccountBurstLimit,
+		AccountMaxPeers:       cobraConfig.AccountMaxPeers,
+		PeerMaxConnections:    cobraConfig.PeerMaxConnections,
+	}
 
 	authenticator := auth.NewTimedHMACValidator(cobraConfig.AuthSecret, 24*time.Hour)
 	srv, err := server.NewServer(metricsServer.Meter, cobraConfig.ExposedAddress, tlsSupport, authenticator)
@@ -170,45 +243,39 @@ func execute(cmd *cobra.Command, args []string) error {
 	return shutDownErrors
 }
 
-func handleTLSConfig(cfg *Config) (*tls.Config, bool, error) {
+func handleTLSConfig(cfg *Config) (*encryption.CertificateManager, error) {
+	challenge, err := encryption.ParseChallenge(cfg.LetsencryptChallenge)
+	if err != nil {
+		return nil, err
+	}
 	if cfg.LetsencryptAWSRoute53 {
-		log.Debugf("using Let's Encrypt DNS resolver with Route 53 support")
-		r53 := encryption.Route53TLS{
-			DataDir: cfg.LetsencryptDataDir,
-			Email:   cfg.LetsencryptEmail,
-			Domains: cfg.LetsencryptDomains,
-		}
-		tlsCfg, err := r53.GetCertificate()
-		if err != nil {
-			return nil, false, fmt.Errorf("%s", err)
-		}
-		return tlsCfg, true, nil
+		challenge = encryption.ChallengeDNS01
 	}
 
-	if cfg.HasLetsEncrypt() {
-		log.Infof("setting up TLS with Let's Encrypt.")
-		tlsCfg, err := setupTLSCertManager(cfg.LetsencryptDataDir, cfg.LetsencryptDomains...)
-		if err != nil {
-			return nil, false, fmt.Errorf("%s", err)
-		}
-		return tlsCfg, true, nil
+	_, port, err := net.SplitHostPort(cfg.ListenAddress)
+	if err != nil {
+		return nil, fmt.Errorf("invalid listen address %s: %v", cfg.ListenAddress, err)
+	}
+	listenPort, err := strconv.Atoi(port)
+	if err != nil {
+		return nil, fmt.Errorf("invalid listen port %s: %v", port, err)
 	}
 
-	if cfg.HasCertConfig() {
-		log.Debugf("using file based TLS config")
-		tlsCfg, err := encryption.LoadTLSConfig(cfg.TlsCertFile, cfg.TlsKeyFile)
-		if err != nil {
-			return nil, false, fmt.Errorf("%s", err)
-		}
-		return tlsCfg, true, nil
+	certConfig := encryption.CertConfig{
+		LetsEncryptDataDir: cfg.LetsencryptDataDir,
+		LetsEncryptDomains: cfg.LetsencryptDomains,
+		LetsEncryptEmail:   cfg.LetsencryptEmail,
+		Challenge:          challenge,
+		ListenPort:         listenPort,
+	}
+	if !cfg.HasLetsEncrypt() && !cfg.LetsencryptAWSRoute53 {
+		certConfig.LetsEncryptDomains = nil
+		certConfig.CertFile = cfg.TlsCertFile
+		certConfig.CertKey = cfg.TlsKeyFile
 	}
-	return nil, false, nil
-}
 
-func setupTLSCertManager(letsencryptDataDir string, letsencryptDomains ...string) (*tls.Config, error) {
-	certManager, err := encryption.CreateCertManager(letsencryptDataDir, letsencryptDomains...)
-	if err != nil {
-		return nil, fmt.Errorf("failed creating LetsEncrypt cert manager: %v", err)
+	if !certConfig.Enabled() {
+		return nil, nil
 	}
-	return certManager.TLSConfig(), nil
+	return encryption.NewCertificateManager(certConfig)
 }
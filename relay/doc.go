@@ -2,13 +2,25 @@
 /*
 The `relay` package contains the implementation of the Relay server and client. The Relay server can be used to relay
 messages between peers on a single network channel. In this implementation the transport layer is the WebSocket
-protocol.
+protocol over TCP or QUIC datagrams over UDP, served on the same port.
 
 Between the server and client communication has been design a custom protocol and message format. These messages are
-transported over the WebSocket connection. Optionally the server can use TLS to secure the communication.
+transported over the WebSocket connection or in QUIC datagrams. Optionally the server can use TLS to secure the
+communication. QUIC always uses TLS, without a configured certificate the server generates a self-signed one. The
+certificate files are reloaded when they change and the expiry of the served certificate is reported on the
+/certificate path of the metrics port.
 
 The service can support multiple Relay server instances. For this purpose the peers must know the server instance URL.
 This URL will be sent to the target peer to choose the common Relay server for the communication via Signal service.
 
+The instances can also form a cluster behind a shared domain with the --cluster-listen-address and --cluster-peers
+flags. The instances announce their connected peers to each other and forward the messages of the peers connected to
+another instance, so two peers can talk even if the load balancer sent them to different instances. The instances
+authenticate each other with the --cluster-secret, which must differ from the auth secret, and connect over TLS.
+
+The server can limit the transfer rate of the peers and of their accounts, and the number of connected peers of an
+account. The defaults are set with flags, the Management server can override them per peer in the signed auth token.
+The messages above the rate limits are dropped.
+
 */
 package main
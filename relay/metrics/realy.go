@@ -6,6 +6,7 @@ import (
 	"time"
 
 	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
 )
 
@@ -19,11 +20,14 @@ type Metrics struct {
 	TransferBytesSent metric.Int64Counter
 	TransferBytesRecv metric.Int64Counter
 
-	peers            metric.Int64UpDownCounter
-	peerActivityChan chan string
-	peerLastActive   map[string]time.Time
-	mutexActivity    sync.Mutex
-	ctx              context.Context
+	peers               metric.Int64UpDownCounter
+	rateLimitedBytes    metric.Int64Counter
+	rateLimitedMessages metric.Int64Counter
+	rejectedConnections metric.Int64Counter
+	peerActivityChan    chan string
+	peerLastActive      map[string]time.Time
+	mutexActivity       sync.Mutex
+	ctx                 context.Context
 }
 
 func NewMetrics(ctx context.Context, meter metric.Meter) (*Metrics, error) {
@@ -42,6 +46,21 @@ func NewMetrics(ctx context.Context, meter metric.Meter) (*Metrics, error) {
 		return nil, err
 	}
 
+	rateLimitedBytes, err := meter.Int64Counter("relay_rate_limited_bytes_total")
+	if err != nil {
+		return nil, err
+	}
+
+	rateLimitedMessages, err := meter.Int64Counter("relay_rate_limited_messages_total")
+	if err != nil {
+		return nil, err
+	}
+
+	rejectedConnections, err := meter.Int64Counter("relay_rejected_connections_total")
+	if err != nil {
+		return nil, err
+	}
+
 	peersActive, err := meter.Int64ObservableGauge("relay_peers_active")
 	if err != nil {
 		return nil, err
@@ -58,6 +77,10 @@ func NewMetrics(ctx context.Context, meter metric.Meter) (*Metrics, error) {
 		TransferBytesRecv: bytesRecv,
 		peers:             peers,
 
+		rateLimitedBytes:    rateLimitedBytes,
+		rateLimitedMessages: rateLimitedMessages,
+		rejectedConnections: rejectedConnections,
+
 		ctx:              ctx,
 		peerActivityChan: make(chan string, 10),
 		peerLastActive:   make(map[string]time.Time),
@@ -107,6 +130,19 @@ func (m *Metrics) PeerActivity(peerID string) {
 	}
 }
 
+// RateLimited counts a transport message dropped because the peer exceeded a rate limit. The scope tells whether the
+// limit of the peer or of its account was exceeded.
+func (m *Metrics) RateLimited(scope string, bytes int) {
+	attrs := metric.WithAttributes(attribute.String("scope", scope))
+	m.rateLimitedMessages.Add(m.ctx, 1, attrs)
+	m.rateLimitedBytes.Add(m.ctx, int64(bytes), attrs)
+}
+
+// ConnectionRejected counts a peer connection rejected because of a connection limit
+func (m *Metrics) ConnectionRejected(reason string) {
+	m.rejectedConnections.Add(m.ctx, 1, metric.WithAttributes(attribute.String("reason", reason)))
+}
+
 func (m *Metrics) calculateActiveIdleConnections() (int64, int64) {
 	active, idle := int64(0), int64(0)
 	m.mutexActivity.Lock()
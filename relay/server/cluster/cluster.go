@@ -0,0 +1,365 @@
+package cluster
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/rs/xid"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	dialTimeout      = 10 * time.Second
+	handshakeTimeout = 10 * time.Second
+	writeTimeout     = 5 * time.Second
+	minRetryInterval = time.Second
+	maxRetryInterval = 30 * time.Second
+)
+
+// Config is the configuration of the relay cluster
+type Config struct {
+	// ListenAddress is the TCP address the instance accepts the connections of the other instances on
+	ListenAddress string
+	// Peers are the cluster addresses (host:port) of the other instances. The own address may be in the list, the
+	// instance detects it and skips it, so all instances can share the same list.
+	Peers []string
+	// InstanceID identifies the instance in the cluster. The hostname is used if it is empty.
+	InstanceID string
+	// Secret is shared by the instances only, they authenticate each other with it. It must differ from the relay auth
+	// secret, the peers hold tokens signed with that one.
+	Secret string
+}
+
+// LocalPeers gives access to the peers connected to this relay instance
+type LocalPeers interface {
+	// PeerIDs returns the IDs of the connected peers
+	PeerIDs() []string
+	// Deliver writes a transport message forwarded by another instance to a connected peer
+	Deliver(peerID string, msg []byte) bool
+}
+
+// Cluster connects relay instances into a mesh. Every instance announces the peers connected to it to the other
+// instances and forwards the transport messages of the peers connected to another instance to that instance, so the
+// peers can use any instance behind a shared domain.
+//
+// An instance dials every other instance and uses that connection to announce its peers and forward its messages,
+// the connections accepted from the other instances only receive. The connections run over TLS and both sides prove
+// the cluster secret in the handshake.
+type Cluster struct {
+	cfg        Config
+	instanceID string
+	local      LocalPeers
+	tlsConfig  *tls.Config
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	mu sync.RWMutex
+	// links are the outgoing connections by instance ID
+	links map[string]*link
+	// inbound are the accepted connections by instance ID
+	inbound map[string]net.Conn
+	// locations are the instance IDs of the peers connected to other instances
+	locations map[string]string
+	listener  net.Listener
+}
+
+// New creates a cluster member. Listen must be called to connect to the other instances.
+func New(cfg Config, local LocalPeers) (*Cluster, error) {
+	if cfg.ListenAddress == "" {
+		return nil, errors.New("cluster listen address is required")
+	}
+	if cfg.Secret == "" {
+		return nil, errors.New("cluster secret is required")
+	}
+
+	cert, err := generateCertificate()
+	if err != nil {
+		return nil, fmt.Errorf("generate cluster certificate: %w", err)
+	}
+
+	instanceID := cfg.InstanceID
+	if instanceID == "" {
+		hostname, err := os.Hostname()
+		if err != nil || hostname == "" {
+			hostname = xid.New().String()
+		}
+		instanceID = hostname
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Cluster{
+		cfg:        cfg,
+		instanceID: instanceID,
+		local:      local,
+		tlsConfig:  serverTLSConfig(cert),
+		ctx:        ctx,
+		cancel:     cancel,
+		links:      make(map[string]*link),
+		inbound:    make(map[string]net.Conn),
+		locations:  make(map[string]string),
+	}, nil
+}
+
+// InstanceID returns the ID of this instance in the cluster
+func (c *Cluster) InstanceID() string {
+	return c.instanceID
+}
+
+// Listen accepts the connections of the other instances and connects to them. It blocks until Shutdown is called.
+func (c *Cluster) Listen() error {
+	listener, err := net.Listen("tcp", c.cfg.ListenAddress)
+	if err != nil {
+		return fmt.Errorf("failed to bind cluster listener: %w", err)
+	}
+
+	c.mu.Lock()
+	if c.ctx.Err() != nil {
+		c.mu.Unlock()
+		return listener.Close()
+	}
+	c.listener = listener
+	// the goroutines are added under the lock so Shutdown can't wait for them before they are added
+	for _, addr := range c.cfg.Peers {
+		c.wg.Add(1)
+		go func(addr string) {
+			defer c.wg.Done()
+			c.connectLoop(addr)
+		}(addr)
+	}
+	c.mu.Unlock()
+
+	log.Infof("cluster listening address: %s, instance ID: %s", c.cfg.ListenAddress, c.instanceID)
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if c.ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("accept cluster connection: %w", err)
+		}
+
+		c.mu.Lock()
+		if c.ctx.Err() != nil {
+			c.mu.Unlock()
+			_ = conn.Close()
+			return nil
+		}
+		c.wg.Add(1)
+		c.mu.Unlock()
+
+		go func() {
+			defer c.wg.Done()
+			c.handleInbound(conn)
+		}()
+	}
+}
+
+// Shutdown closes the connections to the other instances
+func (c *Cluster) Shutdown() error {
+	c.cancel()
+
+	c.mu.Lock()
+	var err error
+	if c.listener != nil {
+		err = c.listener.Close()
+	}
+	for _, l := range c.links {
+		l.close()
+	}
+	for _, conn := range c.inbound {
+		_ = conn.Close()
+	}
+	c.mu.Unlock()
+
+	c.wg.Wait()
+	return err
+}
+
+// PeerAdded announces a peer connected to this instance to the other instances
+func (c *Cluster) PeerAdded(peerID string) {
+	c.broadcast(marshalFrame(framePeerAdded, []byte(peerID)))
+}
+
+// PeerRemoved announces a peer disconnected from this instance to the other instances
+func (c *Cluster) PeerRemoved(peerID string) {
+	c.broadcast(marshalFrame(framePeerRemoved, []byte(peerID)))
+}
+
+// Forward sends a transport message to the instance the peer is connected to. It returns false if the peer is not
+// connected to any instance or the message could not be queued.
+func (c *Cluster) Forward(peerID string, msg []byte) bool {
+	c.mu.RLock()
+	instanceID, ok := c.locations[peerID]
+	l := c.links[instanceID]
+	c.mu.RUnlock()
+	if !ok || l == nil {
+		return false
+	}
+
+	frame, err := marshalTransportFrame(peerID, msg)
+	if err != nil {
+		log.Errorf("failed to marshal transport frame: %s", err)
+		return false
+	}
+	return l.enqueue(frame)
+}
+
+// broadcast queues a control frame on every link. A link that can't take it is reset, the reconnection resends the
+// full peers snapshot.
+func (c *Cluster) broadcast(frame []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, l := range c.links {
+		if !l.enqueue(frame) {
+			l.log.Warnf("cluster link is congested, resetting it")
+			l.close()
+		}
+	}
+}
+
+func (c *Cluster) connectLoop(addr string) {
+	retryInterval := minRetryInterval
+	for {
+		conn, instanceID, err := c.dial(addr)
+		if err != nil {
+			if c.ctx.Err() != nil {
+				return
+			}
+			log.Debugf("failed to connect to relay instance %s: %s", addr, err)
+			if !c.wait(retryInterval) {
+				return
+			}
+			retryInterval = min(retryInterval*2, maxRetryInterval)
+			continue
+		}
+
+		if instanceID == c.instanceID {
+			log.Debugf("skipping own cluster address: %s", addr)
+			_ = conn.Close()
+			return
+		}
+
+		retryInterval = minRetryInterval
+		c.runLink(newLink(instanceID, addr, conn))
+
+		if !c.wait(minRetryInterval) {
+			return
+		}
+	}
+}
+
+func (c *Cluster) dial(addr string) (net.Conn, string, error) {
+	dialer := &net.Dialer{Timeout: dialTimeout}
+	tcpConn, err := dialer.DialContext(c.ctx, "tcp", addr)
+	if err != nil {
+		return nil, "", err
+	}
+
+	conn := tls.Client(tcpConn, clientTLSConfig())
+	instanceID, err := c.clientHandshake(conn)
+	if err != nil {
+		_ = conn.Close()
+		return nil, "", fmt.Errorf("handshake: %w", err)
+	}
+	return conn, instanceID, nil
+}
+
+// runLink registers the link, sends the peers snapshot and blocks until the link is closed
+func (c *Cluster) runLink(l *link) {
+	c.mu.Lock()
+	if c.ctx.Err() != nil {
+		c.mu.Unlock()
+		l.close()
+		return
+	}
+	if old, ok := c.links[l.instanceID]; ok {
+		old.close()
+	}
+	c.links[l.instanceID] = l
+
+	// the snapshot is queued under the lock so the announcements of the peers that connect meanwhile follow it
+	snapshot, err := marshalJSONFrame(framePeersSnapshot, c.local.PeerIDs())
+	if err == nil && l.enqueue(snapshot) {
+		l.log.Infof("connected to relay instance")
+	} else {
+		l.log.Errorf("failed to send peers snapshot: %v", err)
+		l.close()
+	}
+	c.mu.Unlock()
+
+	l.run()
+
+	c.mu.Lock()
+	if c.links[l.instanceID] == l {
+		delete(c.links, l.instanceID)
+	}
+	c.mu.Unlock()
+	l.log.Infof("disconnected from relay instance")
+}
+
+func (c *Cluster) wait(d time.Duration) bool {
+	select {
+	case <-c.ctx.Done():
+		return false
+	case <-time.After(d):
+		return true
+	}
+}
+
+func (c *Cluster) clientHandshake(conn *tls.Conn) (string, error) {
+	if err := conn.SetDeadline(time.Now().Add(handshakeTimeout)); err != nil {
+		return "", err
+	}
+	if err := conn.HandshakeContext(c.ctx); err != nil {
+		return "", fmt.Errorf("tls handshake: %w", err)
+	}
+
+	proof, err := c.proof(conn.ConnectionState(), roleClient, c.instanceID)
+	if err != nil {
+		return "", err
+	}
+
+	hello, err := marshalJSONFrame(frameHello, helloMsg{InstanceID: c.instanceID, Proof: proof})
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := conn.Write(hello); err != nil {
+		return "", fmt.Errorf("write hello: %w", err)
+	}
+
+	ft, payload, err := readFrame(newReader(conn))
+	if err != nil {
+		return "", fmt.Errorf("read hello response: %w", err)
+	}
+	if ft != frameHelloResponse {
+		return "", fmt.Errorf("unexpected frame: %s", ft)
+	}
+
+	var resp helloResponseMsg
+	if err := unmarshalJSON(payload, &resp); err != nil {
+		return "", err
+	}
+	if resp.InstanceID == "" {
+		return "", errors.New("empty instance ID")
+	}
+	// the dialed address is only trusted once it proved the cluster secret too
+	if err := c.verifyProof(conn.ConnectionState(), roleServer, resp.InstanceID, resp.Proof); err != nil {
+		return "", err
+	}
+
+	if err := conn.SetDeadline(time.Time{}); err != nil {
+		return "", err
+	}
+	return resp.InstanceID, nil
+}
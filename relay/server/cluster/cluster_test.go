@@ -0,0 +1,206 @@
+package cluster
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/relay/auth/hmac"
+)
+
+type fakeLocalPeers struct {
+	mu        sync.Mutex
+	peers     map[string]bool
+	delivered chan string
+}
+
+func newFakeLocalPeers(peerIDs ...string) *fakeLocalPeers {
+	f := &fakeLocalPeers{peers: map[string]bool{}, delivered: make(chan string, 10)}
+	for _, id := range peerIDs {
+		f.peers[id] = true
+	}
+	return f
+}
+
+func (f *fakeLocalPeers) PeerIDs() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var ids []string
+	for id := range f.peers {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func (f *fakeLocalPeers) Deliver(peerID string, msg []byte) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if !f.peers[peerID] {
+		return false
+	}
+	f.delivered <- peerID + ":" + string(msg)
+	return true
+}
+
+func startCluster(t *testing.T, cfg Config, local LocalPeers) *Cluster {
+	t.Helper()
+
+	c, err := New(cfg, local)
+	require.NoError(t, err)
+
+	go func() {
+		_ = c.Listen()
+	}()
+	t.Cleanup(func() {
+		_ = c.Shutdown()
+	})
+	return c
+}
+
+func TestCluster_Forward(t *testing.T) {
+	peers := []string{"127.0.0.1:52341", "127.0.0.1:52342"}
+	localA := newFakeLocalPeers("alice")
+	localB := newFakeLocalPeers()
+
+	clusterA := startCluster(t, Config{ListenAddress: peers[0], Peers: peers, InstanceID: "a", Secret: "secret"}, localA)
+	clusterB := startCluster(t, Config{ListenAddress: peers[1], Peers: peers, InstanceID: "b", Secret: "secret"}, localB)
+
+	// alice was connected before the instances met, it is announced in the snapshot
+	require.Eventually(t, func() bool {
+		return clusterB.Forward("alice", []byte("hello"))
+	}, 5*time.Second, 50*time.Millisecond)
+
+	select {
+	case msg := <-localA.delivered:
+		assert.Equal(t, "alice:hello", msg)
+	case <-time.After(5 * time.Second):
+		t.Fatalf("message was not delivered")
+	}
+
+	// bob connects later and is announced incrementally
+	localB.mu.Lock()
+	localB.peers["bob"] = true
+	localB.mu.Unlock()
+	clusterB.PeerAdded("bob")
+
+	require.Eventually(t, func() bool {
+		return clusterA.Forward("bob", []byte("hi"))
+	}, 5*time.Second, 50*time.Millisecond)
+	assert.Equal(t, "bob:hi", <-localB.delivered)
+
+	clusterB.PeerRemoved("bob")
+	require.Eventually(t, func() bool {
+		return !clusterA.Forward("bob", []byte("hi"))
+	}, 5*time.Second, 50*time.Millisecond)
+
+	assert.False(t, clusterA.Forward("unknown", []byte("hi")), "unknown peers should not be forwarded")
+}
+
+func TestCluster_InstanceDown(t *testing.T) {
+	peers := []string{"127.0.0.1:52343", "127.0.0.1:52344"}
+
+	clusterA := startCluster(t, Config{ListenAddress: peers[0], Peers: peers, InstanceID: "a", Secret: "secret"}, newFakeLocalPeers())
+	clusterB, err := New(Config{ListenAddress: peers[1], Peers: peers, InstanceID: "b", Secret: "secret"}, newFakeLocalPeers("bob"))
+	require.NoError(t, err)
+	go func() {
+		_ = clusterB.Listen()
+	}()
+
+	require.Eventually(t, func() bool {
+		return clusterA.Forward("bob", []byte("hi"))
+	}, 5*time.Second, 50*time.Millisecond)
+
+	require.NoError(t, clusterB.Shutdown())
+
+	require.Eventually(t, func() bool {
+		return !clusterA.Forward("bob", []byte("hi"))
+	}, 5*time.Second, 50*time.Millisecond, "the peers of a stopped instance should be forgotten")
+}
+
+func TestCluster_Unauthorized(t *testing.T) {
+	peers := []string{"127.0.0.1:52345", "127.0.0.1:52346"}
+
+	clusterA := startCluster(t, Config{ListenAddress: peers[0], Peers: peers, InstanceID: "a", Secret: "secret"}, newFakeLocalPeers())
+	startCluster(t, Config{ListenAddress: peers[1], Peers: peers, InstanceID: "b", Secret: "other"}, newFakeLocalPeers("bob"))
+
+	time.Sleep(time.Second)
+	assert.False(t, clusterA.Forward("bob", []byte("hi")), "instances with a different secret should not be trusted")
+}
+
+func TestCluster_RelayTokenRejected(t *testing.T) {
+	addr := "127.0.0.1:52347"
+	clusterA := startCluster(t, Config{ListenAddress: addr, InstanceID: "a", Secret: "cluster-secret"}, newFakeLocalPeers())
+
+	// a peer holds relay tokens signed with the auth secret, even one signed with the cluster secret must not do
+	for _, secret := range []string{"relay-secret", "cluster-secret"} {
+		t.Run(secret, func(t *testing.T) {
+			token, err := hmac.NewTimedHMACValidator(secret, time.Hour).GenerateToken(sha256.New)
+			require.NoError(t, err)
+			tokenStore := &hmac.TokenStore{}
+			require.NoError(t, tokenStore.UpdateToken(token))
+
+			var conn *tls.Conn
+			require.Eventually(t, func() bool {
+				conn, err = tls.Dial("tcp", addr, clientTLSConfig())
+				return err == nil
+			}, 5*time.Second, 50*time.Millisecond)
+			defer conn.Close()
+
+			hello, err := marshalJSONFrame(frameHello, helloMsg{InstanceID: "peer", Proof: tokenStore.TokenBinary()})
+			require.NoError(t, err)
+			_, err = conn.Write(hello)
+			require.NoError(t, err)
+
+			require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+			_, _, err = readFrame(newReader(conn))
+			assert.Error(t, err, "the connection should be closed without a hello response")
+
+			clusterA.mu.RLock()
+			assert.Empty(t, clusterA.inbound, "the peer should not be accepted as an instance")
+			clusterA.mu.RUnlock()
+		})
+	}
+}
+
+func TestCluster_DialedInstanceAuthenticated(t *testing.T) {
+	cert, err := generateCertificate()
+	require.NoError(t, err)
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", serverTLSConfig(cert))
+	require.NoError(t, err)
+	defer listener.Close()
+
+	// the server answers the hello without knowing the cluster secret
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		if _, _, err := readFrame(newReader(conn)); err != nil {
+			return
+		}
+		resp, err := marshalJSONFrame(frameHelloResponse, helloResponseMsg{InstanceID: "fake", Proof: []byte("proof")})
+		if err != nil {
+			return
+		}
+		_, _ = conn.Write(resp)
+		_, _ = conn.Read(make([]byte, 1))
+	}()
+
+	c, err := New(Config{ListenAddress: "127.0.0.1:0", InstanceID: "a", Secret: "cluster-secret"}, newFakeLocalPeers())
+	require.NoError(t, err)
+
+	conn, _, err := c.dial(listener.Addr().String())
+	if conn != nil {
+		_ = conn.Close()
+	}
+	assert.ErrorContains(t, err, "invalid proof", "an instance that doesn't prove the cluster secret should be rejected")
+}
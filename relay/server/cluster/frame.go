@@ -0,0 +1,117 @@
+package cluster
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+type frameType uint8
+
+const (
+	frameHello frameType = iota + 1
+	frameHelloResponse
+	framePeersSnapshot
+	framePeerAdded
+	framePeerRemoved
+	frameTransport
+)
+
+const (
+	sizeOfFrameHeader = 1 + 4
+	// maxFrameSize limits the size of a frame, the peers snapshot is the largest one
+	maxFrameSize = 16 * 1024 * 1024
+)
+
+func (t frameType) String() string {
+	switch t {
+	case frameHello:
+		return "hello"
+	case frameHelloResponse:
+		return "hello response"
+	case framePeersSnapshot:
+		return "peers snapshot"
+	case framePeerAdded:
+		return "peer added"
+	case framePeerRemoved:
+		return "peer removed"
+	case frameTransport:
+		return "transport"
+	default:
+		return "unknown"
+	}
+}
+
+type helloMsg struct {
+	InstanceID string `json:"instance_id"`
+	// Proof is the proof of the cluster secret of the dialing instance
+	Proof []byte `json:"proof"`
+}
+
+type helloResponseMsg struct {
+	InstanceID string `json:"instance_id"`
+	// Proof is the proof of the cluster secret of the dialed instance
+	Proof []byte `json:"proof"`
+}
+
+// marshalFrame encodes a frame as a 1 byte type, a 4 bytes big endian payload length and the payload
+func marshalFrame(t frameType, payload []byte) []byte {
+	frame := make([]byte, sizeOfFrameHeader+len(payload))
+	frame[0] = byte(t)
+	binary.BigEndian.PutUint32(frame[1:sizeOfFrameHeader], uint32(len(payload)))
+	copy(frame[sizeOfFrameHeader:], payload)
+	return frame
+}
+
+func marshalJSONFrame(t frameType, v any) ([]byte, error) {
+	payload, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("marshal %s frame: %w", t, err)
+	}
+	return marshalFrame(t, payload), nil
+}
+
+// marshalTransportFrame encodes the destination peer ID and the relay transport message in a transport frame
+func marshalTransportFrame(peerID string, msg []byte) ([]byte, error) {
+	if len(peerID) > 255 {
+		return nil, fmt.Errorf("peer ID too long: %d", len(peerID))
+	}
+
+	payload := make([]byte, 1+len(peerID)+len(msg))
+	payload[0] = byte(len(peerID))
+	copy(payload[1:], peerID)
+	copy(payload[1+len(peerID):], msg)
+	return marshalFrame(frameTransport, payload), nil
+}
+
+func unmarshalTransportFrame(payload []byte) (string, []byte, error) {
+	if len(payload) < 1 {
+		return "", nil, fmt.Errorf("empty transport frame")
+	}
+
+	idLen := int(payload[0])
+	if len(payload) < 1+idLen {
+		return "", nil, fmt.Errorf("invalid transport frame length: %d", len(payload))
+	}
+	return string(payload[1 : 1+idLen]), payload[1+idLen:], nil
+}
+
+func readFrame(r *bufio.Reader) (frameType, []byte, error) {
+	header := make([]byte, sizeOfFrameHeader)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return 0, nil, err
+	}
+
+	size := binary.BigEndian.Uint32(header[1:])
+	if size > maxFrameSize {
+		return 0, nil, fmt.Errorf("frame too large: %d", size)
+	}
+
+	payload := make([]byte, size)
+	if _, err := io.ReadFull(r, payload); err != nil {
+		return 0, nil, err
+	}
+	return frameType(header[0]), payload, nil
+}
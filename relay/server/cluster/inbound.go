@@ -0,0 +1,173 @@
+package cluster
+
+import (
+	"bufio"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const readBufferSize = 64 * 1024
+
+func newReader(conn net.Conn) *bufio.Reader {
+	return bufio.NewReaderSize(conn, readBufferSize)
+}
+
+func unmarshalJSON(payload []byte, v any) error {
+	if err := json.Unmarshal(payload, v); err != nil {
+		return fmt.Errorf("unmarshal frame: %w", err)
+	}
+	return nil
+}
+
+// handleInbound authenticates a connection of another instance and applies its announcements and forwarded messages
+// until it is closed
+func (c *Cluster) handleInbound(tcpConn net.Conn) {
+	conn := tls.Server(tcpConn, c.tlsConfig)
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	reader := newReader(conn)
+	instanceID, err := c.serverHandshake(conn, reader)
+	if err != nil {
+		log.Errorf("failed cluster handshake with %s: %s", conn.RemoteAddr(), err)
+		return
+	}
+
+	connLog := log.WithFields(log.Fields{"instance_id": instanceID, "address": conn.RemoteAddr()})
+
+	c.mu.Lock()
+	if c.ctx.Err() != nil {
+		c.mu.Unlock()
+		return
+	}
+	if old, ok := c.inbound[instanceID]; ok {
+		_ = old.Close()
+	}
+	c.inbound[instanceID] = conn
+	c.mu.Unlock()
+
+	connLog.Infof("relay instance connected")
+	err = c.readInbound(instanceID, reader)
+	if err != nil && !errors.Is(err, io.EOF) && c.ctx.Err() == nil {
+		connLog.Errorf("failed to read from relay instance: %s", err)
+	}
+
+	c.mu.Lock()
+	if c.inbound[instanceID] == conn {
+		delete(c.inbound, instanceID)
+		c.removeLocations(instanceID)
+	}
+	c.mu.Unlock()
+	connLog.Infof("relay instance disconnected")
+}
+
+func (c *Cluster) serverHandshake(conn *tls.Conn, reader *bufio.Reader) (string, error) {
+	if err := conn.SetDeadline(time.Now().Add(handshakeTimeout)); err != nil {
+		return "", err
+	}
+	if err := conn.HandshakeContext(c.ctx); err != nil {
+		return "", fmt.Errorf("tls handshake: %w", err)
+	}
+
+	ft, payload, err := readFrame(reader)
+	if err != nil {
+		return "", fmt.Errorf("read hello: %w", err)
+	}
+	if ft != frameHello {
+		return "", fmt.Errorf("unexpected frame: %s", ft)
+	}
+
+	var hello helloMsg
+	if err := unmarshalJSON(payload, &hello); err != nil {
+		return "", err
+	}
+	if hello.InstanceID == "" {
+		return "", errors.New("empty instance ID")
+	}
+	if err := c.verifyProof(conn.ConnectionState(), roleClient, hello.InstanceID, hello.Proof); err != nil {
+		return "", err
+	}
+
+	proof, err := c.proof(conn.ConnectionState(), roleServer, c.instanceID)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := marshalJSONFrame(frameHelloResponse, helloResponseMsg{InstanceID: c.instanceID, Proof: proof})
+	if err != nil {
+		return "", err
+	}
+	if _, err := conn.Write(resp); err != nil {
+		return "", fmt.Errorf("write hello response: %w", err)
+	}
+
+	if err := conn.SetDeadline(time.Time{}); err != nil {
+		return "", err
+	}
+	return hello.InstanceID, nil
+}
+
+func (c *Cluster) readInbound(instanceID string, reader *bufio.Reader) error {
+	for {
+		ft, payload, err := readFrame(reader)
+		if err != nil {
+			return err
+		}
+
+		switch ft {
+		case framePeersSnapshot:
+			var peerIDs []string
+			if err := unmarshalJSON(payload, &peerIDs); err != nil {
+				return err
+			}
+			c.applySnapshot(instanceID, peerIDs)
+		case framePeerAdded:
+			c.mu.Lock()
+			c.locations[string(payload)] = instanceID
+			c.mu.Unlock()
+		case framePeerRemoved:
+			c.mu.Lock()
+			if c.locations[string(payload)] == instanceID {
+				delete(c.locations, string(payload))
+			}
+			c.mu.Unlock()
+		case frameTransport:
+			peerID, msg, err := unmarshalTransportFrame(payload)
+			if err != nil {
+				return err
+			}
+			if !c.local.Deliver(peerID, msg) {
+				log.Debugf("peer %s forwarded by %s is not connected", peerID, instanceID)
+			}
+		default:
+			return fmt.Errorf("unexpected frame: %s", ft)
+		}
+	}
+}
+
+func (c *Cluster) applySnapshot(instanceID string, peerIDs []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.removeLocations(instanceID)
+	for _, peerID := range peerIDs {
+		c.locations[peerID] = instanceID
+	}
+}
+
+// removeLocations forgets the peers of an instance, the caller must hold the lock
+func (c *Cluster) removeLocations(instanceID string) {
+	for peerID, id := range c.locations {
+		if id == instanceID {
+			delete(c.locations, peerID)
+		}
+	}
+}
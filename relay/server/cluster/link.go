@@ -0,0 +1,83 @@
+package cluster
+
+import (
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const sendQueueSize = 4096
+
+// link is an outgoing connection to another instance. It carries the peer announcements and the forwarded transport
+// messages of this instance.
+type link struct {
+	instanceID string
+	conn       net.Conn
+	log        *log.Entry
+	sendCh     chan []byte
+	done       chan struct{}
+	closeOnce  sync.Once
+}
+
+func newLink(instanceID, addr string, conn net.Conn) *link {
+	return &link{
+		instanceID: instanceID,
+		conn:       conn,
+		log:        log.WithFields(log.Fields{"instance_id": instanceID, "address": addr}),
+		sendCh:     make(chan []byte, sendQueueSize),
+		done:       make(chan struct{}),
+	}
+}
+
+// enqueue queues a frame without blocking. It returns false if the queue is full or the link is closed.
+func (l *link) enqueue(frame []byte) bool {
+	select {
+	case <-l.done:
+		return false
+	default:
+	}
+
+	select {
+	case l.sendCh <- frame:
+		return true
+	default:
+		return false
+	}
+}
+
+// run writes the queued frames until the link is closed
+func (l *link) run() {
+	// the other side never writes after the handshake, reading only detects the closed connection
+	go func() {
+		_, _ = io.Copy(io.Discard, l.conn)
+		l.close()
+	}()
+
+	for {
+		select {
+		case <-l.done:
+			return
+		case frame := <-l.sendCh:
+			if err := l.conn.SetWriteDeadline(time.Now().Add(writeTimeout)); err != nil {
+				l.log.Errorf("failed to set write deadline: %s", err)
+				l.close()
+				return
+			}
+			if _, err := l.conn.Write(frame); err != nil {
+				l.log.Errorf("failed to write to relay instance: %s", err)
+				l.close()
+				return
+			}
+		}
+	}
+}
+
+func (l *link) close() {
+	l.closeOnce.Do(func() {
+		close(l.done)
+		_ = l.conn.Close()
+	})
+}
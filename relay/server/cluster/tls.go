@@ -0,0 +1,102 @@
+package cluster
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
+	"math/big"
+	"time"
+)
+
+const (
+	exporterLabel     = "EXPORTER-netbird-relay-cluster"
+	keyingMaterialLen = 32
+
+	roleClient = "client"
+	roleServer = "server"
+)
+
+// The connections between the instances run over TLS with self-signed certificates that are not verified. The
+// instances authenticate each other with proofs of the cluster secret bound to the TLS session instead, so neither a
+// certificate authority nor certificate files are needed.
+
+func serverTLSConfig(cert tls.Certificate) *tls.Config {
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS13,
+	}
+}
+
+func clientTLSConfig() *tls.Config {
+	return &tls.Config{
+		// the server proves the cluster secret in the handshake
+		InsecureSkipVerify: true, //nolint:gosec
+		MinVersion:         tls.VersionTLS13,
+	}
+}
+
+// proof authenticates one side of a connection: an HMAC with the cluster secret of the keying material of the TLS
+// session, the role of the side and its instance ID. It is only valid in this session, so it can't be replayed or
+// relayed to another instance.
+func (c *Cluster) proof(state tls.ConnectionState, role, instanceID string) ([]byte, error) {
+	keyingMaterial, err := state.ExportKeyingMaterial(exporterLabel, nil, keyingMaterialLen)
+	if err != nil {
+		return nil, fmt.Errorf("export keying material: %w", err)
+	}
+
+	mac := hmac.New(sha256.New, []byte(c.cfg.Secret))
+	mac.Write(keyingMaterial)
+	mac.Write([]byte(role))
+	mac.Write([]byte{0})
+	mac.Write([]byte(instanceID))
+	return mac.Sum(nil), nil
+}
+
+// verifyProof checks the proof of the other side of the connection
+func (c *Cluster) verifyProof(state tls.ConnectionState, role, instanceID string, proof []byte) error {
+	expected, err := c.proof(state, role, instanceID)
+	if err != nil {
+		return err
+	}
+	if !hmac.Equal(expected, proof) {
+		return fmt.Errorf("invalid proof of %s", instanceID)
+	}
+	return nil
+}
+
+func generateCertificate() (tls.Certificate, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return tls.Certificate{}, fmt.Errorf("generate key: %w", err)
+	}
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return tls.Certificate{}, fmt.Errorf("generate serial number: %w", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: "netbird-relay-cluster"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(10 * 365 * 24 * time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return tls.Certificate{}, fmt.Errorf("create certificate: %w", err)
+	}
+
+	return tls.Certificate{
+		Certificate: [][]byte{certDER},
+		PrivateKey:  key,
+	}, nil
+}
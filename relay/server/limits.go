@@ -0,0 +1,250 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/netbirdio/netbird/relay/auth/hmac"
+)
+
+const (
+	// minBurstBytes is the smallest burst of a rate limiter. A message larger than the burst could never pass.
+	minBurstBytes = bufferSize
+
+	limitScopePeer    = "peer"
+	limitScopeAccount = "account"
+)
+
+var (
+	errPeerMaxConnections = errors.New("peer reached the maximum number of connections")
+	errAccountMaxPeers    = errors.New("account reached the maximum number of connected peers")
+)
+
+// Limits are the default relay limits of the peers. The limits carried by the auth token of a peer override them.
+// A zero value disables the limit. The limits are enforced by every relay instance on its own.
+type Limits struct {
+	// PeerBytesPerSecond is the sustained transfer rate of a peer
+	PeerBytesPerSecond int64
+	// PeerBurstBytes is the number of bytes a peer can send at once above the sustained rate. Defaults to one second
+	// of traffic.
+	PeerBurstBytes int64
+	// AccountBytesPerSecond is the sustained transfer rate of all the peers of an account
+	AccountBytesPerSecond int64
+	// AccountBurstBytes is the number of bytes the peers of an account can send at once above the sustained rate.
+	// Defaults to one second of traffic.
+	AccountBurstBytes int64
+	// AccountMaxPeers is the number of peers of an account that can be connected at the same time
+	AccountMaxPeers int
+	// PeerMaxConnections is the number of connections a peer can have at the same time. A reconnecting peer may have
+	// two until the old connection times out.
+	PeerMaxConnections int
+}
+
+// merge returns the limits with the values set by the token overriding the defaults
+func (l Limits) merge(tokenLimits *hmac.Limits) Limits {
+	if tokenLimits == nil {
+		return l
+	}
+
+	if tokenLimits.PeerBytesPerSecond > 0 {
+		l.PeerBytesPerSecond = tokenLimits.PeerBytesPerSecond
+	}
+	if tokenLimits.PeerBurstBytes > 0 {
+		l.PeerBurstBytes = tokenLimits.PeerBurstBytes
+	}
+	if tokenLimits.AccountBytesPerSecond > 0 {
+		l.AccountBytesPerSecond = tokenLimits.AccountBytesPerSecond
+	}
+	if tokenLimits.AccountBurstBytes > 0 {
+		l.AccountBurstBytes = tokenLimits.AccountBurstBytes
+	}
+	if tokenLimits.AccountMaxPeers > 0 {
+		l.AccountMaxPeers = tokenLimits.AccountMaxPeers
+	}
+	if tokenLimits.PeerMaxConnections > 0 {
+		l.PeerMaxConnections = tokenLimits.PeerMaxConnections
+	}
+	return l
+}
+
+// accountState is the shared state of the connected peers of an account
+type accountState struct {
+	limiter *rate.Limiter
+	// peers counts the connections by peer ID, a reconnecting peer may have two until the old one times out
+	peers map[string]int
+}
+
+// limitsManager tracks the connections and the accounts of the connected peers and creates the rate limiters of the
+// peers
+type limitsManager struct {
+	mu       sync.Mutex
+	defaults Limits
+	accounts map[string]*accountState
+	// connections counts the connections by peer ID
+	connections map[string]int
+}
+
+func newLimitsManager() *limitsManager {
+	return &limitsManager{
+		accounts:    make(map[string]*accountState),
+		connections: make(map[string]int),
+	}
+}
+
+// setDefaults sets the limits of the peers whose token doesn't carry them
+func (m *limitsManager) setDefaults(defaults Limits) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.defaults = defaults
+}
+
+// acquire registers a connecting peer and returns its limiter. It fails if the peer reached the maximum number of
+// connections or its account the maximum number of connected peers. The limiter must be released when the peer
+// disconnects.
+func (m *limitsManager) acquire(peerID string, tokenLimits *hmac.Limits) (*peerLimiter, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	limits := m.defaults.merge(tokenLimits)
+	if limits.PeerMaxConnections > 0 && m.connections[peerID] >= limits.PeerMaxConnections {
+		return nil, fmt.Errorf("%w: %d", errPeerMaxConnections, limits.PeerMaxConnections)
+	}
+
+	pl := &peerLimiter{
+		peerID: peerID,
+		peer:   newRateLimiter(limits.PeerBytesPerSecond, limits.PeerBurstBytes),
+	}
+	if err := m.acquireAccount(pl, tokenLimits, limits); err != nil {
+		return nil, err
+	}
+
+	m.connections[peerID]++
+	return pl, nil
+}
+
+// acquireAccount registers the peer in its account and sets the account limiter of the peer
+func (m *limitsManager) acquireAccount(pl *peerLimiter, tokenLimits *hmac.Limits, limits Limits) error {
+	// the account limits need the account of the peer, the tokens of older management servers don't carry it
+	if tokenLimits == nil || tokenLimits.AccountID == "" {
+		return nil
+	}
+	if limits.AccountBytesPerSecond <= 0 && limits.AccountMaxPeers <= 0 {
+		return nil
+	}
+
+	account, ok := m.accounts[tokenLimits.AccountID]
+	if !ok {
+		account = &accountState{peers: make(map[string]int)}
+	}
+
+	if _, connected := account.peers[pl.peerID]; !connected && limits.AccountMaxPeers > 0 && len(account.peers) >= limits.AccountMaxPeers {
+		return fmt.Errorf("%w: account %s, %d", errAccountMaxPeers, tokenLimits.AccountID, limits.AccountMaxPeers)
+	}
+
+	// the limits of the latest token of the account apply to all of its peers
+	account.limiter = updateRateLimiter(account.limiter, limits.AccountBytesPerSecond, limits.AccountBurstBytes)
+	account.peers[pl.peerID]++
+	m.accounts[tokenLimits.AccountID] = account
+
+	pl.accountID = tokenLimits.AccountID
+	pl.account = account.limiter
+	return nil
+}
+
+// release unregisters a disconnected peer
+func (m *limitsManager) release(pl *peerLimiter) {
+	if pl == nil {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.connections[pl.peerID]--
+	if m.connections[pl.peerID] <= 0 {
+		delete(m.connections, pl.peerID)
+	}
+
+	if pl.accountID == "" {
+		return
+	}
+
+	account, ok := m.accounts[pl.accountID]
+	if !ok {
+		return
+	}
+
+	account.peers[pl.peerID]--
+	if account.peers[pl.peerID] <= 0 {
+		delete(account.peers, pl.peerID)
+	}
+	if len(account.peers) == 0 {
+		delete(m.accounts, pl.accountID)
+	}
+}
+
+// peerLimiter limits the transfer rate of a peer and of its account
+type peerLimiter struct {
+	peerID    string
+	accountID string
+	peer      *rate.Limiter
+	account   *rate.Limiter
+}
+
+// allow reports whether a message of n bytes can be relayed now. If not, it returns the scope of the exceeded limit.
+// The tokens are only consumed when both limits allow the message, a dropped message doesn't count against the peer.
+func (l *peerLimiter) allow(n int) (bool, string) {
+	if l == nil {
+		return true, ""
+	}
+
+	now := time.Now()
+	var reservation *rate.Reservation
+	if l.peer != nil {
+		reservation = l.peer.ReserveN(now, n)
+		if !reservation.OK() || reservation.DelayFrom(now) > 0 {
+			reservation.CancelAt(now)
+			return false, limitScopePeer
+		}
+	}
+	if l.account != nil && !l.account.AllowN(now, n) {
+		if reservation != nil {
+			reservation.CancelAt(now)
+		}
+		return false, limitScopeAccount
+	}
+	return true, ""
+}
+
+func newRateLimiter(bytesPerSecond, burstBytes int64) *rate.Limiter {
+	if bytesPerSecond <= 0 {
+		return nil
+	}
+	return rate.NewLimiter(rate.Limit(bytesPerSecond), burst(bytesPerSecond, burstBytes))
+}
+
+func updateRateLimiter(limiter *rate.Limiter, bytesPerSecond, burstBytes int64) *rate.Limiter {
+	if limiter == nil {
+		return newRateLimiter(bytesPerSecond, burstBytes)
+	}
+
+	// the limiter is shared by the connected peers of the account, it is updated in place
+	if bytesPerSecond <= 0 {
+		limiter.SetLimit(rate.Inf)
+		return limiter
+	}
+	limiter.SetLimit(rate.Limit(bytesPerSecond))
+	limiter.SetBurst(burst(bytesPerSecond, burstBytes))
+	return limiter
+}
+
+func burst(bytesPerSecond, burstBytes int64) int {
+	if burstBytes <= 0 {
+		burstBytes = bytesPerSecond
+	}
+	return int(max(burstBytes, minBurstBytes))
+}
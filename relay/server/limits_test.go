@@ -0,0 +1,136 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/relay/auth/hmac"
+)
+
+func TestLimitsManager_PeerRate(t *testing.T) {
+	m := newLimitsManager()
+	m.setDefaults(Limits{PeerBytesPerSecond: 1, PeerBurstBytes: 2 * minBurstBytes})
+
+	pl, err := m.acquire("peer", nil)
+	require.NoError(t, err)
+
+	allowed, _ := pl.allow(minBurstBytes)
+	assert.True(t, allowed, "the burst should pass")
+	allowed, _ = pl.allow(minBurstBytes)
+	assert.True(t, allowed, "the burst should pass")
+	allowed, scope := pl.allow(minBurstBytes)
+	assert.False(t, allowed, "the message above the burst should be dropped")
+	assert.Equal(t, limitScopePeer, scope)
+}
+
+func TestLimitsManager_TokenOverridesDefaults(t *testing.T) {
+	m := newLimitsManager()
+	m.setDefaults(Limits{PeerBytesPerSecond: 1})
+
+	pl, err := m.acquire("peer", &hmac.Limits{PeerBytesPerSecond: 1_000_000_000})
+	require.NoError(t, err)
+
+	for i := 0; i < 10; i++ {
+		allowed, _ := pl.allow(minBurstBytes)
+		assert.True(t, allowed, "the limit of the token should apply")
+	}
+}
+
+func TestLimitsManager_AccountRate(t *testing.T) {
+	m := newLimitsManager()
+	tokenLimits := &hmac.Limits{AccountID: "account", AccountBytesPerSecond: 1}
+
+	alice, err := m.acquire("alice", tokenLimits)
+	require.NoError(t, err)
+	bob, err := m.acquire("bob", tokenLimits)
+	require.NoError(t, err)
+
+	allowed, _ := alice.allow(minBurstBytes)
+	assert.True(t, allowed, "the burst of the account should pass")
+
+	allowed, scope := bob.allow(minBurstBytes)
+	assert.False(t, allowed, "the peers of the account should share the limit")
+	assert.Equal(t, limitScopeAccount, scope)
+
+	other, err := m.acquire("carol", &hmac.Limits{AccountID: "other", AccountBytesPerSecond: 1})
+	require.NoError(t, err)
+	allowed, _ = other.allow(minBurstBytes)
+	assert.True(t, allowed, "other accounts should not be affected")
+}
+
+func TestLimitsManager_AccountMaxPeers(t *testing.T) {
+	m := newLimitsManager()
+	m.setDefaults(Limits{AccountMaxPeers: 2})
+	tokenLimits := &hmac.Limits{AccountID: "account"}
+
+	alice, err := m.acquire("alice", tokenLimits)
+	require.NoError(t, err)
+	_, err = m.acquire("bob", tokenLimits)
+	require.NoError(t, err)
+
+	_, err = m.acquire("carol", tokenLimits)
+	assert.Error(t, err, "the account should be full")
+
+	_, err = m.acquire("alice", tokenLimits)
+	assert.NoError(t, err, "a reconnecting peer should not be rejected")
+
+	// alice has two connections, both must be released to make room
+	m.release(alice)
+	_, err = m.acquire("carol", tokenLimits)
+	assert.Error(t, err, "the account should be still full")
+
+	m.release(alice)
+	_, err = m.acquire("carol", tokenLimits)
+	assert.NoError(t, err)
+
+	_, err = m.acquire("dave", nil)
+	assert.NoError(t, err, "peers without account should not be limited")
+}
+
+func TestLimitsManager_DroppedMessageKeepsTokens(t *testing.T) {
+	m := newLimitsManager()
+	m.setDefaults(Limits{PeerBytesPerSecond: 1, PeerBurstBytes: 2 * minBurstBytes})
+	tokenLimits := &hmac.Limits{AccountID: "account", AccountBytesPerSecond: 1}
+
+	alice, err := m.acquire("alice", tokenLimits)
+	require.NoError(t, err)
+	bob, err := m.acquire("bob", tokenLimits)
+	require.NoError(t, err)
+
+	allowed, _ := bob.allow(minBurstBytes)
+	require.True(t, allowed, "the burst of the account should pass")
+
+	allowed, scope := alice.allow(minBurstBytes)
+	assert.False(t, allowed, "the account should be exhausted")
+	assert.Equal(t, limitScopeAccount, scope)
+
+	// the dropped message must not have consumed the tokens of alice
+	assert.Equal(t, float64(2*minBurstBytes), alice.peer.Tokens())
+}
+
+func TestLimitsManager_PeerMaxConnections(t *testing.T) {
+	m := newLimitsManager()
+	m.setDefaults(Limits{PeerMaxConnections: 2})
+
+	first, err := m.acquire("alice", nil)
+	require.NoError(t, err)
+	_, err = m.acquire("alice", nil)
+	require.NoError(t, err)
+
+	_, err = m.acquire("alice", nil)
+	assert.ErrorIs(t, err, errPeerMaxConnections)
+
+	_, err = m.acquire("bob", nil)
+	assert.NoError(t, err, "other peers should not be affected")
+
+	m.release(first)
+	_, err = m.acquire("alice", nil)
+	assert.NoError(t, err)
+
+	_, err = m.acquire("carol", &hmac.Limits{PeerMaxConnections: 1})
+	require.NoError(t, err)
+	_, err = m.acquire("carol", &hmac.Limits{PeerMaxConnections: 1})
+	assert.ErrorIs(t, err, errPeerMaxConnections, "the limit of the token should apply")
+}
@@ -0,0 +1,102 @@
+package quic
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+
+	"github.com/quic-go/quic-go"
+	log "github.com/sirupsen/logrus"
+)
+
+// ALPN is the application protocol negotiated in the TLS handshake of the relay QUIC connections.
+const ALPN = "nb-relay"
+
+type Listener struct {
+	// Address is the UDP address to listen on.
+	Address string
+	// TLSConfig is the TLS configuration for the server. If it is nil, a self-signed certificate is used.
+	TLSConfig *tls.Config
+
+	transport *quic.Transport
+	listener  *quic.Listener
+	mu        sync.Mutex
+	acceptFn  func(conn net.Conn)
+}
+
+func (l *Listener) Listen(acceptFn func(conn net.Conn)) error {
+	l.acceptFn = acceptFn
+
+	tlsConfig, err := ServerTLSConfig(l.TLSConfig)
+	if err != nil {
+		return fmt.Errorf("prepare TLS config: %w", err)
+	}
+
+	quicCfg := &quic.Config{
+		EnableDatagrams: true,
+	}
+	udpAddr, err := net.ResolveUDPAddr("udp", l.Address)
+	if err != nil {
+		return fmt.Errorf("resolve QUIC listen address: %w", err)
+	}
+	udpConn, err := net.ListenUDP("udp", udpAddr)
+	if err != nil {
+		return fmt.Errorf("failed to bind QUIC listener: %w", err)
+	}
+
+	// the transport is owned by the listener so closing the listener does not tear down the established connections
+	transport := &quic.Transport{Conn: udpConn}
+	listener, err := transport.Listen(tlsConfig, quicCfg)
+	if err != nil {
+		_ = transport.Close()
+		_ = udpConn.Close()
+		return fmt.Errorf("failed to create QUIC listener: %w", err)
+	}
+
+	l.mu.Lock()
+	l.transport = transport
+	l.listener = listener
+	l.mu.Unlock()
+
+	log.Infof("QUIC server listening address: %s", l.Address)
+	for {
+		session, err := listener.Accept(context.Background())
+		if err != nil {
+			if errors.Is(err, quic.ErrServerClosed) {
+				return nil
+			}
+			return fmt.Errorf("accept QUIC connection: %w", err)
+		}
+
+		log.Debugf("new QUIC connection from: %s", session.RemoteAddr())
+		conn := NewConn(session)
+		go l.acceptFn(conn)
+	}
+}
+
+func (l *Listener) Shutdown(ctx context.Context) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.listener == nil {
+		return nil
+	}
+
+	log.Infof("stop QUIC listener")
+	if err := l.listener.Close(); err != nil {
+		return fmt.Errorf("listener shutdown failed: %v", err)
+	}
+	if err := l.transport.Close(); err != nil {
+		return fmt.Errorf("transport shutdown failed: %v", err)
+	}
+	// the transport does not close the connection it did not create
+	if err := l.transport.Conn.Close(); err != nil {
+		return fmt.Errorf("close UDP connection: %v", err)
+	}
+	l.listener = nil
+	log.Infof("QUIC listener stopped")
+	return nil
+}
@@ -0,0 +1,164 @@
+package quic
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/quic-go/quic-go"
+)
+
+const (
+	// MaxDatagramSize is the largest relay message sent in a QUIC datagram. A datagram has to fit in a single QUIC
+	// packet, which is only guaranteed to carry about 1200 bytes, and quic-go silently drops the ones that do not fit.
+	MaxDatagramSize = 1100
+
+	// messageHeaderSize is the size of the length prefix of the messages sent on a stream
+	messageHeaderSize = 2
+	maxStreamMessage  = 1<<(8*messageHeaderSize) - 1
+
+	receiveQueueSize = 64
+)
+
+// Messages sends and receives the relay messages of a QUIC connection. The messages up to MaxDatagramSize are sent in
+// datagrams, the larger ones, like MTU sized WireGuard packets, are sent length-prefixed on a unidirectional stream.
+type Messages struct {
+	session quic.Connection
+	ctx     context.Context
+
+	received chan []byte
+	done     chan struct{}
+	err      error
+	errOnce  sync.Once
+
+	streamMu sync.Mutex
+	stream   quic.SendStream
+}
+
+// NewMessages starts receiving the messages of the session until ctx is done or the session is closed
+func NewMessages(ctx context.Context, session quic.Connection) *Messages {
+	m := &Messages{
+		session:  session,
+		ctx:      ctx,
+		received: make(chan []byte, receiveQueueSize),
+		done:     make(chan struct{}),
+	}
+	go m.receiveDatagrams()
+	go m.acceptStreams()
+	return m
+}
+
+// Read returns the next message, it fails with io.ErrShortBuffer when the message does not fit in b
+func (m *Messages) Read(b []byte) (int, error) {
+	var msg []byte
+	select {
+	case msg = <-m.received:
+	case <-m.done:
+		// prefer the messages received before the session was closed
+		select {
+		case msg = <-m.received:
+		default:
+			return 0, m.err
+		}
+	}
+
+	if len(msg) > len(b) {
+		return 0, io.ErrShortBuffer
+	}
+	return copy(b, msg), nil
+}
+
+func (m *Messages) Write(b []byte) (int, error) {
+	if len(b) <= MaxDatagramSize {
+		if err := m.session.SendDatagram(b); err != nil {
+			return 0, err
+		}
+		return len(b), nil
+	}
+
+	if len(b) > maxStreamMessage {
+		return 0, fmt.Errorf("message of %d bytes exceeds the limit of %d bytes", len(b), maxStreamMessage)
+	}
+
+	frame := make([]byte, messageHeaderSize+len(b))
+	binary.BigEndian.PutUint16(frame, uint16(len(b)))
+	copy(frame[messageHeaderSize:], b)
+
+	m.streamMu.Lock()
+	defer m.streamMu.Unlock()
+
+	if m.stream == nil {
+		stream, err := m.session.OpenUniStreamSync(m.ctx)
+		if err != nil {
+			return 0, err
+		}
+		m.stream = stream
+	}
+
+	if _, err := m.stream.Write(frame); err != nil {
+		return 0, err
+	}
+	return len(b), nil
+}
+
+func (m *Messages) receiveDatagrams() {
+	for {
+		dgram, err := m.session.ReceiveDatagram(m.ctx)
+		if err != nil {
+			m.fail(err)
+			return
+		}
+
+		if !m.deliver(dgram) {
+			return
+		}
+	}
+}
+
+func (m *Messages) acceptStreams() {
+	for {
+		stream, err := m.session.AcceptUniStream(m.ctx)
+		if err != nil {
+			m.fail(err)
+			return
+		}
+		go m.receiveStream(stream)
+	}
+}
+
+func (m *Messages) receiveStream(stream quic.ReceiveStream) {
+	header := make([]byte, messageHeaderSize)
+	for {
+		if _, err := io.ReadFull(stream, header); err != nil {
+			return
+		}
+
+		msg := make([]byte, binary.BigEndian.Uint16(header))
+		if _, err := io.ReadFull(stream, msg); err != nil {
+			return
+		}
+
+		if !m.deliver(msg) {
+			return
+		}
+	}
+}
+
+// deliver queues a received message for Read, it reports false once the session is closed
+func (m *Messages) deliver(msg []byte) bool {
+	select {
+	case m.received <- msg:
+		return true
+	case <-m.done:
+		return false
+	}
+}
+
+func (m *Messages) fail(err error) {
+	m.errOnce.Do(func() {
+		m.err = err
+		close(m.done)
+	})
+}
@@ -27,6 +27,15 @@ type Peer struct {
 	conn    net.Conn
 	connMu  sync.RWMutex
 	store   *Store
+	// forwarder sends the messages of the peers connected to other relay instances, nil without a cluster
+	forwarder Forwarder
+	// limiter enforces the rate limits of the peer and its account, nil without limits
+	limiter *peerLimiter
+}
+
+// Forwarder forwards transport messages to the peers that are connected to other relay instances
+type Forwarder interface {
+	Forward(peerID string, msg []byte) bool
 }
 
 // NewPeer creates a new Peer instance and prepare custom logging
@@ -175,6 +184,12 @@ func (p *Peer) handleHealthcheckEvents(ctx context.Context, hc *healthcheck.Send
 }
 
 func (p *Peer) handleTransportMsg(msg []byte) {
+	if ok, scope := p.limiter.allow(len(msg)); !ok {
+		p.log.Tracef("dropping transport message, %s rate limit exceeded", scope)
+		p.metrics.RateLimited(scope, len(msg))
+		return
+	}
+
 	peerID, err := messages.UnmarshalTransportID(msg[messages.SizeOfProtoHeader:])
 	if err != nil {
 		p.log.Errorf("failed to unmarshal transport message: %s", err)
@@ -183,7 +198,7 @@ func (p *Peer) handleTransportMsg(msg []byte) {
 
 	stringPeerID := messages.HashIDToString(peerID)
 	dp, ok := p.store.Peer(stringPeerID)
-	if !ok {
+	if !ok && p.forwarder == nil {
 		p.log.Errorf("peer not found: %s", stringPeerID)
 		return
 	}
@@ -194,6 +209,15 @@ func (p *Peer) handleTransportMsg(msg []byte) {
 		return
 	}
 
+	if !ok {
+		if !p.forwarder.Forward(stringPeerID, msg) {
+			p.log.Errorf("peer not found: %s", stringPeerID)
+			return
+		}
+		p.metrics.TransferBytesSent.Add(context.Background(), int64(len(msg)))
+		return
+	}
+
 	n, err := dp.Write(msg)
 	if err != nil {
 		p.log.Errorf("failed to write transport message to: %s", dp.String())
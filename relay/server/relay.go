@@ -3,6 +3,7 @@ package server
 import (
 	"context"
 	"crypto/sha256"
+	"errors"
 	"fmt"
 	"net"
 	"net/url"
@@ -13,10 +14,12 @@ import (
 	"go.opentelemetry.io/otel/metric"
 
 	"github.com/netbirdio/netbird/relay/auth"
+	"github.com/netbirdio/netbird/relay/auth/hmac"
 	"github.com/netbirdio/netbird/relay/messages"
 	"github.com/netbirdio/netbird/relay/messages/address"
 	authmsg "github.com/netbirdio/netbird/relay/messages/auth"
 	"github.com/netbirdio/netbird/relay/metrics"
+	"github.com/netbirdio/netbird/relay/server/cluster"
 )
 
 // Relay represents the relay server
@@ -27,6 +30,9 @@ type Relay struct {
 
 	store       *Store
 	instanceURL string
+	// cluster is the mesh of the relay instances, nil if the instance runs standalone
+	cluster *cluster.Cluster
+	limits  *limitsManager
 
 	closed  bool
 	closeMu sync.RWMutex
@@ -60,6 +66,7 @@ func NewRelay(meter metric.Meter, exposedAddress string, tlsSupport bool, valida
 		metricsCancel: metricsCancel,
 		validator:     validator,
 		store:         NewStore(),
+		limits:        newLimitsManager(),
 	}
 
 	r.instanceURL, err = getInstanceURL(exposedAddress, tlsSupport)
@@ -102,10 +109,13 @@ func (r *Relay) Accept(conn net.Conn) {
 	r.closeMu.RLock()
 	defer r.closeMu.RUnlock()
 	if r.closed {
+		if err := conn.Close(); err != nil {
+			log.Errorf("failed to close connection, %s: %s", conn.RemoteAddr(), err)
+		}
 		return
 	}
 
-	peerID, err := r.handshake(conn)
+	peerID, limiter, err := r.handshake(conn)
 	if err != nil {
 		log.Errorf("failed to handshake: %s", err)
 		cErr := conn.Close()
@@ -116,17 +126,46 @@ func (r *Relay) Accept(conn net.Conn) {
 	}
 
 	peer := NewPeer(r.metrics, peerID, conn, r.store)
+	peer.limiter = limiter
 	peer.log.Infof("peer connected from: %s", conn.RemoteAddr())
 	r.store.AddPeer(peer)
+	if r.cluster != nil {
+		peer.forwarder = r.cluster
+		r.cluster.PeerAdded(peer.String())
+	}
 	r.metrics.PeerConnected(peer.String())
 	go func() {
 		peer.Work()
-		r.store.DeletePeer(peer)
+		if r.store.DeletePeer(peer) && r.cluster != nil {
+			r.cluster.PeerRemoved(peer.String())
+		}
+		r.limits.release(limiter)
 		peer.log.Debugf("relay connection closed")
 		r.metrics.PeerDisconnected(peer.String())
 	}()
 }
 
+// PeerIDs returns the IDs of the peers connected to this instance
+func (r *Relay) PeerIDs() []string {
+	return r.store.PeerIDs()
+}
+
+// Deliver writes a transport message forwarded by another relay instance of the cluster to a connected peer
+func (r *Relay) Deliver(peerID string, msg []byte) bool {
+	dp, ok := r.store.Peer(peerID)
+	if !ok {
+		return false
+	}
+
+	n, err := dp.Write(msg)
+	if err != nil {
+		dp.log.Errorf("failed to write forwarded transport message: %s", err)
+		return false
+	}
+	r.metrics.TransferBytesSent.Add(context.Background(), int64(n))
+	return true
+}
+
 // Shutdown closes the relay server
 // It closes the connection with all peers in gracefully and stops accepting new connections.
 func (r *Relay) Shutdown(ctx context.Context) {
@@ -151,56 +190,85 @@ func (r *Relay) InstanceURL() string {
 	return r.instanceURL
 }
 
-func (r *Relay) handshake(conn net.Conn) ([]byte, error) {
+// handshake authenticates the peer and registers it in the limits manager. The returned limiter must be released when
+// the peer disconnects.
+func (r *Relay) handshake(conn net.Conn) ([]byte, *peerLimiter, error) {
 	buf := make([]byte, messages.MaxHandshakeSize)
 	n, err := conn.Read(buf)
 	if err != nil {
-		return nil, fmt.Errorf("read from %s: %w", conn.RemoteAddr(), err)
+		return nil, nil, fmt.Errorf("read from %s: %w", conn.RemoteAddr(), err)
 	}
 
 	_, err = messages.ValidateVersion(buf[:n])
 	if err != nil {
-		return nil, fmt.Errorf("validate version from %s: %w", conn.RemoteAddr(), err)
+		return nil, nil, fmt.Errorf("validate version from %s: %w", conn.RemoteAddr(), err)
 	}
 
 	msgType, err := messages.DetermineClientMessageType(buf[messages.SizeOfVersionByte:n])
 	if err != nil {
-		return nil, fmt.Errorf("determine message type from %s: %w", conn.RemoteAddr(), err)
+		return nil, nil, fmt.Errorf("determine message type from %s: %w", conn.RemoteAddr(), err)
 	}
 
 	if msgType != messages.MsgTypeHello {
-		return nil, fmt.Errorf("invalid message type from %s", conn.RemoteAddr())
+		return nil, nil, fmt.Errorf("invalid message type from %s", conn.RemoteAddr())
 	}
 
 	peerID, authData, err := messages.UnmarshalHelloMsg(buf[messages.SizeOfProtoHeader:n])
 	if err != nil {
-		return nil, fmt.Errorf("unmarshal hello message: %w", err)
+		return nil, nil, fmt.Errorf("unmarshal hello message: %w", err)
 	}
 
 	authMsg, err := authmsg.UnmarshalMsg(authData)
 	if err != nil {
-		return nil, fmt.Errorf("unmarshal auth message: %w", err)
+		return nil, nil, fmt.Errorf("unmarshal auth message: %w", err)
 	}
 
-	if err := r.validator.Validate(sha256.New, authMsg.AdditionalData); err != nil {
-		return nil, fmt.Errorf("validate %s (%s): %w", peerID, conn.RemoteAddr(), err)
+	tokenLimits, err := r.validate(authMsg.AdditionalData)
+	if err != nil {
+		return nil, nil, fmt.Errorf("validate %s (%s): %w", peerID, conn.RemoteAddr(), err)
+	}
+
+	limiter, err := r.limits.acquire(messages.HashIDToString(peerID), tokenLimits)
+	if err != nil {
+		reason := "account_max_peers"
+		if errors.Is(err, errPeerMaxConnections) {
+			reason = "peer_max_connections"
+		}
+		r.metrics.ConnectionRejected(reason)
+		return nil, nil, fmt.Errorf("limit %s (%s): %w", peerID, conn.RemoteAddr(), err)
 	}
 
+	if err := r.writeHelloResponse(conn, peerID); err != nil {
+		r.limits.release(limiter)
+		return nil, nil, err
+	}
+	return peerID, limiter, nil
+}
+
+// validate checks the credentials of the peer and returns the limits they carry, if the validator supports them
+func (r *Relay) validate(credentials any) (*hmac.Limits, error) {
+	if v, ok := r.validator.(auth.LimitsValidator); ok {
+		return v.ValidateLimits(sha256.New, credentials)
+	}
+	return nil, r.validator.Validate(sha256.New, credentials)
+}
+
+func (r *Relay) writeHelloResponse(conn net.Conn, peerID []byte) error {
 	addr := &address.Address{URL: r.instanceURL}
 	addrData, err := addr.Marshal()
 	if err != nil {
-		return nil, fmt.Errorf("marshal addressc to %s (%s): %w", peerID, conn.RemoteAddr(), err)
+		return fmt.Errorf("marshal addressc to %s (%s): %w", peerID, conn.RemoteAddr(), err)
 	}
 
 	msg, err := messages.MarshalHelloResponse(addrData)
 	if err != nil {
-		return nil, fmt.Errorf("marshal hello response to %s (%s): %w", peerID, conn.RemoteAddr(), err)
+		return fmt.Errorf("marshal hello response to %s (%s): %w", peerID, conn.RemoteAddr(), err)
 	}
 
 	_, err = conn.Write(msg)
 	if err != nil {
-		return nil, fmt.Errorf("write to %s (%s): %w", peerID, conn.RemoteAddr(), err)
+		return fmt.Errorf("write to %s (%s): %w", peerID, conn.RemoteAddr(), err)
 	}
 
-	return peerID, nil
+	return nil
 }
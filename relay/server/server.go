@@ -3,29 +3,40 @@ package server
 import (
 	"context"
 	"crypto/tls"
+	"fmt"
+	"sync"
 
+	"github.com/hashicorp/go-multierror"
 	log "github.com/sirupsen/logrus"
 	"go.opentelemetry.io/otel/metric"
 
 	"github.com/netbirdio/netbird/relay/auth"
+	"github.com/netbirdio/netbird/relay/server/cluster"
 	"github.com/netbirdio/netbird/relay/server/listener"
+	"github.com/netbirdio/netbird/relay/server/listener/quic"
 	"github.com/netbirdio/netbird/relay/server/listener/ws"
 )
 
 // ListenerConfig is the configuration for the listener.
 // Address: the address to bind the listener to. It could be an address behind a reverse proxy.
 // TLSConfig: the TLS configuration for the listener.
+// Cluster: the optional configuration of the relay-to-relay mesh. Without it the instance runs standalone.
+// Limits: the optional default limits of the peers. The limits carried by the auth tokens override them.
 type ListenerConfig struct {
 	Address   string
 	TLSConfig *tls.Config
+	Cluster   *cluster.Config
+	Limits    *Limits
 }
 
 // Server is the main entry point for the relay server.
-// It is the gate between the WebSocket listener and the Relay server logic.
-// In a new HTTP connection, the server will accept the connection and pass it to the Relay server via the Accept method.
+// It is the gate between the WebSocket and QUIC listeners and the Relay server logic.
+// In a new connection, the server will accept the connection and pass it to the Relay server via the Accept method.
 type Server struct {
-	relay      *Relay
-	wSListener listener.Listener
+	relay       *Relay
+	cluster     *cluster.Cluster
+	listeners   []listener.Listener
+	listenersMu sync.Mutex
 }
 
 // NewServer creates a new relay server instance.
@@ -43,31 +54,94 @@ func NewServer(meter metric.Meter, exposedAddress string, tlsSupport bool, authV
 	}, nil
 }
 
-// Listen starts the relay server.
+// Listen starts the relay server. The WebSocket listener binds the TCP and the QUIC listener the UDP port of the
+// address. It blocks until all the listeners are stopped.
 func (r *Server) Listen(cfg ListenerConfig) error {
-	r.wSListener = &ws.Listener{
+	wSListener := &ws.Listener{
 		Address:   cfg.Address,
 		TLSConfig: cfg.TLSConfig,
 	}
+	quicListener := &quic.Listener{
+		Address:   cfg.Address,
+		TLSConfig: cfg.TLSConfig,
+	}
+	listeners := []listener.Listener{wSListener, quicListener}
+
+	if cfg.Limits != nil {
+		r.relay.limits.setDefaults(*cfg.Limits)
+	}
+
+	var clusterMember *cluster.Cluster
+	if cfg.Cluster != nil {
+		var err error
+		clusterMember, err = cluster.New(*cfg.Cluster, r.relay)
+		if err != nil {
+			return fmt.Errorf("create cluster: %w", err)
+		}
+		// the cluster is set before the listeners start so every accepted peer is announced
+		r.relay.cluster = clusterMember
+	}
+
+	r.listenersMu.Lock()
+	r.listeners = append(r.listeners, listeners...)
+	r.cluster = clusterMember
+	r.listenersMu.Unlock()
 
-	wslErr := r.wSListener.Listen(r.relay.Accept)
-	if wslErr != nil {
-		log.Errorf("failed to bind ws server: %s", wslErr)
+	errChan := make(chan error, len(listeners)+1)
+	wg := sync.WaitGroup{}
+	if clusterMember != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := clusterMember.Listen(); err != nil {
+				log.Errorf("failed to run cluster: %s", err)
+				errChan <- err
+			}
+		}()
 	}
+	for _, l := range listeners {
+		wg.Add(1)
+		go func(listener listener.Listener) {
+			defer wg.Done()
+			if err := listener.Listen(r.relay.Accept); err != nil {
+				log.Errorf("failed to bind server: %s", err)
+				errChan <- err
+			}
+		}(l)
+	}
+
+	wg.Wait()
+	close(errChan)
 
-	return wslErr
+	var multiErr *multierror.Error
+	for err := range errChan {
+		multiErr = multierror.Append(multiErr, err)
+	}
+	return multiErr.ErrorOrNil()
 }
 
 // Shutdown stops the relay server. If there are active connections, they will be closed gracefully. In case of a context,
 // the connections will be forcefully closed.
-func (r *Server) Shutdown(ctx context.Context) (err error) {
-	// stop service new connections
-	if r.wSListener != nil {
-		err = r.wSListener.Shutdown(ctx)
+func (r *Server) Shutdown(ctx context.Context) error {
+	// close the connections before the listeners, the QUIC connections can't be closed gracefully without the listener
+	r.relay.Shutdown(ctx)
+
+	r.listenersMu.Lock()
+	defer r.listenersMu.Unlock()
+
+	var multiErr *multierror.Error
+	for _, l := range r.listeners {
+		if err := l.Shutdown(ctx); err != nil {
+			multiErr = multierror.Append(multiErr, err)
+		}
 	}
 
-	r.relay.Shutdown(ctx)
-	return
+	if r.cluster != nil {
+		if err := r.cluster.Shutdown(); err != nil {
+			multiErr = multierror.Append(multiErr, err)
+		}
+	}
+	return multiErr.ErrorOrNil()
 }
 
 // InstanceURL returns the instance URL of the relay server.
@@ -45,6 +45,32 @@ const (
 	DomainNetwork
 )
 
+// Strategy is how a client picks the routing peer among the routes of a HA group
+type Strategy string
+
+const (
+	// StrategyDefault scores the routing peers by metric, latency and connection type
+	StrategyDefault Strategy = ""
+	// StrategyMetric picks the routing peer with the lowest metric
+	StrategyMetric Strategy = "metric"
+	// StrategyLatency picks the routing peer with the lowest latency
+	StrategyLatency Strategy = "latency"
+	// StrategySticky keeps the routing peer as long as it is connected, the next one is picked with the default strategy
+	StrategySticky Strategy = "sticky"
+	// StrategyLoadBalance spreads the destinations of the network across all the connected routing peers
+	StrategyLoadBalance Strategy = "load-balance"
+)
+
+// IsValid returns true if the strategy is known
+func (s Strategy) IsValid() bool {
+	switch s {
+	case StrategyDefault, StrategyMetric, StrategyLatency, StrategySticky, StrategyLoadBalance:
+		return true
+	default:
+		return false
+	}
+}
+
 type ID string
 
 type NetID string
@@ -100,6 +126,7 @@ type Route struct {
 	Metric      int
 	Enabled     bool
 	Groups      []string `gorm:"serializer:json"`
+	Strategy    Strategy
 }
 
 // EventMeta returns activity event meta related to the route
@@ -123,6 +150,7 @@ func (r *Route) Copy() *Route {
 		Masquerade:  r.Masquerade,
 		Enabled:     r.Enabled,
 		Groups:      slices.Clone(r.Groups),
+		Strategy:    r.Strategy,
 	}
 	return route
 }
@@ -146,6 +174,7 @@ func (r *Route) IsEqual(other *Route) bool {
 		other.Metric == r.Metric &&
 		other.Masquerade == r.Masquerade &&
 		other.Enabled == r.Enabled &&
+		other.Strategy == r.Strategy &&
 		slices.Equal(r.Groups, other.Groups) &&
 		slices.Equal(r.PeerGroups, other.PeerGroups)
 }
@@ -155,6 +184,11 @@ func (r *Route) IsDynamic() bool {
 	return r.NetworkType == DomainNetwork
 }
 
+// IsDefaultRoute returns if the route is a default route, i.e. routes all traffic through an exit node
+func (r *Route) IsDefaultRoute() bool {
+	return !r.IsDynamic() && r.Network.IsValid() && r.Network.Bits() == 0
+}
+
 func (r *Route) GetHAUniqueID() HAUniqueID {
 	if r.IsDynamic() {
 		domains, err := r.Domains.String()
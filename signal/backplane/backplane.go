@@ -0,0 +1,31 @@
+package backplane
+
+import (
+	"context"
+	"errors"
+
+	"github.com/netbirdio/netbird/signal/proto"
+)
+
+// ErrPeerNotConnected is returned when the remote peer of a message is not connected to any Signal instance
+var ErrPeerNotConnected = errors.New("peer is not connected to any signal instance")
+
+// DeliverFunc delivers a message forwarded by another instance to a peer connected to this instance
+type DeliverFunc func(ctx context.Context, msg *proto.EncryptedMessage)
+
+// Backplane connects the Signal server instances. The instances announce the peers connected to them and forward the
+// messages of the peers connected to another instance through the backplane, so the peers can use any instance.
+type Backplane interface {
+	// Start starts receiving the messages forwarded by the other instances and passes them to deliver
+	Start(deliver DeliverFunc) error
+	// PeerConnected announces that the peer is connected to this instance. A newer announcement of the same peer by
+	// another instance takes over.
+	PeerConnected(ctx context.Context, peerID string) error
+	// PeerDisconnected withdraws the announcement of the peer, unless another instance took it over meanwhile
+	PeerDisconnected(ctx context.Context, peerID string) error
+	// Send forwards the message to the instance the remote peer is connected to. It returns ErrPeerNotConnected if the
+	// peer is not connected to any instance.
+	Send(ctx context.Context, msg *proto.EncryptedMessage) error
+	// Close stops the backplane
+	Close() error
+}
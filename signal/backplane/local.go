@@ -0,0 +1,98 @@
+package backplane
+
+import (
+	"context"
+	"sync"
+
+	"github.com/netbirdio/netbird/signal/proto"
+)
+
+// Hub is an in-process backplane shared by the Signal instances running in the same process
+type Hub struct {
+	mu sync.RWMutex
+	// peers are the instances of the connected peers by peer ID
+	peers map[string]*Local
+}
+
+// NewHub creates an in-process backplane
+func NewHub() *Hub {
+	return &Hub{
+		peers: make(map[string]*Local),
+	}
+}
+
+// Join creates the backplane of an instance connected to the hub
+func (h *Hub) Join() *Local {
+	return &Local{hub: h}
+}
+
+// Local is the Backplane of an instance connected to a Hub
+type Local struct {
+	hub *Hub
+
+	mu      sync.RWMutex
+	deliver DeliverFunc
+}
+
+// NewLocal creates the backplane of a standalone instance. It only knows the peers of the instance.
+func NewLocal() *Local {
+	return NewHub().Join()
+}
+
+// Start implements Backplane
+func (l *Local) Start(deliver DeliverFunc) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.deliver = deliver
+	return nil
+}
+
+// PeerConnected implements Backplane
+func (l *Local) PeerConnected(_ context.Context, peerID string) error {
+	l.hub.mu.Lock()
+	defer l.hub.mu.Unlock()
+	l.hub.peers[peerID] = l
+	return nil
+}
+
+// PeerDisconnected implements Backplane
+func (l *Local) PeerDisconnected(_ context.Context, peerID string) error {
+	l.hub.mu.Lock()
+	defer l.hub.mu.Unlock()
+	if l.hub.peers[peerID] == l {
+		delete(l.hub.peers, peerID)
+	}
+	return nil
+}
+
+// Send implements Backplane
+func (l *Local) Send(ctx context.Context, msg *proto.EncryptedMessage) error {
+	l.hub.mu.RLock()
+	instance, ok := l.hub.peers[msg.RemoteKey]
+	l.hub.mu.RUnlock()
+	if !ok {
+		return ErrPeerNotConnected
+	}
+
+	instance.mu.RLock()
+	deliver := instance.deliver
+	instance.mu.RUnlock()
+	if deliver == nil {
+		return ErrPeerNotConnected
+	}
+
+	deliver(ctx, msg)
+	return nil
+}
+
+// Close implements Backplane. It withdraws the announcements of the peers of the instance.
+func (l *Local) Close() error {
+	l.hub.mu.Lock()
+	defer l.hub.mu.Unlock()
+	for peerID, instance := range l.hub.peers {
+		if instance == l {
+			delete(l.hub.peers, peerID)
+		}
+	}
+	return nil
+}
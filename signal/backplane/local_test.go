@@ -0,0 +1,59 @@
+package backplane
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/signal/proto"
+)
+
+func startLocal(t *testing.T, hub *Hub) (*Local, chan *proto.EncryptedMessage) {
+	t.Helper()
+
+	received := make(chan *proto.EncryptedMessage, 1)
+	l := hub.Join()
+	require.NoError(t, l.Start(func(_ context.Context, msg *proto.EncryptedMessage) {
+		received <- msg
+	}))
+	return l, received
+}
+
+func TestLocal_Send(t *testing.T) {
+	ctx := context.Background()
+	hub := NewHub()
+	instanceA, _ := startLocal(t, hub)
+	instanceB, receivedB := startLocal(t, hub)
+
+	msg := &proto.EncryptedMessage{Key: "alice", RemoteKey: "bob"}
+	assert.ErrorIs(t, instanceA.Send(ctx, msg), ErrPeerNotConnected)
+
+	require.NoError(t, instanceB.PeerConnected(ctx, "bob"))
+	require.NoError(t, instanceA.Send(ctx, msg))
+	assert.Equal(t, msg, <-receivedB)
+
+	require.NoError(t, instanceB.PeerDisconnected(ctx, "bob"))
+	assert.ErrorIs(t, instanceA.Send(ctx, msg), ErrPeerNotConnected)
+}
+
+func TestLocal_TakeOver(t *testing.T) {
+	ctx := context.Background()
+	hub := NewHub()
+	instanceA, receivedA := startLocal(t, hub)
+	instanceB, _ := startLocal(t, hub)
+	instanceC, _ := startLocal(t, hub)
+
+	// bob reconnects to instance A before instance B notices the old stream is gone
+	require.NoError(t, instanceB.PeerConnected(ctx, "bob"))
+	require.NoError(t, instanceA.PeerConnected(ctx, "bob"))
+	require.NoError(t, instanceB.PeerDisconnected(ctx, "bob"))
+
+	msg := &proto.EncryptedMessage{Key: "alice", RemoteKey: "bob"}
+	require.NoError(t, instanceC.Send(ctx, msg))
+	assert.Equal(t, msg, <-receivedA)
+
+	require.NoError(t, instanceA.Close())
+	assert.ErrorIs(t, instanceC.Send(ctx, msg), ErrPeerNotConnected)
+}
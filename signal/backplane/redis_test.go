@@ -0,0 +1,90 @@
+package backplane
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+
+	"github.com/netbirdio/netbird/signal/proto"
+)
+
+func startRedis(t *testing.T) string {
+	t.Helper()
+
+	ctx := context.Background()
+	c, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: testcontainers.ContainerRequest{
+			Image:        "redis:7-alpine",
+			ExposedPorts: []string{"6379/tcp"},
+			WaitingFor:   wait.ForLog("Ready to accept connections").WithStartupTimeout(15 * time.Second),
+		},
+		Started: true,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		timeout := 10 * time.Second
+		_ = c.Stop(ctx, &timeout)
+	})
+
+	endpoint, err := c.Endpoint(ctx, "")
+	require.NoError(t, err)
+	return fmt.Sprintf("redis://%s/0", endpoint)
+}
+
+func startRedisBackplane(t *testing.T, url, instanceID string) (*Redis, chan *proto.EncryptedMessage) {
+	t.Helper()
+
+	received := make(chan *proto.EncryptedMessage, 1)
+	r, err := NewRedis(url, instanceID)
+	require.NoError(t, err)
+	require.NoError(t, r.Start(func(_ context.Context, msg *proto.EncryptedMessage) {
+		received <- msg
+	}))
+	t.Cleanup(func() {
+		_ = r.Close()
+	})
+	return r, received
+}
+
+func TestRedis_Send(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skipf("The Redis backplane test is not properly supported by %s yet", runtime.GOOS)
+	}
+
+	ctx := context.Background()
+	url := startRedis(t)
+	instanceA, _ := startRedisBackplane(t, url, "a")
+	instanceB, receivedB := startRedisBackplane(t, url, "b")
+
+	msg := &proto.EncryptedMessage{Key: "alice", RemoteKey: "bob", Body: []byte("body")}
+	assert.ErrorIs(t, instanceA.Send(ctx, msg), ErrPeerNotConnected)
+
+	require.NoError(t, instanceB.PeerConnected(ctx, "bob"))
+	require.NoError(t, instanceA.Send(ctx, msg))
+
+	select {
+	case received := <-receivedB:
+		assert.Equal(t, msg.Key, received.Key)
+		assert.Equal(t, msg.RemoteKey, received.RemoteKey)
+		assert.Equal(t, msg.Body, received.Body)
+	case <-time.After(5 * time.Second):
+		t.Fatal("message was not delivered")
+	}
+
+	// a newer announcement on another instance is not withdrawn by the old one
+	require.NoError(t, instanceA.PeerConnected(ctx, "bob"))
+	require.NoError(t, instanceB.PeerDisconnected(ctx, "bob"))
+	owner, err := instanceA.client.Get(ctx, peerKeyPrefix+"bob").Result()
+	require.NoError(t, err)
+	assert.Equal(t, "a", owner)
+
+	require.NoError(t, instanceA.PeerDisconnected(ctx, "bob"))
+	assert.ErrorIs(t, instanceB.Send(ctx, msg), ErrPeerNotConnected)
+}
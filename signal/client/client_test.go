@@ -16,6 +16,7 @@ import (
 	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/metadata"
 
+	"github.com/netbirdio/netbird/signal/backplane"
 	sigProto "github.com/netbirdio/netbird/signal/proto"
 	"github.com/netbirdio/netbird/signal/server"
 )
@@ -115,6 +116,111 @@ var _ = Describe("GrpcClient", func() {
 		})
 	})
 
+	Describe("Exchanging messages", func() {
+		Context("between peers connected to different instances", func() {
+			It("should be successful", func() {
+				hub := backplane.NewHub()
+				serverB, listenerB := startSignalWithBackplane(hub.Join())
+				defer func() {
+					serverB.Stop()
+					listenerB.Close()
+				}()
+				serverA, listenerA := startSignalWithBackplane(hub.Join())
+				defer func() {
+					serverA.Stop()
+					listenerA.Close()
+				}()
+
+				var msgReceived sync.WaitGroup
+				msgReceived.Add(1)
+				var payloadReceivedOnB string
+
+				keyA, _ := wgtypes.GenerateKey()
+				clientA := createSignalClient(listenerA.Addr().String(), keyA)
+				go func() {
+					_ = clientA.Receive(context.Background(), func(msg *sigProto.Message) error {
+						return nil
+					})
+				}()
+				clientA.WaitStreamConnected()
+
+				keyB, _ := wgtypes.GenerateKey()
+				clientB := createSignalClient(listenerB.Addr().String(), keyB)
+				go func() {
+					_ = clientB.Receive(context.Background(), func(msg *sigProto.Message) error {
+						payloadReceivedOnB = msg.GetBody().GetPayload()
+						msgReceived.Done()
+						return nil
+					})
+				}()
+				clientB.WaitStreamConnected()
+
+				err := clientA.Send(&sigProto.Message{
+					Key:       keyA.PublicKey().String(),
+					RemoteKey: keyB.PublicKey().String(),
+					Body:      &sigProto.Body{Payload: "ping"},
+				})
+				if err != nil {
+					Fail("failed sending a message to PeerB")
+				}
+
+				if waitTimeout(&msgReceived, 3*time.Second) {
+					Fail("test timed out on waiting for the message forwarded by the other instance")
+				}
+
+				Expect(payloadReceivedOnB).To(BeEquivalentTo("ping"))
+			})
+		})
+
+		Context("to a peer that is not connected", func() {
+			It("should report the peer as not connected", func() {
+				keyA, _ := wgtypes.GenerateKey()
+				clientA := createSignalClient(addr, keyA)
+				go func() {
+					_ = clientA.Receive(context.Background(), func(msg *sigProto.Message) error {
+						return nil
+					})
+				}()
+				clientA.WaitStreamConnected()
+
+				keyB, _ := wgtypes.GenerateKey()
+				err := clientA.Send(&sigProto.Message{
+					Key:       keyA.PublicKey().String(),
+					RemoteKey: keyB.PublicKey().String(),
+					Body:      &sigProto.Body{Payload: "ping"},
+				})
+				Expect(err).To(MatchError(ErrPeerNotConnected))
+			})
+		})
+
+		Context("to a reconnecting peer", func() {
+			It("should deliver the message once the peer is back", func() {
+				client := createRawSignalClient(addr)
+				ctxB, cancelB := context.WithCancel(metadata.NewOutgoingContext(context.Background(), metadata.New(map[string]string{sigProto.HeaderId: "peerB"})))
+				streamB, err := client.ConnectStream(ctxB)
+				Expect(err).To(BeNil())
+				_, err = streamB.Header()
+				Expect(err).To(BeNil())
+				cancelB()
+
+				msg := &sigProto.EncryptedMessage{Key: "peerA", RemoteKey: "peerB", Body: []byte("ping")}
+				Eventually(func() sigProto.DeliveryStatus {
+					resp, err := client.Send(context.Background(), msg)
+					Expect(err).To(BeNil())
+					return resp.GetDeliveryStatus()
+				}, 3*time.Second, 100*time.Millisecond).Should(Equal(sigProto.DeliveryStatus_QUEUED))
+
+				ctxB = metadata.NewOutgoingContext(context.Background(), metadata.New(map[string]string{sigProto.HeaderId: "peerB"}))
+				streamB, err = client.ConnectStream(ctxB)
+				Expect(err).To(BeNil())
+
+				received, err := streamB.Recv()
+				Expect(err).To(BeNil())
+				Expect(received.GetBody()).To(BeEquivalentTo("ping"))
+			})
+		})
+	})
+
 	Describe("Connecting to the Signal stream channel", func() {
 		Context("with a signal client", func() {
 			It("should be successful", func() {
@@ -194,12 +300,16 @@ func createRawSignalClient(addr string) sigProto.SignalExchangeClient {
 }
 
 func startSignal() (*grpc.Server, net.Listener) {
+	return startSignalWithBackplane(backplane.NewLocal())
+}
+
+func startSignalWithBackplane(bp backplane.Backplane) (*grpc.Server, net.Listener) {
 	lis, err := net.Listen("tcp", ":0")
 	if err != nil {
 		panic(err)
 	}
 	s := grpc.NewServer()
-	srv, err := server.NewServer(otel.Meter(""))
+	srv, err := server.NewServerWithBackplane(otel.Meter(""), bp)
 	if err != nil {
 		panic(err)
 	}
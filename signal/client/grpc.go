@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"sync"
@@ -22,6 +23,9 @@ import (
 	nbgrpc "github.com/netbirdio/netbird/util/grpc"
 )
 
+// ErrPeerNotConnected is returned by Send when the remote peer is not connected to the Signal Exchange
+var ErrPeerNotConnected = errors.New("remote peer is not connected to signal")
+
 // ConnStateNotifier is a wrapper interface of the status recorder
 type ConnStateNotifier interface {
 	MarkSignalDisconnected(error)
@@ -351,7 +355,8 @@ func (c *GrpcClient) Send(msg *proto.Message) error {
 		}
 		ctx, cancel := context.WithTimeout(c.ctx, attemptTimeout)
 
-		_, err = c.realClient.Send(ctx, encryptedMessage)
+		var resp *proto.EncryptedMessage
+		resp, err = c.realClient.Send(ctx, encryptedMessage)
 
 		cancel()
 
@@ -360,13 +365,25 @@ func (c *GrpcClient) Send(msg *proto.Message) error {
 		}
 
 		if err == nil {
-			return nil
+			return deliveryError(msg, resp.GetDeliveryStatus())
 		}
 	}
 
 	return err
 }
 
+// deliveryError converts the delivery status reported by the Signal Exchange to an error.
+// Older Signal Exchanges don't report the status, in this case the message is considered delivered.
+func deliveryError(msg *proto.Message, deliveryStatus proto.DeliveryStatus) error {
+	switch deliveryStatus {
+	case proto.DeliveryStatus_NOT_CONNECTED:
+		return ErrPeerNotConnected
+	case proto.DeliveryStatus_QUEUED:
+		log.Debugf("remote peer %s is reconnecting, signal message has been queued", msg.RemoteKey)
+	}
+	return nil
+}
+
 // receive receives messages from other peers coming through the Signal Exchange
 func (c *GrpcClient) receive(stream proto.SignalExchange_ConnectStreamClient,
 	msgHandler func(msg *proto.Message) error) error {
@@ -0,0 +1,185 @@
+package mailbox
+
+import (
+	"sync"
+	"time"
+
+	"github.com/netbirdio/netbird/signal/proto"
+)
+
+const (
+	// DefaultTTL is how long the messages of a reconnecting peer are kept
+	DefaultTTL = 30 * time.Second
+	// DefaultMaxMessages is the number of messages kept per peer, the oldest ones are dropped first
+	DefaultMaxMessages = 32
+)
+
+// Message is a kept message with the time it was first queued, the TTL counts from that time
+type Message struct {
+	*proto.EncryptedMessage
+	QueuedAt time.Time
+}
+
+// Mailbox keeps the messages of the peers that are reconnecting to Signal for a short time, so the offers and answers
+// sent meanwhile are not lost. Only the messages of the peers seen within the TTL are kept, a peer that is offline for
+// longer is considered disconnected.
+type Mailbox struct {
+	mu          sync.Mutex
+	ttl         time.Duration
+	maxMessages int
+	// lastSeen is the last time the peers were known to be connected
+	lastSeen map[string]time.Time
+	messages map[string][]Message
+	// taken keeps the queue time of the messages being delivered, a message put back while it's delivered keeps it
+	taken map[*proto.EncryptedMessage]time.Time
+	// expired counts the messages dropped by Take and Requeue since the last Expire
+	expired int
+	now     func() time.Time
+}
+
+// New creates a mailbox that keeps up to maxMessages messages per peer for ttl
+func New(ttl time.Duration, maxMessages int) *Mailbox {
+	return &Mailbox{
+		ttl:         ttl,
+		maxMessages: maxMessages,
+		lastSeen:    make(map[string]time.Time),
+		messages:    make(map[string][]Message),
+		taken:       make(map[*proto.EncryptedMessage]time.Time),
+		now:         time.Now,
+	}
+}
+
+// Seen records that the peer is connected, so the messages sent to it shortly after it disconnects are kept
+func (m *Mailbox) Seen(peerID string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.lastSeen[peerID] = m.now()
+}
+
+// Put keeps the message if its remote peer was seen recently. It returns false if the message was not kept.
+// A message taken from the mailbox keeps the time it was first queued.
+func (m *Mailbox) Put(msg *proto.EncryptedMessage) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if queuedAt, ok := m.taken[msg]; ok {
+		return m.requeue(Message{EncryptedMessage: msg, QueuedAt: queuedAt})
+	}
+
+	now := m.now()
+	lastSeen, ok := m.lastSeen[msg.RemoteKey]
+	if !ok || now.Sub(lastSeen) > m.ttl {
+		return false
+	}
+
+	m.keep(Message{EncryptedMessage: msg, QueuedAt: now})
+	return true
+}
+
+// Requeue keeps a message taken from the mailbox that could not be delivered. The message keeps the time it was first
+// queued, so a message that can never be delivered expires. It returns false if the message expired and was dropped.
+func (m *Mailbox) Requeue(msg Message) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.requeue(msg)
+}
+
+func (m *Mailbox) requeue(msg Message) bool {
+	delete(m.taken, msg.EncryptedMessage)
+	if m.now().Sub(msg.QueuedAt) > m.ttl {
+		m.expired++
+		return false
+	}
+
+	m.keep(msg)
+	return true
+}
+
+// keep adds the message in the order the messages were queued and drops the oldest ones above the limit
+func (m *Mailbox) keep(msg Message) {
+	entries := m.messages[msg.RemoteKey]
+	i := len(entries)
+	for i > 0 && entries[i-1].QueuedAt.After(msg.QueuedAt) {
+		i--
+	}
+	entries = append(entries, Message{})
+	copy(entries[i+1:], entries[i:])
+	entries[i] = msg
+
+	if len(entries) > m.maxMessages {
+		entries = entries[len(entries)-m.maxMessages:]
+	}
+	m.messages[msg.RemoteKey] = entries
+}
+
+// Take removes and returns the kept messages of the peer in the order they were sent
+func (m *Mailbox) Take(peerID string) []Message {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	entries := m.messages[peerID]
+	delete(m.messages, peerID)
+
+	now := m.now()
+	msgs := make([]Message, 0, len(entries))
+	for _, e := range entries {
+		if now.Sub(e.QueuedAt) <= m.ttl {
+			msgs = append(msgs, e)
+			m.taken[e.EncryptedMessage] = e.QueuedAt
+		} else {
+			m.expired++
+		}
+	}
+	return msgs
+}
+
+// Pending returns the IDs of the peers with kept messages
+func (m *Mailbox) Pending() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	peerIDs := make([]string, 0, len(m.messages))
+	for peerID := range m.messages {
+		peerIDs = append(peerIDs, peerID)
+	}
+	return peerIDs
+}
+
+// Expire drops the messages and the peers older than the TTL. It returns the number of messages that expired since
+// the last call, including the ones dropped by Take and Requeue.
+func (m *Mailbox) Expire() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	dropped := m.expired
+	m.expired = 0
+	for peerID, entries := range m.messages {
+		kept := entries[:0]
+		for _, e := range entries {
+			if now.Sub(e.QueuedAt) <= m.ttl {
+				kept = append(kept, e)
+			}
+		}
+		dropped += len(entries) - len(kept)
+		if len(kept) == 0 {
+			delete(m.messages, peerID)
+		} else {
+			m.messages[peerID] = kept
+		}
+	}
+
+	for msg, queuedAt := range m.taken {
+		if now.Sub(queuedAt) > m.ttl {
+			delete(m.taken, msg)
+		}
+	}
+
+	for peerID, lastSeen := range m.lastSeen {
+		if now.Sub(lastSeen) > m.ttl {
+			delete(m.lastSeen, peerID)
+		}
+	}
+	return dropped
+}
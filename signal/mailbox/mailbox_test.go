@@ -0,0 +1,92 @@
+package mailbox
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/netbirdio/netbird/signal/proto"
+)
+
+func messages(taken []Message) []*proto.EncryptedMessage {
+	msgs := make([]*proto.EncryptedMessage, 0, len(taken))
+	for _, msg := range taken {
+		msgs = append(msgs, msg.EncryptedMessage)
+	}
+	return msgs
+}
+
+func TestMailbox_PutOnlyForSeenPeers(t *testing.T) {
+	m := New(time.Minute, DefaultMaxMessages)
+
+	msg := &proto.EncryptedMessage{Key: "alice", RemoteKey: "bob"}
+	assert.False(t, m.Put(msg), "message of an unknown peer should not be kept")
+
+	m.Seen("bob")
+	assert.True(t, m.Put(msg))
+	assert.Equal(t, []string{"bob"}, m.Pending())
+
+	assert.Equal(t, []*proto.EncryptedMessage{msg}, messages(m.Take("bob")))
+	assert.Empty(t, m.Take("bob"))
+	assert.Empty(t, m.Pending())
+}
+
+func TestMailbox_DropsOldestAboveLimit(t *testing.T) {
+	m := New(time.Minute, 2)
+	m.Seen("bob")
+
+	first := &proto.EncryptedMessage{Key: "alice", RemoteKey: "bob", Body: []byte("1")}
+	second := &proto.EncryptedMessage{Key: "alice", RemoteKey: "bob", Body: []byte("2")}
+	third := &proto.EncryptedMessage{Key: "alice", RemoteKey: "bob", Body: []byte("3")}
+	for _, msg := range []*proto.EncryptedMessage{first, second, third} {
+		assert.True(t, m.Put(msg))
+	}
+
+	assert.Equal(t, []*proto.EncryptedMessage{second, third}, messages(m.Take("bob")))
+}
+
+func TestMailbox_Expire(t *testing.T) {
+	ttl := 50 * time.Millisecond
+	m := New(ttl, DefaultMaxMessages)
+	m.Seen("bob")
+	assert.True(t, m.Put(&proto.EncryptedMessage{Key: "alice", RemoteKey: "bob"}))
+
+	time.Sleep(2 * ttl)
+
+	assert.Equal(t, 1, m.Expire())
+	assert.Empty(t, m.Pending())
+	assert.False(t, m.Put(&proto.EncryptedMessage{Key: "alice", RemoteKey: "bob"}), "peer offline longer than the TTL should be considered disconnected")
+}
+
+func TestMailbox_UndeliverableMessageExpires(t *testing.T) {
+	now := time.Now()
+	m := New(DefaultTTL, DefaultMaxMessages)
+	m.now = func() time.Time { return now }
+
+	m.Seen("bob")
+	requeued := &proto.EncryptedMessage{Key: "alice", RemoteKey: "bob", Body: []byte("1")}
+	putBack := &proto.EncryptedMessage{Key: "alice", RemoteKey: "bob", Body: []byte("2")}
+	assert.True(t, m.Put(requeued))
+	assert.True(t, m.Put(putBack))
+
+	// the messages are retried every second while the peer keeps reconnecting, the retries keep the first queue time
+	expired := 0
+	for elapsed := time.Duration(0); elapsed <= DefaultTTL+time.Second; elapsed += time.Second {
+		now = now.Add(time.Second)
+		m.Seen("bob")
+		for _, msg := range m.Take("bob") {
+			if msg.EncryptedMessage == putBack {
+				// the stream of the peer broke while the message was delivered
+				m.Put(msg.EncryptedMessage)
+				continue
+			}
+			m.Requeue(msg)
+		}
+		expired += m.Expire()
+	}
+
+	assert.Equal(t, 2, expired, "the undeliverable messages should expire after the TTL")
+	assert.Empty(t, m.Pending())
+	assert.Empty(t, m.Take("bob"))
+}
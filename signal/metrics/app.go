@@ -20,6 +20,7 @@ type AppMetrics struct {
 	MessagesForwarded      metric.Int64Counter
 	MessageForwardFailures metric.Int64Counter
 	MessageForwardLatency  metric.Float64Histogram
+	MessagesQueued         metric.Int64Counter
 }
 
 func NewAppMetrics(meter metric.Meter) (*AppMetrics, error) {
@@ -77,6 +78,11 @@ func NewAppMetrics(meter metric.Meter) (*AppMetrics, error) {
 		return nil, err
 	}
 
+	messagesQueued, err := meter.Int64Counter("messages_queued_total")
+	if err != nil {
+		return nil, err
+	}
+
 	return &AppMetrics{
 		Meter: meter,
 
@@ -92,6 +98,7 @@ func NewAppMetrics(meter metric.Meter) (*AppMetrics, error) {
 		MessagesForwarded:      messagesForwarded,
 		MessageForwardFailures: messageForwardFailures,
 		MessageForwardLatency:  messageForwardLatency,
+		MessagesQueued:         messagesQueued,
 	}, nil
 }
 
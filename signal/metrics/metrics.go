@@ -23,6 +23,7 @@ type Metrics struct {
 	Endpoint string
 
 	*http.Server
+	router *http.ServeMux
 }
 
 // NewServer initializes and returns a new Metrics instance
@@ -57,9 +58,15 @@ func NewServer(port int, endpoint string) (*Metrics, error) {
 		provider: provider,
 		Endpoint: endpoint,
 		Server:   server,
+		router:   router,
 	}, nil
 }
 
+// Handle registers an additional handler on the metrics server, e.g. a status endpoint
+func (m *Metrics) Handle(pattern string, handler http.Handler) {
+	m.router.Handle(pattern, handler)
+}
+
 // Shutdown stops the metrics server
 func (m *Metrics) Shutdown(ctx context.Context) error {
 	if err := m.Server.Shutdown(ctx); err != nil {
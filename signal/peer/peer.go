@@ -91,8 +91,9 @@ func (registry *Registry) Register(peer *Peer) {
 	registry.metrics.Registrations.Add(context.Background(), 1)
 }
 
-// Deregister Peer from the Registry (usually once it disconnects)
-func (registry *Registry) Deregister(peer *Peer) {
+// Deregister Peer from the Registry (usually once it disconnects). It returns false if a newer stream of the peer is
+// registered.
+func (registry *Registry) Deregister(peer *Peer) bool {
 	registry.regMutex.Lock()
 	defer registry.regMutex.Unlock()
 
@@ -103,10 +104,11 @@ func (registry *Registry) Deregister(peer *Peer) {
 			registry.Peers.Store(peer.Id, p)
 			log.Warnf("attempted to remove newer registered stream of a peer [%s] [newer streamID %d, previous StreamID %d]. Ignoring.",
 				peer.Id, pp.StreamID, peer.StreamID)
-			return
+			return false
 		}
 	}
 	log.Debugf("peer deregistered [%s]", peer.Id)
 
 	registry.metrics.Deregistrations.Add(context.Background(), 1)
+	return true
 }
@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"time"
@@ -15,6 +16,8 @@ import (
 
 	"github.com/netbirdio/signal-dispatcher/dispatcher"
 
+	"github.com/netbirdio/netbird/signal/backplane"
+	"github.com/netbirdio/netbird/signal/mailbox"
 	"github.com/netbirdio/netbird/signal/metrics"
 	"github.com/netbirdio/netbird/signal/peer"
 	"github.com/netbirdio/netbird/signal/proto"
@@ -27,6 +30,8 @@ const (
 	labelTypeNotRegistered = "not_registered"
 	labelTypeStream        = "stream"
 	labelTypeMessage       = "message"
+	labelTypeBackplane     = "backplane"
+	labelTypeExpired       = "expired"
 
 	labelError             = "error"
 	labelErrorMissingId    = "missing_id"
@@ -44,10 +49,26 @@ type Server struct {
 	proto.UnimplementedSignalExchangeServer
 	dispatcher *dispatcher.Dispatcher
 	metrics    *metrics.AppMetrics
+	// backplane forwards the messages of the peers connected to other instances
+	backplane backplane.Backplane
+	// mailbox keeps the messages of the peers that are reconnecting
+	mailbox *mailbox.Mailbox
+
+	ctx    context.Context
+	cancel context.CancelFunc
 }
 
-// NewServer creates a new Signal server
+// mailboxRetryInterval is how often the kept messages are retried, the peer may have reconnected to another instance
+const mailboxRetryInterval = time.Second
+
+// NewServer creates a new standalone Signal server
 func NewServer(meter metric.Meter) (*Server, error) {
+	return NewServerWithBackplane(meter, backplane.NewLocal())
+}
+
+// NewServerWithBackplane creates a new Signal server that exchanges the messages of the peers with the other instances
+// through the backplane
+func NewServerWithBackplane(meter metric.Meter, bp backplane.Backplane) (*Server, error) {
 	appMetrics, err := metrics.NewAppMetrics(meter)
 	if err != nil {
 		return nil, fmt.Errorf("creating app metrics: %v", err)
@@ -58,15 +79,33 @@ func NewServer(meter metric.Meter) (*Server, error) {
 		return nil, fmt.Errorf("creating dispatcher: %v", err)
 	}
 
+	ctx, cancel := context.WithCancel(context.Background())
 	s := &Server{
 		dispatcher: dispatcher,
 		registry:   peer.NewRegistry(appMetrics),
 		metrics:    appMetrics,
+		backplane:  bp,
+		mailbox:    mailbox.New(mailbox.DefaultTTL, mailbox.DefaultMaxMessages),
+		ctx:        ctx,
+		cancel:     cancel,
 	}
 
+	if err := bp.Start(s.forwardMessageToPeer); err != nil {
+		cancel()
+		return nil, fmt.Errorf("starting backplane: %v", err)
+	}
+
+	go s.retryMailbox()
+
 	return s, nil
 }
 
+// Close stops the backplane of the server
+func (s *Server) Close() error {
+	s.cancel()
+	return s.backplane.Close()
+}
+
 // Send forwards a message to the signal peer
 func (s *Server) Send(ctx context.Context, msg *proto.EncryptedMessage) (*proto.EncryptedMessage, error) {
 	log.Debugf("received a new message to send from peer [%s] to peer [%s]", msg.Key, msg.RemoteKey)
@@ -76,12 +115,8 @@ func (s *Server) Send(ctx context.Context, msg *proto.EncryptedMessage) (*proto.
 		return &proto.EncryptedMessage{}, nil
 	}
 
-	if _, found := s.registry.Get(msg.RemoteKey); found {
-		s.forwardMessageToPeer(ctx, msg)
-		return &proto.EncryptedMessage{}, nil
-	}
-
-	return s.dispatcher.SendMessage(context.Background(), msg)
+	deliveryStatus := s.sendMessage(ctx, msg)
+	return &proto.EncryptedMessage{DeliveryStatus: deliveryStatus}, nil
 }
 
 // ConnectStream connects to the exchange stream
@@ -103,6 +138,9 @@ func (s *Server) ConnectStream(stream proto.SignalExchange_ConnectStreamServer)
 
 	log.Debugf("peer connected [%s] [streamID %d] ", p.Id, p.StreamID)
 
+	// deliver the messages sent while the peer was reconnecting
+	go s.flushMailbox(stream.Context(), p.Id)
+
 	for {
 		// read incoming messages
 		msg, err := stream.Recv()
@@ -114,10 +152,7 @@ func (s *Server) ConnectStream(stream proto.SignalExchange_ConnectStreamServer)
 
 		log.Debugf("Received a response from peer [%s] to peer [%s]", msg.Key, msg.RemoteKey)
 
-		_, err = s.dispatcher.SendMessage(stream.Context(), msg)
-		if err != nil {
-			log.Debugf("error while sending message from peer [%s] to peer [%s] %v", msg.Key, msg.RemoteKey, err)
-		}
+		s.sendMessage(stream.Context(), msg)
 	}
 
 	<-stream.Context().Done()
@@ -131,7 +166,11 @@ func (s *Server) RegisterPeer(stream proto.SignalExchange_ConnectStreamServer) (
 			p := peer.NewPeer(id[0], stream)
 
 			s.registry.Register(p)
+			s.mailbox.Seen(p.Id)
 			s.dispatcher.ListenForMessages(stream.Context(), p.Id, s.forwardMessageToPeer)
+			if err := s.backplane.PeerConnected(stream.Context(), p.Id); err != nil {
+				log.Errorf("failed to announce peer [%s] on the backplane: %v", p.Id, err)
+			}
 
 			s.metrics.ActivePeers.Add(stream.Context(), 1)
 
@@ -148,12 +187,91 @@ func (s *Server) RegisterPeer(stream proto.SignalExchange_ConnectStreamServer) (
 
 func (s *Server) DeregisterPeer(p *peer.Peer) {
 	log.Debugf("peer disconnected [%s] [streamID %d] ", p.Id, p.StreamID)
-	s.registry.Deregister(p)
+	if s.registry.Deregister(p) {
+		// the messages sent to the peer are kept for a while in case it reconnects
+		s.mailbox.Seen(p.Id)
+		if err := s.backplane.PeerDisconnected(context.Background(), p.Id); err != nil {
+			log.Errorf("failed to withdraw peer [%s] from the backplane: %v", p.Id, err)
+		}
+	}
 
 	s.metrics.PeerConnectionDuration.Record(p.Stream.Context(), int64(time.Since(p.RegisteredAt).Seconds()))
 	s.metrics.ActivePeers.Add(context.Background(), -1)
 }
 
+// sendMessage forwards the message to the remote peer. The messages of the peers connected to other instances go through
+// the backplane and the messages of the reconnecting peers are kept in the mailbox.
+func (s *Server) sendMessage(ctx context.Context, msg *proto.EncryptedMessage) proto.DeliveryStatus {
+	if s.deliver(ctx, msg) {
+		return proto.DeliveryStatus_DELIVERED
+	}
+	return s.queueMessage(ctx, msg)
+}
+
+// deliver passes the message to the remote peer connected to this or another instance. It returns false if the peer
+// is not connected or its stream is broken.
+func (s *Server) deliver(ctx context.Context, msg *proto.EncryptedMessage) bool {
+	if dstPeer, found := s.registry.Get(msg.RemoteKey); found {
+		return s.sendToPeer(ctx, dstPeer, msg)
+	}
+
+	err := s.backplane.Send(ctx, msg)
+	if err == nil {
+		s.mailbox.Seen(msg.RemoteKey)
+		return true
+	}
+	if !errors.Is(err, backplane.ErrPeerNotConnected) {
+		log.Warnf("error while forwarding message from peer [%s] to peer [%s] through the backplane: %v", msg.Key, msg.RemoteKey, err)
+		s.metrics.MessageForwardFailures.Add(ctx, 1, metric.WithAttributes(attribute.String(labelType, labelTypeBackplane)))
+	}
+	return false
+}
+
+// queueMessage keeps the message in the mailbox if the remote peer is reconnecting, otherwise the message is dropped
+func (s *Server) queueMessage(ctx context.Context, msg *proto.EncryptedMessage) proto.DeliveryStatus {
+	if s.mailbox.Put(msg) {
+		log.Debugf("peer [%s] is reconnecting, keeping the message from peer [%s]", msg.RemoteKey, msg.Key)
+		s.metrics.MessagesQueued.Add(ctx, 1)
+		return proto.DeliveryStatus_QUEUED
+	}
+
+	s.metrics.MessageForwardFailures.Add(ctx, 1, metric.WithAttributes(attribute.String(labelType, labelTypeNotConnected)))
+	log.Debugf("message from peer [%s] can't be forwarded to peer [%s] because destination peer is not connected", msg.Key, msg.RemoteKey)
+	return proto.DeliveryStatus_NOT_CONNECTED
+}
+
+// flushMailbox delivers the kept messages of the peer. The messages that still can't be delivered are kept.
+func (s *Server) flushMailbox(ctx context.Context, peerID string) {
+	for _, msg := range s.mailbox.Take(peerID) {
+		if !s.deliver(ctx, msg.EncryptedMessage) {
+			s.mailbox.Requeue(msg)
+		}
+	}
+}
+
+// retryMailbox periodically retries the kept messages, the remote peer may have reconnected to another instance, and
+// drops the expired ones
+func (s *Server) retryMailbox() {
+	ticker := time.NewTicker(mailboxRetryInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if dropped := s.mailbox.Expire(); dropped > 0 {
+			s.metrics.MessageForwardFailures.Add(s.ctx, int64(dropped), metric.WithAttributes(attribute.String(labelType, labelTypeExpired)))
+		}
+
+		for _, peerID := range s.mailbox.Pending() {
+			s.flushMailbox(s.ctx, peerID)
+		}
+	}
+}
+
 func (s *Server) forwardMessageToPeer(ctx context.Context, msg *proto.EncryptedMessage) {
 	log.Debugf("forwarding a new message from peer [%s] to peer [%s]", msg.Key, msg.RemoteKey)
 
@@ -162,21 +280,28 @@ func (s *Server) forwardMessageToPeer(ctx context.Context, msg *proto.EncryptedM
 	// lookup the target peer where the message is going to
 	if dstPeer, found := s.registry.Get(msg.RemoteKey); found {
 		s.metrics.GetRegistrationDelay.Record(ctx, float64(time.Since(getRegistrationStart).Nanoseconds())/1e6, metric.WithAttributes(attribute.String(labelType, labelTypeStream), attribute.String(labelRegistrationStatus, labelRegistrationFound)))
-		start := time.Now()
-		// forward the message to the target peer
-		if err := dstPeer.Stream.Send(msg); err != nil {
-			log.Warnf("error while forwarding message from peer [%s] to peer [%s] %v", msg.Key, msg.RemoteKey, err)
-			// todo respond to the sender?
-			s.metrics.MessageForwardFailures.Add(ctx, 1, metric.WithAttributes(attribute.String(labelType, labelTypeError)))
-		} else {
-			// in milliseconds
-			s.metrics.MessageForwardLatency.Record(ctx, float64(time.Since(start).Nanoseconds())/1e6, metric.WithAttributes(attribute.String(labelType, labelTypeStream)))
-			s.metrics.MessagesForwarded.Add(ctx, 1)
+		if !s.sendToPeer(ctx, dstPeer, msg) {
+			s.queueMessage(ctx, msg)
 		}
 	} else {
 		s.metrics.GetRegistrationDelay.Record(ctx, float64(time.Since(getRegistrationStart).Nanoseconds())/1e6, metric.WithAttributes(attribute.String(labelType, labelTypeStream), attribute.String(labelRegistrationStatus, labelRegistrationNotFound)))
-		s.metrics.MessageForwardFailures.Add(ctx, 1, metric.WithAttributes(attribute.String(labelType, labelTypeNotConnected)))
-		log.Debugf("message from peer [%s] can't be forwarded to peer [%s] because destination peer is not connected", msg.Key, msg.RemoteKey)
-		// todo respond to the sender?
+		s.queueMessage(ctx, msg)
+	}
+}
+
+// sendToPeer sends the message on the stream of the peer connected to this instance. It returns false if the stream is
+// broken, the peer is likely reconnecting then, so it is marked as seen for its messages to be kept in the mailbox.
+func (s *Server) sendToPeer(ctx context.Context, dstPeer *peer.Peer, msg *proto.EncryptedMessage) bool {
+	start := time.Now()
+	if err := dstPeer.Stream.Send(msg); err != nil {
+		log.Warnf("error while forwarding message from peer [%s] to peer [%s] %v", msg.Key, msg.RemoteKey, err)
+		s.metrics.MessageForwardFailures.Add(ctx, 1, metric.WithAttributes(attribute.String(labelType, labelTypeError)))
+		s.mailbox.Seen(msg.RemoteKey)
+		return false
 	}
+
+	// in milliseconds
+	s.metrics.MessageForwardLatency.Record(ctx, float64(time.Since(start).Nanoseconds())/1e6, metric.WithAttributes(attribute.String(labelType, labelTypeStream)))
+	s.metrics.MessagesForwarded.Add(ctx, 1)
+	return true
 }